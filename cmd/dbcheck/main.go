@@ -0,0 +1,61 @@
+// dbcheck スキーマを適用する前に、既存のデータが新しいスキーマの制約を満たしているか確認する
+// 満たしていない行を報告して終了コード1で終了する（task db:migrate などが psqldef の前に実行する）
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/config"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+	db, err := infrastructure.NewDB(infrastructure.Config{
+		Host:     cfg.Database.Host,
+		Port:     cfg.Database.Port,
+		User:     cfg.Database.User,
+		Password: cfg.Database.Password,
+		DBName:   cfg.Database.DBName,
+		SSLMode:  cfg.Database.SSLMode,
+	})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = db.Close() }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	// users の (organization_id, lower(email)) 一意インデックスは、大文字小文字だけが異なる重複があると作成できない
+	duplicates, err := infrastructure.FindDuplicateUserEmails(ctx, db)
+	if err != nil {
+		return err
+	}
+	if len(duplicates) == 0 {
+		fmt.Println("no duplicate user emails")
+		return nil
+	}
+	for _, d := range duplicates {
+		users := make([]string, len(d.UserIDs))
+		for i, id := range d.UserIDs {
+			users[i] = fmt.Sprintf("%s (%s)", id, d.Emails[i])
+		}
+		fmt.Fprintf(os.Stderr, "organization %s: %s is used by %s\n", d.OrganizationID, d.Email, strings.Join(users, ", "))
+	}
+	return fmt.Errorf("found %d emails that differ only in case within an organization; change or delete all but one user of each before applying the schema", len(duplicates))
+}
@@ -2,17 +2,27 @@ package main
 
 import (
 	"context"
+	"database/sql"
+	"expvar"
+	"fmt"
 	"log/slog"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
 
+	"github.com/example/go-react-cqrs-template/internal/command"
 	"github.com/example/go-react-cqrs-template/internal/config"
+	"github.com/example/go-react-cqrs-template/internal/domain"
 	"github.com/example/go-react-cqrs-template/internal/handler"
+	"github.com/example/go-react-cqrs-template/internal/handler/graphql"
+	"github.com/example/go-react-cqrs-template/internal/handler/inbound"
 	handlermw "github.com/example/go-react-cqrs-template/internal/handler/middleware"
+	"github.com/example/go-react-cqrs-template/internal/handler/rpc"
 	"github.com/example/go-react-cqrs-template/internal/handler/validation"
 	"github.com/example/go-react-cqrs-template/internal/infrastructure"
 	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
@@ -38,6 +48,13 @@ func main() {
 		os.Exit(1)
 	}
 
+	// ユーザーログのハッシュチェーンに使うキー（未設定・公開済みのキーでは起動しない）
+	userLogHashKey, err := domain.NewUserLogHashKey(cfg.Audit.UserLogHashKey)
+	if err != nil {
+		log.Error("invalid USER_LOG_HASH_KEY", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+
 	// データベース接続設定
 	dbConfig := infrastructure.Config{
 		Host:     cfg.Database.Host,
@@ -72,16 +89,178 @@ func main() {
 
 	log.Info("successfully connected to database")
 
+	// リードレプリカへの振り分け（正常なレプリカがない場合はプライマリから読む）
+	replicas, err := openReplicas(dbConfig, cfg.Replica.Hosts)
+	if err != nil {
+		log.Error("failed to open read replicas", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	readDB := infrastructure.NewReplicaRouter(db, replicas, infrastructure.ReplicaConfig{
+		HealthCheckInterval: time.Duration(cfg.Replica.HealthCheckSeconds) * time.Second,
+		MaxLag:              time.Duration(cfg.Replica.MaxLagSeconds) * time.Second,
+	}, log)
+	readDB.Start(context.Background())
+	defer func() {
+		if closeErr := readDB.Close(); closeErr != nil {
+			log.Error("failed to close read replicas", slog.String("error", closeErr.Error()))
+		}
+	}()
+
+	log.Info("read replicas configured",
+		slog.Int("replicas", len(replicas)),
+		slog.Int("max_lag_seconds", cfg.Replica.MaxLagSeconds),
+		slog.Int("read_your_writes_seconds", cfg.Replica.ReadYourWritesSeconds),
+	)
+
+	// ユーザーをイベントストアに保存するかどうか（ワーカーと同じ設定にする）
+	userEventSourcing := command.UserEventSourcing{
+		Enabled:          cfg.EventSourcing.Users,
+		SnapshotInterval: cfg.EventSourcing.SnapshotInterval,
+	}
+	if userEventSourcing.Enabled {
+		log.Info("user event sourcing enabled", slog.Int("snapshot_interval", cfg.EventSourcing.SnapshotInterval))
+	}
+
 	// 各層の初期化
 	txManager := infrastructure.NewTransactionManager(db)
-	userQueryService := queryservice.NewUserQueryService(db)
+	// コミット後に配信されるドメインイベントを記録（永続的な購読者はワーカーがアウトボックスから配信する）
+	txManager.Subscribe(func(ctx context.Context, event domain.DomainEvent) {
+		logger.FromContext(ctx).Debug("domain event published",
+			slog.String("event_type", event.EventType()),
+			slog.String("aggregate_id", event.AggregateID()),
+		)
+	})
+	// 読み取りモデルを使う場合は、ワーカーの投影を待たずにコミットしたユーザーの一覧の行を更新する（自分の変更がすぐに一覧に反映されるように）
+	if cfg.ReadModel.Users {
+		txManager.Subscribe(func(ctx context.Context, event domain.DomainEvent) {
+			err := txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+				return command.RefreshUserSummary(ctx, tx, event.AggregateID())
+			})
+			if err != nil {
+				logger.FromContext(ctx).Warn("failed to refresh user summary (the worker projection will retry)",
+					slog.String("aggregate_id", event.AggregateID()),
+					slog.String("error", err.Error()),
+				)
+			}
+		}, domain.EventTypeUserCreated, domain.EventTypeUserRenamed, domain.EventTypeUserEmailChanged, domain.EventTypeUserEmailVerified, domain.EventTypeUserEmailInvalidated, domain.EventTypeUserDeleted)
+		log.Info("user read model enabled")
+	}
+	// ユーザー・ログ・インポート・監査イベントの参照はレプリカから読む
+	// 認証に使うAPIキー・セッション・組織は、失効・権限の変更をすぐに反映するためプライマリから読む
+	userQueryService := queryservice.NewUserQueryService(readDB)
+	// IDでのユーザーの検索をキャッシュし、コミットされたユーザーのイベントで削除する（USER_CACHE_SIZE が0の場合は使わない）
+	var (
+		userQuery       usecase.UserQueryRepository = userQueryService
+		cachedUserQuery *queryservice.CachedUserQueryService
+	)
+	if cfg.Cache.UserSize > 0 {
+		cachedUserQuery = queryservice.NewCachedUserQueryService(userQueryService,
+			queryservice.NewLRUUserCache(cfg.Cache.UserSize, time.Duration(cfg.Cache.UserTTLSeconds)*time.Second))
+		txManager.Subscribe(cachedUserQuery.Invalidate)
+		expvar.Publish("user_query_cache", expvar.Func(func() any { return cachedUserQuery.Stats() }))
+		userQuery = cachedUserQuery
+		log.Info("user cache enabled",
+			slog.Int("size", cfg.Cache.UserSize),
+			slog.Int("ttl_seconds", cfg.Cache.UserTTLSeconds),
+		)
+	}
+	userSummaryQueryService := queryservice.NewUserSummaryQueryService(readDB, cfg.ReadModel.Users)
+	userLogQueryService := queryservice.NewUserLogQueryService(readDB)
+	userImportQueryService := queryservice.NewUserImportQueryService(readDB)
+	auditEventQueryService := queryservice.NewAuditEventQueryService(readDB)
+	apiKeyQueryService := queryservice.NewAPIKeyQueryService(db)
+	sessionQueryService := queryservice.NewSessionQueryService(db)
+	organizationQueryService := queryservice.NewOrganizationQueryService(db)
+	changeQueryService := queryservice.NewChangeQueryService(db)
+	webhookQueryService := queryservice.NewWebhookQueryService(db)
+	inboundEventQueryService := queryservice.NewInboundEventQueryService(db)
+
+	// 変更通知の LISTEN（ほかのサーバー・ワーカーのコマンドによる変更も受け取る）
+	changeListener := infrastructure.NewChangeListener(dbConfig, db, time.Duration(cfg.ChangeFeed.RetentionMinutes)*time.Minute, log)
+	if err := changeListener.Start(context.Background()); err != nil {
+		log.Error("failed to start change listener", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	defer func() {
+		if closeErr := changeListener.Close(); closeErr != nil {
+			log.Error("failed to close change listener", slog.String("error", closeErr.Error()))
+		}
+	}()
+
+	// ほかのサーバー・ワーカーで変更されたユーザーを、変更通知を受け取ってキャッシュから削除する
+	if cachedUserQuery != nil {
+		wake, unsubscribe := changeListener.SubscribeAll()
+		defer unsubscribe()
+		go cachedUserQuery.FollowChanges(context.Background(), changeQueryService, wake)
+	}
+
+	log.Info("change feed configured",
+		slog.Int("retention_minutes", cfg.ChangeFeed.RetentionMinutes),
+		slog.Int("heartbeat_seconds", cfg.ChangeFeed.HeartbeatSeconds),
+	)
+
+	// 既定の組織を作成（X-Organization-ID ヘッダーを指定しないリクエストのテナントとなる）
+	if err := usecase.NewEnsureDefaultOrganizationUsecase(txManager).Execute(context.Background()); err != nil {
+		log.Error("failed to ensure default organization", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+
+	// ログインの連続失敗によるアカウントロック（失敗回数はPostgreSQLに保存し、すべてのサーバーで共有する）
+	loginLockout := domain.LoginLockoutPolicy{
+		MaxFailures: cfg.Session.LoginMaxFailures,
+		Lockout:     time.Duration(cfg.Session.LoginLockoutMinutes) * time.Minute,
+	}
+
+	// 保存するメールアドレスの正規化（ワーカーのインポート処理と同じ設定にする）
+	emailNormalization := domain.EmailNormalization{LowercaseLocalPart: cfg.Email.LowercaseLocalPart}
 
 	// Usecases
-	createUserUsecase := usecase.NewCreateUserUsecase(userQueryService, txManager)
-	findUserUsecase := usecase.NewFindUserUsecase(userQueryService)
-	listUsersUsecase := usecase.NewListUsersUsecase(userQueryService)
-	updateUserUsecase := usecase.NewUpdateUserUsecase(userQueryService, txManager)
-	deleteUserUsecase := usecase.NewDeleteUserUsecase(userQueryService, txManager)
+	createUserUsecase := usecase.NewCreateUserUsecase(userQuery, txManager, userEventSourcing, userLogHashKey, emailNormalization)
+	findUserUsecase := usecase.NewFindUserUsecase(userQuery)
+	listUsersUsecase := usecase.NewListUsersUsecase(userSummaryQueryService)
+	updateUserUsecase := usecase.NewUpdateUserUsecase(userQuery, txManager, userEventSourcing, userLogHashKey, emailNormalization)
+	deleteUserUsecase := usecase.NewDeleteUserUsecase(userQuery, txManager, userEventSourcing, userLogHashKey)
+	exportUsersUsecase := usecase.NewExportUsersUsecase(userQuery)
+	listUserLogsUsecase := usecase.NewListUserLogsUsecase(userLogQueryService, userQuery)
+	listUserLogsByUserIDsUsecase := usecase.NewListUserLogsByUserIDsUsecase(userLogQueryService)
+	processUserImportUsecase := usecase.NewProcessUserImportUsecase(txManager, userEventSourcing, userLogHashKey, emailNormalization)
+	importUsersUsecase := usecase.NewImportUsersUsecase(txManager, processUserImportUsecase)
+	findUserImportUsecase := usecase.NewFindUserImportUsecase(userImportQueryService)
+	listUserImportRowsUsecase := usecase.NewListUserImportRowsUsecase(userImportQueryService)
+	listAuditEventsUsecase := usecase.NewListAuditEventsUsecase(auditEventQueryService)
+	verifyUserLogChainUsecase := usecase.NewVerifyUserLogChainUsecase(txManager, userLogHashKey)
+	createAPIKeyUsecase := usecase.NewCreateAPIKeyUsecase(txManager)
+	findAPIKeyUsecase := usecase.NewFindAPIKeyUsecase(apiKeyQueryService)
+	listAPIKeysUsecase := usecase.NewListAPIKeysUsecase(apiKeyQueryService)
+	revokeAPIKeyUsecase := usecase.NewRevokeAPIKeyUsecase(txManager)
+	authenticateAPIKeyUsecase := usecase.NewAuthenticateAPIKeyUsecase(apiKeyQueryService, txManager)
+	changeUserPasswordUsecase := usecase.NewChangeUserPasswordUsecase(txManager, userEventSourcing)
+	loginUsecase := usecase.NewLoginUsecase(txManager, userEventSourcing, loginLockout, time.Duration(cfg.Session.TTLHours)*time.Hour)
+	logoutUsecase := usecase.NewLogoutUsecase(txManager)
+	listSessionsUsecase := usecase.NewListSessionsUsecase(sessionQueryService)
+	revokeSessionUsecase := usecase.NewRevokeSessionUsecase(txManager)
+	authenticateSessionUsecase := usecase.NewAuthenticateSessionUsecase(sessionQueryService, userQuery, organizationQueryService, txManager)
+	requestEmailVerificationUsecase := usecase.NewRequestEmailVerificationUsecase(userQuery, txManager)
+	verifyEmailUsecase := usecase.NewVerifyEmailUsecase(txManager, userEventSourcing)
+	requestPasswordResetUsecase := usecase.NewRequestPasswordResetUsecase(userQuery, txManager)
+	resetPasswordUsecase := usecase.NewResetPasswordUsecase(txManager, userEventSourcing)
+	resolveTenantUsecase := usecase.NewResolveTenantUsecase(organizationQueryService)
+	createOrganizationUsecase := usecase.NewCreateOrganizationUsecase(txManager)
+	listOrganizationsUsecase := usecase.NewListOrganizationsUsecase(organizationQueryService)
+	findCurrentOrganizationUsecase := usecase.NewFindCurrentOrganizationUsecase(organizationQueryService)
+	listMembersUsecase := usecase.NewListMembersUsecase(organizationQueryService)
+	setMemberRolesUsecase := usecase.NewSetMemberRolesUsecase(txManager)
+	removeMemberUsecase := usecase.NewRemoveMemberUsecase(txManager)
+	streamChangesUsecase := usecase.NewStreamChangesUsecase(changeQueryService, changeListener)
+	createWebhookUsecase := usecase.NewCreateWebhookUsecase(txManager)
+	findWebhookUsecase := usecase.NewFindWebhookUsecase(webhookQueryService)
+	listWebhooksUsecase := usecase.NewListWebhooksUsecase(webhookQueryService)
+	updateWebhookUsecase := usecase.NewUpdateWebhookUsecase(txManager)
+	deleteWebhookUsecase := usecase.NewDeleteWebhookUsecase(txManager)
+	listWebhookDeliveriesUsecase := usecase.NewListWebhookDeliveriesUsecase(webhookQueryService)
+	receiveInboundEventsUsecase := usecase.NewReceiveInboundEventsUsecase(txManager)
+	listInboundEventsUsecase := usecase.NewListInboundEventsUsecase(inboundEventQueryService)
+	replayInboundEventUsecase := usecase.NewReplayInboundEventUsecase(txManager)
 
 	userHandler := handler.NewUserHandler(
 		createUserUsecase,
@@ -89,7 +268,61 @@ func main() {
 		listUsersUsecase,
 		updateUserUsecase,
 		deleteUserUsecase,
+		changeUserPasswordUsecase,
+		requestEmailVerificationUsecase,
+		exportUsersUsecase,
+		listUserLogsUsecase,
+		importUsersUsecase,
+		findUserImportUsecase,
+		listUserImportRowsUsecase,
 	)
+	auditEventHandler := handler.NewAuditEventHandler(listAuditEventsUsecase, verifyUserLogChainUsecase)
+	apiKeyHandler := handler.NewAPIKeyHandler(createAPIKeyUsecase, findAPIKeyUsecase, listAPIKeysUsecase, revokeAPIKeyUsecase)
+	authHandler := handler.NewAuthHandler(
+		loginUsecase,
+		logoutUsecase,
+		listSessionsUsecase,
+		revokeSessionUsecase,
+		verifyEmailUsecase,
+		requestPasswordResetUsecase,
+		resetPasswordUsecase,
+		cfg.Session.CookieSecure,
+	)
+	organizationHandler := handler.NewOrganizationHandler(
+		createOrganizationUsecase,
+		listOrganizationsUsecase,
+		findCurrentOrganizationUsecase,
+		listMembersUsecase,
+		setMemberRolesUsecase,
+		removeMemberUsecase,
+	)
+	eventHandler := handler.NewEventHandler(streamChangesUsecase, time.Duration(cfg.ChangeFeed.HeartbeatSeconds)*time.Second)
+	webhookHandler := handler.NewWebhookHandler(
+		createWebhookUsecase,
+		findWebhookUsecase,
+		listWebhooksUsecase,
+		updateWebhookUsecase,
+		deleteWebhookUsecase,
+		listWebhookDeliveriesUsecase,
+	)
+
+	// Webhookを受信するプロバイダー（シークレットが設定されているもののみ受け付ける）
+	var inboundProviders []inbound.Provider
+	if emailSecrets := splitAndTrim(cfg.Inbound.EmailSecrets); len(emailSecrets) > 0 {
+		inboundProviders = append(inboundProviders, inbound.Provider{
+			Name:     "email",
+			Verifier: inbound.NewHMACVerifier(emailSecrets...),
+			Parser:   inbound.ParseEmailEvents,
+		})
+	}
+	inboundRegistry := inbound.NewRegistry(inboundProviders...)
+	inboundWebhookHandler := handler.NewInboundWebhookHandler(
+		inboundRegistry,
+		receiveInboundEventsUsecase,
+		listInboundEventsUsecase,
+		replayInboundEventUsecase,
+	)
+	log.Info("inbound webhooks configured", slog.Any("providers", inboundRegistry.Names()))
 
 	// CORSオリジンの解析（カンマ区切りで複数指定可能）
 	corsOrigins := strings.Split(cfg.Server.CORSOrigins, ",")
@@ -103,8 +336,8 @@ func main() {
 	r.Use(cors.Handler(cors.Options{
 		AllowedOrigins:   corsOrigins,
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type"},
-		ExposedHeaders:   []string{"Link"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "Last-Event-ID", handlermw.APIKeyHeader, handlermw.IdempotencyKeyHeader, handlermw.TenantHeader, "Connect-Protocol-Version", "Connect-Timeout-Ms", "Grpc-Timeout", "X-Grpc-Web", "X-User-Agent"},
+		ExposedHeaders:   []string{"Content-Disposition", "Link", "Location", handlermw.IdempotentReplayedHeader, "Grpc-Status", "Grpc-Message", "Grpc-Status-Details-Bin"},
 		AllowCredentials: true,
 		MaxAge:           300,
 	}))
@@ -132,6 +365,50 @@ func main() {
 		slog.Bool("trust_x_forwarded_for", rateLimitConfig.TrustXForwardedFor),
 	)
 
+	// Idempotency-Keyミドルウェアの初期化
+	idempotencyConfig := handlermw.DefaultIdempotencyConfig()
+	if cfg.Idempotency.TTLHours > 0 {
+		idempotencyConfig.TTL = time.Duration(cfg.Idempotency.TTLHours) * time.Hour
+	}
+	idempotency := handlermw.NewIdempotency(infrastructure.NewIdempotencyStore(db), idempotencyConfig)
+	defer idempotency.Stop()
+
+	log.Info("idempotency configured",
+		slog.Duration("ttl", idempotencyConfig.TTL),
+	)
+
+	// 認証ミドルウェアの初期化（JWT・APIキー・セッションCookieのいずれでも認証できる）
+	jwtKeys, err := loadJWTKeys(cfg.Auth)
+	if err != nil {
+		log.Error("failed to load JWT keys", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	jwtAuth := handlermw.NewJWTAuthenticator(handlermw.JWTConfig{
+		Keys:     jwtKeys,
+		Issuer:   cfg.Auth.JWTIssuer,
+		Audience: cfg.Auth.JWTAudience,
+	})
+	apiKeyAuth := handlermw.NewAPIKeyAuthenticator(authenticateAPIKeyUsecase)
+	sessionAuth := handlermw.NewSessionAuthenticator(handler.SessionCookieName, cfg.Session.CookieSecure, authenticateSessionUsecase)
+	anonymousRoles := domain.ParseRoles(strings.Split(cfg.Auth.AnonymousRoles, ","))
+	authentication := handlermw.Authentication(handlermw.AuthenticationConfig{
+		Required:       cfg.Auth.Required,
+		AnonymousRoles: anonymousRoles,
+	}, jwtAuth, apiKeyAuth, sessionAuth)
+
+	log.Info("authentication configured",
+		slog.Bool("jwt_keys_configured", !jwtKeys.Empty()),
+		slog.Bool("required", cfg.Auth.Required),
+		slog.Any("anonymous_roles", anonymousRoles),
+		slog.Int("login_max_failures", cfg.Session.LoginMaxFailures),
+		slog.Duration("login_lockout", loginLockout.Lockout),
+	)
+	if !cfg.Auth.Required && len(anonymousRoles) > 0 {
+		log.Warn("requests without credentials are granted roles; use only for local development",
+			slog.Any("anonymous_roles", anonymousRoles),
+		)
+	}
+
 	// ヘルスチェックエンドポイント（バリデーション・レートリミット不要）
 	healthHandler := handler.NewHealthHandler(db)
 	r.Get("/healthz", healthHandler.Liveness)
@@ -147,14 +424,71 @@ func main() {
 	}
 	log.Info("OpenAPI validation middleware initialized")
 
+	// GraphQLの読み取りAPI（REST APIと同じユースケースで解決する）
+	graphqlHandler, err := graphql.NewHandler(findUserUsecase, listUsersUsecase, listUserLogsByUserIDsUsecase)
+	if err != nil {
+		log.Error("failed to create graphql handler",
+			slog.String("error", err.Error()),
+		)
+		os.Exit(1)
+	}
+
+	// protobufで定義したUserService（Connect・gRPC・gRPC-Web。REST APIと同じユースケースを呼び出す）
+	userServer := rpc.NewUserServer(
+		createUserUsecase,
+		findUserUsecase,
+		listUsersUsecase,
+		updateUserUsecase,
+		deleteUserUsecase,
+		listUserLogsUsecase,
+	)
+	userServicePath, userServiceHandler := userServer.Handler()
+
 	// OpenAPI生成のハンドラーを使用してAPIルートを設定
 	r.Route("/api/v1", func(r chi.Router) {
 		// レートリミット（ヘルスチェック以外に適用）
 		r.Use(rateLimiter.Handler)
-		// OpenAPI仕様に基づくリクエストバリデーション
-		r.Use(validationMiddleware.Handler)
-		// OpenAPI仕様に従ったルーティングを自動生成
-		openapi.HandlerFromMux(userHandler, r)
+		// 監査イベントに記録するクライアント情報をコンテキストに設定
+		r.Use(handlermw.RequestMetadata(rateLimitConfig.TrustXForwardedFor))
+
+		// 外部のサービスからのWebhookの受信（認証情報の代わりにプロバイダーの署名で認証する）
+		r.Post("/inbound-webhooks/{provider}", func(w http.ResponseWriter, r *http.Request) {
+			inboundWebhookHandler.ReceiveInboundWebhook(w, r, chi.URLParam(r, "provider"))
+		})
+
+		r.Group(func(r chi.Router) {
+			// コマンドと、コマンドの直後のクライアントの参照をプライマリから読む
+			r.Use(handlermw.ReadYourWrites(handlermw.ReadYourWritesConfig{
+				Window:       time.Duration(cfg.Replica.ReadYourWritesSeconds) * time.Second,
+				CookieSecure: cfg.Session.CookieSecure,
+			}))
+			// JWT・APIキー・セッションCookieによる認証（操作の主体をコンテキストに設定）
+			r.Use(authentication)
+			// 操作対象の組織（テナント）を決定し、コンテキストに設定
+			r.Use(handlermw.Tenant(resolveTenantUsecase))
+			// OpenAPI仕様に基づくリクエストバリデーション
+			r.Use(validationMiddleware.Handler)
+			// Idempotency-Keyによる再送リクエストの重複実行防止
+			r.Use(idempotency.Handler)
+			// GraphQLの読み取りAPI（OpenAPI仕様の対象外のため、リクエストのバリデーションは各リゾルバーが行う）
+			r.Post("/graphql", graphqlHandler.ServeHTTP)
+			// OpenAPI仕様に従ったルーティングを自動生成
+			openapi.HandlerFromMux(handler.NewServer(userHandler, auditEventHandler, apiKeyHandler, authHandler, organizationHandler, eventHandler, webhookHandler, inboundWebhookHandler), r)
+		})
+	})
+
+	// RPC（gRPCのクライアントが使えるよう、パッケージ名から始まるパスでルートに登録する）
+	r.Group(func(r chi.Router) {
+		r.Use(rateLimiter.Handler)
+		r.Use(handlermw.RequestMetadata(rateLimitConfig.TrustXForwardedFor))
+		r.Use(handlermw.ReadYourWrites(handlermw.ReadYourWritesConfig{
+			Window:       time.Duration(cfg.Replica.ReadYourWritesSeconds) * time.Second,
+			CookieSecure: cfg.Session.CookieSecure,
+		}))
+		r.Use(authentication)
+		r.Use(handlermw.Tenant(resolveTenantUsecase))
+		// リクエストのバリデーションは各メソッドが行う（OpenAPI仕様の対象外）
+		r.Mount(userServicePath, userServiceHandler)
 	})
 
 	// シグナルハンドリングの設定
@@ -163,7 +497,26 @@ func main() {
 
 	// サーバー起動
 	port := cfg.Server.Port
-	srv := &http.Server{Addr: ":" + port, Handler: r}
+	// gRPCのクライアント向けに、TLSなしのHTTP/2（h2c）も受け付ける
+	protocols := new(http.Protocols)
+	protocols.SetHTTP1(true)
+	protocols.SetUnencryptedHTTP2(true)
+	srv := &http.Server{Addr: ":" + port, Handler: r, Protocols: protocols}
+
+	// キャッシュのヒット率などのメトリクス（expvar）は、APIとは別の内部向けのリスナーで提供する
+	var debugSrv *http.Server
+	if cfg.Server.DebugAddr != "" {
+		debugMux := http.NewServeMux()
+		debugMux.Handle("GET /debug/vars", expvar.Handler())
+		debugSrv = &http.Server{Addr: cfg.Server.DebugAddr, Handler: debugMux}
+		go func() {
+			log.Info("debug server starting", slog.String("address", cfg.Server.DebugAddr))
+			if err := debugSrv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				log.Error("debug server error", slog.String("error", err.Error()))
+				os.Exit(1)
+			}
+		}()
+	}
 
 	go func() {
 		log.Info("server starting",
@@ -188,8 +541,82 @@ func main() {
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
 	defer cancel()
 
+	// 配信中のイベントストリームを終了させ、Shutdown が接続の終了を待ち続けないようにする
+	if err := changeListener.Close(); err != nil {
+		log.Error("failed to close change listener", slog.String("error", err.Error()))
+	}
 	if err := srv.Shutdown(shutdownCtx); err != nil {
 		log.Error("server shutdown error", slog.String("error", err.Error()))
 	}
+	if debugSrv != nil {
+		if err := debugSrv.Shutdown(shutdownCtx); err != nil {
+			log.Error("debug server shutdown error", slog.String("error", err.Error()))
+		}
+	}
 	log.Info("server stopped")
 }
+
+// loadJWTKeys 設定からJWTの検証に使う鍵を読み込む
+func loadJWTKeys(cfg config.AuthConfig) (*handlermw.JWTKeys, error) {
+	keys := handlermw.NewJWTKeys()
+	if cfg.JWTHS256Secret != "" {
+		keys.AddHMACSecret("", []byte(cfg.JWTHS256Secret))
+	}
+	if cfg.JWTRS256PublicKeyFile != "" {
+		data, err := os.ReadFile(cfg.JWTRS256PublicKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		if err := keys.AddRSAPublicKeyPEM("", data); err != nil {
+			return nil, err
+		}
+	}
+	if cfg.JWKSFile != "" {
+		data, err := os.ReadFile(cfg.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		if err := keys.AddJWKS(data); err != nil {
+			return nil, err
+		}
+	}
+	return keys, nil
+}
+
+// openReplicas DB_REPLICA_HOSTS（host または host:port のカンマ区切り）のリードレプリカの接続を作成
+// ユーザー・パスワード・データベース名はプライマリと同じものを使い、接続は確認しない（ReplicaRouter が確認する）
+func openReplicas(primary infrastructure.Config, hosts string) (map[string]*sql.DB, error) {
+	replicas := make(map[string]*sql.DB)
+	for _, host := range strings.Split(hosts, ",") {
+		host = strings.TrimSpace(host)
+		if host == "" {
+			continue
+		}
+		replicaConfig := primary
+		replicaConfig.Host = host
+		if h, p, err := net.SplitHostPort(host); err == nil {
+			port, err := strconv.Atoi(p)
+			if err != nil {
+				return nil, fmt.Errorf("invalid replica port %q: %w", host, err)
+			}
+			replicaConfig.Host, replicaConfig.Port = h, port
+		}
+		db, err := infrastructure.OpenDB(replicaConfig)
+		if err != nil {
+			return nil, err
+		}
+		replicas[host] = db
+	}
+	return replicas, nil
+}
+
+// splitAndTrim カンマ区切りの値を前後の空白を除いて分割する（空の要素は含めない）
+func splitAndTrim(s string) []string {
+	var values []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
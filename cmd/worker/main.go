@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"flag"
 	"log/slog"
 	"os"
 	"os/signal"
@@ -10,14 +11,27 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
 	"github.com/example/go-react-cqrs-template/internal/infrastructure"
 	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
 	"github.com/example/go-react-cqrs-template/internal/worker"
 )
 
 func main() {
+	rebuildProjection := flag.String("rebuild-projection", "", "起動時に作り直す投影の名前（例: user_summaries）")
+	flag.Parse()
+
 	log := logger.Setup()
 
+	// ユーザーログのハッシュチェーンに使うキー（サーバーと同じキーを使う。未設定・公開済みのキーでは起動しない）
+	userLogHashKey, err := domain.NewUserLogHashKey(os.Getenv("USER_LOG_HASH_KEY"))
+	if err != nil {
+		log.Error("invalid USER_LOG_HASH_KEY", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+
 	// データベース接続設定
 	dbConfig := infrastructure.Config{
 		Host:     getEnv("DB_HOST", "localhost"),
@@ -49,6 +63,20 @@ func main() {
 
 	log.Info("successfully connected to database")
 
+	// ユーザーをイベントストアに保存するかどうか（サーバーと同じ設定にする）
+	userEventSourcing := command.UserEventSourcing{
+		Enabled:          getEnv("USER_EVENT_SOURCING", "false") == "true",
+		SnapshotInterval: getEnvInt("USER_SNAPSHOT_INTERVAL", 20),
+	}
+	if userEventSourcing.Enabled {
+		log.Info("user event sourcing enabled", slog.Int("snapshot_interval", userEventSourcing.SnapshotInterval))
+	}
+
+	// 保存するメールアドレスの正規化（サーバーと同じ設定にする）
+	emailNormalization := domain.EmailNormalization{
+		LowercaseLocalPart: getEnv("EMAIL_LOWERCASE_LOCAL_PART", "false") == "true",
+	}
+
 	txManager := infrastructure.NewTransactionManager(db)
 
 	// ワーカー設定
@@ -57,15 +85,61 @@ func main() {
 		BatchSize:       getEnvInt("WORKER_BATCH_SIZE", 10),
 		MaxConcurrency:  getEnvInt("WORKER_MAX_CONCURRENCY", 5),
 		ShutdownTimeout: getDurationEnv("WORKER_SHUTDOWN_TIMEOUT", 30*time.Second),
+		// ロック中の記録を削除しないよう、サーバーと同じロックの時間を使う
+		LoginFailureRetention: time.Duration(getEnvInt("LOGIN_LOCKOUT_MINUTES", 15)) * time.Minute,
 	}
 
+	// メール送信設定（SMTP_HOST が未設定の場合はメールを送信せずログに出力する）
+	mailerConfig := infrastructure.MailerConfig{
+		Host:     getEnv("SMTP_HOST", ""),
+		Port:     getEnvInt("SMTP_PORT", 587),
+		Username: getEnv("SMTP_USERNAME", ""),
+		Password: getEnv("SMTP_PASSWORD", ""),
+		From:     getEnv("MAIL_FROM", "no-reply@example.com"),
+	}
+	var mailer usecase.Mailer = infrastructure.NewLogMailer()
+	if mailerConfig.Host != "" {
+		mailer = infrastructure.NewSMTPMailer(mailerConfig)
+	}
+	appBaseURL := getEnv("APP_BASE_URL", "http://localhost:3000")
+
+	log.Info("mailer configured",
+		slog.String("smtp_host", mailerConfig.Host),
+		slog.String("from", mailerConfig.From),
+		slog.String("app_base_url", appBaseURL),
+	)
+
+	// Webhook送信設定（ローカル開発で手元の受信側へ送る場合は WEBHOOK_ALLOW_PRIVATE_NETWORKS=true にする）
+	webhookSenderConfig := infrastructure.WebhookSenderConfig{
+		Timeout:              getDurationEnv("WEBHOOK_TIMEOUT", 10*time.Second),
+		AllowPrivateNetworks: getEnv("WEBHOOK_ALLOW_PRIVATE_NETWORKS", "false") == "true",
+	}
+	webhookSender := infrastructure.NewHTTPWebhookSender(webhookSenderConfig)
+
+	log.Info("webhook sender configured",
+		slog.Duration("timeout", webhookSenderConfig.Timeout),
+		slog.Bool("allow_private_networks", webhookSenderConfig.AllowPrivateNetworks),
+	)
+
 	// ジョブハンドラーの登録
 	registry := worker.NewRegistry()
-	registerHandlers(registry, log)
+	registerHandlers(registry, txManager, mailer, webhookSender, appBaseURL, userEventSourcing, userLogHashKey, emailNormalization, log)
 
 	// ワーカーの作成と起動
 	w := worker.NewWorker(txManager, registry, workerConfig, log)
 
+	// 指定された投影を作り直す（読み取りモデルを初期状態に戻し、アウトボックスの先頭から読み直す）
+	if *rebuildProjection != "" {
+		if err := w.RebuildProjection(context.Background(), *rebuildProjection); err != nil {
+			log.Error("failed to rebuild projection",
+				slog.String("projection", *rebuildProjection),
+				slog.String("error", err.Error()),
+			)
+			os.Exit(1)
+		}
+		log.Info("projection reset for rebuild", slog.String("projection", *rebuildProjection))
+	}
+
 	// グレースフルシャットダウン
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -92,7 +166,7 @@ func main() {
 }
 
 // registerHandlers ジョブハンドラーを登録
-func registerHandlers(registry *worker.Registry, log *slog.Logger) {
+func registerHandlers(registry *worker.Registry, txManager *infrastructure.TransactionManager, mailer usecase.Mailer, webhookSender usecase.WebhookSender, appBaseURL string, userEventSourcing command.UserEventSourcing, userLogHashKey domain.UserLogHashKey, emailNormalization domain.EmailNormalization, log *slog.Logger) {
 	// サンプル: ウェルカムメール送信ハンドラー
 	registry.RegisterFunc("send_welcome_email", func(ctx context.Context, payload json.RawMessage) error {
 		var data struct {
@@ -111,6 +185,71 @@ func registerHandlers(registry *worker.Registry, log *slog.Logger) {
 		// TODO: 実際のメール送信処理を実装
 		return nil
 	})
+
+	// ユーザー一括インポート処理ハンドラー
+	processUserImport := usecase.NewProcessUserImportUsecase(txManager, userEventSourcing, userLogHashKey, emailNormalization)
+	registry.RegisterFunc(usecase.ProcessUserImportJobType, func(ctx context.Context, payload json.RawMessage) error {
+		var data usecase.ProcessUserImportPayload
+		if err := json.Unmarshal(payload, &data); err != nil {
+			return err
+		}
+		return processUserImport.Execute(ctx, data.ImportID)
+	})
+
+	// メール確認・パスワード再設定メール送信ハンドラー
+	sendUserTokenEmail := usecase.NewSendUserTokenEmailUsecase(txManager, userEventSourcing, mailer, appBaseURL)
+	userTokenPurposes := map[string]domain.UserTokenPurpose{
+		usecase.SendEmailVerificationJobType: domain.UserTokenPurposeEmailVerification,
+		usecase.SendPasswordResetJobType:     domain.UserTokenPurposePasswordReset,
+	}
+	for jobType, purpose := range userTokenPurposes {
+		registry.RegisterFunc(jobType, func(ctx context.Context, payload json.RawMessage) error {
+			var data usecase.SendUserTokenEmailPayload
+			if err := json.Unmarshal(payload, &data); err != nil {
+				return err
+			}
+			return sendUserTokenEmail.Execute(ctx, data.UserID, purpose)
+		})
+	}
+
+	// Webhook配信ハンドラー
+	deliverWebhook := usecase.NewDeliverWebhookUsecase(txManager, webhookSender)
+	registry.RegisterFunc(usecase.DeliverWebhookJobType, func(ctx context.Context, payload json.RawMessage) error {
+		var data usecase.DeliverWebhookPayload
+		if err := json.Unmarshal(payload, &data); err != nil {
+			return err
+		}
+		return deliverWebhook.Execute(ctx, data)
+	})
+
+	// 受信Webhookのイベント処理ハンドラー（処理のないイベントの種類は対象外として記録する）
+	processInboundEvent := usecase.NewProcessInboundEventUsecase(txManager, map[string]usecase.InboundEventProcessor{
+		domain.InboundEventTypeEmailHardBounced: usecase.NewInvalidateBouncedEmail(userEventSourcing),
+	})
+	registry.RegisterFunc(usecase.ProcessInboundEventJobType, func(ctx context.Context, payload json.RawMessage) error {
+		var data usecase.ProcessInboundEventPayload
+		if err := json.Unmarshal(payload, &data); err != nil {
+			return err
+		}
+		return processInboundEvent.Execute(ctx, data)
+	})
+
+	// 読み取りモデルの投影
+	registry.RegisterProjection(worker.NewUserSummaryProjection())
+
+	// Webhookを購読しているイベントの配信ジョブを登録する
+	enqueueWebhookDeliveries := usecase.NewEnqueueWebhookDeliveriesUsecase(txManager)
+	registry.SubscribeFunc(enqueueWebhookDeliveries.Execute, domain.WebhookEventTypes...)
+
+	// サンプル: アウトボックスから配信されるドメインイベントの購読者
+	registry.SubscribeFunc(func(ctx context.Context, event *domain.OutboxEvent) error {
+		log.Info("domain event received (stub)",
+			slog.String("event_id", event.ID),
+			slog.String("event_type", event.EventType),
+			slog.String("aggregate_id", event.AggregateID),
+		)
+		return nil
+	}, domain.EventTypeUserCreated, domain.EventTypeUserEmailChanged, domain.EventTypeUserDeleted)
 }
 
 func getEnv(key, defaultValue string) string {
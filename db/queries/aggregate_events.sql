@@ -0,0 +1,28 @@
+-- name: AppendAggregateEvent :exec
+INSERT INTO aggregate_events (aggregate_type, aggregate_id, sequence, organization_id, event_type, payload, occurred_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: ListAggregateEvents :many
+-- 指定した連番より後のイベントを連番順に取得する
+SELECT aggregate_type, aggregate_id, sequence, organization_id, event_type, payload, occurred_at, created_at
+FROM aggregate_events
+WHERE organization_id = sqlc.arg(organization_id)
+  AND aggregate_type = sqlc.arg(aggregate_type)
+  AND aggregate_id = sqlc.arg(aggregate_id)
+  AND sequence > sqlc.arg(after_sequence)
+ORDER BY sequence ASC;
+
+-- name: GetAggregateSnapshot :one
+SELECT aggregate_type, aggregate_id, organization_id, sequence, state, created_at
+FROM aggregate_snapshots
+WHERE organization_id = sqlc.arg(organization_id)
+  AND aggregate_type = sqlc.arg(aggregate_type)
+  AND aggregate_id = sqlc.arg(aggregate_id);
+
+-- name: UpsertAggregateSnapshot :exec
+-- 古いスナップショットで新しいものを上書きしない
+INSERT INTO aggregate_snapshots (aggregate_type, aggregate_id, organization_id, sequence, state, created_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (aggregate_type, aggregate_id) DO UPDATE
+SET sequence = EXCLUDED.sequence, state = EXCLUDED.state, created_at = EXCLUDED.created_at
+WHERE aggregate_snapshots.sequence < EXCLUDED.sequence;
@@ -0,0 +1,42 @@
+-- name: CreateAPIKey :exec
+INSERT INTO api_keys (id, organization_id, name, prefix, secret_hash, scopes, expires_at, created_by, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+
+-- name: GetAPIKeyByID :one
+SELECT id, organization_id, name, prefix, secret_hash, scopes, expires_at, last_used_at, revoked_at, created_by, created_at
+FROM api_keys
+WHERE organization_id = sqlc.arg(organization_id) AND id = sqlc.arg(id);
+
+-- name: GetAPIKeyByIDForUpdate :one
+SELECT id, organization_id, name, prefix, secret_hash, scopes, expires_at, last_used_at, revoked_at, created_by, created_at
+FROM api_keys
+WHERE organization_id = sqlc.arg(organization_id) AND id = sqlc.arg(id)
+FOR UPDATE;
+
+-- name: GetAPIKeyByPrefix :one
+SELECT id, organization_id, name, prefix, secret_hash, scopes, expires_at, last_used_at, revoked_at, created_by, created_at
+FROM api_keys
+WHERE prefix = $1;
+
+-- name: ListAPIKeys :many
+SELECT id, organization_id, name, prefix, secret_hash, scopes, expires_at, last_used_at, revoked_at, created_by, created_at
+FROM api_keys
+WHERE organization_id = sqlc.arg(organization_id)
+  AND (sqlc.arg(include_revoked)::boolean OR revoked_at IS NULL)
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountAPIKeys :one
+SELECT COUNT(*) FROM api_keys
+WHERE organization_id = sqlc.arg(organization_id)
+  AND (sqlc.arg(include_revoked)::boolean OR revoked_at IS NULL);
+
+-- name: RevokeAPIKey :exec
+UPDATE api_keys SET revoked_at = sqlc.arg(revoked_at)
+WHERE organization_id = sqlc.arg(organization_id) AND id = sqlc.arg(id);
+
+-- name: TouchAPIKeyLastUsed :exec
+-- 書き込みを減らすため、前回の記録から1分以上経っている場合のみ更新する
+UPDATE api_keys SET last_used_at = sqlc.arg(used_at)
+WHERE id = sqlc.arg(id)
+  AND (last_used_at IS NULL OR last_used_at < sqlc.arg(used_at)::timestamp - INTERVAL '1 minute');
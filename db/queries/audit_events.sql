@@ -0,0 +1,26 @@
+-- name: CreateAuditEvent :exec
+INSERT INTO audit_events (id, organization_id, aggregate_type, aggregate_id, action, actor, request_id, metadata, payload, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
+
+-- name: ListAuditEvents :many
+SELECT id, organization_id, aggregate_type, aggregate_id, action, actor, request_id, metadata, payload, created_at
+FROM audit_events
+WHERE organization_id = sqlc.arg(organization_id)
+  AND (sqlc.narg(aggregate_type)::varchar IS NULL OR aggregate_type = sqlc.narg(aggregate_type))
+  AND (sqlc.narg(aggregate_id)::varchar IS NULL OR aggregate_id = sqlc.narg(aggregate_id))
+  AND (sqlc.narg(action)::varchar IS NULL OR action = sqlc.narg(action))
+  AND (sqlc.narg(actor)::varchar IS NULL OR actor = sqlc.narg(actor))
+  AND (sqlc.narg(since)::timestamp IS NULL OR created_at >= sqlc.narg(since))
+  AND (sqlc.narg(until)::timestamp IS NULL OR created_at < sqlc.narg(until))
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountAuditEvents :one
+SELECT COUNT(*) FROM audit_events
+WHERE organization_id = sqlc.arg(organization_id)
+  AND (sqlc.narg(aggregate_type)::varchar IS NULL OR aggregate_type = sqlc.narg(aggregate_type))
+  AND (sqlc.narg(aggregate_id)::varchar IS NULL OR aggregate_id = sqlc.narg(aggregate_id))
+  AND (sqlc.narg(action)::varchar IS NULL OR action = sqlc.narg(action))
+  AND (sqlc.narg(actor)::varchar IS NULL OR actor = sqlc.narg(actor))
+  AND (sqlc.narg(since)::timestamp IS NULL OR created_at >= sqlc.narg(since))
+  AND (sqlc.narg(until)::timestamp IS NULL OR created_at < sqlc.narg(until));
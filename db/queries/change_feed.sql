@@ -0,0 +1,63 @@
+-- name: ReserveChangeIDs :many
+-- 変更通知のIDを先に採番する
+-- CreateChange の settle_xid を採番より後の文で記録し、採番より前に始まったトランザクションを必ず含めるため
+SELECT nextval(pg_get_serial_sequence('change_feed', 'id'))::bigint AS id
+FROM generate_series(1, sqlc.arg(count)::integer);
+
+-- name: CreateChange :exec
+-- ReserveChangeIDs で採番したIDで保存する（settle_xid は既定値で記録する）
+INSERT INTO change_feed (id, organization_id, aggregate_type, aggregate_id, change_type, occurred_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: NotifyChangeFeed :exec
+-- 変更をほかのサーバーのリスナーに通知する（コミット時に配信される）
+SELECT pg_notify('change_feed', sqlc.arg(organization_id)::text);
+
+-- name: ListChangesAfter :many
+-- 指定したIDより後の変更を古い順に取得する
+-- settled が偽の変更は、より小さいIDの変更がまだコミットされる可能性があるため、その変更から先は読み進めない
+SELECT id, organization_id, aggregate_type, aggregate_id, change_type, occurred_at, created_at,
+    (settle_xid <= pg_snapshot_xmin(pg_current_snapshot()))::boolean AS settled
+FROM change_feed
+WHERE organization_id = sqlc.arg(organization_id) AND id > sqlc.arg(after_id)
+ORDER BY id ASC
+LIMIT sqlc.arg('limit');
+
+-- name: ListChangesAfterInAllOrganizations :many
+-- すべての組織の、指定したIDより後の変更を古い順に取得する（キャッシュの削除に使う、settled は ListChangesAfter と同じ）
+SELECT id, organization_id, aggregate_type, aggregate_id, change_type, occurred_at, created_at,
+    (settle_xid <= pg_snapshot_xmin(pg_current_snapshot()))::boolean AS settled
+FROM change_feed
+WHERE id > sqlc.arg(after_id)
+ORDER BY id ASC
+LIMIT sqlc.arg('limit');
+
+-- name: GetLatestChangeID :one
+-- 組織の最新の確定した変更のID（変更がない場合は0）
+-- 確定していない変更より前で止め、それより小さいIDでまだコミットされていない変更を読み飛ばさないようにする
+SELECT COALESCE(MAX(id), 0)::bigint AS id
+FROM change_feed
+WHERE change_feed.organization_id = sqlc.arg(organization_id)
+    AND change_feed.id < COALESCE((
+        SELECT MIN(pending.id) FROM change_feed pending
+        WHERE pending.organization_id = sqlc.arg(organization_id)
+            AND pending.settle_xid > pg_snapshot_xmin(pg_current_snapshot())
+    ), 9223372036854775807);
+
+-- name: GetLatestChangeIDInAllOrganizations :one
+-- すべての組織の最新の確定した変更のID（変更がない場合は0、確定の扱いは GetLatestChangeID と同じ）
+SELECT COALESCE(MAX(id), 0)::bigint AS id
+FROM change_feed
+WHERE id < COALESCE((
+    SELECT MIN(pending.id) FROM change_feed pending
+    WHERE pending.settle_xid > pg_snapshot_xmin(pg_current_snapshot())
+), 9223372036854775807);
+
+-- name: GetOldestChangeID :one
+-- 保持している最も古い変更のID（変更がない場合は0）
+SELECT COALESCE(MIN(id), 0)::bigint AS id
+FROM change_feed;
+
+-- name: DeleteChangesBefore :execrows
+DELETE FROM change_feed
+WHERE created_at < $1;
@@ -0,0 +1,42 @@
+-- name: AcquireIdempotencyKey :execrows
+-- 期限切れのキーと、同じリクエストでリースが切れた処理中のキーは上書きする
+INSERT INTO idempotency_keys (idempotency_key, fingerprint, created_at, expires_at, lease_id, locked_until)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (idempotency_key) DO UPDATE SET
+    fingerprint = EXCLUDED.fingerprint,
+    status_code = NULL,
+    response_headers = '{}',
+    response_body = NULL,
+    created_at = EXCLUDED.created_at,
+    completed_at = NULL,
+    expires_at = EXCLUDED.expires_at,
+    lease_id = EXCLUDED.lease_id,
+    locked_until = EXCLUDED.locked_until
+WHERE idempotency_keys.expires_at <= EXCLUDED.created_at
+    OR (idempotency_keys.completed_at IS NULL
+        AND idempotency_keys.fingerprint = EXCLUDED.fingerprint
+        AND idempotency_keys.locked_until <= EXCLUDED.created_at);
+
+-- name: GetIdempotencyKey :one
+SELECT idempotency_key, fingerprint, status_code, response_headers, response_body,
+       created_at, completed_at, expires_at, lease_id, locked_until
+FROM idempotency_keys
+WHERE idempotency_key = $1;
+
+-- name: ExtendIdempotencyKeyLease :execrows
+-- 処理中のキーのリースを延長する（ほかのリクエストに引き継がれた場合は影響行数が0になる）
+UPDATE idempotency_keys
+SET locked_until = $3
+WHERE idempotency_key = $1 AND lease_id = $2 AND completed_at IS NULL;
+
+-- name: CompleteIdempotencyKey :execrows
+-- リースを持つリクエストのレスポンスを記録する（ほかのリクエストに引き継がれた場合は影響行数が0になる）
+UPDATE idempotency_keys
+SET status_code = $3, response_headers = $4, response_body = $5, completed_at = NOW(), lease_id = NULL, locked_until = NULL
+WHERE idempotency_key = $1 AND lease_id = $2;
+
+-- name: DeleteIdempotencyKey :exec
+DELETE FROM idempotency_keys WHERE idempotency_key = $1 AND lease_id = $2;
+
+-- name: DeleteExpiredIdempotencyKeys :execrows
+DELETE FROM idempotency_keys WHERE expires_at <= $1;
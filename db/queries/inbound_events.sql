@@ -0,0 +1,38 @@
+-- name: CreateInboundEvent :execrows
+-- 同じプロバイダーの同じイベントIDを受信済みの場合は何もしない（影響行数が0になる）
+INSERT INTO inbound_events (id, organization_id, provider, provider_event_id, event_type, subject, payload, status, received_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (provider, provider_event_id) DO NOTHING;
+
+-- name: GetInboundEventByID :one
+SELECT id, organization_id, provider, provider_event_id, event_type, subject, payload, status, last_error, received_at, processed_at
+FROM inbound_events
+WHERE organization_id = sqlc.arg(organization_id) AND id = sqlc.arg(id);
+
+-- name: GetInboundEventByIDForUpdate :one
+SELECT id, organization_id, provider, provider_event_id, event_type, subject, payload, status, last_error, received_at, processed_at
+FROM inbound_events
+WHERE organization_id = sqlc.arg(organization_id) AND id = sqlc.arg(id)
+FOR UPDATE;
+
+-- name: ListInboundEvents :many
+SELECT id, organization_id, provider, provider_event_id, event_type, subject, payload, status, last_error, received_at, processed_at
+FROM inbound_events
+WHERE organization_id = sqlc.arg(organization_id)
+  AND (sqlc.narg(provider)::varchar IS NULL OR provider = sqlc.narg(provider))
+  AND (sqlc.narg(status)::varchar IS NULL OR status = sqlc.narg(status))
+ORDER BY received_at DESC, id DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountInboundEvents :one
+SELECT COUNT(*) FROM inbound_events
+WHERE organization_id = sqlc.arg(organization_id)
+  AND (sqlc.narg(provider)::varchar IS NULL OR provider = sqlc.narg(provider))
+  AND (sqlc.narg(status)::varchar IS NULL OR status = sqlc.narg(status));
+
+-- name: UpdateInboundEventStatus :exec
+UPDATE inbound_events
+SET status = sqlc.arg(status),
+    last_error = sqlc.arg(last_error),
+    processed_at = sqlc.arg(processed_at)
+WHERE organization_id = sqlc.arg(organization_id) AND id = sqlc.arg(id);
@@ -1,9 +1,9 @@
 -- name: EnqueueJob :exec
-INSERT INTO jobs (id, job_type, payload, status, max_attempts, scheduled_at, created_at, updated_at)
-VALUES ($1, $2, $3, 'pending', $4, $5, $6, $7);
+INSERT INTO jobs (id, job_type, organization_id, payload, status, max_attempts, scheduled_at, created_at, updated_at)
+VALUES ($1, $2, $3, $4, 'pending', $5, $6, $7, $8);
 
 -- name: FetchJobs :many
-SELECT id, job_type, payload, status, attempts, max_attempts, last_error,
+SELECT id, job_type, organization_id, payload, status, attempts, max_attempts, last_error,
        scheduled_at, started_at, completed_at, created_at, updated_at
 FROM jobs
 WHERE status IN ('pending', 'retryable')
@@ -33,13 +33,13 @@ SET status = 'dead', last_error = $2, completed_at = NOW(), updated_at = NOW()
 WHERE id = $1;
 
 -- name: GetJobByID :one
-SELECT id, job_type, payload, status, attempts, max_attempts, last_error,
+SELECT id, job_type, organization_id, payload, status, attempts, max_attempts, last_error,
        scheduled_at, started_at, completed_at, created_at, updated_at
 FROM jobs
 WHERE id = $1;
 
 -- name: ListJobsByStatus :many
-SELECT id, job_type, payload, status, attempts, max_attempts, last_error,
+SELECT id, job_type, organization_id, payload, status, attempts, max_attempts, last_error,
        scheduled_at, started_at, completed_at, created_at, updated_at
 FROM jobs
 WHERE status = $1
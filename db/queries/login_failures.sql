@@ -0,0 +1,27 @@
+-- name: CreateLoginFailuresIfNotExists :exec
+-- 記録のないメールアドレスでも行ロックで同時のログインを順に処理できるよう、失敗回数0の行を作成する
+-- （同じ行を同時に作成しようとしたトランザクションは、先に作成したトランザクションの終了を待つ）
+INSERT INTO login_failures (organization_id, email, failures, last_failed_at)
+VALUES (sqlc.arg(organization_id), sqlc.arg(email), 0, sqlc.arg(last_failed_at))
+ON CONFLICT (organization_id, email) DO NOTHING;
+
+-- name: GetLoginFailuresForUpdate :one
+SELECT organization_id, email, failures, last_failed_at
+FROM login_failures
+WHERE organization_id = sqlc.arg(organization_id) AND email = sqlc.arg(email)
+FOR UPDATE;
+
+-- name: UpsertLoginFailures :exec
+INSERT INTO login_failures (organization_id, email, failures, last_failed_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (organization_id, email) DO UPDATE SET
+    failures = EXCLUDED.failures,
+    last_failed_at = EXCLUDED.last_failed_at;
+
+-- name: DeleteLoginFailures :exec
+DELETE FROM login_failures
+WHERE organization_id = sqlc.arg(organization_id) AND email = sqlc.arg(email);
+
+-- name: DeleteStaleLoginFailures :execrows
+-- ロックの時間より前に最後に失敗した記録を削除する（ロック中の記録は削除されない）
+DELETE FROM login_failures WHERE last_failed_at < sqlc.arg(before);
@@ -0,0 +1,52 @@
+-- name: CreateOrganization :exec
+INSERT INTO organizations (id, name, slug, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: EnsureOrganization :exec
+-- 存在しない場合のみ作成する（既定の組織の作成に使用）
+INSERT INTO organizations (id, name, slug, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT DO NOTHING;
+
+-- name: GetOrganizationByID :one
+SELECT id, name, slug, created_at, updated_at
+FROM organizations
+WHERE id = $1;
+
+-- name: ListOrganizationsByMember :many
+SELECT o.id, o.name, o.slug, o.created_at, o.updated_at
+FROM organizations o
+JOIN organization_memberships m ON m.organization_id = o.id
+WHERE m.user_id = sqlc.arg(user_id)
+ORDER BY o.name ASC, o.id ASC;
+
+-- name: GetMembership :one
+SELECT organization_id, user_id, roles, created_at, updated_at
+FROM organization_memberships
+WHERE organization_id = $1 AND user_id = $2;
+
+-- name: GetMembershipForUpdate :one
+SELECT organization_id, user_id, roles, created_at, updated_at
+FROM organization_memberships
+WHERE organization_id = $1 AND user_id = $2
+FOR UPDATE;
+
+-- name: ListMemberships :many
+SELECT organization_id, user_id, roles, created_at, updated_at
+FROM organization_memberships
+WHERE organization_id = sqlc.arg(organization_id)
+ORDER BY created_at ASC, user_id ASC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountMemberships :one
+SELECT COUNT(*) FROM organization_memberships WHERE organization_id = $1;
+
+-- name: UpsertMembership :exec
+INSERT INTO organization_memberships (organization_id, user_id, roles, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (organization_id, user_id) DO UPDATE SET
+    roles = EXCLUDED.roles,
+    updated_at = EXCLUDED.updated_at;
+
+-- name: DeleteMembership :exec
+DELETE FROM organization_memberships WHERE organization_id = $1 AND user_id = $2;
@@ -0,0 +1,35 @@
+-- name: CreateOutboxEvent :exec
+INSERT INTO outbox_events (id, organization_id, aggregate_type, aggregate_id, event_type, payload, occurred_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: FetchPendingOutboxEvents :many
+-- 配信されていないイベントを記録順に取得しロックする（複数のワーカーで同じイベントを配信しない）
+SELECT id, organization_id, aggregate_type, aggregate_id, event_type, payload, occurred_at,
+       published_at, attempts, last_error, created_at, transaction_id
+FROM outbox_events
+WHERE published_at IS NULL
+ORDER BY id ASC
+LIMIT $1
+FOR UPDATE SKIP LOCKED;
+
+-- name: MarkOutboxEventPublished :exec
+UPDATE outbox_events
+SET published_at = $2
+WHERE id = $1;
+
+-- name: MarkOutboxEventFailed :exec
+UPDATE outbox_events
+SET attempts = attempts + 1, last_error = $2
+WHERE id = $1;
+
+-- name: ListOutboxEventsAfter :many
+-- 投影が読み進めるイベントを (transaction_id, id) の順に取得する（配信済みかどうかに関係なく）
+-- 実行中のトランザクションのうち最も古いものより前のトランザクションが記録したイベントのみを返す
+-- （それ以降のトランザクションはまだコミットされうるため、チェックポイントより前のイベントが後から現れないようにする）
+SELECT id, organization_id, aggregate_type, aggregate_id, event_type, payload, occurred_at,
+       published_at, attempts, last_error, created_at, transaction_id
+FROM outbox_events
+WHERE (transaction_id, id) > (sqlc.arg(after_transaction_id)::bigint, sqlc.arg(after_id)::text)
+    AND transaction_id < pg_snapshot_xmin(pg_current_snapshot())::text::bigint
+ORDER BY transaction_id ASC, id ASC
+LIMIT sqlc.arg('limit');
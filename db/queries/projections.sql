@@ -0,0 +1,58 @@
+-- name: InitProjectionCheckpoint :execrows
+-- 初めて実行する投影のチェックポイントを作成する（作成した場合は影響行数が1になる）
+INSERT INTO projection_checkpoints (name, last_event_id, updated_at)
+VALUES ($1, '', $2)
+ON CONFLICT (name) DO NOTHING;
+
+-- name: GetProjectionCheckpointForUpdate :one
+-- 同じ投影を複数のワーカーで同時に進めないよう行ロックする
+SELECT last_transaction_id, last_event_id FROM projection_checkpoints WHERE name = $1 FOR UPDATE;
+
+-- name: UpdateProjectionCheckpoint :exec
+UPDATE projection_checkpoints
+SET last_transaction_id = $2, last_event_id = $3, updated_at = $4
+WHERE name = $1;
+
+-- name: RefreshUserSummary :execrows
+-- ユーザーとユーザーログの現在の状態から一覧の行を作り直す（ユーザーが存在しない場合は影響行数が0になる）
+INSERT INTO user_summaries (id, organization_id, name, email, email_verified_at, email_invalidated_at, created_at, updated_at, log_count, last_activity_at, projected_at)
+SELECT u.id, u.organization_id, u.name, u.email, u.email_verified_at, u.email_invalidated_at, u.created_at, u.updated_at,
+       COUNT(l.id)::INTEGER, MAX(l.created_at), sqlc.arg(projected_at)
+FROM users u
+LEFT JOIN user_logs l ON l.organization_id = u.organization_id AND l.user_id = u.id
+WHERE u.organization_id = sqlc.arg(organization_id) AND u.id = sqlc.arg(id)
+GROUP BY u.id
+ON CONFLICT (id) DO UPDATE SET
+    name = EXCLUDED.name,
+    email = EXCLUDED.email,
+    email_verified_at = EXCLUDED.email_verified_at,
+    email_invalidated_at = EXCLUDED.email_invalidated_at,
+    updated_at = EXCLUDED.updated_at,
+    log_count = EXCLUDED.log_count,
+    last_activity_at = EXCLUDED.last_activity_at,
+    projected_at = EXCLUDED.projected_at;
+
+-- name: DeleteUserSummary :exec
+DELETE FROM user_summaries WHERE organization_id = sqlc.arg(organization_id) AND id = sqlc.arg(id);
+
+-- name: ClearUserSummaries :exec
+DELETE FROM user_summaries;
+
+-- name: SeedUserSummaries :exec
+-- すべての組織のユーザーの一覧の行を作る（投影を作り直す際に、イベントのない既存のユーザーも含めるため）
+INSERT INTO user_summaries (id, organization_id, name, email, email_verified_at, email_invalidated_at, created_at, updated_at, log_count, last_activity_at, projected_at)
+SELECT u.id, u.organization_id, u.name, u.email, u.email_verified_at, u.email_invalidated_at, u.created_at, u.updated_at,
+       COUNT(l.id)::INTEGER, MAX(l.created_at), sqlc.arg(projected_at)
+FROM users u
+LEFT JOIN user_logs l ON l.organization_id = u.organization_id AND l.user_id = u.id
+GROUP BY u.id;
+
+-- name: ListUserSummaries :many
+SELECT id, organization_id, name, email, email_verified_at, email_invalidated_at, created_at, updated_at, log_count, last_activity_at, projected_at
+FROM user_summaries
+WHERE organization_id = sqlc.arg(organization_id)
+ORDER BY created_at DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountUserSummaries :one
+SELECT COUNT(*) FROM user_summaries WHERE organization_id = sqlc.arg(organization_id);
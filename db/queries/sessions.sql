@@ -0,0 +1,46 @@
+-- name: CreateSession :exec
+INSERT INTO sessions (id, user_id, organization_id, token_hash, remote_addr, user_agent, created_at, last_seen_at, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+
+-- name: GetSessionByID :one
+SELECT id, user_id, organization_id, token_hash, remote_addr, user_agent, created_at, last_seen_at, expires_at, revoked_at
+FROM sessions
+WHERE organization_id = sqlc.arg(organization_id) AND id = sqlc.arg(id);
+
+-- name: GetSessionByIDForUpdate :one
+SELECT id, user_id, organization_id, token_hash, remote_addr, user_agent, created_at, last_seen_at, expires_at, revoked_at
+FROM sessions
+WHERE organization_id = sqlc.arg(organization_id) AND id = sqlc.arg(id)
+FOR UPDATE;
+
+-- name: GetSessionByTokenHash :one
+SELECT id, user_id, organization_id, token_hash, remote_addr, user_agent, created_at, last_seen_at, expires_at, revoked_at
+FROM sessions
+WHERE token_hash = $1;
+
+-- name: ListActiveSessionsByUserID :many
+SELECT id, user_id, organization_id, token_hash, remote_addr, user_agent, created_at, last_seen_at, expires_at, revoked_at
+FROM sessions
+WHERE organization_id = sqlc.arg(organization_id)
+  AND user_id = sqlc.arg(user_id)
+  AND revoked_at IS NULL
+  AND expires_at > sqlc.arg(now)
+ORDER BY created_at DESC, id DESC;
+
+-- name: RevokeSession :exec
+UPDATE sessions SET revoked_at = sqlc.arg(revoked_at)
+WHERE organization_id = sqlc.arg(organization_id) AND id = sqlc.arg(id) AND revoked_at IS NULL;
+
+-- name: RevokeUserSessions :exec
+-- except_id のセッション（操作中のセッションなど）は失効させない
+UPDATE sessions SET revoked_at = sqlc.arg(revoked_at)
+WHERE organization_id = sqlc.arg(organization_id)
+  AND user_id = sqlc.arg(user_id)
+  AND id <> sqlc.arg(except_id)
+  AND revoked_at IS NULL;
+
+-- name: TouchSessionLastSeen :exec
+-- 書き込みを減らすため、前回の記録から1分以上経っている場合のみ更新する
+UPDATE sessions SET last_seen_at = sqlc.arg(seen_at)
+WHERE id = sqlc.arg(id)
+  AND last_seen_at < sqlc.arg(seen_at)::timestamp - INTERVAL '1 minute';
@@ -0,0 +1,45 @@
+-- name: CreateUserImport :exec
+INSERT INTO user_imports (id, organization_id, format, status, source, total_rows, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: GetUserImportByID :one
+SELECT id, organization_id, format, status, source, total_rows, last_error, created_at, updated_at, completed_at
+FROM user_imports
+WHERE organization_id = sqlc.arg(organization_id) AND id = sqlc.arg(id);
+
+-- name: GetUserImportByIDForUpdate :one
+SELECT id, organization_id, format, status, source, total_rows, last_error, created_at, updated_at, completed_at
+FROM user_imports
+WHERE organization_id = sqlc.arg(organization_id) AND id = sqlc.arg(id)
+FOR UPDATE;
+
+-- name: UpdateUserImportStatus :exec
+UPDATE user_imports
+SET status = sqlc.arg(status), last_error = sqlc.arg(last_error), updated_at = sqlc.arg(updated_at), completed_at = sqlc.arg(completed_at)
+WHERE organization_id = sqlc.arg(organization_id) AND id = sqlc.arg(id);
+
+-- name: CreateUserImportRow :exec
+INSERT INTO user_import_rows (import_id, line, status, name, email, user_id, reason, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: ListUserImportRowLines :many
+SELECT line FROM user_import_rows WHERE import_id = $1;
+
+-- name: ListUserImportRows :many
+SELECT import_id, line, status, name, email, user_id, reason, created_at
+FROM user_import_rows
+WHERE import_id = sqlc.arg(import_id)
+  AND (sqlc.narg(status)::varchar IS NULL OR status = sqlc.narg(status))
+ORDER BY line ASC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountUserImportRows :one
+SELECT COUNT(*) FROM user_import_rows
+WHERE import_id = sqlc.arg(import_id)
+  AND (sqlc.narg(status)::varchar IS NULL OR status = sqlc.narg(status));
+
+-- name: CountUserImportRowsByStatus :many
+SELECT status, COUNT(*) AS count
+FROM user_import_rows
+WHERE import_id = $1
+GROUP BY status;
@@ -1,13 +1,68 @@
 -- name: CreateUserLog :exec
-INSERT INTO user_logs (id, user_id, action, created_at)
-VALUES ($1, $2, $3, $4);
+INSERT INTO user_logs (id, user_id, organization_id, action, changes, actor, request_id, created_at, seq, prev_hash, hash)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);
 
 -- name: GetUserLogsByUserID :many
-SELECT id, user_id, action, created_at
+SELECT id, user_id, organization_id, action, changes, actor, request_id, created_at, seq, prev_hash, hash
 FROM user_logs
-WHERE user_id = $1
-ORDER BY created_at DESC
-LIMIT $2 OFFSET $3;
+WHERE organization_id = sqlc.arg(organization_id)
+  AND user_id = sqlc.arg(user_id)
+  AND (sqlc.narg(action)::varchar IS NULL OR action = sqlc.narg(action))
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
 
 -- name: CountUserLogsByUserID :one
-SELECT COUNT(*) FROM user_logs WHERE user_id = $1;
+SELECT COUNT(*) FROM user_logs
+WHERE organization_id = sqlc.arg(organization_id)
+  AND user_id = sqlc.arg(user_id)
+  AND (sqlc.narg(action)::varchar IS NULL OR action = sqlc.narg(action));
+
+-- name: ListUserLogsByUserIDs :many
+-- ユーザーごとにログを新しい順に並べ、それぞれの offset 件目から limit 件を取得（複数のユーザーのログを1回で読む）
+SELECT id, user_id, organization_id, action, changes, actor, request_id, created_at, seq, prev_hash, hash
+FROM (
+  SELECT id, user_id, organization_id, action, changes, actor, request_id, created_at, seq, prev_hash, hash,
+    ROW_NUMBER() OVER (PARTITION BY user_id ORDER BY created_at DESC, id DESC) AS position
+  FROM user_logs
+  WHERE organization_id = sqlc.arg(organization_id)
+    AND user_id = ANY(sqlc.arg(user_ids)::VARCHAR[])
+    AND (sqlc.narg(action)::varchar IS NULL OR action = sqlc.narg(action))
+) AS ranked_user_logs
+WHERE position > sqlc.arg('offset')::INTEGER AND position <= sqlc.arg('offset')::INTEGER + sqlc.arg('limit')::INTEGER
+ORDER BY user_id, created_at DESC, id DESC;
+
+-- name: CountUserLogsByUserIDs :many
+-- ユーザーごとのログの件数（ログのないユーザーは含まれない）
+SELECT user_id, COUNT(*)::INTEGER AS log_count
+FROM user_logs
+WHERE organization_id = sqlc.arg(organization_id)
+  AND user_id = ANY(sqlc.arg(user_ids)::VARCHAR[])
+  AND (sqlc.narg(action)::varchar IS NULL OR action = sqlc.narg(action))
+GROUP BY user_id;
+
+-- name: SummarizeUserLogsByUserIDs :many
+-- ユーザーごとのログの件数と最新の日時（ログのないユーザーは含まれない）
+SELECT user_id, COUNT(*)::INTEGER AS log_count, MAX(created_at)::TIMESTAMP AS last_activity_at
+FROM user_logs
+WHERE organization_id = sqlc.arg(organization_id) AND user_id = ANY(sqlc.arg(user_ids)::VARCHAR[])
+GROUP BY user_id;
+
+-- name: ListUserLogChain :many
+SELECT id, user_id, organization_id, action, changes, actor, request_id, created_at, seq, prev_hash, hash
+FROM user_logs
+WHERE organization_id = sqlc.arg(organization_id) AND seq > sqlc.arg(after_seq) AND seq <= sqlc.arg(until_seq)
+ORDER BY seq
+LIMIT sqlc.arg('limit');
+
+-- name: InitUserLogChainHead :exec
+INSERT INTO user_log_chain (organization_id, seq, hash) VALUES ($1, 0, '')
+ON CONFLICT (organization_id) DO NOTHING;
+
+-- name: GetUserLogChainHead :one
+SELECT seq, hash, signature FROM user_log_chain WHERE organization_id = $1;
+
+-- name: GetUserLogChainHeadForUpdate :one
+SELECT seq, hash, signature FROM user_log_chain WHERE organization_id = $1 FOR UPDATE;
+
+-- name: UpdateUserLogChainHead :exec
+UPDATE user_log_chain SET seq = $2, hash = $3, signature = $4, updated_at = $5 WHERE organization_id = $1;
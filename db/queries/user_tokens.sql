@@ -0,0 +1,17 @@
+-- name: CreateUserToken :exec
+INSERT INTO user_tokens (id, user_id, organization_id, purpose, token_hash, email, expires_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: GetUserTokenByHashForUpdate :one
+SELECT id, user_id, organization_id, purpose, token_hash, email, expires_at, used_at, created_at
+FROM user_tokens
+WHERE token_hash = $1
+FOR UPDATE;
+
+-- name: InvalidateUserTokens :exec
+-- 未使用のトークンを使用済みにする（新しいトークンの発行時やパスワードの再設定後に古いリンクを無効にする）
+UPDATE user_tokens SET used_at = sqlc.arg(used_at)
+WHERE organization_id = sqlc.arg(organization_id)
+  AND user_id = sqlc.arg(user_id)
+  AND purpose = sqlc.arg(purpose)
+  AND used_at IS NULL;
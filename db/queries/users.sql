@@ -1,50 +1,80 @@
 -- name: GetUserByID :one
-SELECT id, name, email, created_at, updated_at
+SELECT id, organization_id, name, email, email_verified_at, email_invalidated_at, password_hash, created_at, updated_at
 FROM users
-WHERE id = $1;
+WHERE organization_id = sqlc.arg(organization_id) AND id = sqlc.arg(id);
 
 -- name: GetUserByEmail :one
-SELECT id, name, email, created_at, updated_at
+SELECT id, organization_id, name, email, email_verified_at, email_invalidated_at, password_hash, created_at, updated_at
 FROM users
-WHERE email = $1;
+WHERE organization_id = sqlc.arg(organization_id) AND lower(email) = lower(sqlc.arg(email));
 
 -- name: ListUsers :many
-SELECT id, name, email, created_at, updated_at
+SELECT id, organization_id, name, email, email_verified_at, email_invalidated_at, password_hash, created_at, updated_at
 FROM users
+WHERE organization_id = sqlc.arg(organization_id)
 ORDER BY created_at DESC
-LIMIT $1 OFFSET $2;
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: ListUsersForStream :many
+-- 一覧と同じ順序（作成日時の降順、同じ日時はIDの降順）で先頭から取得する（キーセットページネーションの最初のページ）
+SELECT id, organization_id, name, email, email_verified_at, email_invalidated_at, password_hash, created_at, updated_at
+FROM users
+WHERE organization_id = sqlc.arg(organization_id)
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: ListUsersForStreamAfter :many
+-- ListUsersForStream の続きとして、指定したユーザーより後のユーザーを取得する
+SELECT id, organization_id, name, email, email_verified_at, email_invalidated_at, password_hash, created_at, updated_at
+FROM users
+WHERE organization_id = sqlc.arg(organization_id)
+  AND (created_at, id) < (sqlc.arg(after_created_at)::timestamp, sqlc.arg(after_id)::varchar)
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg('limit');
 
 -- name: CountUsers :one
-SELECT COUNT(*) FROM users;
+SELECT COUNT(*) FROM users WHERE organization_id = sqlc.arg(organization_id);
 
 -- name: CreateUser :exec
-INSERT INTO users (id, name, email, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5);
+INSERT INTO users (id, organization_id, name, email, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6);
 
 -- name: UpdateUser :exec
 UPDATE users
-SET name = $1, email = $2, updated_at = $3
-WHERE id = $4;
+SET name = sqlc.arg(name), email = sqlc.arg(email), updated_at = sqlc.arg(updated_at)
+WHERE organization_id = sqlc.arg(organization_id) AND id = sqlc.arg(id);
 
 -- name: DeleteUser :exec
-DELETE FROM users WHERE id = $1;
+DELETE FROM users WHERE organization_id = sqlc.arg(organization_id) AND id = sqlc.arg(id);
 
 -- name: GetUserByIDForUpdate :one
-SELECT id, name, email, created_at, updated_at
+SELECT id, organization_id, name, email, email_verified_at, email_invalidated_at, password_hash, created_at, updated_at
 FROM users
-WHERE id = $1
+WHERE organization_id = sqlc.arg(organization_id) AND id = sqlc.arg(id)
 FOR UPDATE;
 
 -- name: GetUserByEmailForUpdate :one
-SELECT id, name, email, created_at, updated_at
+SELECT id, organization_id, name, email, email_verified_at, email_invalidated_at, password_hash, created_at, updated_at
 FROM users
-WHERE email = $1
+WHERE organization_id = sqlc.arg(organization_id) AND lower(email) = lower(sqlc.arg(email))
 FOR UPDATE;
 
--- name: UpsertUser :exec
-INSERT INTO users (id, name, email, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5)
+-- name: UpsertUser :execrows
+-- 別の組織の同じIDのユーザーは上書きしない（影響行数が0になる）
+INSERT INTO users (id, organization_id, name, email, email_verified_at, email_invalidated_at, password_hash, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 ON CONFLICT (id) DO UPDATE SET
     name = EXCLUDED.name,
     email = EXCLUDED.email,
-    updated_at = EXCLUDED.updated_at;
+    email_verified_at = EXCLUDED.email_verified_at,
+    email_invalidated_at = EXCLUDED.email_invalidated_at,
+    password_hash = EXCLUDED.password_hash,
+    updated_at = EXCLUDED.updated_at
+WHERE users.organization_id = EXCLUDED.organization_id;
+
+-- name: ListUserOrganizationIDsByEmail :many
+-- すべての組織からメールアドレスが一致するユーザーの組織を探す（組織を区別しない外部からの通知の処理用）
+SELECT organization_id
+FROM users
+WHERE lower(email) = lower(sqlc.arg(email))
+ORDER BY organization_id;
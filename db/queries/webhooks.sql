@@ -0,0 +1,72 @@
+-- name: CreateWebhookSubscription :exec
+INSERT INTO webhook_subscriptions (id, organization_id, url, secret, event_types, created_by, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: GetWebhookSubscriptionByID :one
+SELECT id, organization_id, url, secret, event_types, consecutive_failures, disabled_at, disabled_reason, created_by, created_at, updated_at
+FROM webhook_subscriptions
+WHERE organization_id = sqlc.arg(organization_id) AND id = sqlc.arg(id);
+
+-- name: GetWebhookSubscriptionByIDForUpdate :one
+SELECT id, organization_id, url, secret, event_types, consecutive_failures, disabled_at, disabled_reason, created_by, created_at, updated_at
+FROM webhook_subscriptions
+WHERE organization_id = sqlc.arg(organization_id) AND id = sqlc.arg(id)
+FOR UPDATE;
+
+-- name: ListWebhookSubscriptions :many
+SELECT id, organization_id, url, secret, event_types, consecutive_failures, disabled_at, disabled_reason, created_by, created_at, updated_at
+FROM webhook_subscriptions
+WHERE organization_id = sqlc.arg(organization_id)
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountWebhookSubscriptions :one
+SELECT COUNT(*) FROM webhook_subscriptions
+WHERE organization_id = sqlc.arg(organization_id);
+
+-- name: ListActiveWebhookSubscriptionsByEventType :many
+SELECT id, organization_id, url, secret, event_types, consecutive_failures, disabled_at, disabled_reason, created_by, created_at, updated_at
+FROM webhook_subscriptions
+WHERE organization_id = sqlc.arg(organization_id)
+  AND disabled_at IS NULL
+  AND sqlc.arg(event_type)::text = ANY(event_types)
+ORDER BY created_at, id;
+
+-- name: UpdateWebhookSubscription :exec
+UPDATE webhook_subscriptions
+SET url = sqlc.arg(url),
+    event_types = sqlc.arg(event_types),
+    consecutive_failures = sqlc.arg(consecutive_failures),
+    disabled_at = sqlc.arg(disabled_at),
+    disabled_reason = sqlc.arg(disabled_reason),
+    updated_at = sqlc.arg(updated_at)
+WHERE organization_id = sqlc.arg(organization_id) AND id = sqlc.arg(id);
+
+-- name: DeleteWebhookSubscription :exec
+DELETE FROM webhook_subscriptions
+WHERE organization_id = sqlc.arg(organization_id) AND id = sqlc.arg(id);
+
+-- name: CreateWebhookDelivery :exec
+INSERT INTO webhook_deliveries (id, organization_id, subscription_id, event_id, event_type, attempt, status_code, error, duration_ms, delivered_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
+
+-- name: ListWebhookDeliveries :many
+SELECT id, organization_id, subscription_id, event_id, event_type, attempt, status_code, error, duration_ms, delivered_at
+FROM webhook_deliveries
+WHERE organization_id = sqlc.arg(organization_id) AND subscription_id = sqlc.arg(subscription_id)
+ORDER BY delivered_at DESC, id DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountWebhookDeliveries :one
+SELECT COUNT(*) FROM webhook_deliveries
+WHERE organization_id = sqlc.arg(organization_id) AND subscription_id = sqlc.arg(subscription_id);
+
+-- name: GetWebhookEventDeliveryState :one
+-- イベントの配信を試みた回数と、成功した配信があるかどうか
+SELECT COUNT(*)::integer AS attempts, COALESCE(BOOL_OR(error = ''), false)::boolean AS delivered
+FROM webhook_deliveries
+WHERE subscription_id = sqlc.arg(subscription_id) AND event_id = sqlc.arg(event_id);
+
+-- name: DeleteWebhookDeliveries :exec
+DELETE FROM webhook_deliveries
+WHERE organization_id = sqlc.arg(organization_id) AND subscription_id = sqlc.arg(subscription_id);
@@ -0,0 +1,25 @@
+-- Event store of event-sourced aggregates (one row per event, numbered per aggregate)
+CREATE TABLE IF NOT EXISTS aggregate_events (
+    aggregate_type VARCHAR(50) NOT NULL,
+    aggregate_id VARCHAR(64) NOT NULL,
+    -- Per-aggregate sequence starting at 1; the primary key rejects concurrent appends (optimistic concurrency)
+    sequence INTEGER NOT NULL,
+    -- Organization (tenant) the aggregate belongs to
+    organization_id VARCHAR(26) NOT NULL,
+    event_type VARCHAR(100) NOT NULL,
+    payload JSONB NOT NULL DEFAULT '{}',
+    occurred_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (aggregate_type, aggregate_id, sequence)
+);
+
+-- Latest snapshot of each event-sourced aggregate (state after the event with the given sequence)
+CREATE TABLE IF NOT EXISTS aggregate_snapshots (
+    aggregate_type VARCHAR(50) NOT NULL,
+    aggregate_id VARCHAR(64) NOT NULL,
+    organization_id VARCHAR(26) NOT NULL,
+    sequence INTEGER NOT NULL,
+    state JSONB NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (aggregate_type, aggregate_id)
+);
@@ -0,0 +1,18 @@
+-- API keys table (service-to-service credentials; only the hash of the secret is stored)
+CREATE TABLE IF NOT EXISTS api_keys (
+    id VARCHAR(26) PRIMARY KEY,
+    -- Organization (tenant) the key acts in
+    organization_id VARCHAR(26) NOT NULL DEFAULT '00000000000000000000000000',
+    name VARCHAR(100) NOT NULL,
+    prefix VARCHAR(16) NOT NULL UNIQUE,
+    secret_hash VARCHAR(64) NOT NULL,
+    scopes TEXT[] NOT NULL DEFAULT '{}',
+    expires_at TIMESTAMP,
+    last_used_at TIMESTAMP,
+    revoked_at TIMESTAMP,
+    created_by VARCHAR(100) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Index for listing the keys of an organization sorted by created_at
+CREATE INDEX IF NOT EXISTS idx_api_keys_organization_created_at ON api_keys(organization_id, created_at DESC);
@@ -0,0 +1,23 @@
+-- Audit events table (generic audit trail for all aggregates)
+CREATE TABLE IF NOT EXISTS audit_events (
+    id VARCHAR(26) PRIMARY KEY,
+    -- Organization (tenant) the event belongs to
+    organization_id VARCHAR(26) NOT NULL DEFAULT '00000000000000000000000000',
+    aggregate_type VARCHAR(50) NOT NULL,
+    aggregate_id VARCHAR(64) NOT NULL,
+    action VARCHAR(50) NOT NULL,
+    actor VARCHAR(100) NOT NULL DEFAULT '',
+    request_id VARCHAR(64) NOT NULL DEFAULT '',
+    metadata JSONB NOT NULL DEFAULT '{}',
+    payload JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Index for per-aggregate history ordered by time
+CREATE INDEX IF NOT EXISTS idx_audit_events_aggregate ON audit_events(aggregate_type, aggregate_id, created_at DESC);
+
+-- Index for actor lookup
+CREATE INDEX IF NOT EXISTS idx_audit_events_actor ON audit_events(actor);
+
+-- Index for listing the events of an organization sorted by created_at
+CREATE INDEX IF NOT EXISTS idx_audit_events_organization_created_at ON audit_events(organization_id, created_at DESC);
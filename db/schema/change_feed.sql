@@ -0,0 +1,23 @@
+-- Change notifications streamed to clients over Server-Sent Events (kept for a short time so clients can resume with Last-Event-ID)
+CREATE TABLE IF NOT EXISTS change_feed (
+    -- Sequence number used as the SSE event ID
+    id BIGSERIAL PRIMARY KEY,
+    -- Organization (tenant) the change belongs to
+    organization_id VARCHAR(26) NOT NULL,
+    aggregate_type VARCHAR(50) NOT NULL,
+    aggregate_id VARCHAR(64) NOT NULL,
+    -- created, updated or deleted
+    change_type VARCHAR(20) NOT NULL,
+    occurred_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    -- Transaction ID bound recorded after the id was assigned. IDs are assigned at insert time, not commit time,
+    -- so a smaller id may still be committed later. Once every transaction older than this bound has finished
+    -- (pg_snapshot_xmin reaches it), no change with a smaller id can appear anymore and readers may move past this id
+    settle_xid xid8 NOT NULL DEFAULT pg_snapshot_xmax(pg_current_snapshot())
+);
+
+-- Index for reading the changes of an organization after an event ID
+CREATE INDEX IF NOT EXISTS idx_change_feed_organization_id ON change_feed(organization_id, id);
+
+-- Index for deleting changes past the retention period
+CREATE INDEX IF NOT EXISTS idx_change_feed_created_at ON change_feed(created_at);
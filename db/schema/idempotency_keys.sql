@@ -0,0 +1,19 @@
+-- Idempotency keys table
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    -- Client-supplied key prefixed with the organization (tenant) and principal, e.g. "<organization_id>:user:<user_id>:<key>"
+    idempotency_key VARCHAR(400) PRIMARY KEY,
+    fingerprint VARCHAR(64) NOT NULL,
+    status_code INTEGER,
+    response_headers JSONB NOT NULL DEFAULT '{}',
+    response_body BYTEA,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    completed_at TIMESTAMP,
+    expires_at TIMESTAMP NOT NULL,
+    -- Request currently holding the key while in progress; renewed until the response is recorded
+    lease_id VARCHAR(26),
+    -- A retry with the same request may take over an in-progress key once its lease runs out (the request died)
+    locked_until TIMESTAMP
+);
+
+-- Index for expired key cleanup
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at ON idempotency_keys(expires_at);
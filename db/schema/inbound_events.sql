@@ -0,0 +1,28 @@
+-- Events received from external services through inbound webhooks (e.g. bounces reported by the email provider)
+CREATE TABLE IF NOT EXISTS inbound_events (
+    id VARCHAR(26) PRIMARY KEY,
+    -- Organization (tenant) the event is recorded in (providers do not know tenants, so the default organization)
+    organization_id VARCHAR(26) NOT NULL DEFAULT '00000000000000000000000000',
+    -- Provider that sent the event (the path segment of the receiving URL)
+    provider VARCHAR(50) NOT NULL,
+    -- Event ID assigned by the provider (events already received are ignored)
+    provider_event_id VARCHAR(255) NOT NULL,
+    -- Normalized event type (e.g. "email.hard_bounced")
+    event_type VARCHAR(100) NOT NULL,
+    -- Subject of the event such as the bounced email address (empty when the event has none)
+    subject VARCHAR(255) NOT NULL DEFAULT '',
+    -- Event as received from the provider (kept for replay)
+    payload JSONB NOT NULL DEFAULT '{}',
+    -- pending, processed, ignored or failed
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    -- Reason the last processing failed (empty when it succeeded)
+    last_error TEXT NOT NULL DEFAULT '',
+    received_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    processed_at TIMESTAMP
+);
+
+-- Deduplication of events redelivered by the provider
+CREATE UNIQUE INDEX IF NOT EXISTS idx_inbound_events_provider_event_id ON inbound_events(provider, provider_event_id);
+
+-- Index for listing the events of an organization sorted by received_at
+CREATE INDEX IF NOT EXISTS idx_inbound_events_organization_received_at ON inbound_events(organization_id, received_at DESC);
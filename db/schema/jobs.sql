@@ -2,6 +2,8 @@
 CREATE TABLE IF NOT EXISTS jobs (
     id VARCHAR(26) PRIMARY KEY,
     job_type VARCHAR(100) NOT NULL,
+    -- テナント（組織）。ジョブはこの組織のデータに対してのみ処理を行う
+    organization_id VARCHAR(26) NOT NULL DEFAULT '00000000000000000000000000',
     payload JSONB NOT NULL DEFAULT '{}',
     status VARCHAR(20) NOT NULL DEFAULT 'pending',
     attempts INTEGER NOT NULL DEFAULT 0,
@@ -0,0 +1,12 @@
+-- Login failures table (consecutive failed logins per email address, shared by every server process)
+CREATE TABLE IF NOT EXISTS login_failures (
+    organization_id VARCHAR(26) NOT NULL,
+    -- Lower-cased email address the login was attempted with (also recorded for unknown addresses)
+    email VARCHAR(255) NOT NULL,
+    failures INTEGER NOT NULL,
+    last_failed_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (organization_id, email)
+);
+
+-- Index for deleting failures older than the lockout
+CREATE INDEX IF NOT EXISTS idx_login_failures_last_failed_at ON login_failures(last_failed_at);
@@ -0,0 +1,22 @@
+-- Organizations table (tenants; every tenant-owned row carries an organization_id)
+CREATE TABLE IF NOT EXISTS organizations (
+    id VARCHAR(26) PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    slug VARCHAR(63) NOT NULL UNIQUE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Organization memberships table (roles of a user within an organization)
+CREATE TABLE IF NOT EXISTS organization_memberships (
+    organization_id VARCHAR(26) NOT NULL,
+    -- User ID (or the subject of an external identity)
+    user_id VARCHAR(255) NOT NULL,
+    roles TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (organization_id, user_id)
+);
+
+-- Index for listing the organizations of a user
+CREATE INDEX IF NOT EXISTS idx_organization_memberships_user_id ON organization_memberships(user_id);
@@ -0,0 +1,26 @@
+-- Outbox of domain events (written in the same transaction as the aggregate, relayed to durable subscribers by the worker)
+CREATE TABLE IF NOT EXISTS outbox_events (
+    id VARCHAR(26) PRIMARY KEY,
+    -- Organization (tenant) the event belongs to
+    organization_id VARCHAR(26) NOT NULL,
+    aggregate_type VARCHAR(50) NOT NULL,
+    aggregate_id VARCHAR(64) NOT NULL,
+    event_type VARCHAR(100) NOT NULL,
+    payload JSONB NOT NULL DEFAULT '{}',
+    occurred_at TIMESTAMP NOT NULL,
+    -- Time the event was delivered to every durable subscriber (NULL while pending)
+    published_at TIMESTAMP,
+    -- Failed delivery attempts and the last error (retried on the next poll)
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    -- ID of the transaction that recorded the event. IDs and created_at are taken when the row is written, not when it is
+    -- committed, so projections read in (transaction_id, id) order and only past transactions older than every running one
+    transaction_id BIGINT NOT NULL DEFAULT pg_current_xact_id()::text::bigint
+);
+
+-- Index for relaying pending events in the order they were recorded
+CREATE INDEX IF NOT EXISTS idx_outbox_events_pending ON outbox_events(id) WHERE published_at IS NULL;
+
+-- Index for projections reading events in the order of the recording transaction
+CREATE INDEX IF NOT EXISTS idx_outbox_events_transaction_id ON outbox_events(transaction_id, id);
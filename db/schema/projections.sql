@@ -0,0 +1,31 @@
+-- Progress of each read model projection through outbox_events
+CREATE TABLE IF NOT EXISTS projection_checkpoints (
+    name VARCHAR(100) PRIMARY KEY,
+    -- Position of the last outbox event applied to the projection: (transaction_id, id) of outbox_events
+    -- (0 and '' until the first event)
+    last_transaction_id BIGINT NOT NULL DEFAULT 0,
+    last_event_id VARCHAR(26) NOT NULL DEFAULT '',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Read model for listing users with their activity (maintained by the user_summaries projection)
+CREATE TABLE IF NOT EXISTS user_summaries (
+    id VARCHAR(26) PRIMARY KEY,
+    -- Organization (tenant) the user belongs to
+    organization_id VARCHAR(26) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    email VARCHAR(255) NOT NULL,
+    email_verified_at TIMESTAMP,
+    email_invalidated_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    -- Number of user_logs rows of the user
+    log_count INTEGER NOT NULL DEFAULT 0,
+    -- Time of the latest user_logs row (NULL when the user has no logs)
+    last_activity_at TIMESTAMP,
+    -- Time the row was last refreshed by the projection
+    projected_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Index for listing the users of an organization sorted by created_at
+CREATE INDEX IF NOT EXISTS idx_user_summaries_organization_created_at ON user_summaries(organization_id, created_at DESC);
@@ -1,14 +1,25 @@
 -- Users table
 CREATE TABLE IF NOT EXISTS users (
     id VARCHAR(26) PRIMARY KEY,
+    -- Organization (tenant) the user belongs to
+    organization_id VARCHAR(26) NOT NULL DEFAULT '00000000000000000000000000',
     name VARCHAR(255) NOT NULL,
-    email VARCHAR(255) NOT NULL UNIQUE,
+    email VARCHAR(255) NOT NULL,
+    -- Time the current email address was confirmed through a verification link
+    email_verified_at TIMESTAMP,
+    -- Time the current email address was reported undeliverable (e.g. a hard bounce); cleared when the address changes
+    email_invalidated_at TIMESTAMP,
+    -- argon2id hash of the password (empty when the user cannot log in with a password)
+    password_hash VARCHAR(255) NOT NULL DEFAULT '',
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
 );
 
--- Index for email lookup
-CREATE INDEX IF NOT EXISTS idx_users_email ON users(email);
+-- Case-insensitive unique index for email within an organization (also used for email lookup)
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_organization_email_lower ON users(organization_id, lower(email));
 
--- Index for created_at for sorting
-CREATE INDEX IF NOT EXISTS idx_users_created_at ON users(created_at DESC);
+-- Index for listing the users of an organization sorted by created_at
+CREATE INDEX IF NOT EXISTS idx_users_organization_created_at ON users(organization_id, created_at DESC);
+
+-- Index for finding the users of every organization by email (e.g. for bounces reported by the email provider)
+CREATE INDEX IF NOT EXISTS idx_users_email_lower ON users(lower(email));
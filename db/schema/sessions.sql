@@ -0,0 +1,17 @@
+-- Sessions table (server-side login sessions; only the hash of the cookie token is stored)
+CREATE TABLE IF NOT EXISTS sessions (
+    id VARCHAR(26) PRIMARY KEY,
+    user_id VARCHAR(26) NOT NULL,
+    -- Organization (tenant) of the user; requests authenticated by the session are scoped to it
+    organization_id VARCHAR(26) NOT NULL DEFAULT '00000000000000000000000000',
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    remote_addr VARCHAR(64) NOT NULL DEFAULT '',
+    user_agent VARCHAR(512) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_seen_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP
+);
+
+-- Index for listing the sessions of a user
+CREATE INDEX IF NOT EXISTS idx_sessions_user_id ON sessions(user_id, created_at DESC);
@@ -0,0 +1,33 @@
+-- User imports table
+CREATE TABLE IF NOT EXISTS user_imports (
+    id VARCHAR(26) PRIMARY KEY,
+    -- Organization (tenant) the users are imported into
+    organization_id VARCHAR(26) NOT NULL DEFAULT '00000000000000000000000000',
+    format VARCHAR(20) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    source BYTEA NOT NULL,
+    total_rows INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    completed_at TIMESTAMP
+);
+
+-- Index for listing the imports of an organization sorted by created_at
+CREATE INDEX IF NOT EXISTS idx_user_imports_organization_created_at ON user_imports(organization_id, created_at DESC);
+
+-- User import rows table (per-row report)
+CREATE TABLE IF NOT EXISTS user_import_rows (
+    import_id VARCHAR(26) NOT NULL,
+    line INTEGER NOT NULL,
+    status VARCHAR(30) NOT NULL,
+    name VARCHAR(255) NOT NULL DEFAULT '',
+    email VARCHAR(255) NOT NULL DEFAULT '',
+    user_id VARCHAR(26),
+    reason TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (import_id, line)
+);
+
+-- Index for status filtering within an import
+CREATE INDEX IF NOT EXISTS idx_user_import_rows_import_id_status ON user_import_rows(import_id, status);
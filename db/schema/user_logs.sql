@@ -2,15 +2,42 @@
 CREATE TABLE IF NOT EXISTS user_logs (
     id VARCHAR(26) PRIMARY KEY,
     user_id VARCHAR(26) NOT NULL,
+    -- Organization (tenant) of the user
+    organization_id VARCHAR(26) NOT NULL DEFAULT '00000000000000000000000000',
     action VARCHAR(50) NOT NULL,
-    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+    changes JSONB NOT NULL DEFAULT '{}',
+    actor VARCHAR(100) NOT NULL DEFAULT '',
+    request_id VARCHAR(64) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    -- Position in the organization's hash chain (0 for rows written before the chain was introduced)
+    seq BIGINT NOT NULL DEFAULT 0,
+    -- Hash of the previous row in the chain
+    prev_hash VARCHAR(64) NOT NULL DEFAULT '',
+    -- HMAC-SHA256 of this row's content and prev_hash
+    hash VARCHAR(64) NOT NULL DEFAULT ''
 );
 
 -- Index for user_id lookup
 CREATE INDEX IF NOT EXISTS idx_user_logs_user_id ON user_logs(user_id);
 
+-- Index for per-user history ordered by time
+CREATE INDEX IF NOT EXISTS idx_user_logs_user_id_created_at ON user_logs(user_id, created_at DESC);
+
 -- Index for action lookup
 CREATE INDEX IF NOT EXISTS idx_user_logs_action ON user_logs(action);
 
 -- Index for created_at for sorting
 CREATE INDEX IF NOT EXISTS idx_user_logs_created_at ON user_logs(created_at DESC);
+
+-- Unique index for the hash chain position (one chain per organization)
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_logs_organization_id_seq ON user_logs(organization_id, seq) WHERE seq > 0;
+
+-- Head of each organization's user_logs hash chain (locked while appending)
+-- signature is an HMAC of (organization_id, seq, hash) so the head cannot be rewound without the key
+CREATE TABLE IF NOT EXISTS user_log_chain (
+    organization_id VARCHAR(26) PRIMARY KEY,
+    seq BIGINT NOT NULL DEFAULT 0,
+    hash VARCHAR(64) NOT NULL DEFAULT '',
+    signature VARCHAR(64) NOT NULL DEFAULT '',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,17 @@
+-- User tokens table (single-use email verification and password reset tokens; only the hash is stored)
+CREATE TABLE IF NOT EXISTS user_tokens (
+    id VARCHAR(26) PRIMARY KEY,
+    user_id VARCHAR(26) NOT NULL,
+    -- Organization (tenant) of the user
+    organization_id VARCHAR(26) NOT NULL DEFAULT '00000000000000000000000000',
+    purpose VARCHAR(32) NOT NULL,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    -- Email address the token was sent to (the token is invalid once the address changes)
+    email VARCHAR(255) NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Index for invalidating the outstanding tokens of a user
+CREATE INDEX IF NOT EXISTS idx_user_tokens_user_id ON user_tokens(user_id, purpose);
@@ -0,0 +1,43 @@
+-- Webhook subscriptions table (outgoing notifications of user changes to partner endpoints)
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+    id VARCHAR(26) PRIMARY KEY,
+    -- Organization (tenant) whose events are delivered
+    organization_id VARCHAR(26) NOT NULL DEFAULT '00000000000000000000000000',
+    url VARCHAR(2048) NOT NULL,
+    -- Shared secret used to sign the requests (HMAC-SHA256); returned to the client only once on creation
+    secret VARCHAR(100) NOT NULL,
+    event_types TEXT[] NOT NULL DEFAULT '{}',
+    -- Failed deliveries since the last successful one (the subscription is disabled when it reaches the limit)
+    consecutive_failures INTEGER NOT NULL DEFAULT 0,
+    disabled_at TIMESTAMP,
+    disabled_reason TEXT NOT NULL DEFAULT '',
+    created_by VARCHAR(100) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Index for listing the subscriptions of an organization sorted by created_at
+CREATE INDEX IF NOT EXISTS idx_webhook_subscriptions_organization_created_at ON webhook_subscriptions(organization_id, created_at DESC);
+
+-- Webhook deliveries table (one row per delivery attempt)
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id VARCHAR(26) PRIMARY KEY,
+    organization_id VARCHAR(26) NOT NULL DEFAULT '00000000000000000000000000',
+    subscription_id VARCHAR(26) NOT NULL,
+    -- Outbox event that was delivered (sent as X-Webhook-ID)
+    event_id VARCHAR(26) NOT NULL,
+    event_type VARCHAR(100) NOT NULL,
+    attempt INTEGER NOT NULL,
+    -- HTTP status returned by the receiver (0 when no response was received)
+    status_code INTEGER NOT NULL DEFAULT 0,
+    -- Reason of the failure (empty when the delivery succeeded)
+    error TEXT NOT NULL DEFAULT '',
+    duration_ms INTEGER NOT NULL DEFAULT 0,
+    delivered_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Index for the delivery log of a subscription sorted by delivered_at
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_subscription_delivered_at ON webhook_deliveries(subscription_id, delivered_at DESC);
+
+-- Index for counting the attempts of an event
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_subscription_event ON webhook_deliveries(subscription_id, event_id);
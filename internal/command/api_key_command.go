@@ -0,0 +1,112 @@
+package command
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+)
+
+// SaveAPIKey APIキーをコンテキストのテナントに作成（トランザクション内で使用）
+func SaveAPIKey(ctx context.Context, tx infrastructure.DBTX, apiKey *domain.APIKey) error {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
+	params := dao.CreateAPIKeyParams{
+		ID:             apiKey.ID,
+		OrganizationID: organizationID,
+		Name:           apiKey.Name,
+		Prefix:         apiKey.Prefix,
+		SecretHash:     apiKey.SecretHash,
+		Scopes:         apiKey.Scopes,
+		CreatedBy:      apiKey.CreatedBy,
+		CreatedAt:      apiKey.CreatedAt,
+	}
+	if apiKey.ExpiresAt != nil {
+		params.ExpiresAt = sql.NullTime{Time: *apiKey.ExpiresAt, Valid: true}
+	}
+
+	queries := dao.New(tx)
+	if err := queries.CreateAPIKey(ctx, params); err != nil {
+		return fmt.Errorf("failed to save api key: %w", err)
+	}
+	apiKey.OrganizationID = organizationID
+	return nil
+}
+
+// FindAPIKeyByIDForUpdate IDでAPIキーを検索しロックを取得（トランザクション内で使用）
+func FindAPIKeyByIDForUpdate(ctx context.Context, tx infrastructure.DBTX, id string) (*domain.APIKey, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	queries := dao.New(tx)
+	apiKey, err := queries.GetAPIKeyByIDForUpdate(ctx, dao.GetAPIKeyByIDForUpdateParams{OrganizationID: organizationID, ID: id})
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find api key for update: %w", err)
+	}
+	return toDomainAPIKey(apiKey), nil
+}
+
+// RevokeAPIKey APIキーを失効させる（トランザクション内で使用）
+func RevokeAPIKey(ctx context.Context, tx infrastructure.DBTX, id string, revokedAt time.Time) error {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
+	queries := dao.New(tx)
+	err = queries.RevokeAPIKey(ctx, dao.RevokeAPIKeyParams{
+		RevokedAt:      sql.NullTime{Time: revokedAt, Valid: true},
+		OrganizationID: organizationID,
+		ID:             id,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to revoke api key: %w", err)
+	}
+	return nil
+}
+
+// TouchAPIKeyLastUsed APIキーの最終利用日時を更新（前回の記録から1分以内の場合は更新しない）
+func TouchAPIKeyLastUsed(ctx context.Context, tx infrastructure.DBTX, id string, usedAt time.Time) error {
+	queries := dao.New(tx)
+	err := queries.TouchAPIKeyLastUsed(ctx, dao.TouchAPIKeyLastUsedParams{
+		ID:     id,
+		UsedAt: sql.NullTime{Time: usedAt, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update api key last used: %w", err)
+	}
+	return nil
+}
+
+// toDomainAPIKey dao.ApiKeyをdomain.APIKeyに変換
+func toDomainAPIKey(k dao.ApiKey) *domain.APIKey {
+	apiKey := &domain.APIKey{
+		ID:             k.ID,
+		OrganizationID: k.OrganizationID,
+		Name:           k.Name,
+		Prefix:         k.Prefix,
+		SecretHash:     k.SecretHash,
+		Scopes:         k.Scopes,
+		CreatedBy:      k.CreatedBy,
+		CreatedAt:      k.CreatedAt,
+	}
+	if k.ExpiresAt.Valid {
+		apiKey.ExpiresAt = &k.ExpiresAt.Time
+	}
+	if k.LastUsedAt.Valid {
+		apiKey.LastUsedAt = &k.LastUsedAt.Time
+	}
+	if k.RevokedAt.Valid {
+		apiKey.RevokedAt = &k.RevokedAt.Time
+	}
+	return apiKey
+}
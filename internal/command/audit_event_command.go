@@ -0,0 +1,45 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+)
+
+// SaveAuditEvent 監査イベントをコンテキストのテナントに保存（トランザクション内で使用）
+func SaveAuditEvent(ctx context.Context, tx infrastructure.DBTX, event *domain.AuditEvent) error {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
+	metadataJSON, err := json.Marshal(event.Metadata)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit event metadata: %w", err)
+	}
+	payload := event.Payload
+	if len(payload) == 0 {
+		payload = json.RawMessage(`{}`)
+	}
+
+	queries := dao.New(tx)
+	err = queries.CreateAuditEvent(ctx, dao.CreateAuditEventParams{
+		ID:             event.ID,
+		OrganizationID: organizationID,
+		AggregateType:  string(event.AggregateType),
+		AggregateID:    event.AggregateID,
+		Action:         event.Action,
+		Actor:          event.Actor,
+		RequestID:      event.RequestID,
+		Metadata:       metadataJSON,
+		Payload:        payload,
+		CreatedAt:      event.CreatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save audit event: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+)
+
+// saveChanges ドメインイベントを変更通知として保存し、サーバーのリスナーに通知する（トランザクション内で使用）
+// NOTIFY はコミット時に配信されるため、リスナーが読むときには変更通知が保存されている
+// IDは保存時に採番されるため、先に採番したトランザクションが後でコミットすることがある
+// 読み手がそのIDを読み飛ばさないよう、採番した後の文で settle_xid を記録する（ListChangesAfter の settled）
+// 採番の前にこのトランザクションのIDが割り当てられている必要があるため、送信用のイベントを保存した後で呼び出す
+func saveChanges(ctx context.Context, tx infrastructure.DBTX, organizationID string, events []domain.DomainEvent) error {
+	changes := domain.ChangesFromEvents(events)
+	if len(changes) == 0 {
+		return nil
+	}
+
+	queries := dao.New(tx)
+	ids, err := queries.ReserveChangeIDs(ctx, int32(len(changes)))
+	if err != nil {
+		return fmt.Errorf("failed to reserve change ids: %w", err)
+	}
+	now := time.Now()
+	for i, change := range changes {
+		err := queries.CreateChange(ctx, dao.CreateChangeParams{
+			ID:             ids[i],
+			OrganizationID: organizationID,
+			AggregateType:  string(change.AggregateType),
+			AggregateID:    change.AggregateID,
+			ChangeType:     string(change.Type),
+			OccurredAt:     change.OccurredAt,
+			CreatedAt:      now,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to save change: %w", err)
+		}
+	}
+	if err := queries.NotifyChangeFeed(ctx, organizationID); err != nil {
+		return fmt.Errorf("failed to notify change feed: %w", err)
+	}
+	return nil
+}
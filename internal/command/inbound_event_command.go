@@ -0,0 +1,96 @@
+package command
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+)
+
+// SaveInboundEvent 受信イベントをコンテキストのテナントに保存（トランザクション内で使用）
+// 同じプロバイダーの同じイベントIDを受信済みの場合は保存せずに false を返す
+func SaveInboundEvent(ctx context.Context, tx infrastructure.DBTX, event *domain.InboundEvent) (bool, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return false, err
+	}
+	queries := dao.New(tx)
+	affected, err := queries.CreateInboundEvent(ctx, dao.CreateInboundEventParams{
+		ID:              event.ID,
+		OrganizationID:  organizationID,
+		Provider:        event.Provider,
+		ProviderEventID: event.ProviderEventID,
+		EventType:       event.EventType,
+		Subject:         event.Subject,
+		Payload:         event.Payload,
+		Status:          string(event.Status),
+		ReceivedAt:      event.ReceivedAt,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to save inbound event: %w", err)
+	}
+	event.OrganizationID = organizationID
+	return affected > 0, nil
+}
+
+// FindInboundEventByIDForUpdate IDで受信イベントを検索しロックを取得（トランザクション内で使用）
+func FindInboundEventByIDForUpdate(ctx context.Context, tx infrastructure.DBTX, id string) (*domain.InboundEvent, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	queries := dao.New(tx)
+	event, err := queries.GetInboundEventByIDForUpdate(ctx, dao.GetInboundEventByIDForUpdateParams{OrganizationID: organizationID, ID: id})
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find inbound event for update: %w", err)
+	}
+	return toDomainInboundEvent(event), nil
+}
+
+// UpdateInboundEventStatus 受信イベントの処理の状態を更新（トランザクション内で使用）
+func UpdateInboundEventStatus(ctx context.Context, tx infrastructure.DBTX, event *domain.InboundEvent) error {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
+	params := dao.UpdateInboundEventStatusParams{
+		OrganizationID: organizationID,
+		ID:             event.ID,
+		Status:         string(event.Status),
+		LastError:      event.LastError,
+	}
+	if event.ProcessedAt != nil {
+		params.ProcessedAt = sql.NullTime{Time: *event.ProcessedAt, Valid: true}
+	}
+	queries := dao.New(tx)
+	if err := queries.UpdateInboundEventStatus(ctx, params); err != nil {
+		return fmt.Errorf("failed to update inbound event: %w", err)
+	}
+	return nil
+}
+
+// toDomainInboundEvent dao.InboundEventをdomain.InboundEventに変換
+func toDomainInboundEvent(e dao.InboundEvent) *domain.InboundEvent {
+	event := &domain.InboundEvent{
+		ID:              e.ID,
+		OrganizationID:  e.OrganizationID,
+		Provider:        e.Provider,
+		ProviderEventID: e.ProviderEventID,
+		EventType:       e.EventType,
+		Subject:         e.Subject,
+		Payload:         e.Payload,
+		Status:          domain.InboundEventStatus(e.Status),
+		LastError:       e.LastError,
+		ReceivedAt:      e.ReceivedAt,
+	}
+	if e.ProcessedAt.Valid {
+		event.ProcessedAt = &e.ProcessedAt.Time
+	}
+	return event
+}
@@ -12,21 +12,27 @@ import (
 	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
 )
 
-// EnqueueJob ジョブをキューに追加（トランザクション内で使用）
+// EnqueueJob ジョブをコンテキストのテナントのキューに追加（トランザクション内で使用）
 func EnqueueJob(ctx context.Context, tx infrastructure.DBTX, job *domain.Job) error {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
 	queries := dao.New(tx)
-	err := queries.EnqueueJob(ctx, dao.EnqueueJobParams{
-		ID:          job.ID,
-		JobType:     job.JobType,
-		Payload:     job.Payload,
-		MaxAttempts: int32(job.MaxAttempts),
-		ScheduledAt: job.ScheduledAt,
-		CreatedAt:   job.CreatedAt,
-		UpdatedAt:   job.UpdatedAt,
+	err = queries.EnqueueJob(ctx, dao.EnqueueJobParams{
+		ID:             job.ID,
+		JobType:        job.JobType,
+		OrganizationID: organizationID,
+		Payload:        job.Payload,
+		MaxAttempts:    int32(job.MaxAttempts),
+		ScheduledAt:    job.ScheduledAt,
+		CreatedAt:      job.CreatedAt,
+		UpdatedAt:      job.UpdatedAt,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to enqueue job: %w", err)
 	}
+	job.OrganizationID = organizationID
 	return nil
 }
 
@@ -79,15 +85,16 @@ func MarkJobDead(ctx context.Context, tx infrastructure.DBTX, jobID string, last
 // toDomainJob dao.Jobをdomain.Jobに変換
 func toDomainJob(j dao.Job) *domain.Job {
 	job := &domain.Job{
-		ID:          j.ID,
-		JobType:     j.JobType,
-		Payload:     json.RawMessage(j.Payload),
-		Status:      domain.JobStatus(j.Status),
-		Attempts:    int(j.Attempts),
-		MaxAttempts: int(j.MaxAttempts),
-		ScheduledAt: j.ScheduledAt,
-		CreatedAt:   j.CreatedAt,
-		UpdatedAt:   j.UpdatedAt,
+		ID:             j.ID,
+		JobType:        j.JobType,
+		OrganizationID: j.OrganizationID,
+		Payload:        json.RawMessage(j.Payload),
+		Status:         domain.JobStatus(j.Status),
+		Attempts:       int(j.Attempts),
+		MaxAttempts:    int(j.MaxAttempts),
+		ScheduledAt:    j.ScheduledAt,
+		CreatedAt:      j.CreatedAt,
+		UpdatedAt:      j.UpdatedAt,
 	}
 	if j.LastError.Valid {
 		job.LastError = j.LastError.String
@@ -0,0 +1,88 @@
+package command
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+)
+
+// FindLoginFailuresForUpdate コンテキストのテナントでメールアドレスのログイン失敗を検索しロックを取得（トランザクション内で使用）
+// 失敗の記録がない場合も失敗回数0の行を作成してロックするため、同じメールアドレスへの同時のログインは順に処理される
+// （存在しない行の SELECT ... FOR UPDATE は何もロックしないため、作成しないと同時の失敗がすべて1回目として保存される）
+func FindLoginFailuresForUpdate(ctx context.Context, tx infrastructure.DBTX, email string) (*domain.LoginFailures, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	failures := domain.NewLoginFailures(organizationID, email)
+	queries := dao.New(tx)
+	err = queries.CreateLoginFailuresIfNotExists(ctx, dao.CreateLoginFailuresIfNotExistsParams{
+		OrganizationID: organizationID,
+		Email:          failures.Email,
+		LastFailedAt:   failures.LastFailedAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create login failures: %w", err)
+	}
+	row, err := queries.GetLoginFailuresForUpdate(ctx, dao.GetLoginFailuresForUpdateParams{
+		OrganizationID: organizationID,
+		Email:          failures.Email,
+	})
+	if err == sql.ErrNoRows {
+		return failures, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find login failures for update: %w", err)
+	}
+	failures.Count = int(row.Failures)
+	failures.LastFailedAt = row.LastFailedAt
+	return failures, nil
+}
+
+// SaveLoginFailures ログイン失敗を保存（トランザクション内で使用）
+func SaveLoginFailures(ctx context.Context, tx infrastructure.DBTX, failures *domain.LoginFailures) error {
+	queries := dao.New(tx)
+	err := queries.UpsertLoginFailures(ctx, dao.UpsertLoginFailuresParams{
+		OrganizationID: failures.OrganizationID,
+		Email:          failures.Email,
+		Failures:       int32(failures.Count),
+		LastFailedAt:   failures.LastFailedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save login failures: %w", err)
+	}
+	return nil
+}
+
+// DeleteLoginFailures コンテキストのテナントでメールアドレスのログイン失敗を削除（ロックを解除する、トランザクション内で使用）
+func DeleteLoginFailures(ctx context.Context, tx infrastructure.DBTX, email string) error {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
+	queries := dao.New(tx)
+	err = queries.DeleteLoginFailures(ctx, dao.DeleteLoginFailuresParams{
+		OrganizationID: organizationID,
+		Email:          domain.LoginFailureEmail(email),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete login failures: %w", err)
+	}
+	return nil
+}
+
+// DeleteStaleLoginFailures すべての組織で、before より前に最後に失敗したログイン失敗を削除し、削除件数を返す
+// before にロックの時間より前を指定すると、ロック中の記録は削除されない
+func DeleteStaleLoginFailures(ctx context.Context, tx infrastructure.DBTX, before time.Time) (int64, error) {
+	queries := dao.New(tx)
+	deleted, err := queries.DeleteStaleLoginFailures(ctx, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete stale login failures: %w", err)
+	}
+	return deleted, nil
+}
@@ -0,0 +1,167 @@
+package command
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+)
+
+// fakeLoginFailureDB ログイン失敗のクエリだけを実装し、PostgreSQLの行ロックを再現するメモリ上のデータベース
+// SELECT ... FOR UPDATE は存在する行だけをロックし、ロックはトランザクションの終了まで保持される
+type fakeLoginFailureDB struct {
+	mu       sync.Mutex
+	released *sync.Cond
+	rows     map[string][]driver.Value
+	// locks 行をロックしている接続
+	locks map[string]*fakeLoginFailureConn
+}
+
+func newFakeLoginFailureDB(t *testing.T) (*fakeLoginFailureDB, *sql.DB) {
+	t.Helper()
+	fake := &fakeLoginFailureDB{rows: map[string][]driver.Value{}, locks: map[string]*fakeLoginFailureConn{}}
+	fake.released = sync.NewCond(&fake.mu)
+	db := sql.OpenDB(fake)
+	t.Cleanup(func() { _ = db.Close() })
+	return fake, db
+}
+
+func (f *fakeLoginFailureDB) Connect(context.Context) (driver.Conn, error) {
+	return &fakeLoginFailureConn{db: f}, nil
+}
+func (f *fakeLoginFailureDB) Driver() driver.Driver { return nil }
+
+// lock 行のロックを取得する（他の接続がロックしている場合は解放を待つ、mu を保持して呼び出す）
+func (f *fakeLoginFailureDB) lock(key string, conn *fakeLoginFailureConn) {
+	for f.locks[key] != nil && f.locks[key] != conn {
+		f.released.Wait()
+	}
+	f.locks[key] = conn
+}
+
+type fakeLoginFailureConn struct{ db *fakeLoginFailureDB }
+
+func (c *fakeLoginFailureConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepare is not supported")
+}
+func (c *fakeLoginFailureConn) Close() error              { return nil }
+func (c *fakeLoginFailureConn) Begin() (driver.Tx, error) { return c, nil }
+
+// Commit トランザクションの終了時に、保持している行ロックを解放する
+func (c *fakeLoginFailureConn) Commit() error {
+	f := c.db
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for key, owner := range f.locks {
+		if owner == c {
+			delete(f.locks, key)
+		}
+	}
+	f.released.Broadcast()
+	return nil
+}
+func (c *fakeLoginFailureConn) Rollback() error { return c.Commit() }
+
+func (c *fakeLoginFailureConn) ExecContext(_ context.Context, query string, named []driver.NamedValue) (driver.Result, error) {
+	f := c.db
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := values(named)
+	key := fmt.Sprint(args[0], ":", args[1])
+
+	switch name := queryName(query); name {
+	case "CreateLoginFailuresIfNotExists":
+		// 作成した行は作成したトランザクションがロックし、同じ行を作成しようとしたトランザクションは終了を待つ
+		f.lock(key, c)
+		if _, ok := f.rows[key]; !ok {
+			f.rows[key] = []driver.Value{args[0], args[1], int64(0), args[2]}
+		}
+	case "UpsertLoginFailures":
+		f.rows[key] = args
+	default:
+		return nil, fmt.Errorf("unexpected exec: %s", name)
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (c *fakeLoginFailureConn) QueryContext(_ context.Context, query string, named []driver.NamedValue) (driver.Rows, error) {
+	f := c.db
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := values(named)
+	key := fmt.Sprint(args[0], ":", args[1])
+
+	if name := queryName(query); name != "GetLoginFailuresForUpdate" {
+		return nil, fmt.Errorf("unexpected query: %s", name)
+	}
+	rows := &fakeRows{}
+	if _, ok := f.rows[key]; ok {
+		f.lock(key, c)
+		rows.values = append(rows.values, f.rows[key])
+	}
+	return rows, nil
+}
+
+func TestLoginFailures_ConcurrentFailuresAreAllCounted(t *testing.T) {
+	_, db := newFakeLoginFailureDB(t)
+	ctx := domain.WithTenant(context.Background(), domain.DefaultOrganizationID)
+	policy := domain.LoginLockoutPolicy{MaxFailures: 3, Lockout: 15 * time.Minute}
+	const attempts = 10
+
+	// 記録のないメールアドレスへの同時の失敗（読み取りと保存の間に他のトランザクションが割り込む余地を作る）
+	var wg sync.WaitGroup
+	errs := make(chan error, attempts)
+	for range attempts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- func() error {
+				tx, err := db.BeginTx(ctx, nil)
+				if err != nil {
+					return err
+				}
+				defer func() { _ = tx.Rollback() }()
+				failures, err := FindLoginFailuresForUpdate(ctx, tx, "John@Example.com")
+				if err != nil {
+					return err
+				}
+				time.Sleep(time.Millisecond)
+				failures.Record(policy, time.Now())
+				if err := SaveLoginFailures(ctx, tx, failures); err != nil {
+					return err
+				}
+				return tx.Commit()
+			}()
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+	failures, err := FindLoginFailuresForUpdate(ctx, tx, "john@example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// どの失敗も失われず、ロックされる
+	if failures.Count != attempts {
+		t.Errorf("expected %d failures, got %d", attempts, failures.Count)
+	}
+	if _, locked := failures.RetryAfter(policy, time.Now()); !locked {
+		t.Error("expected the email to be locked out")
+	}
+}
@@ -0,0 +1,119 @@
+package command
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+)
+
+// organizationsSlugUniqueIndex 組織のスラッグの一意性を保証する制約名（db/schema/organizations.sql）
+const organizationsSlugUniqueIndex = "organizations_slug_key"
+
+// SaveOrganization 組織を作成（トランザクション内で使用）
+// スラッグが重複した場合は domain.ErrOrganizationSlugAlreadyExists を返す
+func SaveOrganization(ctx context.Context, tx infrastructure.DBTX, organization *domain.Organization) error {
+	queries := dao.New(tx)
+	err := queries.CreateOrganization(ctx, dao.CreateOrganizationParams{
+		ID:        organization.ID,
+		Name:      organization.Name,
+		Slug:      organization.Slug,
+		CreatedAt: organization.CreatedAt,
+		UpdatedAt: organization.UpdatedAt,
+	})
+	if infrastructure.IsUniqueViolation(err, organizationsSlugUniqueIndex) {
+		return domain.ErrOrganizationSlugAlreadyExists(organization.Slug)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to save organization: %w", err)
+	}
+	return nil
+}
+
+// EnsureOrganization 組織が存在しない場合のみ作成（トランザクション内で使用）
+func EnsureOrganization(ctx context.Context, tx infrastructure.DBTX, organization *domain.Organization) error {
+	queries := dao.New(tx)
+	err := queries.EnsureOrganization(ctx, dao.EnsureOrganizationParams{
+		ID:        organization.ID,
+		Name:      organization.Name,
+		Slug:      organization.Slug,
+		CreatedAt: organization.CreatedAt,
+		UpdatedAt: organization.UpdatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to ensure organization: %w", err)
+	}
+	return nil
+}
+
+// SaveMembership メンバーシップをコンテキストのテナントに保存（トランザクション内で使用）
+func SaveMembership(ctx context.Context, tx infrastructure.DBTX, membership *domain.Membership) error {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
+	queries := dao.New(tx)
+	err = queries.UpsertMembership(ctx, dao.UpsertMembershipParams{
+		OrganizationID: organizationID,
+		UserID:         membership.UserID,
+		Roles:          domain.RoleNames(membership.Roles),
+		CreatedAt:      membership.CreatedAt,
+		UpdatedAt:      membership.UpdatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save membership: %w", err)
+	}
+	membership.OrganizationID = organizationID
+	return nil
+}
+
+// FindMembershipForUpdate コンテキストのテナントのメンバーシップを検索しロックを取得（トランザクション内で使用）
+func FindMembershipForUpdate(ctx context.Context, tx infrastructure.DBTX, userID string) (*domain.Membership, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	queries := dao.New(tx)
+	membership, err := queries.GetMembershipForUpdate(ctx, dao.GetMembershipForUpdateParams{
+		OrganizationID: organizationID,
+		UserID:         userID,
+	})
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find membership for update: %w", err)
+	}
+	return toDomainMembership(membership), nil
+}
+
+// DeleteMembership コンテキストのテナントからメンバーシップを削除（トランザクション内で使用）
+func DeleteMembership(ctx context.Context, tx infrastructure.DBTX, userID string) error {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
+	queries := dao.New(tx)
+	err = queries.DeleteMembership(ctx, dao.DeleteMembershipParams{
+		OrganizationID: organizationID,
+		UserID:         userID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete membership: %w", err)
+	}
+	return nil
+}
+
+// toDomainMembership dao.OrganizationMembershipをdomain.Membershipに変換
+func toDomainMembership(m dao.OrganizationMembership) *domain.Membership {
+	return &domain.Membership{
+		OrganizationID: m.OrganizationID,
+		UserID:         m.UserID,
+		Roles:          domain.ParseRoles(m.Roles),
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+	}
+}
@@ -0,0 +1,133 @@
+package command
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+	"github.com/oklog/ulid/v2"
+)
+
+// SaveEvents ドメインイベントをコンテキストのテナントのアウトボックスと変更通知に保存し、
+// コミット後にプロセス内の購読者へ配信するためコンテキストの EventCollector に集める（トランザクション内で使用）
+func SaveEvents(ctx context.Context, tx infrastructure.DBTX, events []domain.DomainEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
+
+	queries := dao.New(tx)
+	now := time.Now()
+	for _, event := range events {
+		payload, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s event: %w", event.EventType(), err)
+		}
+		err = queries.CreateOutboxEvent(ctx, dao.CreateOutboxEventParams{
+			ID:             ulid.MustNew(ulid.Timestamp(now), rand.Reader).String(),
+			OrganizationID: organizationID,
+			AggregateType:  string(event.AggregateType()),
+			AggregateID:    event.AggregateID(),
+			EventType:      event.EventType(),
+			Payload:        payload,
+			OccurredAt:     event.OccurredAt(),
+			CreatedAt:      now,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to save outbox event: %w", err)
+		}
+	}
+	if err := saveChanges(ctx, tx, organizationID, events); err != nil {
+		return err
+	}
+
+	domain.CollectEvents(ctx, events...)
+	return nil
+}
+
+// FetchPendingOutboxEvents 配信されていないイベントを記録順に取得しロック（トランザクション内で使用）
+// ワーカーがすべてのテナントのイベントを配信するため、テナントでは絞り込まない
+func FetchPendingOutboxEvents(ctx context.Context, tx infrastructure.DBTX, limit int) ([]*domain.OutboxEvent, error) {
+	queries := dao.New(tx)
+	rows, err := queries.FetchPendingOutboxEvents(ctx, int32(limit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch outbox events: %w", err)
+	}
+
+	events := make([]*domain.OutboxEvent, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, toDomainOutboxEvent(row))
+	}
+	return events, nil
+}
+
+// ListOutboxEventsAfter 投影のチェックポイントより後のイベントを、記録したトランザクションの順に取得（トランザクション内で使用）
+// 投影が配信済みのイベントも含めて読み進めるため、まだコミットされうるトランザクションより前に記録されたもののみを返す
+// 返したイベントより前の位置に、後からイベントがコミットされることはない
+func ListOutboxEventsAfter(ctx context.Context, tx infrastructure.DBTX, after ProjectionCheckpoint, limit int) ([]*domain.OutboxEvent, error) {
+	queries := dao.New(tx)
+	rows, err := queries.ListOutboxEventsAfter(ctx, dao.ListOutboxEventsAfterParams{
+		AfterTransactionID: after.TransactionID,
+		AfterID:            after.EventID,
+		Limit:              int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list outbox events: %w", err)
+	}
+
+	events := make([]*domain.OutboxEvent, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, toDomainOutboxEvent(row))
+	}
+	return events, nil
+}
+
+// MarkOutboxEventPublished イベントを配信済みに変更（トランザクション内で使用）
+func MarkOutboxEventPublished(ctx context.Context, tx infrastructure.DBTX, eventID string, publishedAt time.Time) error {
+	queries := dao.New(tx)
+	return queries.MarkOutboxEventPublished(ctx, dao.MarkOutboxEventPublishedParams{
+		ID:          eventID,
+		PublishedAt: sql.NullTime{Time: publishedAt, Valid: true},
+	})
+}
+
+// MarkOutboxEventFailed イベントの配信の失敗を記録（トランザクション内で使用）
+func MarkOutboxEventFailed(ctx context.Context, tx infrastructure.DBTX, eventID string, lastError string) error {
+	queries := dao.New(tx)
+	return queries.MarkOutboxEventFailed(ctx, dao.MarkOutboxEventFailedParams{
+		ID:        eventID,
+		LastError: sql.NullString{String: lastError, Valid: true},
+	})
+}
+
+// toDomainOutboxEvent dao.OutboxEventをdomain.OutboxEventに変換
+func toDomainOutboxEvent(e dao.OutboxEvent) *domain.OutboxEvent {
+	event := &domain.OutboxEvent{
+		ID:             e.ID,
+		OrganizationID: e.OrganizationID,
+		AggregateType:  domain.AggregateType(e.AggregateType),
+		AggregateID:    e.AggregateID,
+		EventType:      e.EventType,
+		Payload:        e.Payload,
+		OccurredAt:     e.OccurredAt,
+		Attempts:       int(e.Attempts),
+		CreatedAt:      e.CreatedAt,
+		TransactionID:  e.TransactionID,
+	}
+	if e.PublishedAt.Valid {
+		event.PublishedAt = &e.PublishedAt.Time
+	}
+	if e.LastError.Valid {
+		event.LastError = e.LastError.String
+	}
+	return event
+}
@@ -0,0 +1,91 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+)
+
+// ProjectionCheckpoint 投影が最後に適用したアウトボックスのイベントの位置（ゼロ値はアウトボックスの先頭）
+// イベントのIDや記録日時はコミットの順とは限らないため、記録したトランザクションのIDとイベントIDの順に読み進める
+type ProjectionCheckpoint struct {
+	TransactionID int64
+	EventID       string
+}
+
+// CheckpointOf イベントまで適用した投影のチェックポイント
+func CheckpointOf(event *domain.OutboxEvent) ProjectionCheckpoint {
+	return ProjectionCheckpoint{TransactionID: event.TransactionID, EventID: event.ID}
+}
+
+// LockProjectionCheckpoint 投影のチェックポイント（最後に適用したアウトボックスのイベントの位置）を取得し行ロック（トランザクション内で使用）
+// 初めて実行する投影の場合はチェックポイントを作成し、created に true を返す
+func LockProjectionCheckpoint(ctx context.Context, tx infrastructure.DBTX, name string) (checkpoint ProjectionCheckpoint, created bool, err error) {
+	queries := dao.New(tx)
+	rows, err := queries.InitProjectionCheckpoint(ctx, dao.InitProjectionCheckpointParams{
+		Name:      name,
+		UpdatedAt: time.Now(),
+	})
+	if err != nil {
+		return ProjectionCheckpoint{}, false, fmt.Errorf("failed to init projection checkpoint: %w", err)
+	}
+	row, err := queries.GetProjectionCheckpointForUpdate(ctx, name)
+	if err != nil {
+		return ProjectionCheckpoint{}, false, fmt.Errorf("failed to lock projection checkpoint: %w", err)
+	}
+	return ProjectionCheckpoint{TransactionID: row.LastTransactionID, EventID: row.LastEventID}, rows > 0, nil
+}
+
+// UpdateProjectionCheckpoint 投影のチェックポイントを更新（トランザクション内で使用）
+// ゼロ値を指定すると、次回はアウトボックスの先頭から読み直す
+func UpdateProjectionCheckpoint(ctx context.Context, tx infrastructure.DBTX, name string, checkpoint ProjectionCheckpoint) error {
+	queries := dao.New(tx)
+	return queries.UpdateProjectionCheckpoint(ctx, dao.UpdateProjectionCheckpointParams{
+		Name:              name,
+		LastTransactionID: checkpoint.TransactionID,
+		LastEventID:       checkpoint.EventID,
+		UpdatedAt:         time.Now(),
+	})
+}
+
+// RefreshUserSummary コンテキストのテナントのユーザーの一覧の行を、ユーザーとユーザーログの現在の状態から作り直す（トランザクション内で使用）
+// ユーザーが存在しない（削除された）場合は行を削除する。何度実行しても同じ結果になる
+func RefreshUserSummary(ctx context.Context, tx infrastructure.DBTX, userID string) error {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
+
+	queries := dao.New(tx)
+	rows, err := queries.RefreshUserSummary(ctx, dao.RefreshUserSummaryParams{
+		ProjectedAt:    time.Now(),
+		OrganizationID: organizationID,
+		ID:             userID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to refresh user summary: %w", err)
+	}
+	if rows > 0 {
+		return nil
+	}
+	return queries.DeleteUserSummary(ctx, dao.DeleteUserSummaryParams{
+		OrganizationID: organizationID,
+		ID:             userID,
+	})
+}
+
+// ResetUserSummaries すべての組織のユーザーの一覧を users テーブルから作り直す（トランザクション内で使用）
+func ResetUserSummaries(ctx context.Context, tx infrastructure.DBTX) error {
+	queries := dao.New(tx)
+	if err := queries.ClearUserSummaries(ctx); err != nil {
+		return fmt.Errorf("failed to clear user summaries: %w", err)
+	}
+	if err := queries.SeedUserSummaries(ctx, time.Now()); err != nil {
+		return fmt.Errorf("failed to seed user summaries: %w", err)
+	}
+	return nil
+}
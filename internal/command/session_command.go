@@ -0,0 +1,124 @@
+package command
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+)
+
+// SaveSession セッションをコンテキストのテナントに保存（トランザクション内で使用）
+func SaveSession(ctx context.Context, tx infrastructure.DBTX, session *domain.Session) error {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
+	queries := dao.New(tx)
+	err = queries.CreateSession(ctx, dao.CreateSessionParams{
+		ID:             session.ID,
+		UserID:         session.UserID,
+		OrganizationID: organizationID,
+		TokenHash:      session.TokenHash,
+		RemoteAddr:     session.RemoteAddr,
+		UserAgent:      session.UserAgent,
+		CreatedAt:      session.CreatedAt,
+		LastSeenAt:     session.LastSeenAt,
+		ExpiresAt:      session.ExpiresAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save session: %w", err)
+	}
+	session.OrganizationID = organizationID
+	return nil
+}
+
+// FindSessionByIDForUpdate IDでセッションを検索しロックを取得（トランザクション内で使用）
+func FindSessionByIDForUpdate(ctx context.Context, tx infrastructure.DBTX, id string) (*domain.Session, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	queries := dao.New(tx)
+	session, err := queries.GetSessionByIDForUpdate(ctx, dao.GetSessionByIDForUpdateParams{OrganizationID: organizationID, ID: id})
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find session for update: %w", err)
+	}
+	return toDomainSession(session), nil
+}
+
+// RevokeSession セッションを失効させる（トランザクション内で使用）
+func RevokeSession(ctx context.Context, tx infrastructure.DBTX, id string, revokedAt time.Time) error {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
+	queries := dao.New(tx)
+	err = queries.RevokeSession(ctx, dao.RevokeSessionParams{
+		RevokedAt:      sql.NullTime{Time: revokedAt, Valid: true},
+		OrganizationID: organizationID,
+		ID:             id,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+	return nil
+}
+
+// RevokeUserSessions ユーザーのセッションを exceptID 以外すべて失効させる（トランザクション内で使用）
+// すべて失効させる場合は exceptID に空文字列を指定する
+func RevokeUserSessions(ctx context.Context, tx infrastructure.DBTX, userID, exceptID string, revokedAt time.Time) error {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
+	queries := dao.New(tx)
+	err = queries.RevokeUserSessions(ctx, dao.RevokeUserSessionsParams{
+		RevokedAt:      sql.NullTime{Time: revokedAt, Valid: true},
+		OrganizationID: organizationID,
+		UserID:         userID,
+		ExceptID:       exceptID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to revoke user sessions: %w", err)
+	}
+	return nil
+}
+
+// TouchSessionLastSeen セッションの最終利用日時を更新（トランザクション内で使用）
+func TouchSessionLastSeen(ctx context.Context, tx infrastructure.DBTX, id string, seenAt time.Time) error {
+	queries := dao.New(tx)
+	err := queries.TouchSessionLastSeen(ctx, dao.TouchSessionLastSeenParams{
+		SeenAt: seenAt,
+		ID:     id,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update session last seen: %w", err)
+	}
+	return nil
+}
+
+// toDomainSession dao.Sessionをdomain.Sessionに変換
+func toDomainSession(s dao.Session) *domain.Session {
+	session := &domain.Session{
+		ID:             s.ID,
+		UserID:         s.UserID,
+		OrganizationID: s.OrganizationID,
+		TokenHash:      s.TokenHash,
+		RemoteAddr:     s.RemoteAddr,
+		UserAgent:      s.UserAgent,
+		CreatedAt:      s.CreatedAt,
+		LastSeenAt:     s.LastSeenAt,
+		ExpiresAt:      s.ExpiresAt,
+	}
+	if s.RevokedAt.Valid {
+		session.RevokedAt = &s.RevokedAt.Time
+	}
+	return session
+}
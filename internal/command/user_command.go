@@ -10,76 +10,162 @@ import (
 	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
 )
 
-// Save ユーザーを保存（トランザクション内で使用）
-func Save(ctx context.Context, tx infrastructure.DBTX, user *domain.User) error {
+// usersEmailUniqueIndex 組織ごとのメールアドレスの一意性を保証するインデックス名（db/schema/schema.sql）
+const usersEmailUniqueIndex = "idx_users_organization_email_lower"
+
+// Save ユーザーをコンテキストのテナントに保存（トランザクション内で使用）
+// 事前の重複チェックをすり抜けてメールアドレスが重複した場合は domain.ErrEmailAlreadyExists を返す
+// イベントソーシングが有効な場合は記録されたイベントをイベントストアに追加し、users テーブルを投影として更新する
+func Save(ctx context.Context, tx infrastructure.DBTX, eventSourcing UserEventSourcing, user *domain.User) error {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
+	events := user.PullEvents()
+	if eventSourcing.Enabled {
+		// 変更がない場合は投影も更新しない（イベントストアの状態と一致させる）
+		if len(events) == 0 {
+			return nil
+		}
+		if err := appendUserEvents(ctx, tx, eventSourcing, organizationID, user, events); err != nil {
+			return err
+		}
+	}
+
 	queries := dao.New(tx)
-	err := queries.UpsertUser(ctx, dao.UpsertUserParams{
-		ID:        user.ID,
-		Name:      user.Name,
-		Email:     user.Email,
-		CreatedAt: user.CreatedAt,
-		UpdatedAt: user.UpdatedAt,
-	})
+	params := dao.UpsertUserParams{
+		ID:             user.ID,
+		OrganizationID: organizationID,
+		Name:           user.Name,
+		Email:          user.Email,
+		PasswordHash:   user.PasswordHash,
+		CreatedAt:      user.CreatedAt,
+		UpdatedAt:      user.UpdatedAt,
+	}
+	if user.EmailVerifiedAt != nil {
+		params.EmailVerifiedAt = sql.NullTime{Time: *user.EmailVerifiedAt, Valid: true}
+	}
+	if user.EmailInvalidatedAt != nil {
+		params.EmailInvalidatedAt = sql.NullTime{Time: *user.EmailInvalidatedAt, Valid: true}
+	}
+	affected, err := queries.UpsertUser(ctx, params)
+	if infrastructure.IsUniqueViolation(err, usersEmailUniqueIndex) {
+		return domain.ErrEmailAlreadyExists(user.Email)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to save user: %w", err)
 	}
-	return nil
+	// 同じIDのユーザーが別の組織に存在する（他のテナントのデータは上書きしない）
+	if affected == 0 {
+		return fmt.Errorf("failed to save user: user %s belongs to another organization", user.ID)
+	}
+	user.OrganizationID = organizationID
+
+	// 記録されたドメインイベントをアウトボックスに保存
+	return SaveEvents(ctx, tx, events)
 }
 
-// Delete ユーザーを削除（トランザクション内で使用）
-func Delete(ctx context.Context, tx infrastructure.DBTX, id string) error {
+// Delete ユーザーを削除し、記録されたドメインイベントをアウトボックスに保存（トランザクション内で使用）
+func Delete(ctx context.Context, tx infrastructure.DBTX, eventSourcing UserEventSourcing, user *domain.User) error {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
 	queries := dao.New(tx)
 
 	// ユーザーの存在確認（FOR UPDATEでロック取得）
-	_, err := queries.GetUserByIDForUpdate(ctx, id)
+	_, err = queries.GetUserByIDForUpdate(ctx, dao.GetUserByIDForUpdateParams{OrganizationID: organizationID, ID: user.ID})
 	if err == sql.ErrNoRows {
-		return fmt.Errorf("user not found: %s", id)
+		return fmt.Errorf("user not found: %s", user.ID)
 	}
 	if err != nil {
 		return fmt.Errorf("failed to find user: %w", err)
 	}
 
+	events := user.PullEvents()
+	if eventSourcing.Enabled {
+		if err := appendUserEvents(ctx, tx, eventSourcing, organizationID, user, events); err != nil {
+			return err
+		}
+	}
+
 	// 削除実行
-	if err := queries.DeleteUser(ctx, id); err != nil {
+	if err := queries.DeleteUser(ctx, dao.DeleteUserParams{OrganizationID: organizationID, ID: user.ID}); err != nil {
 		return fmt.Errorf("failed to delete user: %w", err)
 	}
 
-	return nil
+	return SaveEvents(ctx, tx, events)
 }
 
 // FindByIDForUpdate IDでユーザーを検索しロックを取得（トランザクション内で使用）
-func FindByIDForUpdate(ctx context.Context, tx infrastructure.DBTX, id string) (*domain.User, error) {
+// イベントソーシングが有効な場合は、users の行をロックしたうえでイベントストアから再構築する
+func FindByIDForUpdate(ctx context.Context, tx infrastructure.DBTX, eventSourcing UserEventSourcing, id string) (*domain.User, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
 	queries := dao.New(tx)
-	user, err := queries.GetUserByIDForUpdate(ctx, id)
+	user, err := queries.GetUserByIDForUpdate(ctx, dao.GetUserByIDForUpdateParams{OrganizationID: organizationID, ID: id})
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to find user for update: %w", err)
 	}
+	if eventSourcing.Enabled {
+		return loadUser(ctx, tx, organizationID, &user)
+	}
 	return toDomainUser(user), nil
 }
 
 // FindByEmailForUpdate メールアドレスでユーザーを検索しロックを取得（トランザクション内で使用）
-func FindByEmailForUpdate(ctx context.Context, tx infrastructure.DBTX, email string) (*domain.User, error) {
+// イベントソーシングが有効な場合は、users の行をロックしたうえでイベントストアから再構築する
+func FindByEmailForUpdate(ctx context.Context, tx infrastructure.DBTX, eventSourcing UserEventSourcing, email string) (*domain.User, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
 	queries := dao.New(tx)
-	user, err := queries.GetUserByEmailForUpdate(ctx, email)
+	user, err := queries.GetUserByEmailForUpdate(ctx, dao.GetUserByEmailForUpdateParams{OrganizationID: organizationID, Email: email})
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to find user by email for update: %w", err)
 	}
+	if eventSourcing.Enabled {
+		return loadUser(ctx, tx, organizationID, &user)
+	}
 	return toDomainUser(user), nil
 }
 
+// FindOrganizationIDsByUserEmail メールアドレスが一致するユーザーが所属する組織をすべての組織から探す（トランザクション内で使用）
+// テナントを問わずに検索するため、組織を区別しない外部からの通知（メールのバウンスなど）の処理にのみ使う
+func FindOrganizationIDsByUserEmail(ctx context.Context, tx infrastructure.DBTX, email string) ([]string, error) {
+	queries := dao.New(tx)
+	organizationIDs, err := queries.ListUserOrganizationIDsByEmail(ctx, email)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find organizations by user email: %w", err)
+	}
+	return organizationIDs, nil
+}
+
 // toDomainUser dao.Userをdomain.Userに変換
 func toDomainUser(u dao.User) *domain.User {
-	return &domain.User{
-		ID:        u.ID,
-		Name:      u.Name,
-		Email:     u.Email,
-		CreatedAt: u.CreatedAt,
-		UpdatedAt: u.UpdatedAt,
+	user := &domain.User{
+		ID:             u.ID,
+		OrganizationID: u.OrganizationID,
+		Name:           u.Name,
+		Email:          u.Email,
+		PasswordHash:   u.PasswordHash,
+		CreatedAt:      u.CreatedAt,
+		UpdatedAt:      u.UpdatedAt,
+	}
+	if u.EmailVerifiedAt.Valid {
+		user.EmailVerifiedAt = &u.EmailVerifiedAt.Time
+	}
+	if u.EmailInvalidatedAt.Valid {
+		user.EmailInvalidatedAt = &u.EmailInvalidatedAt.Time
 	}
+	return user
 }
@@ -0,0 +1,278 @@
+package command
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+)
+
+// aggregateEventsPrimaryKey 集約ごとのイベントの連番の一意性を保証する制約名（db/schema/aggregate_events.sql）
+const aggregateEventsPrimaryKey = "aggregate_events_pkey"
+
+// defaultUserSnapshotInterval スナップショットを保存するイベントの間隔の既定値
+const defaultUserSnapshotInterval = 20
+
+// UserEventSourcing ユーザーの保存方法の設定（ゼロ値は users テーブルに直接保存する）
+// サーバーとワーカーで同じ設定にする
+type UserEventSourcing struct {
+	// Enabled ユーザーをイベントストアに保存し、users テーブルを投影として更新する
+	Enabled bool
+	// SnapshotInterval この件数のイベントごとにスナップショットを保存する（0以下の場合は既定値）
+	SnapshotInterval int
+}
+
+// snapshotInterval スナップショットを保存するイベントの間隔
+func (c UserEventSourcing) snapshotInterval() int {
+	if c.SnapshotInterval > 0 {
+		return c.SnapshotInterval
+	}
+	return defaultUserSnapshotInterval
+}
+
+// userSnapshot スナップショットとして保存するユーザーの状態
+type userSnapshot struct {
+	ID                 string     `json:"id"`
+	Name               string     `json:"name"`
+	Email              string     `json:"email"`
+	EmailVerifiedAt    *time.Time `json:"emailVerifiedAt,omitempty"`
+	EmailInvalidatedAt *time.Time `json:"emailInvalidatedAt,omitempty"`
+	PasswordHash       string     `json:"passwordHash"`
+	CreatedAt          time.Time  `json:"createdAt"`
+	UpdatedAt          time.Time  `json:"updatedAt"`
+}
+
+// storedUserPasswordChanged パスワードのハッシュを含めてイベントストアに保存する UserPasswordChanged
+type storedUserPasswordChanged struct {
+	domain.UserPasswordChanged
+	PasswordHash string `json:"passwordHash"`
+}
+
+// appendUserEvents ユーザーのイベントを user.Version の続きの連番でイベントストアに追加（トランザクション内で使用）
+// ほかのトランザクションが先に同じ連番を追加していた場合は domain.ErrUserConcurrentModification を返す
+// イベントソーシング導入前のユーザー（イベントがない）は、最初のイベントを追加するときに users の行を連番0のスナップショットとして保存する
+func appendUserEvents(ctx context.Context, tx infrastructure.DBTX, eventSourcing UserEventSourcing, organizationID string, user *domain.User, events []domain.DomainEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	queries := dao.New(tx)
+	if user.Version == 0 && events[0].EventType() != domain.EventTypeUserCreated {
+		if err := saveLegacyUserSnapshot(ctx, queries, organizationID, user.ID); err != nil {
+			return err
+		}
+	}
+
+	now := time.Now()
+	version := user.Version
+	for _, event := range events {
+		payload, err := marshalUserEvent(event)
+		if err != nil {
+			return err
+		}
+		version++
+		err = queries.AppendAggregateEvent(ctx, dao.AppendAggregateEventParams{
+			AggregateType:  string(domain.AggregateTypeUser),
+			AggregateID:    user.ID,
+			Sequence:       int32(version),
+			OrganizationID: organizationID,
+			EventType:      event.EventType(),
+			Payload:        payload,
+			OccurredAt:     event.OccurredAt(),
+			CreatedAt:      now,
+		})
+		if infrastructure.IsUniqueViolation(err, aggregateEventsPrimaryKey) {
+			return domain.ErrUserConcurrentModification(user.ID)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to append user event: %w", err)
+		}
+	}
+
+	// スナップショットの間隔をまたいだ場合は現在の状態を保存する
+	previous := user.Version
+	user.Version = version
+	interval := eventSourcing.snapshotInterval()
+	if version/interval > previous/interval {
+		return saveUserSnapshot(ctx, queries, organizationID, user)
+	}
+	return nil
+}
+
+// saveLegacyUserSnapshot イベントソーシング導入前のユーザーの users の行（更新前）を連番0のスナップショットとして保存
+func saveLegacyUserSnapshot(ctx context.Context, queries *dao.Queries, organizationID, userID string) error {
+	row, err := queries.GetUserByIDForUpdate(ctx, dao.GetUserByIDForUpdateParams{OrganizationID: organizationID, ID: userID})
+	if err != nil {
+		return fmt.Errorf("failed to find user for snapshot: %w", err)
+	}
+	return saveUserSnapshot(ctx, queries, organizationID, toDomainUser(row))
+}
+
+// loadUser イベントストアからユーザーを再構築する（存在しない・削除済みの場合は nil）
+// 最新のスナップショットの後のイベントのみを適用する。読み込みのみで、スナップショットは保存しない
+// イベントソーシング導入前のユーザー（スナップショットもイベントもない）は users の行を連番0の状態として扱う
+func loadUser(ctx context.Context, tx infrastructure.DBTX, organizationID string, row *dao.User) (*domain.User, error) {
+	queries := dao.New(tx)
+	var snapshot *domain.User
+	stored, err := queries.GetAggregateSnapshot(ctx, dao.GetAggregateSnapshotParams{
+		OrganizationID: organizationID,
+		AggregateType:  string(domain.AggregateTypeUser),
+		AggregateID:    row.ID,
+	})
+	switch {
+	case err == sql.ErrNoRows:
+	case err != nil:
+		return nil, fmt.Errorf("failed to find user snapshot: %w", err)
+	default:
+		snapshot, err = unmarshalUserSnapshot(organizationID, stored)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	after := 0
+	if snapshot != nil {
+		after = snapshot.Version
+	}
+	rows, err := queries.ListAggregateEvents(ctx, dao.ListAggregateEventsParams{
+		OrganizationID: organizationID,
+		AggregateType:  string(domain.AggregateTypeUser),
+		AggregateID:    row.ID,
+		AfterSequence:  int32(after),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user events: %w", err)
+	}
+
+	if snapshot == nil && len(rows) == 0 {
+		snapshot = toDomainUser(*row)
+	}
+
+	events := make([]domain.DomainEvent, 0, len(rows))
+	for _, r := range rows {
+		event, err := unmarshalUserEvent(r.EventType, r.Payload)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	if len(events) > 0 && events[len(events)-1].EventType() == domain.EventTypeUserDeleted {
+		return nil, nil
+	}
+
+	user := domain.ReplayUser(snapshot, events)
+	user.OrganizationID = organizationID
+	return user, nil
+}
+
+// saveUserSnapshot ユーザーの現在の状態を user.Version のスナップショットとして保存
+func saveUserSnapshot(ctx context.Context, queries *dao.Queries, organizationID string, user *domain.User) error {
+	state, err := json.Marshal(userSnapshot{
+		ID:                 user.ID,
+		Name:               user.Name,
+		Email:              user.Email,
+		EmailVerifiedAt:    user.EmailVerifiedAt,
+		EmailInvalidatedAt: user.EmailInvalidatedAt,
+		PasswordHash:       user.PasswordHash,
+		CreatedAt:          user.CreatedAt,
+		UpdatedAt:          user.UpdatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal user snapshot: %w", err)
+	}
+
+	err = queries.UpsertAggregateSnapshot(ctx, dao.UpsertAggregateSnapshotParams{
+		AggregateType:  string(domain.AggregateTypeUser),
+		AggregateID:    user.ID,
+		OrganizationID: organizationID,
+		Sequence:       int32(user.Version),
+		State:          state,
+		CreatedAt:      time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save user snapshot: %w", err)
+	}
+	return nil
+}
+
+// unmarshalUserSnapshot スナップショットからユーザーを復元
+func unmarshalUserSnapshot(organizationID string, stored dao.AggregateSnapshot) (*domain.User, error) {
+	var state userSnapshot
+	if err := json.Unmarshal(stored.State, &state); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal user snapshot: %w", err)
+	}
+	return &domain.User{
+		ID:                 state.ID,
+		OrganizationID:     organizationID,
+		Name:               state.Name,
+		Email:              state.Email,
+		EmailVerifiedAt:    state.EmailVerifiedAt,
+		EmailInvalidatedAt: state.EmailInvalidatedAt,
+		PasswordHash:       state.PasswordHash,
+		CreatedAt:          state.CreatedAt,
+		UpdatedAt:          state.UpdatedAt,
+		Version:            int(stored.Sequence),
+	}, nil
+}
+
+// marshalUserEvent イベントストアに保存するペイロードを作成（外部に公開しない項目も含める）
+func marshalUserEvent(event domain.DomainEvent) (json.RawMessage, error) {
+	var value any = event
+	if e, ok := event.(domain.UserPasswordChanged); ok {
+		value = storedUserPasswordChanged{UserPasswordChanged: e, PasswordHash: e.PasswordHash}
+	}
+	payload, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s event: %w", event.EventType(), err)
+	}
+	return payload, nil
+}
+
+// unmarshalUserEvent イベントストアのペイロードからイベントを復元
+func unmarshalUserEvent(eventType string, payload json.RawMessage) (domain.DomainEvent, error) {
+	var (
+		event domain.DomainEvent
+		err   error
+	)
+	switch eventType {
+	case domain.EventTypeUserCreated:
+		var e domain.UserCreated
+		err = json.Unmarshal(payload, &e)
+		event = e
+	case domain.EventTypeUserRenamed:
+		var e domain.UserRenamed
+		err = json.Unmarshal(payload, &e)
+		event = e
+	case domain.EventTypeUserEmailChanged:
+		var e domain.UserEmailChanged
+		err = json.Unmarshal(payload, &e)
+		event = e
+	case domain.EventTypeUserEmailVerified:
+		var e domain.UserEmailVerified
+		err = json.Unmarshal(payload, &e)
+		event = e
+	case domain.EventTypeUserEmailInvalidated:
+		var e domain.UserEmailInvalidated
+		err = json.Unmarshal(payload, &e)
+		event = e
+	case domain.EventTypeUserPasswordChanged:
+		var e storedUserPasswordChanged
+		err = json.Unmarshal(payload, &e)
+		e.UserPasswordChanged.PasswordHash = e.PasswordHash
+		event = e.UserPasswordChanged
+	case domain.EventTypeUserDeleted:
+		var e domain.UserDeleted
+		err = json.Unmarshal(payload, &e)
+		event = e
+	default:
+		return nil, fmt.Errorf("unknown user event type: %s", eventType)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s event: %w", eventType, err)
+	}
+	return event, nil
+}
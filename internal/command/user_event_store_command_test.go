@@ -0,0 +1,364 @@
+package command
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/lib/pq"
+)
+
+// fakeEventStoreDB イベントストアとユーザーの投影に使うクエリだけを実装したメモリ上のデータベース
+// クエリは sqlc が生成するSQLの先頭の "-- name: クエリ名" で判別する
+type fakeEventStoreDB struct {
+	mu        sync.Mutex
+	users     map[string][]driver.Value
+	events    [][]driver.Value
+	snapshots map[string][]driver.Value
+	// executed 実行されたクエリ名（実行順）
+	executed []string
+}
+
+func newFakeEventStoreDB(t *testing.T) (*fakeEventStoreDB, *sql.DB) {
+	t.Helper()
+	store := &fakeEventStoreDB{
+		users:     map[string][]driver.Value{},
+		snapshots: map[string][]driver.Value{},
+	}
+	db := sql.OpenDB(store)
+	t.Cleanup(func() { _ = db.Close() })
+	return store, db
+}
+
+// writes 実行された書き込みクエリ名
+func (s *fakeEventStoreDB) writes() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var writes []string
+	for _, name := range s.executed {
+		if !strings.HasPrefix(name, "Get") && !strings.HasPrefix(name, "List") {
+			writes = append(writes, name)
+		}
+	}
+	return writes
+}
+
+// deleteEventsUntil 連番 sequence までのイベントを削除する（スナップショットから再構築されることの確認用）
+func (s *fakeEventStoreDB) deleteEventsUntil(sequence int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = slices.DeleteFunc(s.events, func(e []driver.Value) bool { return e[2].(int64) <= sequence })
+}
+
+func (s *fakeEventStoreDB) Connect(context.Context) (driver.Conn, error) {
+	return fakeEventStoreConn{s}, nil
+}
+func (s *fakeEventStoreDB) Driver() driver.Driver { return nil }
+
+type fakeEventStoreConn struct{ db *fakeEventStoreDB }
+
+func (c fakeEventStoreConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepare is not supported")
+}
+func (c fakeEventStoreConn) Close() error { return nil }
+func (c fakeEventStoreConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("begin is not supported")
+}
+
+func (c fakeEventStoreConn) ExecContext(_ context.Context, query string, named []driver.NamedValue) (driver.Result, error) {
+	s := c.db
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	name := queryName(query)
+	s.executed = append(s.executed, name)
+	args := values(named)
+
+	switch name {
+	case "AppendAggregateEvent":
+		// (aggregate_type, aggregate_id, sequence) の主キー
+		for _, e := range s.events {
+			if e[0] == args[0] && e[1] == args[1] && e[2] == args[2] {
+				return nil, &pq.Error{Code: "23505", Constraint: aggregateEventsPrimaryKey}
+			}
+		}
+		s.events = append(s.events, args)
+	case "UpsertAggregateSnapshot":
+		// 古いスナップショットで新しいものを上書きしない
+		key := fmt.Sprint(args[0], "/", args[1])
+		if current, ok := s.snapshots[key]; !ok || current[3].(int64) < args[3].(int64) {
+			s.snapshots[key] = args
+		}
+	case "UpsertUser":
+		s.users[args[0].(string)] = args
+	case "DeleteUser":
+		delete(s.users, args[1].(string))
+	case "CreateOutboxEvent", "CreateChange", "NotifyChangeFeed":
+	default:
+		return nil, fmt.Errorf("unexpected exec: %s", name)
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (c fakeEventStoreConn) QueryContext(_ context.Context, query string, named []driver.NamedValue) (driver.Rows, error) {
+	s := c.db
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	name := queryName(query)
+	s.executed = append(s.executed, name)
+	args := values(named)
+
+	rows := &fakeRows{}
+	switch name {
+	case "GetUserByIDForUpdate":
+		if user, ok := s.users[args[1].(string)]; ok && user[1] == args[0] {
+			rows.values = append(rows.values, user)
+		}
+	case "GetUserByEmailForUpdate":
+		for _, user := range s.users {
+			if user[1] == args[0] && strings.EqualFold(user[3].(string), args[1].(string)) {
+				rows.values = append(rows.values, user)
+			}
+		}
+	case "ListAggregateEvents":
+		for _, e := range s.events {
+			if e[3] == args[0] && e[0] == args[1] && e[1] == args[2] && e[2].(int64) > args[3].(int64) {
+				rows.values = append(rows.values, e)
+			}
+		}
+		slices.SortFunc(rows.values, func(a, b []driver.Value) int { return int(a[2].(int64) - b[2].(int64)) })
+	case "GetAggregateSnapshot":
+		if snapshot, ok := s.snapshots[fmt.Sprint(args[1], "/", args[2])]; ok && snapshot[2] == args[0] {
+			rows.values = append(rows.values, snapshot)
+		}
+	case "ReserveChangeIDs":
+		for i := range args[0].(int64) {
+			rows.values = append(rows.values, []driver.Value{i + 1})
+		}
+	default:
+		return nil, fmt.Errorf("unexpected query: %s", name)
+	}
+	return rows, nil
+}
+
+// queryName sqlc が生成したSQLの先頭の "-- name: クエリ名 :種類" からクエリ名を取り出す
+func queryName(query string) string {
+	fields := strings.Fields(query)
+	if len(fields) < 3 {
+		return ""
+	}
+	return fields[2]
+}
+
+func values(named []driver.NamedValue) []driver.Value {
+	args := make([]driver.Value, len(named))
+	for i, v := range named {
+		args[i] = v.Value
+	}
+	return args
+}
+
+type fakeRows struct {
+	values [][]driver.Value
+	next   int
+}
+
+func (r *fakeRows) Columns() []string {
+	if len(r.values) == 0 {
+		return nil
+	}
+	return make([]string, len(r.values[0]))
+}
+func (r *fakeRows) Close() error { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.values) {
+		return io.EOF
+	}
+	copy(dest, r.values[r.next])
+	r.next++
+	return nil
+}
+
+// testEventSourcingContext イベントソーシングのテストで使うテナントを設定したコンテキスト
+func testEventSourcingContext() context.Context {
+	return domain.WithTenant(context.Background(), domain.DefaultOrganizationID)
+}
+
+// createTestUser イベントソーシングでユーザーを作成する
+func createTestUser(t *testing.T, ctx context.Context, db *sql.DB, eventSourcing UserEventSourcing) *domain.User {
+	t.Helper()
+	user, err := domain.NewUser("John", "john@example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := Save(ctx, db, eventSourcing, user); err != nil {
+		t.Fatalf("failed to save user: %v", err)
+	}
+	return user
+}
+
+// renameTestUser ユーザーを読み込んで名前を変更する
+func renameTestUser(t *testing.T, ctx context.Context, db *sql.DB, eventSourcing UserEventSourcing, id, name string) {
+	t.Helper()
+	user, err := FindByIDForUpdate(ctx, db, eventSourcing, id)
+	if err != nil {
+		t.Fatalf("failed to load user: %v", err)
+	}
+	if err := user.Update(name, ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := Save(ctx, db, eventSourcing, user); err != nil {
+		t.Fatalf("failed to save user: %v", err)
+	}
+}
+
+func TestUserEventStore_SaveAndReplay(t *testing.T) {
+	ctx := testEventSourcingContext()
+	store, db := newFakeEventStoreDB(t)
+	eventSourcing := UserEventSourcing{Enabled: true}
+
+	created := createTestUser(t, ctx, db, eventSourcing)
+	if created.Version != 1 {
+		t.Errorf("expected version 1 after create, got %d", created.Version)
+	}
+	renameTestUser(t, ctx, db, eventSourcing, created.ID, "Jane")
+
+	user, err := FindByIDForUpdate(ctx, db, eventSourcing, created.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if user.Name != "Jane" || user.Email != "john@example.com" {
+		t.Errorf("unexpected replayed user: %+v", user)
+	}
+	if user.Version != 2 {
+		t.Errorf("expected version 2, got %d", user.Version)
+	}
+	if len(store.events) != 2 {
+		t.Errorf("expected 2 stored events, got %d", len(store.events))
+	}
+	if len(store.snapshots) != 0 {
+		t.Errorf("expected no snapshot before the interval, got %d", len(store.snapshots))
+	}
+}
+
+func TestUserEventStore_ReplayFromSnapshot(t *testing.T) {
+	ctx := testEventSourcingContext()
+	store, db := newFakeEventStoreDB(t)
+	eventSourcing := UserEventSourcing{Enabled: true, SnapshotInterval: 2}
+
+	created := createTestUser(t, ctx, db, eventSourcing)
+	renameTestUser(t, ctx, db, eventSourcing, created.ID, "Jane")
+	renameTestUser(t, ctx, db, eventSourcing, created.ID, "Alice")
+
+	snapshot, ok := store.snapshots[fmt.Sprint(domain.AggregateTypeUser, "/", created.ID)]
+	if !ok {
+		t.Fatal("expected a snapshot after crossing the interval")
+	}
+	if snapshot[3].(int64) != 2 {
+		t.Errorf("expected snapshot at sequence 2, got %d", snapshot[3])
+	}
+
+	// スナップショットより前のイベントがなくても、スナップショットとその後のイベントから再構築される
+	store.deleteEventsUntil(2)
+	user, err := FindByIDForUpdate(ctx, db, eventSourcing, created.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if user.Name != "Alice" || user.Email != "john@example.com" {
+		t.Errorf("unexpected replayed user: %+v", user)
+	}
+	if user.Version != 3 {
+		t.Errorf("expected version 3, got %d", user.Version)
+	}
+}
+
+func TestUserEventStore_ConcurrentModification(t *testing.T) {
+	ctx := testEventSourcingContext()
+	_, db := newFakeEventStoreDB(t)
+	eventSourcing := UserEventSourcing{Enabled: true}
+
+	created := createTestUser(t, ctx, db, eventSourcing)
+
+	// 同じバージョンから読み込んだ2つの変更のうち、後から保存したほうは競合する
+	first, err := FindByIDForUpdate(ctx, db, eventSourcing, created.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second, err := FindByIDForUpdate(ctx, db, eventSourcing, created.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_ = first.Update("Jane", "")
+	_ = second.Update("Alice", "")
+
+	if err := Save(ctx, db, eventSourcing, first); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	err = Save(ctx, db, eventSourcing, second)
+	var conflict *domain.ConflictError
+	if !errors.As(err, &conflict) {
+		t.Fatalf("expected ConflictError, got %v", err)
+	}
+
+	user, err := FindByIDForUpdate(ctx, db, eventSourcing, created.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if user.Name != "Jane" {
+		t.Errorf("expected the first change to win, got name %q", user.Name)
+	}
+}
+
+func TestUserEventStore_LegacyUser(t *testing.T) {
+	ctx := testEventSourcingContext()
+	store, db := newFakeEventStoreDB(t)
+	eventSourcing := UserEventSourcing{Enabled: true}
+
+	// イベントソーシングを有効にする前に作成されたユーザー（users の行のみ）
+	createdAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	store.users["01ARZ3NDEKTSV4RRFFQ69G5FAV"] = []driver.Value{
+		"01ARZ3NDEKTSV4RRFFQ69G5FAV", domain.DefaultOrganizationID, "John", "john@example.com", nil, nil, "", createdAt, createdAt,
+	}
+
+	t.Run("loading does not write a snapshot", func(t *testing.T) {
+		// メールアドレスの重複チェックと同じ読み込み
+		user, err := FindByEmailForUpdate(ctx, db, eventSourcing, "john@example.com")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if user.Name != "John" || user.Version != 0 {
+			t.Errorf("unexpected user: %+v", user)
+		}
+		if writes := store.writes(); len(writes) != 0 {
+			t.Errorf("expected no writes while loading, got %v", writes)
+		}
+	})
+
+	t.Run("first change saves the previous state as snapshot 0", func(t *testing.T) {
+		renameTestUser(t, ctx, db, eventSourcing, "01ARZ3NDEKTSV4RRFFQ69G5FAV", "Jane")
+
+		snapshot, ok := store.snapshots[fmt.Sprint(domain.AggregateTypeUser, "/", "01ARZ3NDEKTSV4RRFFQ69G5FAV")]
+		if !ok || snapshot[3].(int64) != 0 {
+			t.Fatalf("expected snapshot at sequence 0, got %v", snapshot)
+		}
+
+		user, err := FindByIDForUpdate(ctx, db, eventSourcing, "01ARZ3NDEKTSV4RRFFQ69G5FAV")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if user.Name != "Jane" || user.Email != "john@example.com" || !user.CreatedAt.Equal(createdAt) {
+			t.Errorf("unexpected replayed user: %+v", user)
+		}
+		if user.Version != 1 {
+			t.Errorf("expected version 1, got %d", user.Version)
+		}
+	})
+}
@@ -0,0 +1,134 @@
+package command
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+)
+
+// CreateUserImport インポートをコンテキストのテナントに保存（トランザクション内で使用）
+func CreateUserImport(ctx context.Context, tx infrastructure.DBTX, userImport *domain.UserImport) error {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
+	queries := dao.New(tx)
+	err = queries.CreateUserImport(ctx, dao.CreateUserImportParams{
+		ID:             userImport.ID,
+		OrganizationID: organizationID,
+		Format:         string(userImport.Format),
+		Status:         string(userImport.Status),
+		Source:         userImport.Source,
+		TotalRows:      int32(userImport.TotalRows),
+		CreatedAt:      userImport.CreatedAt,
+		UpdatedAt:      userImport.UpdatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create user import: %w", err)
+	}
+	return nil
+}
+
+// FindUserImportByIDForUpdate IDでインポートを検索しロックを取得（トランザクション内で使用）
+func FindUserImportByIDForUpdate(ctx context.Context, tx infrastructure.DBTX, id string) (*domain.UserImport, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	queries := dao.New(tx)
+	userImport, err := queries.GetUserImportByIDForUpdate(ctx, dao.GetUserImportByIDForUpdateParams{OrganizationID: organizationID, ID: id})
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user import for update: %w", err)
+	}
+	return toDomainUserImport(userImport), nil
+}
+
+// UpdateUserImportStatus インポートの状態を保存（トランザクション内で使用）
+func UpdateUserImportStatus(ctx context.Context, tx infrastructure.DBTX, userImport *domain.UserImport) error {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
+	queries := dao.New(tx)
+	params := dao.UpdateUserImportStatusParams{
+		Status:         string(userImport.Status),
+		UpdatedAt:      userImport.UpdatedAt,
+		OrganizationID: organizationID,
+		ID:             userImport.ID,
+	}
+	if userImport.LastError != "" {
+		params.LastError = sql.NullString{String: userImport.LastError, Valid: true}
+	}
+	if userImport.CompletedAt != nil {
+		params.CompletedAt = sql.NullTime{Time: *userImport.CompletedAt, Valid: true}
+	}
+	if err := queries.UpdateUserImportStatus(ctx, params); err != nil {
+		return fmt.Errorf("failed to update user import status: %w", err)
+	}
+	return nil
+}
+
+// SaveUserImportRow インポート行の処理結果を保存（トランザクション内で使用）
+func SaveUserImportRow(ctx context.Context, tx infrastructure.DBTX, row *domain.UserImportRow) error {
+	queries := dao.New(tx)
+	params := dao.CreateUserImportRowParams{
+		ImportID:  row.ImportID,
+		Line:      int32(row.Line),
+		Status:    string(row.Status),
+		Name:      row.Name,
+		Email:     row.Email,
+		CreatedAt: row.CreatedAt,
+	}
+	if row.UserID != "" {
+		params.UserID = sql.NullString{String: row.UserID, Valid: true}
+	}
+	if row.Reason != "" {
+		params.Reason = sql.NullString{String: row.Reason, Valid: true}
+	}
+	if err := queries.CreateUserImportRow(ctx, params); err != nil {
+		return fmt.Errorf("failed to save user import row: %w", err)
+	}
+	return nil
+}
+
+// FindProcessedUserImportLines 処理済みの行番号を取得（トランザクション内で使用）
+func FindProcessedUserImportLines(ctx context.Context, tx infrastructure.DBTX, importID string) (map[int]bool, error) {
+	queries := dao.New(tx)
+	lines, err := queries.ListUserImportRowLines(ctx, importID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list processed user import lines: %w", err)
+	}
+
+	processed := make(map[int]bool, len(lines))
+	for _, line := range lines {
+		processed[int(line)] = true
+	}
+	return processed, nil
+}
+
+// toDomainUserImport dao.UserImportをdomain.UserImportに変換
+func toDomainUserImport(i dao.UserImport) *domain.UserImport {
+	userImport := &domain.UserImport{
+		ID:        i.ID,
+		Format:    domain.UserImportFormat(i.Format),
+		Status:    domain.UserImportStatus(i.Status),
+		Source:    i.Source,
+		TotalRows: int(i.TotalRows),
+		CreatedAt: i.CreatedAt,
+		UpdatedAt: i.UpdatedAt,
+	}
+	if i.LastError.Valid {
+		userImport.LastError = i.LastError.String
+	}
+	if i.CompletedAt.Valid {
+		userImport.CompletedAt = &i.CompletedAt.Time
+	}
+	return userImport
+}
@@ -2,24 +2,176 @@ package command
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
+	"time"
 
 	"github.com/example/go-react-cqrs-template/internal/domain"
 	"github.com/example/go-react-cqrs-template/internal/infrastructure"
 	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
 )
 
-// SaveUserLog ユーザーログを保存（トランザクション内で使用）
-func SaveUserLog(ctx context.Context, tx infrastructure.DBTX, log *domain.UserLog) error {
+// userLogChainBatchSize ハッシュチェーンの検証で一度に読み込むログの件数
+const userLogChainBatchSize = 1000
+
+// SaveUserLog ユーザーログを組織のハッシュチェーンの末尾に追加して保存（トランザクション内で使用）
+// チェーンの末尾を行ロックするため、同じ組織のユーザーログの追加はトランザクションのコミットまで直列化される
+func SaveUserLog(ctx context.Context, tx infrastructure.DBTX, hashKey domain.UserLogHashKey, log *domain.UserLog) error {
+	if len(hashKey) == 0 {
+		return errors.New("user log hash key is not configured")
+	}
+
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
+
 	queries := dao.New(tx)
-	err := queries.CreateUserLog(ctx, dao.CreateUserLogParams{
-		ID:        log.ID,
-		UserID:    log.UserID,
-		Action:    string(log.Action),
-		CreatedAt: log.CreatedAt,
+	head, err := lockUserLogChainHead(ctx, queries, organizationID)
+	if err != nil {
+		return err
+	}
+	log.OrganizationID = organizationID
+	head = log.ChainTo(hashKey, head)
+
+	changes := log.Changes
+	if changes == nil {
+		changes = domain.UserChanges{}
+	}
+	changesJSON, err := json.Marshal(changes)
+	if err != nil {
+		return fmt.Errorf("failed to marshal user log changes: %w", err)
+	}
+
+	err = queries.CreateUserLog(ctx, dao.CreateUserLogParams{
+		ID:             log.ID,
+		OrganizationID: organizationID,
+		UserID:         log.UserID,
+		Action:         string(log.Action),
+		Changes:        changesJSON,
+		Actor:          log.Actor,
+		RequestID:      log.RequestID,
+		CreatedAt:      log.CreatedAt,
+		Seq:            log.Seq,
+		PrevHash:       log.PrevHash,
+		Hash:           log.Hash,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to save user log: %w", err)
 	}
+
+	err = queries.UpdateUserLogChainHead(ctx, dao.UpdateUserLogChainHeadParams{
+		OrganizationID: organizationID,
+		Seq:            head.Seq,
+		Hash:           head.Hash,
+		Signature:      head.Signature,
+		UpdatedAt:      time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update user log chain head: %w", err)
+	}
 	return nil
 }
+
+// lockUserLogChainHead 組織のチェーンの末尾を行ロック付きで取得（未作成の場合は作成する）
+func lockUserLogChainHead(ctx context.Context, queries *dao.Queries, organizationID string) (domain.UserLogChainHead, error) {
+	row, err := queries.GetUserLogChainHeadForUpdate(ctx, organizationID)
+	if errors.Is(err, sql.ErrNoRows) {
+		if err := queries.InitUserLogChainHead(ctx, organizationID); err != nil {
+			return domain.UserLogChainHead{}, fmt.Errorf("failed to init user log chain head: %w", err)
+		}
+		row, err = queries.GetUserLogChainHeadForUpdate(ctx, organizationID)
+	}
+	if err != nil {
+		return domain.UserLogChainHead{}, fmt.Errorf("failed to lock user log chain head: %w", err)
+	}
+	return domain.UserLogChainHead{Seq: row.Seq, Hash: row.Hash, Signature: row.Signature}, nil
+}
+
+// VerifyUserLogChain 組織のユーザーログのハッシュチェーンを先頭から検証し、最初の切れ目を返す（トランザクション内で使用）
+// 検証開始時点の末尾までを対象とするため、検証中に追加されたログは含まれない
+func VerifyUserLogChain(ctx context.Context, tx infrastructure.DBTX, hashKey domain.UserLogHashKey) (*domain.UserLogChainVerification, error) {
+	if len(hashKey) == 0 {
+		return nil, errors.New("user log hash key is not configured")
+	}
+
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	queries := dao.New(tx)
+	verifier := domain.NewUserLogChainVerifier(hashKey)
+	var head domain.UserLogChainHead
+	untilSeq := int64(math.MaxInt64)
+	row, err := queries.GetUserLogChainHead(ctx, organizationID)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		// この組織にはまだ1件もログが追加されていない（末尾の行が削除された場合は、残っているログを末尾と照合して検出する）
+	case err != nil:
+		return nil, fmt.Errorf("failed to get user log chain head: %w", err)
+	default:
+		head = domain.UserLogChainHead{Seq: row.Seq, Hash: row.Hash, Signature: row.Signature}
+		if chainBreak := verifier.VerifyHeadSignature(organizationID, head); chainBreak != nil {
+			return &domain.UserLogChainVerification{Head: head, Break: chainBreak}, nil
+		}
+		untilSeq = head.Seq
+	}
+
+	result := &domain.UserLogChainVerification{Head: head}
+	afterSeq := int64(0)
+	for {
+		logs, err := queries.ListUserLogChain(ctx, dao.ListUserLogChainParams{
+			OrganizationID: organizationID,
+			AfterSeq:       afterSeq,
+			UntilSeq:       untilSeq,
+			Limit:          userLogChainBatchSize,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list user log chain: %w", err)
+		}
+		for _, l := range logs {
+			userLog, err := toDomainUserLog(l)
+			if err != nil {
+				return nil, err
+			}
+			if chainBreak := verifier.Verify(userLog); chainBreak != nil {
+				result.CheckedCount = verifier.CheckedCount()
+				result.Break = chainBreak
+				return result, nil
+			}
+			afterSeq = userLog.Seq
+		}
+		if len(logs) < userLogChainBatchSize {
+			break
+		}
+	}
+
+	result.CheckedCount = verifier.CheckedCount()
+	result.Break = verifier.VerifyHead(head)
+	return result, nil
+}
+
+// toDomainUserLog dao.UserLogをdomain.UserLogに変換
+func toDomainUserLog(l dao.UserLog) (*domain.UserLog, error) {
+	changes := domain.UserChanges{}
+	if err := json.Unmarshal(l.Changes, &changes); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal user log changes: %w", err)
+	}
+	return &domain.UserLog{
+		ID:             l.ID,
+		UserID:         l.UserID,
+		OrganizationID: l.OrganizationID,
+		Action:         domain.UserLogAction(l.Action),
+		Changes:        changes,
+		Actor:          l.Actor,
+		RequestID:      l.RequestID,
+		CreatedAt:      l.CreatedAt,
+		Seq:            l.Seq,
+		PrevHash:       l.PrevHash,
+		Hash:           l.Hash,
+	}, nil
+}
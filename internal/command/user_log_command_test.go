@@ -0,0 +1,232 @@
+package command
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+)
+
+var testUserLogHashKey = domain.UserLogHashKey("0123456789abcdef0123456789abcdef")
+
+// fakeUserLogDB ユーザーログとチェーンの末尾のクエリだけを実装したメモリ上のデータベース（1つの組織のみ）
+type fakeUserLogDB struct {
+	mu sync.Mutex
+	// logs seq ごとの user_logs の行（CreateUserLog の引数の順）
+	logs map[int64][]driver.Value
+	// head user_log_chain の行（seq, hash, signature、未作成の場合は nil）
+	head []driver.Value
+}
+
+func newFakeUserLogDB(t *testing.T) (*fakeUserLogDB, *sql.DB) {
+	t.Helper()
+	fake := &fakeUserLogDB{logs: map[int64][]driver.Value{}}
+	db := sql.OpenDB(fake)
+	t.Cleanup(func() { _ = db.Close() })
+	return fake, db
+}
+
+func (f *fakeUserLogDB) Connect(context.Context) (driver.Conn, error) {
+	return fakeUserLogConn{f}, nil
+}
+func (f *fakeUserLogDB) Driver() driver.Driver { return nil }
+
+type fakeUserLogConn struct{ db *fakeUserLogDB }
+
+func (c fakeUserLogConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepare is not supported")
+}
+func (c fakeUserLogConn) Close() error              { return nil }
+func (c fakeUserLogConn) Begin() (driver.Tx, error) { return c, nil }
+func (c fakeUserLogConn) Commit() error             { return nil }
+func (c fakeUserLogConn) Rollback() error           { return nil }
+
+func (c fakeUserLogConn) ExecContext(_ context.Context, query string, named []driver.NamedValue) (driver.Result, error) {
+	f := c.db
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := values(named)
+
+	switch name := queryName(query); name {
+	case "InitUserLogChainHead":
+		if f.head == nil {
+			f.head = []driver.Value{int64(0), "", ""}
+		}
+	case "CreateUserLog":
+		f.logs[args[8].(int64)] = args
+	case "UpdateUserLogChainHead":
+		f.head = []driver.Value{args[1], args[2], args[3]}
+	default:
+		return nil, fmt.Errorf("unexpected exec: %s", name)
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (c fakeUserLogConn) QueryContext(_ context.Context, query string, named []driver.NamedValue) (driver.Rows, error) {
+	f := c.db
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	args := values(named)
+
+	rows := &fakeRows{}
+	switch name := queryName(query); name {
+	case "GetUserLogChainHead", "GetUserLogChainHeadForUpdate":
+		if f.head != nil {
+			rows.values = append(rows.values, f.head)
+		}
+	case "ListUserLogChain":
+		afterSeq, untilSeq, limit := args[1].(int64), args[2].(int64), int(args[3].(int64))
+		seqs := make([]int64, 0, len(f.logs))
+		for seq := range f.logs {
+			if seq > afterSeq && seq <= untilSeq {
+				seqs = append(seqs, seq)
+			}
+		}
+		sort.Slice(seqs, func(i, j int) bool { return seqs[i] < seqs[j] })
+		for _, seq := range seqs {
+			if len(rows.values) == limit {
+				break
+			}
+			rows.values = append(rows.values, f.logs[seq])
+		}
+	default:
+		return nil, fmt.Errorf("unexpected query: %s", name)
+	}
+	return rows, nil
+}
+
+// saveTestUserLogs ユーザーログを count 件追加する
+func saveTestUserLogs(t *testing.T, ctx context.Context, db *sql.DB, count int) {
+	t.Helper()
+	createdAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	for i := range count {
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		log := &domain.UserLog{
+			ID:        fmt.Sprintf("01ARZ3NDEKTSV4RRFFQ69G5FB%d", i+1),
+			UserID:    "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+			Action:    domain.UserLogActionUpdated,
+			Changes:   domain.UserChanges{"name": {Before: "John", After: fmt.Sprintf("Jane %d", i+1)}},
+			Actor:     "anonymous",
+			CreatedAt: createdAt.Add(time.Duration(i) * time.Second),
+		}
+		if err := SaveUserLog(ctx, tx, testUserLogHashKey, log); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := tx.Commit(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+}
+
+func TestVerifyUserLogChain(t *testing.T) {
+	tests := []struct {
+		name string
+		// tamper DB への書き込み権限を持つ攻撃者の操作（キーは知らない）
+		tamper      func(f *fakeUserLogDB)
+		wantChecked int
+		wantSeq     int64
+		wantReason  domain.UserLogChainBreakReason
+	}{
+		{
+			name:        "intact chain",
+			tamper:      func(*fakeUserLogDB) {},
+			wantChecked: 3,
+		},
+		{
+			name: "deleted last row",
+			tamper: func(f *fakeUserLogDB) {
+				delete(f.logs, 3)
+			},
+			wantChecked: 2,
+			wantSeq:     3,
+			wantReason:  domain.UserLogChainBreakHeadMismatch,
+		},
+		{
+			name: "truncated tail and rewound head",
+			tamper: func(f *fakeUserLogDB) {
+				// 末尾の2件を削除し、残った最後のログに合わせて末尾の位置とハッシュを書き戻す
+				delete(f.logs, 3)
+				delete(f.logs, 2)
+				f.head[0], f.head[1] = int64(1), f.logs[1][10]
+			},
+			wantSeq:    1,
+			wantReason: domain.UserLogChainBreakHeadSignatureMismatch,
+		},
+		{
+			name: "truncated tail and rewound head without signature",
+			tamper: func(f *fakeUserLogDB) {
+				delete(f.logs, 3)
+				f.head = []driver.Value{int64(2), f.logs[2][10], ""}
+			},
+			wantSeq:    2,
+			wantReason: domain.UserLogChainBreakHeadSignatureMismatch,
+		},
+		{
+			name: "truncated tail and deleted head",
+			tamper: func(f *fakeUserLogDB) {
+				// 末尾の行がなくても、残っているログはチェーンの末尾と一致しない
+				delete(f.logs, 3)
+				f.head = nil
+			},
+			wantChecked: 2,
+			wantSeq:     3,
+			wantReason:  domain.UserLogChainBreakHeadMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake, db := newFakeUserLogDB(t)
+			ctx := domain.WithTenant(context.Background(), domain.DefaultOrganizationID)
+			saveTestUserLogs(t, ctx, db, 3)
+			tt.tamper(fake)
+
+			tx, err := db.BeginTx(ctx, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			defer func() { _ = tx.Rollback() }()
+			got, err := VerifyUserLogChain(ctx, tx, testUserLogHashKey)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.CheckedCount != tt.wantChecked {
+				t.Errorf("expected %d checked logs, got %d", tt.wantChecked, got.CheckedCount)
+			}
+			if tt.wantReason == "" {
+				if !got.Valid() {
+					t.Fatalf("expected intact chain, got %+v", got.Break)
+				}
+				return
+			}
+			if got.Valid() {
+				t.Fatal("expected broken chain")
+			}
+			if got.Break.Seq != tt.wantSeq || got.Break.Reason != tt.wantReason {
+				t.Errorf("expected {%d %s}, got %+v", tt.wantSeq, tt.wantReason, got.Break)
+			}
+		})
+	}
+}
+
+func TestSaveUserLog_SignsChainHead(t *testing.T) {
+	fake, db := newFakeUserLogDB(t)
+	ctx := domain.WithTenant(context.Background(), domain.DefaultOrganizationID)
+	saveTestUserLogs(t, ctx, db, 2)
+
+	head := domain.UserLogChainHead{Seq: fake.head[0].(int64), Hash: fake.head[1].(string)}
+	want := domain.ComputeUserLogChainHeadSignature(testUserLogHashKey, domain.DefaultOrganizationID, head)
+	if head.Seq != 2 || fake.head[2] != want {
+		t.Errorf("expected head at seq 2 signed with %s, got %v", want, fake.head)
+	}
+}
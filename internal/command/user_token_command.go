@@ -0,0 +1,87 @@
+package command
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+)
+
+// SaveUserToken ユーザートークンをコンテキストのテナントに保存（トランザクション内で使用）
+func SaveUserToken(ctx context.Context, tx infrastructure.DBTX, token *domain.UserToken) error {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
+	queries := dao.New(tx)
+	err = queries.CreateUserToken(ctx, dao.CreateUserTokenParams{
+		ID:             token.ID,
+		UserID:         token.UserID,
+		OrganizationID: organizationID,
+		Purpose:        string(token.Purpose),
+		TokenHash:      token.TokenHash,
+		Email:          token.Email,
+		ExpiresAt:      token.ExpiresAt,
+		CreatedAt:      token.CreatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save user token: %w", err)
+	}
+	token.OrganizationID = organizationID
+	return nil
+}
+
+// FindUserTokenByHashForUpdate トークンのハッシュでユーザートークンを検索しロックを取得（トランザクション内で使用）
+// トークンを持っていること自体がテナントの証明となるため、テナントでは絞り込まない（以降の処理は UserToken.OrganizationID をテナントとする）
+func FindUserTokenByHashForUpdate(ctx context.Context, tx infrastructure.DBTX, tokenHash string) (*domain.UserToken, error) {
+	queries := dao.New(tx)
+	token, err := queries.GetUserTokenByHashForUpdate(ctx, tokenHash)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user token for update: %w", err)
+	}
+	return toDomainUserToken(token), nil
+}
+
+// InvalidateUserTokens ユーザーの指定した用途の未使用トークンをすべて使用済みにする（トランザクション内で使用）
+func InvalidateUserTokens(ctx context.Context, tx infrastructure.DBTX, userID string, purpose domain.UserTokenPurpose, usedAt time.Time) error {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
+	queries := dao.New(tx)
+	err = queries.InvalidateUserTokens(ctx, dao.InvalidateUserTokensParams{
+		UsedAt:         sql.NullTime{Time: usedAt, Valid: true},
+		OrganizationID: organizationID,
+		UserID:         userID,
+		Purpose:        string(purpose),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to invalidate user tokens: %w", err)
+	}
+	return nil
+}
+
+// toDomainUserToken dao.UserTokenをdomain.UserTokenに変換
+func toDomainUserToken(t dao.UserToken) *domain.UserToken {
+	token := &domain.UserToken{
+		ID:             t.ID,
+		UserID:         t.UserID,
+		OrganizationID: t.OrganizationID,
+		Purpose:        domain.UserTokenPurpose(t.Purpose),
+		TokenHash:      t.TokenHash,
+		Email:          t.Email,
+		ExpiresAt:      t.ExpiresAt,
+		CreatedAt:      t.CreatedAt,
+	}
+	if t.UsedAt.Valid {
+		token.UsedAt = &t.UsedAt.Time
+	}
+	return token
+}
@@ -0,0 +1,171 @@
+package command
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+)
+
+// SaveWebhookSubscription Webhookをコンテキストのテナントに作成（トランザクション内で使用）
+func SaveWebhookSubscription(ctx context.Context, tx infrastructure.DBTX, subscription *domain.WebhookSubscription) error {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
+	queries := dao.New(tx)
+	err = queries.CreateWebhookSubscription(ctx, dao.CreateWebhookSubscriptionParams{
+		ID:             subscription.ID,
+		OrganizationID: organizationID,
+		Url:            subscription.URL,
+		Secret:         subscription.Secret,
+		EventTypes:     subscription.EventTypes,
+		CreatedBy:      subscription.CreatedBy,
+		CreatedAt:      subscription.CreatedAt,
+		UpdatedAt:      subscription.UpdatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save webhook subscription: %w", err)
+	}
+	subscription.OrganizationID = organizationID
+	return nil
+}
+
+// FindWebhookSubscriptionByIDForUpdate IDでWebhookを検索しロックを取得（トランザクション内で使用）
+func FindWebhookSubscriptionByIDForUpdate(ctx context.Context, tx infrastructure.DBTX, id string) (*domain.WebhookSubscription, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	queries := dao.New(tx)
+	subscription, err := queries.GetWebhookSubscriptionByIDForUpdate(ctx, dao.GetWebhookSubscriptionByIDForUpdateParams{OrganizationID: organizationID, ID: id})
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find webhook subscription for update: %w", err)
+	}
+	return toDomainWebhookSubscription(subscription), nil
+}
+
+// FindActiveWebhookSubscriptionsByEventType イベントの種類を購読している有効なWebhookを取得（トランザクション内で使用）
+func FindActiveWebhookSubscriptionsByEventType(ctx context.Context, tx infrastructure.DBTX, eventType string) ([]*domain.WebhookSubscription, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	queries := dao.New(tx)
+	subscriptions, err := queries.ListActiveWebhookSubscriptionsByEventType(ctx, dao.ListActiveWebhookSubscriptionsByEventTypeParams{
+		OrganizationID: organizationID,
+		EventType:      eventType,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find webhook subscriptions: %w", err)
+	}
+
+	result := make([]*domain.WebhookSubscription, len(subscriptions))
+	for i, s := range subscriptions {
+		result[i] = toDomainWebhookSubscription(s)
+	}
+	return result, nil
+}
+
+// UpdateWebhookSubscription Webhookを更新（トランザクション内で使用）
+func UpdateWebhookSubscription(ctx context.Context, tx infrastructure.DBTX, subscription *domain.WebhookSubscription) error {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
+	params := dao.UpdateWebhookSubscriptionParams{
+		Url:                 subscription.URL,
+		EventTypes:          subscription.EventTypes,
+		ConsecutiveFailures: int32(subscription.ConsecutiveFailures),
+		DisabledReason:      subscription.DisabledReason,
+		UpdatedAt:           subscription.UpdatedAt,
+		OrganizationID:      organizationID,
+		ID:                  subscription.ID,
+	}
+	if subscription.DisabledAt != nil {
+		params.DisabledAt = sql.NullTime{Time: *subscription.DisabledAt, Valid: true}
+	}
+
+	queries := dao.New(tx)
+	if err := queries.UpdateWebhookSubscription(ctx, params); err != nil {
+		return fmt.Errorf("failed to update webhook subscription: %w", err)
+	}
+	return nil
+}
+
+// DeleteWebhookSubscription Webhookと配信の記録を削除（トランザクション内で使用）
+func DeleteWebhookSubscription(ctx context.Context, tx infrastructure.DBTX, id string) error {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
+	queries := dao.New(tx)
+	if err := queries.DeleteWebhookDeliveries(ctx, dao.DeleteWebhookDeliveriesParams{OrganizationID: organizationID, SubscriptionID: id}); err != nil {
+		return fmt.Errorf("failed to delete webhook deliveries: %w", err)
+	}
+	if err := queries.DeleteWebhookSubscription(ctx, dao.DeleteWebhookSubscriptionParams{OrganizationID: organizationID, ID: id}); err != nil {
+		return fmt.Errorf("failed to delete webhook subscription: %w", err)
+	}
+	return nil
+}
+
+// SaveWebhookDelivery Webhookの配信を記録（トランザクション内で使用）
+func SaveWebhookDelivery(ctx context.Context, tx infrastructure.DBTX, delivery *domain.WebhookDelivery) error {
+	queries := dao.New(tx)
+	err := queries.CreateWebhookDelivery(ctx, dao.CreateWebhookDeliveryParams{
+		ID:             delivery.ID,
+		OrganizationID: delivery.OrganizationID,
+		SubscriptionID: delivery.SubscriptionID,
+		EventID:        delivery.EventID,
+		EventType:      delivery.EventType,
+		Attempt:        int32(delivery.Attempt),
+		StatusCode:     int32(delivery.StatusCode),
+		Error:          delivery.Error,
+		DurationMs:     int32(delivery.Duration / time.Millisecond),
+		DeliveredAt:    delivery.DeliveredAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save webhook delivery: %w", err)
+	}
+	return nil
+}
+
+// GetWebhookEventDeliveryState イベントの配信を試みた回数と、成功した配信があるかどうかを取得（トランザクション内で使用）
+func GetWebhookEventDeliveryState(ctx context.Context, tx infrastructure.DBTX, subscriptionID, eventID string) (attempts int, delivered bool, err error) {
+	queries := dao.New(tx)
+	state, err := queries.GetWebhookEventDeliveryState(ctx, dao.GetWebhookEventDeliveryStateParams{
+		SubscriptionID: subscriptionID,
+		EventID:        eventID,
+	})
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to get webhook delivery state: %w", err)
+	}
+	return int(state.Attempts), state.Delivered, nil
+}
+
+// toDomainWebhookSubscription dao.WebhookSubscriptionをdomain.WebhookSubscriptionに変換
+func toDomainWebhookSubscription(s dao.WebhookSubscription) *domain.WebhookSubscription {
+	subscription := &domain.WebhookSubscription{
+		ID:                  s.ID,
+		OrganizationID:      s.OrganizationID,
+		URL:                 s.Url,
+		Secret:              s.Secret,
+		EventTypes:          s.EventTypes,
+		ConsecutiveFailures: int(s.ConsecutiveFailures),
+		DisabledReason:      s.DisabledReason,
+		CreatedBy:           s.CreatedBy,
+		CreatedAt:           s.CreatedAt,
+		UpdatedAt:           s.UpdatedAt,
+	}
+	if s.DisabledAt.Valid {
+		subscription.DisabledAt = &s.DisabledAt.Time
+	}
+	return subscription
+}
@@ -9,10 +9,21 @@ import (
 
 // Config はアプリケーション全体の設定を保持する構造体
 type Config struct {
-	Server      ServerConfig
-	Database    DatabaseConfig
-	Log         LogConfig
-	RateLimiter RateLimiterConfig
+	Server        ServerConfig
+	Database      DatabaseConfig
+	Replica       ReplicaConfig
+	Log           LogConfig
+	RateLimiter   RateLimiterConfig
+	Idempotency   IdempotencyConfig
+	Audit         AuditConfig
+	Auth          AuthConfig
+	Session       SessionConfig
+	Email         EmailConfig
+	EventSourcing EventSourcingConfig
+	ReadModel     ReadModelConfig
+	Cache         CacheConfig
+	ChangeFeed    ChangeFeedConfig
+	Inbound       InboundWebhookConfig
 }
 
 // ServerConfig はHTTPサーバーの設定
@@ -20,6 +31,9 @@ type ServerConfig struct {
 	Port            string `envconfig:"PORT" default:"8080"`
 	ShutdownTimeout int    `envconfig:"SHUTDOWN_TIMEOUT" default:"30"`
 	CORSOrigins     string `envconfig:"CORS_ORIGINS" default:"http://localhost:3000"`
+	// DebugAddr はメトリクス（/debug/vars）を提供する内部向けのアドレス（例: 127.0.0.1:6060、未設定の場合は提供しない）
+	// APIとは別のリスナーで提供するため、外部に公開しないアドレスを指定する
+	DebugAddr string `envconfig:"DEBUG_ADDR"`
 }
 
 // DatabaseConfig はデータベース接続の設定
@@ -32,6 +46,19 @@ type DatabaseConfig struct {
 	SSLMode  string `envconfig:"DB_SSLMODE" default:"disable"`
 }
 
+// ReplicaConfig はリードレプリカの設定
+type ReplicaConfig struct {
+	// Hosts はリードレプリカのホスト（host または host:port のカンマ区切り、ポートを省略した場合は DB_PORT）
+	// ユーザー・パスワード・データベース名はプライマリと同じものを使う。未設定の場合はすべてプライマリから読む
+	Hosts string `envconfig:"DB_REPLICA_HOSTS"`
+	// HealthCheckSeconds はレプリカの死活・遅延を確認する間隔（秒）
+	HealthCheckSeconds int `envconfig:"DB_REPLICA_HEALTH_CHECK_SECONDS" default:"5"`
+	// MaxLagSeconds はこれより遅延しているレプリカを使わない（秒、0の場合は遅延を確認しない）
+	MaxLagSeconds int `envconfig:"DB_REPLICA_MAX_LAG_SECONDS" default:"30"`
+	// ReadYourWritesSeconds はコマンドの成功後にクライアントの読み取りをプライマリに固定する時間（秒、0の場合は固定しない）
+	ReadYourWritesSeconds int `envconfig:"DB_READ_YOUR_WRITES_SECONDS" default:"5"`
+}
+
 // LogConfig はロギングの設定
 type LogConfig struct {
 	Level  string `envconfig:"LOG_LEVEL" default:"info"`
@@ -45,6 +72,92 @@ type RateLimiterConfig struct {
 	TrustXForwardedFor bool    `envconfig:"RATE_LIMIT_TRUST_XFF" default:"false"`
 }
 
+// IdempotencyConfig はIdempotency-Keyの設定
+type IdempotencyConfig struct {
+	TTLHours int `envconfig:"IDEMPOTENCY_TTL_HOURS" default:"24"`
+}
+
+// AuditConfig は監査ログの設定
+type AuditConfig struct {
+	// UserLogHashKey はユーザーログのハッシュチェーンに使うHMACキー（必須、32バイト以上。サーバーとワーカーで同じ値にする）
+	UserLogHashKey string `envconfig:"USER_LOG_HASH_KEY"`
+}
+
+// AuthConfig は認証の設定
+type AuthConfig struct {
+	// JWTHS256Secret はHS256で署名されたJWTを検証する共有シークレット
+	JWTHS256Secret string `envconfig:"AUTH_JWT_HS256_SECRET"`
+	// JWTRS256PublicKeyFile はRS256で署名されたJWTを検証する公開鍵（PEM）のファイルパス
+	JWTRS256PublicKeyFile string `envconfig:"AUTH_JWT_RS256_PUBLIC_KEY_FILE"`
+	// JWKSFile はJWTを検証する鍵を含むJWKSファイルのパス
+	JWKSFile string `envconfig:"AUTH_JWKS_FILE"`
+	// JWTIssuer を設定すると、JWTの iss クレームが一致する必要がある
+	JWTIssuer string `envconfig:"AUTH_JWT_ISSUER"`
+	// JWTAudience を設定すると、JWTの aud クレームに含まれている必要がある
+	JWTAudience string `envconfig:"AUTH_JWT_AUDIENCE"`
+	// Required を有効にすると、認証情報のないリクエストを401で拒否する（無効の場合は匿名として扱う）
+	Required bool `envconfig:"AUTH_REQUIRED" default:"false"`
+	// AnonymousRoles は認証情報のないリクエストに付与するロール（カンマ区切り、空の場合は権限なし）
+	// ログインなしで開発する場合のみ、ローカルの環境変数で admin などを指定する
+	AnonymousRoles string `envconfig:"AUTH_ANONYMOUS_ROLES"`
+}
+
+// SessionConfig はログインセッションの設定
+type SessionConfig struct {
+	// TTLHours はログインセッションの有効期間（時間）
+	TTLHours int `envconfig:"SESSION_TTL_HOURS" default:"168"`
+	// CookieSecure を有効にすると、セッションCookieをHTTPSでのみ送信する（localhost はHTTPでも送信される）
+	CookieSecure bool `envconfig:"SESSION_COOKIE_SECURE" default:"true"`
+	// LoginMaxFailures はアカウントをロックするまでに許容するログインの連続失敗回数
+	LoginMaxFailures int `envconfig:"LOGIN_MAX_FAILURES" default:"5"`
+	// LoginLockoutMinutes はロックされたアカウントで再びログインを試行できるようになるまでの時間（分）
+	LoginLockoutMinutes int `envconfig:"LOGIN_LOCKOUT_MINUTES" default:"15"`
+}
+
+// EmailConfig はメールアドレスの設定
+type EmailConfig struct {
+	// LowercaseLocalPart を有効にすると、ローカル部も小文字にしてメールアドレスを保存する（サーバーとワーカーで同じ値にする）
+	// 無効の場合はドメイン部だけを小文字にする。一意性はどちらの場合も大文字小文字を区別せずに判定する
+	LowercaseLocalPart bool `envconfig:"EMAIL_LOWERCASE_LOCAL_PART" default:"false"`
+}
+
+// EventSourcingConfig はイベントソーシングの設定
+type EventSourcingConfig struct {
+	// Users を有効にすると、ユーザーをイベントストア（aggregate_events）に保存し、users テーブルを投影として更新する
+	Users bool `envconfig:"USER_EVENT_SOURCING" default:"false"`
+	// SnapshotInterval はスナップショットを保存するイベントの間隔
+	SnapshotInterval int `envconfig:"USER_SNAPSHOT_INTERVAL" default:"20"`
+}
+
+// ReadModelConfig は読み取りモデル（投影）の設定
+type ReadModelConfig struct {
+	// Users を有効にすると、ユーザー一覧をワーカーの投影が更新する user_summaries から読み込む
+	Users bool `envconfig:"USER_READ_MODEL" default:"false"`
+}
+
+// CacheConfig はプロセス内キャッシュの設定
+type CacheConfig struct {
+	// UserSize はIDで検索したユーザーをキャッシュする件数の上限（0の場合はキャッシュしない）
+	UserSize int `envconfig:"USER_CACHE_SIZE" default:"1000"`
+	// UserTTLSeconds はユーザーのキャッシュの有効期限（秒、ほかのプロセスでの変更はこの時間まで反映されない）
+	UserTTLSeconds int `envconfig:"USER_CACHE_TTL_SECONDS" default:"30"`
+}
+
+// ChangeFeedConfig は変更通知（Server-Sent Events）の設定
+type ChangeFeedConfig struct {
+	// RetentionMinutes は Last-Event-ID で再開できるよう変更通知を保持する時間（分）
+	RetentionMinutes int `envconfig:"CHANGE_FEED_RETENTION_MINUTES" default:"60"`
+	// HeartbeatSeconds は接続を維持するためにコメント行を送る間隔（秒）
+	HeartbeatSeconds int `envconfig:"CHANGE_FEED_HEARTBEAT_SECONDS" default:"15"`
+}
+
+// InboundWebhookConfig は外部のサービスから受信するWebhookの設定
+type InboundWebhookConfig struct {
+	// EmailSecrets はメール配信サービス（/inbound-webhooks/email）の署名を検証するシークレット
+	// カンマ区切りで複数指定でき、切り替える間は新旧のどちらで署名されていても受け付ける。未設定の場合は受信しない
+	EmailSecrets string `envconfig:"INBOUND_WEBHOOK_EMAIL_SECRETS"`
+}
+
 // Load は環境変数からConfigを読み込む
 func Load() (*Config, error) {
 	var cfg Config
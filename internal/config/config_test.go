@@ -8,10 +8,19 @@ import (
 func TestLoad_DefaultValues(t *testing.T) {
 	// 環境変数をクリアしてデフォルト値をテスト
 	envVars := []string{
-		"PORT", "SHUTDOWN_TIMEOUT", "CORS_ORIGINS",
+		"PORT", "SHUTDOWN_TIMEOUT", "CORS_ORIGINS", "DEBUG_ADDR",
 		"DB_HOST", "DB_PORT", "DB_USER", "DB_PASSWORD", "DB_NAME", "DB_SSLMODE",
 		"LOG_LEVEL", "LOG_FORMAT",
 		"RATE_LIMIT_RPS", "RATE_LIMIT_BURST",
+		"IDEMPOTENCY_TTL_HOURS",
+		"USER_LOG_HASH_KEY",
+		"AUTH_JWT_HS256_SECRET", "AUTH_REQUIRED",
+		"SESSION_TTL_HOURS", "SESSION_COOKIE_SECURE", "LOGIN_MAX_FAILURES", "LOGIN_LOCKOUT_MINUTES",
+		"EMAIL_LOWERCASE_LOCAL_PART",
+		"USER_EVENT_SOURCING", "USER_SNAPSHOT_INTERVAL", "USER_READ_MODEL",
+		"DB_REPLICA_HOSTS", "DB_REPLICA_HEALTH_CHECK_SECONDS", "DB_REPLICA_MAX_LAG_SECONDS", "DB_READ_YOUR_WRITES_SECONDS",
+		"USER_CACHE_SIZE", "USER_CACHE_TTL_SECONDS",
+		"CHANGE_FEED_RETENTION_MINUTES", "CHANGE_FEED_HEARTBEAT_SECONDS",
 	}
 
 	// 既存の環境変数を保存してクリア
@@ -38,6 +47,9 @@ func TestLoad_DefaultValues(t *testing.T) {
 	if cfg.Server.CORSOrigins != "http://localhost:3000" {
 		t.Errorf("Server.CORSOrigins = %q, want %q", cfg.Server.CORSOrigins, "http://localhost:3000")
 	}
+	if cfg.Server.DebugAddr != "" {
+		t.Errorf("Server.DebugAddr = %q, want empty", cfg.Server.DebugAddr)
+	}
 
 	// Database defaults
 	if cfg.Database.Host != "localhost" {
@@ -74,24 +86,128 @@ func TestLoad_DefaultValues(t *testing.T) {
 	if cfg.RateLimiter.BurstSize != 0 {
 		t.Errorf("RateLimiter.BurstSize = %d, want %d", cfg.RateLimiter.BurstSize, 0)
 	}
+
+	// Idempotency defaults
+	if cfg.Idempotency.TTLHours != 24 {
+		t.Errorf("Idempotency.TTLHours = %d, want %d", cfg.Idempotency.TTLHours, 24)
+	}
+
+	// Audit defaults
+	if cfg.Audit.UserLogHashKey != "" {
+		t.Errorf("Audit.UserLogHashKey = %q, want empty", cfg.Audit.UserLogHashKey)
+	}
+
+	// Auth defaults
+	if cfg.Auth.JWTHS256Secret != "" {
+		t.Errorf("Auth.JWTHS256Secret = %q, want empty", cfg.Auth.JWTHS256Secret)
+	}
+	if cfg.Auth.Required {
+		t.Errorf("Auth.Required = %v, want %v", cfg.Auth.Required, false)
+	}
+	if cfg.Auth.AnonymousRoles != "" {
+		t.Errorf("Auth.AnonymousRoles = %q, want empty", cfg.Auth.AnonymousRoles)
+	}
+
+	// Session defaults
+	if cfg.Session.TTLHours != 168 {
+		t.Errorf("Session.TTLHours = %d, want %d", cfg.Session.TTLHours, 168)
+	}
+	if !cfg.Session.CookieSecure {
+		t.Errorf("Session.CookieSecure = %v, want %v", cfg.Session.CookieSecure, true)
+	}
+	if cfg.Session.LoginMaxFailures != 5 {
+		t.Errorf("Session.LoginMaxFailures = %d, want %d", cfg.Session.LoginMaxFailures, 5)
+	}
+	if cfg.Session.LoginLockoutMinutes != 15 {
+		t.Errorf("Session.LoginLockoutMinutes = %d, want %d", cfg.Session.LoginLockoutMinutes, 15)
+	}
+
+	// Email defaults
+	if cfg.Email.LowercaseLocalPart {
+		t.Error("Email.LowercaseLocalPart = true, want false")
+	}
+
+	// EventSourcing defaults
+	if cfg.EventSourcing.Users {
+		t.Errorf("EventSourcing.Users = %v, want %v", cfg.EventSourcing.Users, false)
+	}
+	if cfg.EventSourcing.SnapshotInterval != 20 {
+		t.Errorf("EventSourcing.SnapshotInterval = %d, want %d", cfg.EventSourcing.SnapshotInterval, 20)
+	}
+
+	// ReadModel defaults
+	if cfg.ReadModel.Users {
+		t.Errorf("ReadModel.Users = %v, want %v", cfg.ReadModel.Users, false)
+	}
+
+	// Replica defaults
+	if cfg.Replica.Hosts != "" {
+		t.Errorf("Replica.Hosts = %q, want empty", cfg.Replica.Hosts)
+	}
+	if cfg.Replica.HealthCheckSeconds != 5 {
+		t.Errorf("Replica.HealthCheckSeconds = %d, want %d", cfg.Replica.HealthCheckSeconds, 5)
+	}
+	if cfg.Replica.MaxLagSeconds != 30 {
+		t.Errorf("Replica.MaxLagSeconds = %d, want %d", cfg.Replica.MaxLagSeconds, 30)
+	}
+	if cfg.Replica.ReadYourWritesSeconds != 5 {
+		t.Errorf("Replica.ReadYourWritesSeconds = %d, want %d", cfg.Replica.ReadYourWritesSeconds, 5)
+	}
+
+	// Cache defaults
+	if cfg.Cache.UserSize != 1000 {
+		t.Errorf("Cache.UserSize = %d, want %d", cfg.Cache.UserSize, 1000)
+	}
+	if cfg.Cache.UserTTLSeconds != 30 {
+		t.Errorf("Cache.UserTTLSeconds = %d, want %d", cfg.Cache.UserTTLSeconds, 30)
+	}
+
+	// ChangeFeed defaults
+	if cfg.ChangeFeed.RetentionMinutes != 60 {
+		t.Errorf("ChangeFeed.RetentionMinutes = %d, want %d", cfg.ChangeFeed.RetentionMinutes, 60)
+	}
+	if cfg.ChangeFeed.HeartbeatSeconds != 15 {
+		t.Errorf("ChangeFeed.HeartbeatSeconds = %d, want %d", cfg.ChangeFeed.HeartbeatSeconds, 15)
+	}
+
+	// Inbound webhook defaults
+	if cfg.Inbound.EmailSecrets != "" {
+		t.Errorf("Inbound.EmailSecrets = %q, want empty", cfg.Inbound.EmailSecrets)
+	}
 }
 
 func TestLoad_EnvironmentVariableOverrides(t *testing.T) {
 	// 環境変数を設定
 	overrides := map[string]string{
-		"PORT":             "9090",
-		"SHUTDOWN_TIMEOUT": "60",
-		"CORS_ORIGINS":     "https://example.com",
-		"DB_HOST":          "db.example.com",
-		"DB_PORT":          "5433",
-		"DB_USER":          "myuser",
-		"DB_PASSWORD":      "mypassword",
-		"DB_NAME":          "mydb",
-		"DB_SSLMODE":       "require",
-		"LOG_LEVEL":        "debug",
-		"LOG_FORMAT":       "text",
-		"RATE_LIMIT_RPS":   "100.5",
-		"RATE_LIMIT_BURST": "200",
+		"PORT":                          "9090",
+		"SHUTDOWN_TIMEOUT":              "60",
+		"CORS_ORIGINS":                  "https://example.com",
+		"DEBUG_ADDR":                    "127.0.0.1:6060",
+		"DB_HOST":                       "db.example.com",
+		"DB_PORT":                       "5433",
+		"DB_USER":                       "myuser",
+		"DB_PASSWORD":                   "mypassword",
+		"DB_NAME":                       "mydb",
+		"DB_SSLMODE":                    "require",
+		"LOG_LEVEL":                     "debug",
+		"LOG_FORMAT":                    "text",
+		"RATE_LIMIT_RPS":                "100.5",
+		"RATE_LIMIT_BURST":              "200",
+		"USER_LOG_HASH_KEY":             "production-secret",
+		"AUTH_JWT_HS256_SECRET":         "jwt-secret",
+		"AUTH_REQUIRED":                 "true",
+		"AUTH_ANONYMOUS_ROLES":          "viewer,auditor",
+		"SESSION_TTL_HOURS":             "12",
+		"SESSION_COOKIE_SECURE":         "false",
+		"LOGIN_MAX_FAILURES":            "3",
+		"USER_EVENT_SOURCING":           "true",
+		"USER_SNAPSHOT_INTERVAL":        "50",
+		"USER_READ_MODEL":               "true",
+		"DB_REPLICA_HOSTS":              "replica1,replica2:5433",
+		"DB_READ_YOUR_WRITES_SECONDS":   "10",
+		"USER_CACHE_SIZE":               "0",
+		"CHANGE_FEED_RETENTION_MINUTES": "5",
+		"INBOUND_WEBHOOK_EMAIL_SECRETS": "whsec_new,whsec_old",
 	}
 
 	for key, val := range overrides {
@@ -113,6 +229,9 @@ func TestLoad_EnvironmentVariableOverrides(t *testing.T) {
 	if cfg.Server.CORSOrigins != "https://example.com" {
 		t.Errorf("Server.CORSOrigins = %q, want %q", cfg.Server.CORSOrigins, "https://example.com")
 	}
+	if cfg.Server.DebugAddr != "127.0.0.1:6060" {
+		t.Errorf("Server.DebugAddr = %q, want %q", cfg.Server.DebugAddr, "127.0.0.1:6060")
+	}
 
 	// Database overrides
 	if cfg.Database.Host != "db.example.com" {
@@ -149,4 +268,67 @@ func TestLoad_EnvironmentVariableOverrides(t *testing.T) {
 	if cfg.RateLimiter.BurstSize != 200 {
 		t.Errorf("RateLimiter.BurstSize = %d, want %d", cfg.RateLimiter.BurstSize, 200)
 	}
+
+	// Audit overrides
+	if cfg.Audit.UserLogHashKey != "production-secret" {
+		t.Errorf("Audit.UserLogHashKey = %q, want %q", cfg.Audit.UserLogHashKey, "production-secret")
+	}
+
+	// Auth overrides
+	if cfg.Auth.JWTHS256Secret != "jwt-secret" {
+		t.Errorf("Auth.JWTHS256Secret = %q, want %q", cfg.Auth.JWTHS256Secret, "jwt-secret")
+	}
+	if !cfg.Auth.Required {
+		t.Errorf("Auth.Required = %v, want %v", cfg.Auth.Required, true)
+	}
+	if cfg.Auth.AnonymousRoles != "viewer,auditor" {
+		t.Errorf("Auth.AnonymousRoles = %q, want %q", cfg.Auth.AnonymousRoles, "viewer,auditor")
+	}
+
+	// Session overrides
+	if cfg.Session.TTLHours != 12 {
+		t.Errorf("Session.TTLHours = %d, want %d", cfg.Session.TTLHours, 12)
+	}
+	if cfg.Session.CookieSecure {
+		t.Errorf("Session.CookieSecure = %v, want %v", cfg.Session.CookieSecure, false)
+	}
+	if cfg.Session.LoginMaxFailures != 3 {
+		t.Errorf("Session.LoginMaxFailures = %d, want %d", cfg.Session.LoginMaxFailures, 3)
+	}
+
+	// EventSourcing overrides
+	if !cfg.EventSourcing.Users {
+		t.Errorf("EventSourcing.Users = %v, want %v", cfg.EventSourcing.Users, true)
+	}
+	if cfg.EventSourcing.SnapshotInterval != 50 {
+		t.Errorf("EventSourcing.SnapshotInterval = %d, want %d", cfg.EventSourcing.SnapshotInterval, 50)
+	}
+
+	// ReadModel overrides
+	if !cfg.ReadModel.Users {
+		t.Errorf("ReadModel.Users = %v, want %v", cfg.ReadModel.Users, true)
+	}
+
+	// Replica overrides
+	if cfg.Replica.Hosts != "replica1,replica2:5433" {
+		t.Errorf("Replica.Hosts = %q, want %q", cfg.Replica.Hosts, "replica1,replica2:5433")
+	}
+	if cfg.Replica.ReadYourWritesSeconds != 10 {
+		t.Errorf("Replica.ReadYourWritesSeconds = %d, want %d", cfg.Replica.ReadYourWritesSeconds, 10)
+	}
+
+	// Cache overrides
+	if cfg.Cache.UserSize != 0 {
+		t.Errorf("Cache.UserSize = %d, want %d", cfg.Cache.UserSize, 0)
+	}
+
+	// ChangeFeed overrides
+	if cfg.ChangeFeed.RetentionMinutes != 5 {
+		t.Errorf("ChangeFeed.RetentionMinutes = %d, want %d", cfg.ChangeFeed.RetentionMinutes, 5)
+	}
+
+	// Inbound webhook overrides
+	if cfg.Inbound.EmailSecrets != "whsec_new,whsec_old" {
+		t.Errorf("Inbound.EmailSecrets = %q, want %q", cfg.Inbound.EmailSecrets, "whsec_new,whsec_old")
+	}
 }
@@ -0,0 +1,152 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// APIKeyNameMaxLength APIキー名の最大文字数
+const APIKeyNameMaxLength = 100
+
+// apiKeyTokenPrefix APIキーの先頭に付ける識別子（シークレットスキャナーなどで検出しやすくする）
+const apiKeyTokenPrefix = "cqk_"
+
+// apiKeyPrefixLength APIキーを検索するためのプレフィックスの長さ（16進文字列）
+const apiKeyPrefixLength = 16
+
+// apiKeyPrefixPattern プレフィックスの形式
+var apiKeyPrefixPattern = regexp.MustCompile(`^[0-9a-f]{16}$`)
+
+// APIKey サービス間連携用のAPIキーのドメインモデル
+// シークレットはハッシュのみを保持し、平文は作成時に一度だけ返す
+type APIKey struct {
+	ID   string
+	Name string
+	// OrganizationID APIキーを作成した組織のID（APIキーで認証されたリクエストはこの組織に限定される）
+	OrganizationID string
+	// Prefix APIキーを検索するための公開部分（"cqk_<prefix>_<secret>" の <prefix>）
+	Prefix string
+	// SecretHash APIキー全体のSHA-256（16進文字列）
+	SecretHash string
+	Scopes     []string
+	// ExpiresAt 有効期限（nil の場合は無期限）
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	// CreatedBy 作成した主体（Principal.String() の形式）
+	CreatedBy string
+	CreatedAt time.Time
+}
+
+// NewAPIKey APIキーを作成し、APIキーと平文のトークンを返す（トークンは保存されないため呼び出し元で一度だけ表示する）
+func NewAPIKey(name string, scopes []string, expiresAt *time.Time, createdBy Principal) (*APIKey, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, "", ErrAPIKeyNameRequired()
+	}
+	if utf8.RuneCountInString(name) > APIKeyNameMaxLength {
+		return nil, "", ErrAPIKeyNameTooLong(APIKeyNameMaxLength)
+	}
+	normalizedScopes, err := normalizeAPIKeyScopes(scopes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := time.Now()
+	if expiresAt != nil && !expiresAt.After(now) {
+		return nil, "", ErrAPIKeyExpiryInPast()
+	}
+
+	prefixBytes := make([]byte, apiKeyPrefixLength/2)
+	secretBytes := make([]byte, 32)
+	if _, err := rand.Read(prefixBytes); err != nil {
+		return nil, "", err
+	}
+	if _, err := rand.Read(secretBytes); err != nil {
+		return nil, "", err
+	}
+	prefix := hex.EncodeToString(prefixBytes)
+	token := apiKeyTokenPrefix + prefix + "_" + base64.RawURLEncoding.EncodeToString(secretBytes)
+
+	return &APIKey{
+		ID:         ulid.MustNew(ulid.Timestamp(now), rand.Reader).String(),
+		Name:       name,
+		Prefix:     prefix,
+		SecretHash: hashAPIKeyToken(token),
+		Scopes:     normalizedScopes,
+		ExpiresAt:  expiresAt,
+		CreatedBy:  createdBy.String(),
+		CreatedAt:  now,
+	}, token, nil
+}
+
+// ParseAPIKeyToken トークンからプレフィックスを取り出す（形式が不正な場合は ok=false）
+func ParseAPIKeyToken(token string) (prefix string, ok bool) {
+	rest, ok := strings.CutPrefix(token, apiKeyTokenPrefix)
+	if !ok {
+		return "", false
+	}
+	prefix, secret, ok := strings.Cut(rest, "_")
+	if !ok || secret == "" || !apiKeyPrefixPattern.MatchString(prefix) {
+		return "", false
+	}
+	return prefix, true
+}
+
+// VerifyToken トークンがこのAPIキーのものかどうか（定数時間で比較する）
+func (k *APIKey) VerifyToken(token string) bool {
+	return subtle.ConstantTimeCompare([]byte(hashAPIKeyToken(token)), []byte(k.SecretHash)) == 1
+}
+
+// Active 指定時刻に利用できるかどうか（失効・期限切れでない）
+func (k *APIKey) Active(now time.Time) bool {
+	if k.RevokedAt != nil {
+		return false
+	}
+	return k.ExpiresAt == nil || now.Before(*k.ExpiresAt)
+}
+
+// Revoke APIキーを失効させる（失効済みの場合は何もしない）
+func (k *APIKey) Revoke(now time.Time) {
+	if k.RevokedAt == nil {
+		k.RevokedAt = &now
+	}
+}
+
+// DisplayPrefix 一覧などで表示する識別用の文字列（"cqk_<prefix>"）
+func (k *APIKey) DisplayPrefix() string {
+	return apiKeyTokenPrefix + k.Prefix
+}
+
+// hashAPIKeyToken トークンのSHA-256を16進文字列で返す（トークンは十分なエントロピーを持つためソルトは不要）
+func hashAPIKeyToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// normalizeAPIKeyScopes スコープが定義されている権限（またはそのワイルドカード）であることを検証し、重複を除いて返す
+func normalizeAPIKeyScopes(scopes []string) ([]string, error) {
+	result := make([]string, 0, len(scopes))
+	seen := make(map[string]bool, len(scopes))
+	for _, scope := range scopes {
+		scope = strings.TrimSpace(scope)
+		if len(ScopePermissions(scope)) == 0 {
+			return nil, ErrAPIKeyScopeInvalid(scope)
+		}
+		if seen[scope] {
+			continue
+		}
+		seen[scope] = true
+		result = append(result, scope)
+	}
+	return result, nil
+}
@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewAPIKey(t *testing.T) {
+	future := time.Now().Add(24 * time.Hour)
+	past := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name       string
+		keyName    string
+		scopes     []string
+		expiresAt  *time.Time
+		wantScopes []string
+		wantErr    bool
+	}{
+		{name: "valid", keyName: "nightly-sync", scopes: []string{"users:read", "users:create"}, wantScopes: []string{"users:read", "users:create"}},
+		{name: "duplicate scopes", keyName: "nightly-sync", scopes: []string{"users:read", " users:read"}, wantScopes: []string{"users:read"}},
+		{name: "wildcard scope", keyName: "admin", scopes: []string{"users:*"}, wantScopes: []string{"users:*"}},
+		{name: "with expiry", keyName: "nightly-sync", expiresAt: &future, wantScopes: []string{}},
+		{name: "empty name", keyName: "  ", wantErr: true},
+		{name: "name too long", keyName: strings.Repeat("a", APIKeyNameMaxLength+1), wantErr: true},
+		{name: "invalid scope", keyName: "nightly-sync", scopes: []string{"Users Read"}, wantErr: true},
+		{name: "unknown scope", keyName: "nightly-sync", scopes: []string{"users:write"}, wantErr: true},
+		{name: "unknown resource wildcard", keyName: "nightly-sync", scopes: []string{"billing:*"}, wantErr: true},
+		{name: "expiry in past", keyName: "nightly-sync", expiresAt: &past, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiKey, token, err := NewAPIKey(tt.keyName, tt.scopes, tt.expiresAt, NewUserPrincipal("01ARZ3NDEKTSV4RRFFQ69G5FAV"))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if strings.Join(apiKey.Scopes, ",") != strings.Join(tt.wantScopes, ",") {
+				t.Errorf("expected scopes %v, got %v", tt.wantScopes, apiKey.Scopes)
+			}
+			if apiKey.CreatedBy != "user:01ARZ3NDEKTSV4RRFFQ69G5FAV" {
+				t.Errorf("expected createdBy user:01ARZ3NDEKTSV4RRFFQ69G5FAV, got %s", apiKey.CreatedBy)
+			}
+			if !strings.HasPrefix(token, apiKey.DisplayPrefix()+"_") {
+				t.Errorf("expected token to start with %s_, got %s", apiKey.DisplayPrefix(), token)
+			}
+			if strings.Contains(apiKey.SecretHash, token) {
+				t.Error("expected the secret not to be stored in plain text")
+			}
+		})
+	}
+}
+
+func TestAPIKey_VerifyToken(t *testing.T) {
+	apiKey, token, err := NewAPIKey("nightly-sync", nil, nil, NewSystemPrincipal("test"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	prefix, ok := ParseAPIKeyToken(token)
+	if !ok || prefix != apiKey.Prefix {
+		t.Fatalf("expected prefix %s, got %s (ok=%v)", apiKey.Prefix, prefix, ok)
+	}
+	if !apiKey.VerifyToken(token) {
+		t.Error("expected token to be verified")
+	}
+	if apiKey.VerifyToken(token + "x") {
+		t.Error("expected modified token to be rejected")
+	}
+
+	other, otherToken, err := NewAPIKey("other", nil, nil, NewSystemPrincipal("test"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if apiKey.VerifyToken(otherToken) || other.VerifyToken(token) {
+		t.Error("expected tokens of other keys to be rejected")
+	}
+}
+
+func TestParseAPIKeyToken(t *testing.T) {
+	tests := []struct {
+		name   string
+		token  string
+		wantOK bool
+	}{
+		{name: "valid", token: "cqk_0123456789abcdef_c2VjcmV0", wantOK: true},
+		{name: "missing identifier", token: "0123456789abcdef_c2VjcmV0"},
+		{name: "missing secret", token: "cqk_0123456789abcdef_"},
+		{name: "short prefix", token: "cqk_0123_c2VjcmV0"},
+		{name: "uppercase prefix", token: "cqk_0123456789ABCDEF_c2VjcmV0"},
+		{name: "jwt", token: "eyJhbGciOiJIUzI1NiJ9.e30.sig"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, ok := ParseAPIKeyToken(tt.token); ok != tt.wantOK {
+				t.Errorf("expected ok=%v, got %v", tt.wantOK, ok)
+			}
+		})
+	}
+}
+
+func TestAPIKey_Active(t *testing.T) {
+	now := time.Now()
+	expiresAt := now.Add(time.Hour)
+	apiKey := &APIKey{ExpiresAt: &expiresAt}
+
+	if !apiKey.Active(now) {
+		t.Error("expected key to be active before expiry")
+	}
+	if apiKey.Active(expiresAt) {
+		t.Error("expected key to be inactive at expiry")
+	}
+
+	apiKey.Revoke(now)
+	revokedAt := *apiKey.RevokedAt
+	if apiKey.Active(now) {
+		t.Error("expected revoked key to be inactive")
+	}
+	apiKey.Revoke(now.Add(time.Minute))
+	if !apiKey.RevokedAt.Equal(revokedAt) {
+		t.Error("expected revoking twice to keep the first revocation time")
+	}
+}
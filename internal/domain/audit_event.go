@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// AuditAggregateType 監査イベントの対象となる集約の種類
+type AuditAggregateType string
+
+const (
+	// AuditAggregateTypeUser ユーザー
+	AuditAggregateTypeUser AuditAggregateType = "user"
+	// AuditAggregateTypeUserImport ユーザーインポート
+	AuditAggregateTypeUserImport AuditAggregateType = "user_import"
+	// AuditAggregateTypeAPIKey APIキー
+	AuditAggregateTypeAPIKey AuditAggregateType = "api_key"
+	// AuditAggregateTypeSession ログインセッション
+	AuditAggregateTypeSession AuditAggregateType = "session"
+	// AuditAggregateTypeOrganization 組織
+	AuditAggregateTypeOrganization AuditAggregateType = "organization"
+	// AuditAggregateTypeWebhook Webhook
+	AuditAggregateTypeWebhook AuditAggregateType = "webhook"
+	// AuditAggregateTypeInboundEvent 受信したWebhookのイベント
+	AuditAggregateTypeInboundEvent AuditAggregateType = "inbound_event"
+)
+
+// AuditEvent 集約に対する操作の監査イベント
+// 集約の種類を問わず共通の形式で記録し、集約固有の内容は Payload に格納する
+type AuditEvent struct {
+	ID            string
+	AggregateType AuditAggregateType
+	AggregateID   string
+	// Action 操作の種類（"created" などの集約ごとの動詞）
+	Action string
+	// Actor 操作を行った主体（Principal.String() の形式）
+	Actor string
+	// RequestID 操作を行ったリクエストのID
+	RequestID string
+	Metadata  RequestMetadata
+	// Payload 集約固有の内容（JSONオブジェクト）
+	Payload   json.RawMessage
+	CreatedAt time.Time
+}
+
+// NewAuditEvent 監査イベントを作成（payload はJSONオブジェクトに変換できる値、nil の場合は空オブジェクト）
+func NewAuditEvent(aggregateType AuditAggregateType, aggregateID, action string, payload any) (*AuditEvent, error) {
+	payloadJSON := json.RawMessage(`{}`)
+	if payload != nil {
+		b, err := json.Marshal(payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal audit event payload: %w", err)
+		}
+		if len(b) == 0 || b[0] != '{' {
+			return nil, fmt.Errorf("audit event payload must be a JSON object: %s", b)
+		}
+		payloadJSON = b
+	}
+
+	now := time.Now()
+	return &AuditEvent{
+		ID:            ulid.MustNew(ulid.Timestamp(now), rand.Reader).String(),
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		Action:        action,
+		Payload:       payloadJSON,
+		CreatedAt:     now,
+	}, nil
+}
+
+// AttributeTo 操作を行った主体、リクエストID、リクエストの付帯情報を記録
+func (e *AuditEvent) AttributeTo(principal Principal, requestID string, metadata RequestMetadata) *AuditEvent {
+	e.Actor = principal.String()
+	e.RequestID = requestID
+	e.Metadata = metadata
+	return e
+}
+
+// AuditEventFilter 監査イベントの絞り込み条件（ゼロ値の項目は条件に含めない）
+type AuditEventFilter struct {
+	AggregateType AuditAggregateType
+	AggregateID   string
+	Action        string
+	Actor         string
+	// Since この日時以降（含む）のイベント
+	Since time.Time
+	// Until この日時より前（含まない）のイベント
+	Until time.Time
+}
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"context"
+	"testing"
+)
+
+func TestNewAuditEvent(t *testing.T) {
+	tests := []struct {
+		name        string
+		payload     any
+		wantPayload string
+		wantErr     bool
+	}{
+		{name: "nil payload", payload: nil, wantPayload: `{}`},
+		{name: "map payload", payload: map[string]any{"name": "John Doe"}, wantPayload: `{"name":"John Doe"}`},
+		{name: "struct payload", payload: UserFieldChange{Before: "a", After: "b"}, wantPayload: `{"before":"a","after":"b"}`},
+		{name: "non-object payload", payload: []string{"a"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, err := NewAuditEvent(AuditAggregateTypeUser, "01ARZ3NDEKTSV4RRFFQ69G5FAV", "created", tt.payload)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(event.Payload) != tt.wantPayload {
+				t.Errorf("expected payload %s, got %s", tt.wantPayload, event.Payload)
+			}
+			if event.ID == "" {
+				t.Error("expected ID to be set")
+			}
+		})
+	}
+}
+
+func TestAuditEvent_AttributeTo(t *testing.T) {
+	ctx := WithPrincipal(context.Background(), NewUserPrincipal("01ARZ3NDEKTSV4RRFFQ69G5FAV"))
+	ctx = WithRequestMetadata(ctx, RequestMetadata{RemoteAddr: "192.0.2.1", UserAgent: "curl/8.0"})
+
+	event, err := NewAuditEvent(AuditAggregateTypeUser, "01ARZ3NDEKTSV4RRFFQ69G5FAW", "deleted", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	event.AttributeTo(PrincipalFromContext(ctx), "req-1", RequestMetadataFromContext(ctx))
+
+	if event.Actor != "user:01ARZ3NDEKTSV4RRFFQ69G5FAV" {
+		t.Errorf("expected actor user:01ARZ3NDEKTSV4RRFFQ69G5FAV, got %s", event.Actor)
+	}
+	if event.RequestID != "req-1" {
+		t.Errorf("expected request ID req-1, got %s", event.RequestID)
+	}
+	if event.Metadata.RemoteAddr != "192.0.2.1" || event.Metadata.UserAgent != "curl/8.0" {
+		t.Errorf("unexpected metadata %+v", event.Metadata)
+	}
+}
+
+func TestRequestMetadataFromContext_Unset(t *testing.T) {
+	if got := RequestMetadataFromContext(context.Background()); got != (RequestMetadata{}) {
+		t.Errorf("expected zero RequestMetadata, got %+v", got)
+	}
+}
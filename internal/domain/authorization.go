@@ -0,0 +1,146 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+)
+
+// Permission 操作に必要な権限（"リソース:操作" の形式。APIキーのスコープと同じ形式）
+type Permission string
+
+const (
+	// PermissionUsersRead ユーザーの参照・エクスポート
+	PermissionUsersRead Permission = "users:read"
+	// PermissionUsersCreate ユーザーの作成
+	PermissionUsersCreate Permission = "users:create"
+	// PermissionUsersUpdate 他のユーザーの更新（本人の更新には不要）
+	PermissionUsersUpdate Permission = "users:update"
+	// PermissionUsersDelete ユーザーの削除
+	PermissionUsersDelete Permission = "users:delete"
+	// PermissionUsersImport ユーザーの一括インポート
+	PermissionUsersImport Permission = "users:import"
+	// PermissionAuditRead 監査イベントの参照・ユーザーログの改ざん検知
+	PermissionAuditRead Permission = "audit:read"
+	// PermissionAPIKeysManage APIキーの作成・参照・失効
+	PermissionAPIKeysManage Permission = "api_keys:manage"
+	// PermissionMembersManage 組織のメンバーとロールの管理
+	PermissionMembersManage Permission = "members:manage"
+	// PermissionWebhooksManage Webhookの作成・参照・更新・削除
+	PermissionWebhooksManage Permission = "webhooks:manage"
+	// PermissionInboundEventsManage 受信したWebhookのイベントの参照・再処理
+	PermissionInboundEventsManage Permission = "inbound_events:manage"
+)
+
+// permissions 定義されているすべての権限（APIキーに指定できるスコープ）
+var permissions = []Permission{
+	PermissionUsersRead, PermissionUsersCreate, PermissionUsersUpdate, PermissionUsersDelete, PermissionUsersImport,
+	PermissionAuditRead, PermissionAPIKeysManage, PermissionMembersManage, PermissionWebhooksManage,
+	PermissionInboundEventsManage,
+}
+
+// ScopePermissions スコープが表す権限（"users:*" はそのリソースのすべての権限。未知のスコープは nil）
+func ScopePermissions(scope string) []Permission {
+	resource, action, _ := strings.Cut(scope, ":")
+	var result []Permission
+	for _, permission := range permissions {
+		if string(permission) == scope || (action == "*" && strings.HasPrefix(string(permission), resource+":")) {
+			result = append(result, permission)
+		}
+	}
+	return result
+}
+
+// Role 権限をまとめたロール
+type Role string
+
+const (
+	// RoleAdmin すべての権限を持つ管理者
+	RoleAdmin Role = "admin"
+	// RoleUserManager ユーザーの管理（作成・更新・削除・インポート）ができる
+	RoleUserManager Role = "user_manager"
+	// RoleAuditor 監査イベントとユーザーを参照できる
+	RoleAuditor Role = "auditor"
+	// RoleViewer ユーザーを参照できる
+	RoleViewer Role = "viewer"
+)
+
+// rolePermissions 各ロールに付与される権限
+var rolePermissions = map[Role][]Permission{
+	RoleAdmin: {
+		PermissionUsersRead, PermissionUsersCreate, PermissionUsersUpdate, PermissionUsersDelete, PermissionUsersImport,
+		PermissionAuditRead, PermissionAPIKeysManage, PermissionMembersManage, PermissionWebhooksManage,
+		PermissionInboundEventsManage,
+	},
+	RoleUserManager: {
+		PermissionUsersRead, PermissionUsersCreate, PermissionUsersUpdate, PermissionUsersDelete, PermissionUsersImport,
+	},
+	RoleAuditor: {PermissionUsersRead, PermissionAuditRead},
+	RoleViewer:  {PermissionUsersRead},
+}
+
+// Permissions ロールに付与される権限（未知のロールは権限を持たない）
+func (r Role) Permissions() []Permission {
+	return rolePermissions[r]
+}
+
+// ParseRoles 文字列のロールを変換する（前後の空白を除き、空文字列と未知のロールは無視する）
+func ParseRoles(values []string) []Role {
+	var roles []Role
+	for _, v := range values {
+		role := Role(strings.TrimSpace(v))
+		if _, ok := rolePermissions[role]; ok && !slices.Contains(roles, role) {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+// RoleNames ロールを文字列に変換する（永続化・レスポンス用）
+func RoleNames(roles []Role) []string {
+	names := make([]string, len(roles))
+	for i, role := range roles {
+		names[i] = string(role)
+	}
+	return names
+}
+
+// Can 主体が権限を持つかどうか
+// システム内部の処理はすべての権限を持ち、それ以外はロールと直接付与されたスコープ（"users:*" のようなワイルドカードを含む）で判定する
+func (p Principal) Can(permission Permission) bool {
+	if p.Type == PrincipalTypeSystem {
+		return true
+	}
+	for _, role := range p.Roles {
+		if slices.Contains(role.Permissions(), permission) {
+			return true
+		}
+	}
+	resource, _, _ := strings.Cut(string(permission), ":")
+	for _, scope := range p.Scopes {
+		if scope == string(permission) || scope == resource+":*" {
+			return true
+		}
+	}
+	return false
+}
+
+// IsUser 主体が指定したユーザー本人かどうか
+func (p Principal) IsUser(userID string) bool {
+	return p.Type == PrincipalTypeUser && p.ID != "" && p.ID == userID
+}
+
+// Authorize 主体が権限を持たない場合は ErrPermissionDenied を返す
+func Authorize(principal Principal, permission Permission) error {
+	if !principal.Can(permission) {
+		return ErrPermissionDenied(principal, permission)
+	}
+	return nil
+}
+
+// AuthorizeSelfOr 対象ユーザー本人であれば権限を問わず許可し、それ以外は Authorize と同じく判定する
+func AuthorizeSelfOr(principal Principal, userID string, permission Permission) error {
+	if principal.IsUser(userID) {
+		return nil
+	}
+	return Authorize(principal, permission)
+}
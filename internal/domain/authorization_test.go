@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPrincipal_Can(t *testing.T) {
+	tests := []struct {
+		name       string
+		principal  Principal
+		permission Permission
+		want       bool
+	}{
+		{name: "admin", principal: NewUserPrincipal("u1").WithRoles(RoleAdmin), permission: PermissionUsersDelete, want: true},
+		{name: "user manager deletes users", principal: NewUserPrincipal("u1").WithRoles(RoleUserManager), permission: PermissionUsersDelete, want: true},
+		{name: "user manager reads audit events", principal: NewUserPrincipal("u1").WithRoles(RoleUserManager), permission: PermissionAuditRead, want: false},
+		{name: "viewer deletes users", principal: NewUserPrincipal("u1").WithRoles(RoleViewer), permission: PermissionUsersDelete, want: false},
+		{name: "multiple roles", principal: NewUserPrincipal("u1").WithRoles(RoleViewer, RoleAuditor), permission: PermissionAuditRead, want: true},
+		{name: "unknown role", principal: NewUserPrincipal("u1").WithRoles(Role("root")), permission: PermissionUsersRead, want: false},
+		{name: "user without roles", principal: NewUserPrincipal("u1"), permission: PermissionUsersRead, want: false},
+		{name: "anonymous", principal: AnonymousPrincipal, permission: PermissionUsersRead, want: false},
+		{name: "anonymous with roles", principal: AnonymousPrincipal.WithRoles(RoleViewer), permission: PermissionUsersRead, want: true},
+		{name: "system", principal: NewSystemPrincipal("process_user_import"), permission: PermissionUsersImport, want: true},
+		{name: "api key scope", principal: NewAPIKeyPrincipal("k1").WithScopes("users:delete"), permission: PermissionUsersDelete, want: true},
+		{name: "api key other scope", principal: NewAPIKeyPrincipal("k1").WithScopes("users:read"), permission: PermissionUsersDelete, want: false},
+		{name: "api key wildcard scope", principal: NewAPIKeyPrincipal("k1").WithScopes("users:*"), permission: PermissionUsersDelete, want: true},
+		{name: "api key wildcard of other resource", principal: NewAPIKeyPrincipal("k1").WithScopes("audit:*"), permission: PermissionUsersDelete, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.principal.Can(tt.permission); got != tt.want {
+				t.Errorf("Can(%s) = %v, want %v", tt.permission, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuthorizeSelfOr(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal Principal
+		userID    string
+		wantErr   bool
+	}{
+		{name: "self without roles", principal: NewUserPrincipal("u1"), userID: "u1"},
+		{name: "other user without roles", principal: NewUserPrincipal("u1"), userID: "u2", wantErr: true},
+		{name: "other user with permission", principal: NewUserPrincipal("u1").WithRoles(RoleUserManager), userID: "u2"},
+		{name: "api key with the same ID", principal: NewAPIKeyPrincipal("u1"), userID: "u1", wantErr: true},
+		{name: "anonymous", principal: AnonymousPrincipal, userID: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := AuthorizeSelfOr(tt.principal, tt.userID, PermissionUsersUpdate)
+			if tt.wantErr {
+				if _, ok := err.(*ForbiddenError); !ok {
+					t.Fatalf("expected ForbiddenError, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestParseRoles(t *testing.T) {
+	roles := ParseRoles([]string{" admin", "viewer", "", "root", "admin"})
+	if len(roles) != 2 || roles[0] != RoleAdmin || roles[1] != RoleViewer {
+		t.Errorf("ParseRoles() = %v, want [admin viewer]", roles)
+	}
+}
+
+func TestScopePermissions(t *testing.T) {
+	tests := []struct {
+		scope string
+		want  []Permission
+	}{
+		{scope: "users:read", want: []Permission{PermissionUsersRead}},
+		{scope: "audit:*", want: []Permission{PermissionAuditRead}},
+		{scope: "users:*", want: []Permission{PermissionUsersRead, PermissionUsersCreate, PermissionUsersUpdate, PermissionUsersDelete, PermissionUsersImport}},
+		{scope: "users:write", want: nil},
+		{scope: "billing:*", want: nil},
+		{scope: "*:*", want: nil},
+		{scope: "users", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scope, func(t *testing.T) {
+			if got := ScopePermissions(tt.scope); !slices.Equal(got, tt.want) {
+				t.Errorf("ScopePermissions(%q) = %v, want %v", tt.scope, got, tt.want)
+			}
+		})
+	}
+}
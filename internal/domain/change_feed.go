@@ -0,0 +1,85 @@
+package domain
+
+import "time"
+
+// ChangeType 変更通知の種類
+type ChangeType string
+
+const (
+	// ChangeTypeCreated 作成された
+	ChangeTypeCreated ChangeType = "created"
+	// ChangeTypeUpdated 更新された
+	ChangeTypeUpdated ChangeType = "updated"
+	// ChangeTypeDeleted 削除された
+	ChangeTypeDeleted ChangeType = "deleted"
+)
+
+// Change クライアントへ配信する集約の変更通知（Server-Sent Events で配信する）
+// 内容は含めず、クライアントは通知を受けて集約を読み直す
+type Change struct {
+	// ID 通知の連番（テナントをまたいで単調増加し、Last-Event-ID での再開に使う）
+	ID             int64
+	OrganizationID string
+	AggregateType  AggregateType
+	AggregateID    string
+	Type           ChangeType
+	OccurredAt     time.Time
+	// Pending より小さいIDの変更通知がまだコミットされる可能性がある（IDは保存時に採番され、コミットの順とは限らない）
+	// 読み手はこの変更通知から先へ読み進めず、確定してから読み直す
+	Pending bool
+}
+
+// EventName 通知のイベント名（user.created など）
+func (c *Change) EventName() string {
+	return string(c.AggregateType) + "." + string(c.Type)
+}
+
+// SettledChanges 最初の確定していない変更通知より前の変更通知を返す（確定していない変更通知を残した場合は pending が真）
+func SettledChanges(changes []*Change) (settled []*Change, pending bool) {
+	for i, change := range changes {
+		if change.Pending {
+			return changes[:i], true
+		}
+	}
+	return changes, false
+}
+
+// ChangesFromEvents ドメインイベントを集約ごとの変更通知にまとめる（最初に発生した順）
+// 同じ集約の作成・削除は更新より優先し、1つのトランザクションで作成して更新した場合は作成のみを通知する
+func ChangesFromEvents(events []DomainEvent) []*Change {
+	changes := make([]*Change, 0, len(events))
+	byAggregate := make(map[string]*Change)
+	for _, event := range events {
+		changeType := changeTypeOf(event)
+		key := string(event.AggregateType()) + ":" + event.AggregateID()
+		if change, ok := byAggregate[key]; ok {
+			if changeType != ChangeTypeUpdated {
+				change.Type = changeType
+			}
+			change.OccurredAt = event.OccurredAt()
+			continue
+		}
+
+		change := &Change{
+			AggregateType: event.AggregateType(),
+			AggregateID:   event.AggregateID(),
+			Type:          changeType,
+			OccurredAt:    event.OccurredAt(),
+		}
+		byAggregate[key] = change
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// changeTypeOf ドメインイベントに対応する変更通知の種類
+func changeTypeOf(event DomainEvent) ChangeType {
+	switch event.(type) {
+	case UserCreated:
+		return ChangeTypeCreated
+	case UserDeleted:
+		return ChangeTypeDeleted
+	default:
+		return ChangeTypeUpdated
+	}
+}
@@ -0,0 +1,68 @@
+package domain
+
+import "testing"
+
+func TestChangesFromEvents(t *testing.T) {
+	first, err := NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second, err := NewUser("Jane Doe", "jane@example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	first.PullEvents()
+	second.PullEvents()
+
+	t.Run("updates are collapsed per aggregate", func(t *testing.T) {
+		if err := first.Update("Johnny", "johnny@example.com"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := second.Update("Janie", ""); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		changes := ChangesFromEvents(append(first.PullEvents(), second.PullEvents()...))
+		if len(changes) != 2 {
+			t.Fatalf("expected 2 changes, got %d", len(changes))
+		}
+		if changes[0].AggregateID != first.ID || changes[1].AggregateID != second.ID {
+			t.Errorf("expected changes in the order they occurred, got %s, %s", changes[0].AggregateID, changes[1].AggregateID)
+		}
+		for _, change := range changes {
+			if change.EventName() != "user.updated" {
+				t.Errorf("expected user.updated, got %s", change.EventName())
+			}
+		}
+	})
+
+	t.Run("created takes precedence over updates", func(t *testing.T) {
+		user, err := NewUser("New User", "new@example.com")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := user.Update("Renamed", ""); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		changes := ChangesFromEvents(user.PullEvents())
+		if len(changes) != 1 || changes[0].Type != ChangeTypeCreated {
+			t.Fatalf("expected a single created change, got %v", changes)
+		}
+	})
+
+	t.Run("deleted takes precedence over updates", func(t *testing.T) {
+		if err := first.Update("John", ""); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		first.Delete()
+		changes := ChangesFromEvents(first.PullEvents())
+		if len(changes) != 1 || changes[0].Type != ChangeTypeDeleted {
+			t.Fatalf("expected a single deleted change, got %v", changes)
+		}
+	})
+
+	t.Run("no events", func(t *testing.T) {
+		if changes := ChangesFromEvents(nil); len(changes) != 0 {
+			t.Errorf("expected no changes, got %v", changes)
+		}
+	})
+}
@@ -0,0 +1,220 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// ドメインイベントの種類
+const (
+	// EventTypeUserCreated ユーザーが作成された
+	EventTypeUserCreated = "user.created"
+	// EventTypeUserRenamed ユーザーの名前が変更された
+	EventTypeUserRenamed = "user.renamed"
+	// EventTypeUserEmailChanged ユーザーのメールアドレスが変更された
+	EventTypeUserEmailChanged = "user.email_changed"
+	// EventTypeUserEmailVerified ユーザーのメールアドレスが確認された
+	EventTypeUserEmailVerified = "user.email_verified"
+	// EventTypeUserEmailInvalidated ユーザーのメールアドレスが配信できないと判定された
+	EventTypeUserEmailInvalidated = "user.email_invalidated"
+	// EventTypeUserPasswordChanged ユーザーのパスワードが設定・変更された
+	EventTypeUserPasswordChanged = "user.password_changed"
+	// EventTypeUserDeleted ユーザーが削除された
+	EventTypeUserDeleted = "user.deleted"
+)
+
+// AggregateType ドメインイベントが発生した集約の種類（アウトボックス・イベントストア・変更通知に記録する）
+// 監査イベントの対象の種類（AuditAggregateType）とは独立に定義する
+type AggregateType string
+
+const (
+	// AggregateTypeUser ユーザー
+	AggregateTypeUser AggregateType = "user"
+)
+
+// DomainEvent 集約で発生したドメインイベント
+// 集約が記録し、command が保存する際にアウトボックスに書き込まれ、コミット後にプロセス内の購読者へ配信される
+type DomainEvent interface {
+	// EventType イベントの種類（user.created など）
+	EventType() string
+	// AggregateType イベントが発生した集約の種類
+	AggregateType() AggregateType
+	// AggregateID イベントが発生した集約のID
+	AggregateID() string
+	// OccurredAt イベントの発生日時
+	OccurredAt() time.Time
+}
+
+// eventTime ドメインイベントの発生日時
+type eventTime struct {
+	Time time.Time `json:"occurredAt"`
+}
+
+// OccurredAt イベントの発生日時を返す
+func (e eventTime) OccurredAt() time.Time {
+	return e.Time
+}
+
+// UserCreated ユーザーが作成されたイベント
+type UserCreated struct {
+	eventTime
+	UserID string `json:"userId"`
+	Name   string `json:"name"`
+	Email  string `json:"email"`
+}
+
+// EventType DomainEventインターフェースを実装
+func (e UserCreated) EventType() string { return EventTypeUserCreated }
+
+// AggregateType DomainEventインターフェースを実装
+func (e UserCreated) AggregateType() AggregateType { return AggregateTypeUser }
+
+// AggregateID DomainEventインターフェースを実装
+func (e UserCreated) AggregateID() string { return e.UserID }
+
+// UserRenamed ユーザーの名前が変更されたイベント
+type UserRenamed struct {
+	eventTime
+	UserID string `json:"userId"`
+	Name   string `json:"name"`
+}
+
+// EventType DomainEventインターフェースを実装
+func (e UserRenamed) EventType() string { return EventTypeUserRenamed }
+
+// AggregateType DomainEventインターフェースを実装
+func (e UserRenamed) AggregateType() AggregateType { return AggregateTypeUser }
+
+// AggregateID DomainEventインターフェースを実装
+func (e UserRenamed) AggregateID() string { return e.UserID }
+
+// UserEmailChanged ユーザーのメールアドレスが変更されたイベント
+type UserEmailChanged struct {
+	eventTime
+	UserID   string `json:"userId"`
+	OldEmail string `json:"oldEmail"`
+	NewEmail string `json:"newEmail"`
+}
+
+// EventType DomainEventインターフェースを実装
+func (e UserEmailChanged) EventType() string { return EventTypeUserEmailChanged }
+
+// AggregateType DomainEventインターフェースを実装
+func (e UserEmailChanged) AggregateType() AggregateType { return AggregateTypeUser }
+
+// AggregateID DomainEventインターフェースを実装
+func (e UserEmailChanged) AggregateID() string { return e.UserID }
+
+// UserEmailVerified ユーザーのメールアドレスが確認されたイベント
+type UserEmailVerified struct {
+	eventTime
+	UserID string `json:"userId"`
+	Email  string `json:"email"`
+}
+
+// EventType DomainEventインターフェースを実装
+func (e UserEmailVerified) EventType() string { return EventTypeUserEmailVerified }
+
+// AggregateType DomainEventインターフェースを実装
+func (e UserEmailVerified) AggregateType() AggregateType { return AggregateTypeUser }
+
+// AggregateID DomainEventインターフェースを実装
+func (e UserEmailVerified) AggregateID() string { return e.UserID }
+
+// UserEmailInvalidated ユーザーのメールアドレスが配信できないと判定されたイベント（ハードバウンスなど）
+type UserEmailInvalidated struct {
+	eventTime
+	UserID string `json:"userId"`
+	Email  string `json:"email"`
+	// Reason 配信できないと判定した理由（メール配信サービスの通知の種類など）
+	Reason string `json:"reason"`
+}
+
+// EventType DomainEventインターフェースを実装
+func (e UserEmailInvalidated) EventType() string { return EventTypeUserEmailInvalidated }
+
+// AggregateType DomainEventインターフェースを実装
+func (e UserEmailInvalidated) AggregateType() AggregateType { return AggregateTypeUser }
+
+// AggregateID DomainEventインターフェースを実装
+func (e UserEmailInvalidated) AggregateID() string { return e.UserID }
+
+// UserPasswordChanged ユーザーのパスワードが設定・変更されたイベント
+// ハッシュはイベントストアにのみ保存し、アウトボックス・購読者には公開しない（JSONに含めない）
+type UserPasswordChanged struct {
+	eventTime
+	UserID       string `json:"userId"`
+	PasswordHash string `json:"-"`
+}
+
+// EventType DomainEventインターフェースを実装
+func (e UserPasswordChanged) EventType() string { return EventTypeUserPasswordChanged }
+
+// AggregateType DomainEventインターフェースを実装
+func (e UserPasswordChanged) AggregateType() AggregateType { return AggregateTypeUser }
+
+// AggregateID DomainEventインターフェースを実装
+func (e UserPasswordChanged) AggregateID() string { return e.UserID }
+
+// UserDeleted ユーザーが削除されたイベント
+type UserDeleted struct {
+	eventTime
+	UserID string `json:"userId"`
+	Email  string `json:"email"`
+}
+
+// EventType DomainEventインターフェースを実装
+func (e UserDeleted) EventType() string { return EventTypeUserDeleted }
+
+// AggregateType DomainEventインターフェースを実装
+func (e UserDeleted) AggregateType() AggregateType { return AggregateTypeUser }
+
+// AggregateID DomainEventインターフェースを実装
+func (e UserDeleted) AggregateID() string { return e.UserID }
+
+// OutboxEvent アウトボックスに保存されたドメインイベント
+// ワーカーが永続的な購読者へ配信し、配信済みの日時を記録する（少なくとも1回配信される）
+type OutboxEvent struct {
+	ID             string
+	OrganizationID string
+	AggregateType  AggregateType
+	AggregateID    string
+	EventType      string
+	// Payload イベントのJSON表現
+	Payload     json.RawMessage
+	OccurredAt  time.Time
+	PublishedAt *time.Time
+	Attempts    int
+	LastError   string
+	CreatedAt   time.Time
+	// TransactionID イベントを記録したトランザクションのID（投影が読み進める順序に使う）
+	TransactionID int64
+}
+
+// EventCollector トランザクション内で保存されたドメインイベントを集める（コミット後に配信するため）
+type EventCollector struct {
+	events []DomainEvent
+}
+
+type eventCollectorContextKey struct{}
+
+// WithEventCollector ドメインイベントを集める EventCollector をコンテキストに設定
+func WithEventCollector(ctx context.Context) (context.Context, *EventCollector) {
+	collector := &EventCollector{}
+	return context.WithValue(ctx, eventCollectorContextKey{}, collector), collector
+}
+
+// CollectEvents コンテキストの EventCollector にドメインイベントを追加する（設定されていない場合は何もしない）
+func CollectEvents(ctx context.Context, events ...DomainEvent) {
+	collector, ok := ctx.Value(eventCollectorContextKey{}).(*EventCollector)
+	if !ok {
+		return
+	}
+	collector.events = append(collector.events, events...)
+}
+
+// Events 集めたドメインイベントを発生順に返す
+func (c *EventCollector) Events() []DomainEvent {
+	return c.events
+}
@@ -0,0 +1,168 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestUserEvents(t *testing.T) {
+	user, err := NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	events := user.PullEvents()
+	if len(events) != 1 || events[0].EventType() != EventTypeUserCreated {
+		t.Fatalf("expected a %s event, got %v", EventTypeUserCreated, events)
+	}
+	if events[0].AggregateID() != user.ID || events[0].AggregateType() != AggregateTypeUser {
+		t.Errorf("unexpected aggregate %s:%s", events[0].AggregateType(), events[0].AggregateID())
+	}
+	if got := user.PullEvents(); len(got) != 0 {
+		t.Errorf("expected events to be cleared, got %v", got)
+	}
+
+	t.Run("rename", func(t *testing.T) {
+		if err := user.Update("Johnny", ""); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		events := user.PullEvents()
+		if len(events) != 1 || events[0].EventType() != EventTypeUserRenamed {
+			t.Fatalf("expected a %s event, got %v", EventTypeUserRenamed, events)
+		}
+	})
+
+	t.Run("email change", func(t *testing.T) {
+		if err := user.Update("", "johnny@example.com"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		events := user.PullEvents()
+		if len(events) != 1 {
+			t.Fatalf("expected one event, got %v", events)
+		}
+		changed, ok := events[0].(UserEmailChanged)
+		if !ok {
+			t.Fatalf("expected UserEmailChanged, got %T", events[0])
+		}
+		if changed.OldEmail != "john@example.com" || changed.NewEmail != "johnny@example.com" {
+			t.Errorf("unexpected change %s -> %s", changed.OldEmail, changed.NewEmail)
+		}
+	})
+
+	t.Run("unchanged values record no event", func(t *testing.T) {
+		if err := user.Update("Johnny", "johnny@example.com"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := user.PullEvents(); len(got) != 0 {
+			t.Errorf("expected no events, got %v", got)
+		}
+	})
+
+	t.Run("password change does not expose the hash", func(t *testing.T) {
+		if err := user.SetPassword("correct horse battery staple"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		events := user.PullEvents()
+		if len(events) != 1 || events[0].EventType() != EventTypeUserPasswordChanged {
+			t.Fatalf("expected a %s event, got %v", EventTypeUserPasswordChanged, events)
+		}
+		data, err := json.Marshal(events[0])
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if strings.Contains(string(data), user.PasswordHash) {
+			t.Errorf("expected the password hash to be omitted, got %s", data)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		user.Delete()
+		events := user.PullEvents()
+		if len(events) != 1 || events[0].EventType() != EventTypeUserDeleted {
+			t.Fatalf("expected a %s event, got %v", EventTypeUserDeleted, events)
+		}
+	})
+}
+
+func TestDomainEventJSON(t *testing.T) {
+	user, err := NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data, err := json.Marshal(user.PullEvents()[0])
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var payload map[string]any
+	if err := json.Unmarshal(data, &payload); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, key := range []string{"userId", "name", "email", "occurredAt"} {
+		if _, ok := payload[key]; !ok {
+			t.Errorf("expected %q in payload %s", key, data)
+		}
+	}
+}
+
+func TestCollectEvents(t *testing.T) {
+	user, err := NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// EventCollector が設定されていない場合は何もしない
+	CollectEvents(context.Background(), user.PullEvents()...)
+
+	ctx, collector := WithEventCollector(context.Background())
+	user.Delete()
+	CollectEvents(ctx, user.PullEvents()...)
+	if events := collector.Events(); len(events) != 1 || events[0].EventType() != EventTypeUserDeleted {
+		t.Errorf("expected the collected %s event, got %v", EventTypeUserDeleted, events)
+	}
+}
+
+func TestReplayUser(t *testing.T) {
+	user, err := NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := user.SetPassword("correct horse battery staple"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	user.VerifyEmail(time.Now())
+	if err := user.Update("Johnny", "johnny@example.com"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	events := user.PullEvents()
+
+	replayed := ReplayUser(nil, events)
+	if replayed.Version != len(events) {
+		t.Errorf("expected version %d, got %d", len(events), replayed.Version)
+	}
+	if replayed.ID != user.ID || replayed.Name != "Johnny" || replayed.Email != "johnny@example.com" {
+		t.Errorf("unexpected state %+v", replayed)
+	}
+	if replayed.PasswordHash != user.PasswordHash {
+		t.Error("expected the password hash to be restored")
+	}
+	if replayed.EmailVerified() {
+		t.Error("expected the email change to reset verification")
+	}
+	if len(replayed.PullEvents()) != 0 {
+		t.Error("expected replayed events not to be pending")
+	}
+
+	// スナップショットから続きのイベントを適用する
+	snapshot := ReplayUser(nil, events[:2])
+	resumed := ReplayUser(snapshot, events[2:])
+	if resumed.Version != replayed.Version || resumed.Email != replayed.Email || resumed.PasswordHash != replayed.PasswordHash {
+		t.Errorf("expected the same state from the snapshot, got %+v", resumed)
+	}
+	if snapshot.Version != 2 {
+		t.Errorf("expected the snapshot to be left unchanged, got version %d", snapshot.Version)
+	}
+}
@@ -0,0 +1,8 @@
+package domain
+
+// EmailMessage 送信するメール（本文はプレーンテキスト）
+type EmailMessage struct {
+	To      string
+	Subject string
+	Body    string
+}
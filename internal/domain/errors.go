@@ -1,6 +1,9 @@
 package domain
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // ErrorCode はドメインエラーの種類を識別するコード
 type ErrorCode string
@@ -12,6 +15,12 @@ const (
 	ErrCodeNotFound ErrorCode = "NOT_FOUND"
 	// ErrCodeConflict はリソースの競合エラー
 	ErrCodeConflict ErrorCode = "CONFLICT"
+	// ErrCodeForbidden は権限不足のエラー
+	ErrCodeForbidden ErrorCode = "FORBIDDEN"
+	// ErrCodeUnauthorized は認証に失敗したエラー
+	ErrCodeUnauthorized ErrorCode = "UNAUTHORIZED"
+	// ErrCodeTooManyRequests は試行回数の上限を超えたエラー
+	ErrCodeTooManyRequests ErrorCode = "TOO_MANY_REQUESTS"
 )
 
 // DomainError はドメイン層のエラーを表す基本構造体
@@ -94,6 +103,75 @@ func NewConflictError(resource, message, userMessage string) *ConflictError {
 	}
 }
 
+// --- 権限エラー ---
+
+// ForbiddenError は操作に必要な権限がないエラーを表す
+type ForbiddenError struct {
+	DomainError
+	// Permission は不足している権限
+	Permission Permission
+}
+
+// NewForbiddenError は権限不足のエラーを作成
+func NewForbiddenError(permission Permission, message, userMessage string) *ForbiddenError {
+	return &ForbiddenError{
+		DomainError: DomainError{
+			Code:        ErrCodeForbidden,
+			Message:     message,
+			UserMessage: userMessage,
+		},
+		Permission: permission,
+	}
+}
+
+// ErrPermissionDenied は主体が操作に必要な権限を持たないエラー
+func ErrPermissionDenied(principal Principal, permission Permission) *ForbiddenError {
+	return NewForbiddenError(
+		permission,
+		fmt.Sprintf("%s does not have permission %s", principal, permission),
+		"この操作を行う権限がありません",
+	)
+}
+
+// --- 認証エラー ---
+
+// UnauthorizedError は認証に失敗したエラーを表す
+type UnauthorizedError struct {
+	DomainError
+}
+
+// NewUnauthorizedError は認証エラーを作成
+func NewUnauthorizedError(message, userMessage string) *UnauthorizedError {
+	return &UnauthorizedError{
+		DomainError: DomainError{
+			Code:        ErrCodeUnauthorized,
+			Message:     message,
+			UserMessage: userMessage,
+		},
+	}
+}
+
+// --- 試行回数超過エラー ---
+
+// TooManyRequestsError は試行回数の上限を超えたエラーを表す
+type TooManyRequestsError struct {
+	DomainError
+	// RetryAfter は再試行できるようになるまでの時間
+	RetryAfter time.Duration
+}
+
+// NewTooManyRequestsError は試行回数超過のエラーを作成
+func NewTooManyRequestsError(retryAfter time.Duration, message, userMessage string) *TooManyRequestsError {
+	return &TooManyRequestsError{
+		DomainError: DomainError{
+			Code:        ErrCodeTooManyRequests,
+			Message:     message,
+			UserMessage: userMessage,
+		},
+		RetryAfter: retryAfter,
+	}
+}
+
 // --- User 関連のエラー（よく使うものを定義） ---
 
 // ErrUserNotFound はユーザーが見つからないエラー
@@ -114,6 +192,15 @@ func ErrEmailAlreadyExists(email string) *ConflictError {
 	)
 }
 
+// ErrUserConcurrentModification はユーザーが同時に更新されたエラー（イベントストアの楽観的排他制御）
+func ErrUserConcurrentModification(userID string) *ConflictError {
+	return NewConflictError(
+		"user",
+		fmt.Sprintf("user was modified concurrently: %s", userID),
+		"ユーザーが同時に更新されました。もう一度お試しください",
+	)
+}
+
 // ErrNameRequired は名前が必須エラー
 func ErrNameRequired() *ValidationError {
 	return NewValidationError(
@@ -131,3 +218,360 @@ func ErrEmailRequired() *ValidationError {
 		"メールアドレスは必須です",
 	)
 }
+
+// ErrNameTooLong は名前が長すぎるエラー
+func ErrNameTooLong(maxLength int) *ValidationError {
+	return NewValidationError(
+		"name",
+		fmt.Sprintf("name must be at most %d characters", maxLength),
+		fmt.Sprintf("名前は%d文字以下で入力してください", maxLength),
+	)
+}
+
+// ErrEmailInvalid はメールアドレスの形式が不正なエラー
+func ErrEmailInvalid(email string) *ValidationError {
+	return NewValidationError(
+		"email",
+		fmt.Sprintf("invalid email format: %s", email),
+		"有効なメールアドレスを入力してください",
+	)
+}
+
+// --- UserImport 関連のエラー ---
+
+// ErrUserImportNotFound はインポートが見つからないエラー
+func ErrUserImportNotFound(importID string) *NotFoundError {
+	return NewNotFoundError(
+		"user_import",
+		fmt.Sprintf("user import not found: %s", importID),
+		"指定されたインポートが見つかりません",
+	)
+}
+
+// ErrUserImportFormatUnsupported は未対応のインポート形式エラー
+func ErrUserImportFormatUnsupported(format string) *ValidationError {
+	return NewValidationError(
+		"format",
+		fmt.Sprintf("unsupported import format: %s", format),
+		"インポート形式はCSVまたはNDJSONを指定してください",
+	)
+}
+
+// ErrUserImportEmpty はインポートするデータがないエラー
+func ErrUserImportEmpty() *ValidationError {
+	return NewValidationError(
+		"body",
+		"import file has no rows",
+		"インポートするデータがありません",
+	)
+}
+
+// ErrUserImportTooManyRows はインポートの行数が多すぎるエラー
+func ErrUserImportTooManyRows(maxRows int) *ValidationError {
+	return NewValidationError(
+		"body",
+		fmt.Sprintf("import file exceeds %d rows", maxRows),
+		fmt.Sprintf("一度にインポートできるのは%d行までです", maxRows),
+	)
+}
+
+// ErrUserImportMalformed はインポートファイルを読み取れないエラー
+func ErrUserImportMalformed(reason string) *ValidationError {
+	return NewValidationError(
+		"body",
+		fmt.Sprintf("malformed import file: %s", reason),
+		"インポートファイルの形式が正しくありません",
+	)
+}
+
+// --- AuditEvent 関連のエラー ---
+
+// ErrAuditEventPeriodInvalid は監査イベントの期間指定が不正なエラー
+func ErrAuditEventPeriodInvalid() *ValidationError {
+	return NewValidationError(
+		"since",
+		"since must be before until",
+		"開始日時は終了日時より前を指定してください",
+	)
+}
+
+// --- APIKey 関連のエラー ---
+
+// ErrAPIKeyNotFound はAPIキーが見つからないエラー
+func ErrAPIKeyNotFound(apiKeyID string) *NotFoundError {
+	return NewNotFoundError(
+		"api_key",
+		fmt.Sprintf("api key not found: %s", apiKeyID),
+		"指定されたAPIキーが見つかりません",
+	)
+}
+
+// ErrAPIKeyNameRequired はAPIキー名が必須エラー
+func ErrAPIKeyNameRequired() *ValidationError {
+	return NewValidationError(
+		"name",
+		"api key name is required",
+		"APIキーの名前は必須です",
+	)
+}
+
+// ErrAPIKeyNameTooLong はAPIキー名が長すぎるエラー
+func ErrAPIKeyNameTooLong(maxLength int) *ValidationError {
+	return NewValidationError(
+		"name",
+		fmt.Sprintf("api key name must be at most %d characters", maxLength),
+		fmt.Sprintf("APIキーの名前は%d文字以下で入力してください", maxLength),
+	)
+}
+
+// ErrAPIKeyScopeInvalid はスコープの形式が不正なエラー
+func ErrAPIKeyScopeInvalid(scope string) *ValidationError {
+	return NewValidationError(
+		"scopes",
+		fmt.Sprintf("invalid api key scope: %q", scope),
+		"スコープには定義されている権限（例: users:read、users:*）を指定してください",
+	)
+}
+
+// ErrAPIKeyExpiryInPast は有効期限が過去の日時であるエラー
+func ErrAPIKeyExpiryInPast() *ValidationError {
+	return NewValidationError(
+		"expiresAt",
+		"api key expiry must be in the future",
+		"有効期限には未来の日時を指定してください",
+	)
+}
+
+// --- Webhook 関連のエラー ---
+
+// ErrWebhookNotFound はWebhookが見つからないエラー
+func ErrWebhookNotFound(webhookID string) *NotFoundError {
+	return NewNotFoundError(
+		"webhook",
+		fmt.Sprintf("webhook not found: %s", webhookID),
+		"指定されたWebhookが見つかりません",
+	)
+}
+
+// ErrWebhookURLInvalid はWebhookのURLが不正なエラー
+func ErrWebhookURLInvalid() *ValidationError {
+	return NewValidationError(
+		"url",
+		"webhook url must be an absolute http or https url",
+		fmt.Sprintf("URLはhttp://またはhttps://で始まる%d文字以下のURLを入力してください", WebhookURLMaxLength),
+	)
+}
+
+// ErrWebhookEventTypesRequired はイベントの種類が指定されていないエラー
+func ErrWebhookEventTypesRequired() *ValidationError {
+	return NewValidationError(
+		"eventTypes",
+		"webhook event types are required",
+		"イベントの種類を1つ以上指定してください",
+	)
+}
+
+// ErrWebhookEventTypeInvalid は購読できないイベントの種類が指定されたエラー
+func ErrWebhookEventTypeInvalid(eventType string) *ValidationError {
+	return NewValidationError(
+		"eventTypes",
+		fmt.Sprintf("invalid webhook event type: %q", eventType),
+		fmt.Sprintf("イベントの種類「%s」は購読できません", eventType),
+	)
+}
+
+// --- 受信Webhook 関連のエラー ---
+
+// ErrInboundWebhookProviderNotFound はWebhookを受信するプロバイダーが設定されていないエラー
+func ErrInboundWebhookProviderNotFound(provider string) *NotFoundError {
+	return NewNotFoundError(
+		"inbound_webhook_provider",
+		fmt.Sprintf("inbound webhook provider not found: %s", provider),
+		"指定されたプロバイダーのWebhookは受け付けていません",
+	)
+}
+
+// ErrInboundWebhookSignatureInvalid は受信したWebhookの署名が正しくないエラー
+func ErrInboundWebhookSignatureInvalid() *UnauthorizedError {
+	return NewUnauthorizedError(
+		"inbound webhook signature is invalid",
+		"Webhookの署名が正しくありません",
+	)
+}
+
+// ErrInboundWebhookPayloadInvalid は受信したWebhookのペイロードを解釈できないエラー
+func ErrInboundWebhookPayloadInvalid(reason string) *ValidationError {
+	return NewValidationError(
+		"body",
+		fmt.Sprintf("invalid inbound webhook payload: %s", reason),
+		"Webhookのペイロードの形式が不正です",
+	)
+}
+
+// ErrInboundEventIDInvalid は受信したイベントにプロバイダーのイベントIDがないエラー
+func ErrInboundEventIDInvalid() *ValidationError {
+	return NewValidationError(
+		"id",
+		"inbound event id is required",
+		fmt.Sprintf("イベントIDは%d文字以下で指定してください", InboundEventIDMaxLength),
+	)
+}
+
+// ErrInboundEventTypeRequired は受信したイベントに種類がないエラー
+func ErrInboundEventTypeRequired() *ValidationError {
+	return NewValidationError(
+		"type",
+		"inbound event type is required",
+		"イベントの種類を指定してください",
+	)
+}
+
+// ErrInboundEventNotFound は受信イベントが見つからないエラー
+func ErrInboundEventNotFound(inboundEventID string) *NotFoundError {
+	return NewNotFoundError(
+		"inbound_event",
+		fmt.Sprintf("inbound event not found: %s", inboundEventID),
+		"指定された受信イベントが見つかりません",
+	)
+}
+
+// --- 認証・セッション関連のエラー ---
+
+// ErrInvalidCredentials はメールアドレスまたはパスワードが正しくないエラー
+// どちらが誤っているかは区別しない（登録済みのメールアドレスを推測されないようにするため）
+func ErrInvalidCredentials() *UnauthorizedError {
+	return NewUnauthorizedError(
+		"invalid email or password",
+		"メールアドレスまたはパスワードが正しくありません",
+	)
+}
+
+// ErrLoginRequired はセッションによるログインが必要なエラー
+func ErrLoginRequired() *UnauthorizedError {
+	return NewUnauthorizedError(
+		"login session is required",
+		"ログインが必要です",
+	)
+}
+
+// ErrTooManyLoginAttempts はログインの失敗が続いたため一時的にロックされているエラー
+func ErrTooManyLoginAttempts(retryAfter time.Duration) *TooManyRequestsError {
+	return NewTooManyRequestsError(
+		retryAfter,
+		fmt.Sprintf("too many failed login attempts, retry after %s", retryAfter),
+		"ログインの失敗が続いたため、しばらく時間をおいてから再度お試しください",
+	)
+}
+
+// ErrSessionNotFound はセッションが見つからないエラー
+func ErrSessionNotFound(sessionID string) *NotFoundError {
+	return NewNotFoundError(
+		"session",
+		fmt.Sprintf("session not found: %s", sessionID),
+		"指定されたセッションが見つかりません",
+	)
+}
+
+// ErrPasswordTooShort はパスワードが短すぎるエラー
+func ErrPasswordTooShort(minLength int) *ValidationError {
+	return NewValidationError(
+		"password",
+		fmt.Sprintf("password must be at least %d characters", minLength),
+		fmt.Sprintf("パスワードは%d文字以上で入力してください", minLength),
+	)
+}
+
+// ErrPasswordTooLong はパスワードが長すぎるエラー
+func ErrPasswordTooLong(maxLength int) *ValidationError {
+	return NewValidationError(
+		"password",
+		fmt.Sprintf("password must be at most %d characters", maxLength),
+		fmt.Sprintf("パスワードは%d文字以下で入力してください", maxLength),
+	)
+}
+
+// ErrCurrentPasswordIncorrect は現在のパスワードが正しくないエラー
+func ErrCurrentPasswordIncorrect() *ValidationError {
+	return NewValidationError(
+		"currentPassword",
+		"current password is incorrect",
+		"現在のパスワードが正しくありません",
+	)
+}
+
+// --- メール確認・パスワード再設定関連のエラー ---
+
+// ErrUserTokenInvalid はメール確認・パスワード再設定のトークンが無効なエラー
+// 存在しない・使用済み・期限切れを区別せずに同じエラーを返す
+func ErrUserTokenInvalid() *ValidationError {
+	return NewValidationError(
+		"token",
+		"token is invalid or expired",
+		"リンクが無効か有効期限が切れています。もう一度お試しください",
+	)
+}
+
+// --- 組織関連のエラー ---
+
+// ErrOrganizationNotFound は組織が見つからないエラー
+func ErrOrganizationNotFound(organizationID string) *NotFoundError {
+	return NewNotFoundError(
+		"organization",
+		fmt.Sprintf("organization not found: %s", organizationID),
+		"指定された組織が見つかりません",
+	)
+}
+
+// ErrOrganizationSlugAlreadyExists はスラッグが既に使用されているエラー
+func ErrOrganizationSlugAlreadyExists(slug string) *ConflictError {
+	return NewConflictError(
+		"organization",
+		fmt.Sprintf("organization slug already exists: %s", slug),
+		"このスラッグは既に使用されています",
+	)
+}
+
+// ErrOrganizationNameRequired は組織名が必須エラー
+func ErrOrganizationNameRequired() *ValidationError {
+	return NewValidationError(
+		"name",
+		"organization name is required",
+		"組織名は必須です",
+	)
+}
+
+// ErrOrganizationNameTooLong は組織名が長すぎるエラー
+func ErrOrganizationNameTooLong(maxLength int) *ValidationError {
+	return NewValidationError(
+		"name",
+		fmt.Sprintf("organization name must be at most %d characters", maxLength),
+		fmt.Sprintf("組織名は%d文字以下で入力してください", maxLength),
+	)
+}
+
+// ErrOrganizationSlugInvalid はスラッグの形式が不正なエラー
+func ErrOrganizationSlugInvalid(slug string) *ValidationError {
+	return NewValidationError(
+		"slug",
+		fmt.Sprintf("invalid organization slug: %q", slug),
+		"スラッグは小文字の英数字とハイフンで3〜63文字で入力してください",
+	)
+}
+
+// ErrMembershipNotFound は組織のメンバーが見つからないエラー
+func ErrMembershipNotFound(userID string) *NotFoundError {
+	return NewNotFoundError(
+		"membership",
+		fmt.Sprintf("membership not found: %s", userID),
+		"指定されたメンバーが見つかりません",
+	)
+}
+
+// ErrNotOrganizationMember は主体が指定された組織のメンバーでないエラー
+func ErrNotOrganizationMember(principal Principal, organizationID string) *ForbiddenError {
+	return NewForbiddenError(
+		"",
+		fmt.Sprintf("%s is not a member of organization %s", principal, organizationID),
+		"指定された組織にアクセスする権限がありません",
+	)
+}
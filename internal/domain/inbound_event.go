@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// 受信イベントの種類（プロバイダーごとの通知を正規化したもの）
+const (
+	// InboundEventTypeEmailHardBounced メールが恒久的な理由で配信できなかった（存在しないアドレスなど）
+	InboundEventTypeEmailHardBounced = "email.hard_bounced"
+	// InboundEventTypeEmailSoftBounced メールが一時的な理由で配信できなかった（メールボックスの容量超過など）
+	InboundEventTypeEmailSoftBounced = "email.soft_bounced"
+	// InboundEventTypeEmailComplained 受信者がメールを迷惑メールとして報告した
+	InboundEventTypeEmailComplained = "email.complained"
+)
+
+// InboundEventStatus 受信イベントの処理の状態
+type InboundEventStatus string
+
+const (
+	// InboundEventStatusPending 処理待ち（受信した直後・再処理を指示した後）
+	InboundEventStatusPending InboundEventStatus = "pending"
+	// InboundEventStatusProcessed 処理済み
+	InboundEventStatusProcessed InboundEventStatus = "processed"
+	// InboundEventStatusIgnored 処理する対象がない種類のイベント
+	InboundEventStatusIgnored InboundEventStatus = "ignored"
+	// InboundEventStatusFailed 処理に失敗した（ジョブが再試行する）
+	InboundEventStatusFailed InboundEventStatus = "failed"
+)
+
+// InboundEventIDMaxLength プロバイダーのイベントIDの最大文字数
+const InboundEventIDMaxLength = 255
+
+// InboundEvent 外部のサービス（メール配信サービスなど）から受信したWebhookのイベント
+// 受信したままのペイロードを保存し、ワーカーのジョブが種類ごとの処理を実行する（管理者が再処理を指示できる）
+type InboundEvent struct {
+	ID string
+	// OrganizationID イベントを記録した組織のID（プロバイダーは組織を区別しないため既定の組織に記録する）
+	OrganizationID string
+	// Provider イベントを送信したプロバイダーの名前（受信したURLのパス）
+	Provider string
+	// ProviderEventID プロバイダーが割り当てたイベントのID（同じプロバイダーの同じIDは重複として無視する）
+	ProviderEventID string
+	EventType       string
+	// Subject イベントの対象（バウンスしたメールアドレスなど。ない場合は空）
+	Subject string
+	// Payload 受信したイベントのJSON（プロバイダーの形式のまま）
+	Payload json.RawMessage
+	Status  InboundEventStatus
+	// LastError 最後の処理に失敗した理由（成功した場合は空）
+	LastError   string
+	ReceivedAt  time.Time
+	ProcessedAt *time.Time
+}
+
+// NewInboundEvent 受信したイベントを作成
+func NewInboundEvent(provider, providerEventID, eventType, subject string, payload json.RawMessage) (*InboundEvent, error) {
+	providerEventID = strings.TrimSpace(providerEventID)
+	if providerEventID == "" || len(providerEventID) > InboundEventIDMaxLength {
+		return nil, ErrInboundEventIDInvalid()
+	}
+	eventType = strings.TrimSpace(eventType)
+	if eventType == "" {
+		return nil, ErrInboundEventTypeRequired()
+	}
+
+	now := time.Now()
+	return &InboundEvent{
+		ID:              ulid.MustNew(ulid.Timestamp(now), rand.Reader).String(),
+		Provider:        provider,
+		ProviderEventID: providerEventID,
+		EventType:       eventType,
+		Subject:         strings.TrimSpace(subject),
+		Payload:         payload,
+		Status:          InboundEventStatusPending,
+		ReceivedAt:      now,
+	}, nil
+}
+
+// Processable 処理を実行するかどうか（処理済み・対象外のイベントは再処理を指示されるまで処理しない）
+func (e *InboundEvent) Processable() bool {
+	return e.Status == InboundEventStatusPending || e.Status == InboundEventStatusFailed
+}
+
+// MarkProcessed 処理済みにする
+func (e *InboundEvent) MarkProcessed(now time.Time) {
+	e.Status = InboundEventStatusProcessed
+	e.LastError = ""
+	e.ProcessedAt = &now
+}
+
+// MarkIgnored 処理する対象がないイベントとして記録する
+func (e *InboundEvent) MarkIgnored(now time.Time) {
+	e.Status = InboundEventStatusIgnored
+	e.LastError = ""
+	e.ProcessedAt = &now
+}
+
+// MarkFailed 処理の失敗を記録する
+func (e *InboundEvent) MarkFailed(reason string) {
+	e.Status = InboundEventStatusFailed
+	e.LastError = reason
+}
+
+// Replay 再処理を指示する（処理済みのイベントも処理待ちに戻す）
+func (e *InboundEvent) Replay() {
+	e.Status = InboundEventStatusPending
+	e.LastError = ""
+	e.ProcessedAt = nil
+}
+
+// InboundEventFilter 受信イベントの絞り込み条件（ゼロ値の項目は条件に含めない）
+type InboundEventFilter struct {
+	Provider string
+	Status   InboundEventStatus
+}
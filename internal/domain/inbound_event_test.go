@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewInboundEvent(t *testing.T) {
+	tests := []struct {
+		name            string
+		providerEventID string
+		eventType       string
+		wantErr         bool
+	}{
+		{name: "valid event", providerEventID: " evt_1 ", eventType: InboundEventTypeEmailHardBounced},
+		{name: "empty event id", providerEventID: " ", eventType: InboundEventTypeEmailHardBounced, wantErr: true},
+		{name: "too long event id", providerEventID: strings.Repeat("a", InboundEventIDMaxLength+1), eventType: InboundEventTypeEmailHardBounced, wantErr: true},
+		{name: "empty event type", providerEventID: "evt_1", eventType: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, err := NewInboundEvent("email", tt.providerEventID, tt.eventType, " alice@example.com ", []byte(`{}`))
+			if tt.wantErr {
+				var validationErr *ValidationError
+				if !errors.As(err, &validationErr) {
+					t.Fatalf("expected ValidationError, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if event.ID == "" || event.ProviderEventID != "evt_1" || event.Subject != "alice@example.com" {
+				t.Errorf("unexpected event: %+v", event)
+			}
+			if event.Status != InboundEventStatusPending || !event.Processable() {
+				t.Errorf("expected a new event to be pending, got %s", event.Status)
+			}
+		})
+	}
+}
+
+func TestInboundEvent_Lifecycle(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	event, err := NewInboundEvent("email", "evt_1", InboundEventTypeEmailHardBounced, "alice@example.com", []byte(`{}`))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	event.MarkFailed("connection refused")
+	if event.Status != InboundEventStatusFailed || event.LastError != "connection refused" || !event.Processable() {
+		t.Errorf("expected a failed event to be retried, got %+v", event)
+	}
+
+	event.MarkProcessed(now)
+	if event.Status != InboundEventStatusProcessed || event.LastError != "" || event.ProcessedAt == nil || event.Processable() {
+		t.Errorf("expected a processed event not to be processed again, got %+v", event)
+	}
+
+	event.Replay()
+	if event.Status != InboundEventStatusPending || event.ProcessedAt != nil || !event.Processable() {
+		t.Errorf("expected a replayed event to be pending, got %+v", event)
+	}
+
+	event.MarkIgnored(now)
+	if event.Status != InboundEventStatusIgnored || event.ProcessedAt == nil || event.Processable() {
+		t.Errorf("expected an ignored event not to be processed again, got %+v", event)
+	}
+}
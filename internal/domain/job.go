@@ -21,18 +21,20 @@ const (
 
 // Job ジョブのドメインモデル
 type Job struct {
-	ID          string
-	JobType     string
-	Payload     json.RawMessage
-	Status      JobStatus
-	Attempts    int
-	MaxAttempts int
-	LastError   string
-	ScheduledAt time.Time
-	StartedAt   *time.Time
-	CompletedAt *time.Time
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	ID      string
+	JobType string
+	// OrganizationID ジョブを登録した組織のID（ワーカーはこの組織をテナントとして処理する）
+	OrganizationID string
+	Payload        json.RawMessage
+	Status         JobStatus
+	Attempts       int
+	MaxAttempts    int
+	LastError      string
+	ScheduledAt    time.Time
+	StartedAt      *time.Time
+	CompletedAt    *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
 }
 
 // NewJob 新しいジョブを作成
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// LoginLockoutPolicy ログインの連続失敗によるアカウントロックの設定
+type LoginLockoutPolicy struct {
+	// MaxFailures ロックするまでに許容する連続失敗回数
+	MaxFailures int
+	// Lockout ロックする時間（最後の失敗からこの時間が経過すると、失敗回数を数え直す）
+	Lockout time.Duration
+}
+
+// LoginFailures メールアドレスごとのログインの連続失敗
+// 登録済みかどうかを推測されないよう、存在しないメールアドレスの失敗も同じように数える
+type LoginFailures struct {
+	OrganizationID string
+	// Email 大文字小文字を区別しないよう小文字にしたメールアドレス
+	Email        string
+	Count        int
+	LastFailedAt time.Time
+}
+
+// NewLoginFailures 失敗のないメールアドレスのLoginFailuresを作成
+func NewLoginFailures(organizationID, email string) *LoginFailures {
+	return &LoginFailures{OrganizationID: organizationID, Email: LoginFailureEmail(email)}
+}
+
+// LoginFailureEmail 失敗を数えるメールアドレス（正規化し、ローカル部も小文字にする）
+func LoginFailureEmail(email string) string {
+	return strings.ToLower(NormalizeEmail(email))
+}
+
+// RetryAfter ロックされている場合に、再びログインを試行できるようになるまでの時間を返す
+func (f *LoginFailures) RetryAfter(policy LoginLockoutPolicy, now time.Time) (time.Duration, bool) {
+	if f.Count < policy.MaxFailures {
+		return 0, false
+	}
+	unlockAt := f.LastFailedAt.Add(policy.Lockout)
+	if !now.Before(unlockAt) {
+		return 0, false
+	}
+	return unlockAt.Sub(now), true
+}
+
+// Record 失敗を1回記録する（最後の失敗からロックの時間が経過している場合は1回目として数え直す）
+func (f *LoginFailures) Record(policy LoginLockoutPolicy, now time.Time) {
+	if now.Sub(f.LastFailedAt) >= policy.Lockout {
+		f.Count = 0
+	}
+	f.Count++
+	f.LastFailedAt = now
+}
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLoginFailures(t *testing.T) {
+	policy := LoginLockoutPolicy{MaxFailures: 3, Lockout: 15 * time.Minute}
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	f := NewLoginFailures(DefaultOrganizationID, " John@Example.COM ")
+
+	if f.Email != "john@example.com" {
+		t.Errorf("expected lower-cased email, got %q", f.Email)
+	}
+
+	for i := range 3 {
+		if _, locked := f.RetryAfter(policy, now); locked {
+			t.Fatalf("failure %d: expected not to be locked", i+1)
+		}
+		f.Record(policy, now)
+	}
+
+	// 上限に達した後は最後の失敗からロックの時間だけ拒否する
+	now = now.Add(5 * time.Minute)
+	retryAfter, locked := f.RetryAfter(policy, now)
+	if !locked || retryAfter != 10*time.Minute {
+		t.Errorf("expected to be locked for 10m, got %s (locked=%v)", retryAfter, locked)
+	}
+
+	now = now.Add(10 * time.Minute)
+	if _, locked := f.RetryAfter(policy, now); locked {
+		t.Error("expected lock to expire")
+	}
+
+	// ロックが解除された後の失敗は1回目として数え直す
+	f.Record(policy, now)
+	if f.Count != 1 {
+		t.Errorf("expected failures to restart at 1, got %d", f.Count)
+	}
+}
+
+func TestLoginFailures_Record_RestartsAfterLockout(t *testing.T) {
+	policy := LoginLockoutPolicy{MaxFailures: 3, Lockout: 15 * time.Minute}
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	f := NewLoginFailures(DefaultOrganizationID, "john@example.com")
+
+	f.Record(policy, now)
+	f.Record(policy, now.Add(time.Minute))
+	// 間隔の空いた失敗は連続した失敗として数えない
+	f.Record(policy, now.Add(20*time.Minute))
+
+	if f.Count != 1 {
+		t.Errorf("expected failures to restart at 1, got %d", f.Count)
+	}
+	if _, locked := f.RetryAfter(policy, now.Add(20*time.Minute)); locked {
+		t.Error("expected not to be locked")
+	}
+}
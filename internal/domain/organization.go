@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"crypto/rand"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// DefaultOrganizationID 既定の組織のID（サーバーの起動時に作成される）
+// マルチテナント化以前のデータと、組織を指定しないリクエストはこの組織に属する
+const DefaultOrganizationID = "00000000000000000000000000"
+
+// OrganizationNameMaxLength 組織名の最大文字数
+const OrganizationNameMaxLength = 100
+
+// organizationSlugPattern 組織のスラッグの形式（小文字英数字とハイフン、3〜63文字）
+var organizationSlugPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$`)
+
+// Organization 組織（テナント）のドメインモデル
+type Organization struct {
+	ID   string
+	Name string
+	// Slug URLなどで組織を識別する一意の名前
+	Slug      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewOrganization 組織を作成
+func NewOrganization(name, slug string) (*Organization, error) {
+	name = strings.TrimSpace(name)
+	slug = strings.ToLower(strings.TrimSpace(slug))
+	if name == "" {
+		return nil, ErrOrganizationNameRequired()
+	}
+	if utf8.RuneCountInString(name) > OrganizationNameMaxLength {
+		return nil, ErrOrganizationNameTooLong(OrganizationNameMaxLength)
+	}
+	if !organizationSlugPattern.MatchString(slug) {
+		return nil, ErrOrganizationSlugInvalid(slug)
+	}
+
+	now := time.Now()
+	return &Organization{
+		ID:        ulid.MustNew(ulid.Timestamp(now), rand.Reader).String(),
+		Name:      name,
+		Slug:      slug,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// NewDefaultOrganization 既定の組織を作成
+func NewDefaultOrganization() *Organization {
+	now := time.Now()
+	return &Organization{
+		ID:        DefaultOrganizationID,
+		Name:      "Default",
+		Slug:      "default",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// Membership 組織のメンバーシップ（組織内でユーザーに付与されるロール）
+type Membership struct {
+	OrganizationID string
+	// UserID ユーザーID（外部のIDプロバイダーで認証される場合は JWT の sub）
+	UserID    string
+	Roles     []Role
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewMembership メンバーシップを作成（未知のロールは無視する）
+func NewMembership(organizationID, userID string, roles []Role) *Membership {
+	now := time.Now()
+	return &Membership{
+		OrganizationID: organizationID,
+		UserID:         userID,
+		Roles:          normalizeRoles(roles),
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+}
+
+// SetRoles ロールを置き換える（未知のロールは無視する）
+func (m *Membership) SetRoles(roles []Role) {
+	m.Roles = normalizeRoles(roles)
+	m.UpdatedAt = time.Now()
+}
+
+// normalizeRoles 未知のロールと重複を除く
+func normalizeRoles(roles []Role) []Role {
+	return ParseRoles(RoleNames(roles))
+}
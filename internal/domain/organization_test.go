@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewOrganization(t *testing.T) {
+	tests := []struct {
+		name     string
+		orgName  string
+		slug     string
+		wantSlug string
+		wantErr  bool
+	}{
+		{name: "valid", orgName: "Acme Inc.", slug: "acme", wantSlug: "acme"},
+		{name: "slug is lowercased", orgName: "Acme Inc.", slug: " Acme-Japan ", wantSlug: "acme-japan"},
+		{name: "empty name", orgName: "  ", slug: "acme", wantErr: true},
+		{name: "name too long", orgName: strings.Repeat("a", OrganizationNameMaxLength+1), slug: "acme", wantErr: true},
+		{name: "slug too short", orgName: "Acme Inc.", slug: "ac", wantErr: true},
+		{name: "slug with leading hyphen", orgName: "Acme Inc.", slug: "-acme", wantErr: true},
+		{name: "slug with invalid character", orgName: "Acme Inc.", slug: "acme_inc", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			organization, err := NewOrganization(tt.orgName, tt.slug)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if organization.Slug != tt.wantSlug {
+				t.Errorf("expected slug %q, got %q", tt.wantSlug, organization.Slug)
+			}
+			if len(organization.ID) != 26 || organization.ID == DefaultOrganizationID {
+				t.Errorf("expected a new ULID, got %q", organization.ID)
+			}
+		})
+	}
+}
+
+func TestNewMembership(t *testing.T) {
+	membership := NewMembership(DefaultOrganizationID, "01ARZ3NDEKTSV4RRFFQ69G5FAV", []Role{RoleViewer, "owner", RoleViewer, RoleAuditor})
+
+	if got := strings.Join(RoleNames(membership.Roles), ","); got != "viewer,auditor" {
+		t.Errorf("expected unknown and duplicate roles to be dropped, got %s", got)
+	}
+
+	membership.SetRoles(nil)
+	if len(membership.Roles) != 0 {
+		t.Errorf("expected no roles, got %v", membership.Roles)
+	}
+}
@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	// PasswordMinLength パスワードの最小文字数
+	PasswordMinLength = 8
+	// PasswordMaxLength パスワードの最大文字数（ハッシュ計算の負荷を抑えるため上限を設ける）
+	PasswordMaxLength = 128
+)
+
+// argon2id のパラメータ（OWASP Password Storage Cheat Sheet の推奨値）
+const (
+	argon2Memory      = 19 * 1024 // KiB
+	argon2Iterations  = 2
+	argon2Parallelism = 1
+	argon2SaltLength  = 16
+	argon2KeyLength   = 32
+)
+
+// HashPassword パスワードを検証し、argon2id のハッシュを PHC 文字列形式で返す
+// 形式: $argon2id$v=19$m=19456,t=2,p=1$<salt>$<hash>（salt・hash はパディングなしの Base64）
+func HashPassword(password string) (string, error) {
+	if err := validatePassword(password); err != nil {
+		return "", err
+	}
+
+	salt := make([]byte, argon2SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key := argon2.IDKey([]byte(password), salt, argon2Iterations, argon2Memory, argon2Parallelism, argon2KeyLength)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, argon2Memory, argon2Iterations, argon2Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// VerifyPassword パスワードがハッシュと一致するかどうか（定数時間で比較する）
+// ハッシュに記録されたパラメータで計算するため、パラメータを変更しても既存のハッシュを検証できる
+func VerifyPassword(encodedHash, password string) bool {
+	params, salt, key, ok := decodePasswordHash(encodedHash)
+	if !ok || utf8.RuneCountInString(password) > PasswordMaxLength {
+		return false
+	}
+	actual := argon2.IDKey([]byte(password), salt, params.iterations, params.memory, params.parallelism, uint32(len(key)))
+	return subtle.ConstantTimeCompare(actual, key) == 1
+}
+
+var (
+	dummyPasswordHash     string
+	dummyPasswordHashOnce sync.Once
+)
+
+// VerifyDummyPassword 存在しないユーザーのログインでも同じだけハッシュ計算を行う
+// 応答時間の差からメールアドレスが登録済みかどうかを推測されないようにするため
+func VerifyDummyPassword(password string) {
+	dummyPasswordHashOnce.Do(func() {
+		dummyPasswordHash, _ = HashPassword("dummy-password-for-timing")
+	})
+	VerifyPassword(dummyPasswordHash, password)
+}
+
+// validatePassword パスワードの長さを検証
+func validatePassword(password string) error {
+	length := utf8.RuneCountInString(password)
+	if length < PasswordMinLength {
+		return ErrPasswordTooShort(PasswordMinLength)
+	}
+	if length > PasswordMaxLength {
+		return ErrPasswordTooLong(PasswordMaxLength)
+	}
+	return nil
+}
+
+// argon2Params ハッシュに記録された argon2id のパラメータ
+type argon2Params struct {
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+}
+
+// decodePasswordHash PHC 文字列形式のハッシュを分解する（形式が不正な場合は ok=false）
+func decodePasswordHash(encodedHash string) (params argon2Params, salt, key []byte, ok bool) {
+	parts := strings.Split(encodedHash, "$")
+	if len(parts) != 6 || parts[0] != "" || parts[1] != "argon2id" {
+		return params, nil, nil, false
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return params, nil, nil, false
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.memory, &params.iterations, &params.parallelism); err != nil {
+		return params, nil, nil, false
+	}
+	if params.memory == 0 || params.iterations == 0 || params.parallelism == 0 {
+		return params, nil, nil, false
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil || len(salt) == 0 {
+		return params, nil, nil, false
+	}
+	key, err = base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return params, nil, nil, false
+	}
+	return params, salt, key, true
+}
@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestHashPassword(t *testing.T) {
+	tests := []struct {
+		name     string
+		password string
+		wantErr  bool
+	}{
+		{name: "valid", password: "correct horse battery staple"},
+		{name: "minimum length", password: strings.Repeat("a", PasswordMinLength)},
+		{name: "multibyte characters", password: "パスワードは八文字"},
+		{name: "too short", password: strings.Repeat("a", PasswordMinLength-1), wantErr: true},
+		{name: "too long", password: strings.Repeat("a", PasswordMaxLength+1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hash, err := HashPassword(tt.password)
+			if tt.wantErr {
+				var validationErr *ValidationError
+				if !errors.As(err, &validationErr) {
+					t.Fatalf("expected ValidationError, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !strings.HasPrefix(hash, "$argon2id$v=19$m=19456,t=2,p=1$") {
+				t.Errorf("unexpected hash format %q", hash)
+			}
+			if !VerifyPassword(hash, tt.password) {
+				t.Error("expected the password to match its hash")
+			}
+			if VerifyPassword(hash, tt.password+"x") {
+				t.Error("expected a different password not to match")
+			}
+		})
+	}
+}
+
+func TestHashPassword_Salted(t *testing.T) {
+	first, err := HashPassword("correct horse battery staple")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second, err := HashPassword("correct horse battery staple")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if first == second {
+		t.Error("expected hashes of the same password to differ")
+	}
+}
+
+func TestVerifyPassword_MalformedHash(t *testing.T) {
+	valid, err := HashPassword("correct horse battery staple")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	parts := strings.Split(valid, "$")
+
+	tests := []struct {
+		name string
+		hash string
+	}{
+		{name: "empty", hash: ""},
+		{name: "other algorithm", hash: strings.Replace(valid, "argon2id", "argon2i", 1)},
+		{name: "unknown version", hash: strings.Replace(valid, "v=19", "v=16", 1)},
+		{name: "zero iterations", hash: strings.Replace(valid, "t=2", "t=0", 1)},
+		{name: "invalid salt", hash: strings.Join([]string{"", parts[1], parts[2], parts[3], "!!", parts[5]}, "$")},
+		{name: "missing hash", hash: strings.Join(parts[:5], "$")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if VerifyPassword(tt.hash, "correct horse battery staple") {
+				t.Errorf("expected malformed hash %q not to match", tt.hash)
+			}
+		})
+	}
+}
+
+func TestUser_Password(t *testing.T) {
+	user, err := NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if user.HasPassword() || user.VerifyPassword("") {
+		t.Fatal("expected a new user not to have a password")
+	}
+
+	if err := user.SetPassword("short"); err == nil {
+		t.Fatal("expected error for short password")
+	}
+	if user.HasPassword() {
+		t.Fatal("expected the password to stay unset after a validation error")
+	}
+
+	if err := user.SetPassword("correct horse battery staple"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(user.PasswordHash, "correct horse") {
+		t.Error("expected the password not to be stored in plain text")
+	}
+	if !user.VerifyPassword("correct horse battery staple") {
+		t.Error("expected the password to match")
+	}
+	if user.VerifyPassword("wrong password") {
+		t.Error("expected a wrong password not to match")
+	}
+}
@@ -0,0 +1,96 @@
+package domain
+
+import "context"
+
+// PrincipalType 操作を行った主体の種類
+type PrincipalType string
+
+const (
+	// PrincipalTypeAnonymous 認証されていない呼び出し
+	PrincipalTypeAnonymous PrincipalType = "anonymous"
+	// PrincipalTypeUser 認証されたユーザー
+	PrincipalTypeUser PrincipalType = "user"
+	// PrincipalTypeSystem ワーカーなどシステム内部の処理
+	PrincipalTypeSystem PrincipalType = "system"
+	// PrincipalTypeAPIKey APIキーで認証されたサービス
+	PrincipalTypeAPIKey PrincipalType = "api_key"
+)
+
+// Principal 操作を行った主体
+type Principal struct {
+	Type PrincipalType
+	ID   string
+	// Roles 主体に付与されたロール（JWT の roles クレームなど）
+	Roles []Role
+	// Scopes 主体に直接付与された権限（APIキーのスコープ）
+	Scopes []string
+	// SessionID ログインセッションで認証された場合のセッションID
+	SessionID string
+	// OrganizationID 認証情報が紐づく組織のID（セッション・APIキー・JWT の org_id クレーム。空の場合は未指定）
+	OrganizationID string
+}
+
+// AnonymousPrincipal 認証されていない呼び出しの主体
+var AnonymousPrincipal = Principal{Type: PrincipalTypeAnonymous}
+
+// NewUserPrincipal 認証されたユーザーの主体を作成
+func NewUserPrincipal(userID string) Principal {
+	return Principal{Type: PrincipalTypeUser, ID: userID}
+}
+
+// NewSystemPrincipal システム内部の処理の主体を作成（name は処理の名前）
+func NewSystemPrincipal(name string) Principal {
+	return Principal{Type: PrincipalTypeSystem, ID: name}
+}
+
+// NewAPIKeyPrincipal APIキーで認証されたサービスの主体を作成
+func NewAPIKeyPrincipal(apiKeyID string) Principal {
+	return Principal{Type: PrincipalTypeAPIKey, ID: apiKeyID}
+}
+
+// WithRoles ロールを付与した主体を返す
+func (p Principal) WithRoles(roles ...Role) Principal {
+	p.Roles = roles
+	return p
+}
+
+// WithScopes スコープを付与した主体を返す
+func (p Principal) WithScopes(scopes ...string) Principal {
+	p.Scopes = scopes
+	return p
+}
+
+// WithSession ログインセッションで認証された主体を返す
+func (p Principal) WithSession(sessionID string) Principal {
+	p.SessionID = sessionID
+	return p
+}
+
+// WithOrganization 認証情報が紐づく組織を設定した主体を返す
+func (p Principal) WithOrganization(organizationID string) Principal {
+	p.OrganizationID = organizationID
+	return p
+}
+
+// String 監査ログに記録する形式（"user:01ARZ..." や "anonymous"）
+func (p Principal) String() string {
+	if p.ID == "" {
+		return string(p.Type)
+	}
+	return string(p.Type) + ":" + p.ID
+}
+
+type principalContextKey struct{}
+
+// WithPrincipal コンテキストに操作の主体を設定
+func WithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+// PrincipalFromContext コンテキストから操作の主体を取得（未設定の場合は AnonymousPrincipal）
+func PrincipalFromContext(ctx context.Context) Principal {
+	if principal, ok := ctx.Value(principalContextKey{}).(Principal); ok {
+		return principal
+	}
+	return AnonymousPrincipal
+}
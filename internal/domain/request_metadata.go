@@ -0,0 +1,26 @@
+package domain
+
+import "context"
+
+// RequestMetadata 操作を行ったリクエストの付帯情報（監査イベントに記録する）
+type RequestMetadata struct {
+	// RemoteAddr クライアントのIPアドレス
+	RemoteAddr string `json:"remoteAddr,omitempty"`
+	// UserAgent クライアントの User-Agent
+	UserAgent string `json:"userAgent,omitempty"`
+}
+
+type requestMetadataContextKey struct{}
+
+// WithRequestMetadata コンテキストにリクエストの付帯情報を設定
+func WithRequestMetadata(ctx context.Context, metadata RequestMetadata) context.Context {
+	return context.WithValue(ctx, requestMetadataContextKey{}, metadata)
+}
+
+// RequestMetadataFromContext コンテキストからリクエストの付帯情報を取得（未設定の場合はゼロ値）
+func RequestMetadataFromContext(ctx context.Context) RequestMetadata {
+	if metadata, ok := ctx.Value(requestMetadataContextKey{}).(RequestMetadata); ok {
+		return metadata
+	}
+	return RequestMetadata{}
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// sessionTokenPrefix セッショントークンの先頭に付ける識別子
+const sessionTokenPrefix = "cqs_"
+
+// Session ログインによって発行されるサーバーサイドセッションのドメインモデル
+// トークン（Cookie の値）はハッシュのみを保持し、平文はログイン時に一度だけ返す
+type Session struct {
+	ID     string
+	UserID string
+	// OrganizationID ログインした組織のID（セッションで認証されたリクエストはこの組織に限定される）
+	OrganizationID string
+	// TokenHash トークンのSHA-256（16進文字列）
+	TokenHash string
+	// RemoteAddr・UserAgent ログインしたクライアントの情報（セッション一覧での識別用）
+	RemoteAddr string
+	UserAgent  string
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	ExpiresAt  time.Time
+	RevokedAt  *time.Time
+}
+
+// NewSession セッションを作成し、セッションと平文のトークンを返す
+func NewSession(userID string, metadata RequestMetadata, ttl time.Duration) (*Session, string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", err
+	}
+	token := sessionTokenPrefix + base64.RawURLEncoding.EncodeToString(secret)
+
+	now := time.Now()
+	return &Session{
+		ID:         ulid.MustNew(ulid.Timestamp(now), rand.Reader).String(),
+		UserID:     userID,
+		TokenHash:  HashSessionToken(token),
+		RemoteAddr: metadata.RemoteAddr,
+		UserAgent:  metadata.UserAgent,
+		CreatedAt:  now,
+		LastSeenAt: now,
+		ExpiresAt:  now.Add(ttl),
+	}, token, nil
+}
+
+// HashSessionToken トークンのSHA-256を16進文字列で返す（トークンは十分なエントロピーを持つためソルトは不要）
+func HashSessionToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// Active 指定時刻に利用できるかどうか（失効・期限切れでない）
+func (s *Session) Active(now time.Time) bool {
+	return s.RevokedAt == nil && now.Before(s.ExpiresAt)
+}
+
+// Revoke セッションを失効させる（失効済みの場合は何もしない）
+func (s *Session) Revoke(now time.Time) {
+	if s.RevokedAt == nil {
+		s.RevokedAt = &now
+	}
+}
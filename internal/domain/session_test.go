@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewSession(t *testing.T) {
+	metadata := RequestMetadata{RemoteAddr: "192.0.2.1", UserAgent: "test-agent"}
+	session, token, err := NewSession("01ARZ3NDEKTSV4RRFFQ69G5FAV", metadata, time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.HasPrefix(token, sessionTokenPrefix) {
+		t.Errorf("expected token to start with %s, got %s", sessionTokenPrefix, token)
+	}
+	if session.TokenHash != HashSessionToken(token) {
+		t.Error("expected the token hash to match the token")
+	}
+	if strings.Contains(session.TokenHash, token) {
+		t.Error("expected the token not to be stored in plain text")
+	}
+	if session.RemoteAddr != metadata.RemoteAddr || session.UserAgent != metadata.UserAgent {
+		t.Errorf("unexpected client info %q %q", session.RemoteAddr, session.UserAgent)
+	}
+	if got := session.ExpiresAt.Sub(session.CreatedAt); got != time.Hour {
+		t.Errorf("expected ttl 1h, got %s", got)
+	}
+
+	_, other, err := NewSession("01ARZ3NDEKTSV4RRFFQ69G5FAV", metadata, time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token == other {
+		t.Error("expected tokens to be unique")
+	}
+}
+
+func TestSession_Active(t *testing.T) {
+	session, _, err := NewSession("01ARZ3NDEKTSV4RRFFQ69G5FAV", RequestMetadata{}, time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	now := session.CreatedAt
+
+	if !session.Active(now) {
+		t.Error("expected a new session to be active")
+	}
+	if session.Active(session.ExpiresAt) {
+		t.Error("expected the session to expire at ExpiresAt")
+	}
+
+	session.Revoke(now)
+	revokedAt := *session.RevokedAt
+	session.Revoke(now.Add(time.Minute))
+	if !session.RevokedAt.Equal(revokedAt) {
+		t.Error("expected Revoke to keep the first revocation time")
+	}
+	if session.Active(now) {
+		t.Error("expected a revoked session not to be active")
+	}
+}
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"context"
+	"errors"
+)
+
+// errTenantNotSet コンテキストにテナントが設定されていない（テナントを特定できない処理からのデータアクセス）
+var errTenantNotSet = errors.New("tenant is not set in context")
+
+type tenantContextKey struct{}
+
+// WithTenant コンテキストにテナント（組織ID）を設定
+// command・queryservice のすべてのクエリはこのテナントのデータに絞り込まれる
+func WithTenant(ctx context.Context, organizationID string) context.Context {
+	return context.WithValue(ctx, tenantContextKey{}, organizationID)
+}
+
+// TenantFromContext コンテキストからテナント（組織ID）を取得
+func TenantFromContext(ctx context.Context) (string, bool) {
+	organizationID, ok := ctx.Value(tenantContextKey{}).(string)
+	return organizationID, ok && organizationID != ""
+}
+
+// RequireTenant コンテキストからテナントを取得する（未設定の場合はエラー）
+// 設定漏れで全テナントのデータにアクセスしないよう、データアクセスの前に必ず呼び出す
+func RequireTenant(ctx context.Context) (string, error) {
+	organizationID, ok := TenantFromContext(ctx)
+	if !ok {
+		return "", errTenantNotSet
+	}
+	return organizationID, nil
+}
@@ -0,0 +1,23 @@
+package domain
+
+import (
+	"context"
+	"testing"
+)
+
+func TestRequireTenant(t *testing.T) {
+	if _, err := RequireTenant(context.Background()); err == nil {
+		t.Error("expected error without tenant, got nil")
+	}
+	if _, err := RequireTenant(WithTenant(context.Background(), "")); err == nil {
+		t.Error("expected error with empty tenant, got nil")
+	}
+
+	organizationID, err := RequireTenant(WithTenant(context.Background(), DefaultOrganizationID))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if organizationID != DefaultOrganizationID {
+		t.Errorf("expected %s, got %s", DefaultOrganizationID, organizationID)
+	}
+}
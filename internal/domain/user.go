@@ -2,47 +2,290 @@ package domain
 
 import (
 	"crypto/rand"
+	"regexp"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/oklog/ulid/v2"
 )
 
+// UserNameMaxLength ユーザー名の最大文字数
+const UserNameMaxLength = 100
+
+// emailPattern メールアドレスの形式（OpenAPIバリデーションと同じ規則）
+var emailPattern = regexp.MustCompile(`^[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}$`)
+
 // User ドメインモデル
 type User struct {
-	ID        string
-	Name      string
-	Email     string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID string
+	// OrganizationID 所属する組織（テナント）のID（保存時はコンテキストのテナントが使われる）
+	OrganizationID string
+	Name           string
+	Email          string
+	// EmailVerifiedAt 現在のメールアドレスを確認した日時（未確認の場合は nil）
+	EmailVerifiedAt *time.Time
+	// EmailInvalidatedAt 現在のメールアドレスに配信できないと判定された日時（ハードバウンスなど。問題がない場合は nil）
+	EmailInvalidatedAt *time.Time
+	// PasswordHash argon2id のハッシュ（空文字列の場合はパスワードでログインできない）
+	PasswordHash string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	// Version イベントストアに保存済みの最後のイベントの連番（イベントソーシングを使わない場合・新規作成時は0）
+	Version int
+
+	// events 保存されていないドメインイベント（command.Save / command.Delete がアウトボックスに書き込む）
+	events []DomainEvent
+}
+
+// UserSummary ユーザー一覧の読み取りモデル（ユーザーとユーザーログの集計）
+type UserSummary struct {
+	User *User
+	// LogCount ユーザーログの件数
+	LogCount int
+	// LastActivityAt 最新のユーザーログの日時（ログがない場合は nil）
+	LastActivityAt *time.Time
 }
 
 // NewUser ユーザーを作成
 func NewUser(name, email string) (*User, error) {
+	email = NormalizeEmail(email)
 	if name == "" {
 		return nil, ErrNameRequired()
 	}
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
 	if email == "" {
 		return nil, ErrEmailRequired()
 	}
+	if err := validateEmail(email); err != nil {
+		return nil, err
+	}
 
 	now := time.Now()
-	return &User{
-		ID:        ulid.MustNew(ulid.Timestamp(now), rand.Reader).String(),
+	user := &User{}
+	user.raise(UserCreated{
+		eventTime: eventTime{Time: now},
+		UserID:    ulid.MustNew(ulid.Timestamp(now), rand.Reader).String(),
 		Name:      name,
 		Email:     email,
-		CreatedAt: now,
-		UpdatedAt: now,
-	}, nil
+	})
+	return user, nil
+}
+
+// ReplayUser スナップショット（nil の場合は空の状態）にイベントストアのイベントを順に適用してユーザーを再構築する
+// Version は適用したイベントの数だけ進む
+func ReplayUser(snapshot *User, events []DomainEvent) *User {
+	user := &User{}
+	if snapshot != nil {
+		restored := *snapshot
+		restored.events = nil
+		user = &restored
+	}
+	for _, event := range events {
+		user.apply(event)
+		user.Version++
+	}
+	return user
 }
 
 // Update ユーザー情報を更新
 func (u *User) Update(name, email string) error {
 	if name != "" {
-		u.Name = name
+		if err := validateName(name); err != nil {
+			return err
+		}
 	}
 	if email != "" {
-		u.Email = email
+		email = NormalizeEmail(email)
+		if err := validateEmail(email); err != nil {
+			return err
+		}
+	}
+
+	now := time.Now()
+	if name != "" && name != u.Name {
+		u.raise(UserRenamed{
+			eventTime: eventTime{Time: now},
+			UserID:    u.ID,
+			Name:      name,
+		})
+	}
+	if email != "" && email != u.Email {
+		u.raise(UserEmailChanged{
+			eventTime: eventTime{Time: now},
+			UserID:    u.ID,
+			OldEmail:  u.Email,
+			NewEmail:  email,
+		})
+	}
+	u.UpdatedAt = now
+	return nil
+}
+
+// Delete ユーザーの削除を記録する（行の削除は command.Delete が行う）
+func (u *User) Delete() {
+	u.raise(UserDeleted{
+		eventTime: eventTime{Time: time.Now()},
+		UserID:    u.ID,
+		Email:     u.Email,
+	})
+}
+
+// PullEvents 保存されていないドメインイベントを返し、記録を消去する
+func (u *User) PullEvents() []DomainEvent {
+	events := u.events
+	u.events = nil
+	return events
+}
+
+// raise ドメインイベントを状態に適用し、保存するまで記録する
+func (u *User) raise(event DomainEvent) {
+	u.apply(event)
+	u.events = append(u.events, event)
+}
+
+// apply ドメインイベントを状態に適用する（イベントストアからの再構築でも使う）
+func (u *User) apply(event DomainEvent) {
+	switch e := event.(type) {
+	case UserCreated:
+		u.ID = e.UserID
+		u.Name = e.Name
+		u.Email = e.Email
+		u.CreatedAt = e.Time
+	case UserRenamed:
+		u.Name = e.Name
+	case UserEmailChanged:
+		// 別のアドレスに変更した場合は確認をやり直し、配信できないという判定も解除する
+		if !SameEmail(e.NewEmail, u.Email) {
+			u.EmailVerifiedAt = nil
+			u.EmailInvalidatedAt = nil
+		}
+		u.Email = e.NewEmail
+	case UserEmailVerified:
+		// 確認のメールを受け取れたため、配信できないという判定は解除する
+		verifiedAt := e.Time
+		u.EmailVerifiedAt = &verifiedAt
+		u.EmailInvalidatedAt = nil
+	case UserEmailInvalidated:
+		invalidatedAt := e.Time
+		u.EmailInvalidatedAt = &invalidatedAt
+	case UserPasswordChanged:
+		u.PasswordHash = e.PasswordHash
+	case UserDeleted:
+		// 削除は状態を変えない（イベントストアでは削除済みの集約として扱う）
+		return
+	}
+	u.UpdatedAt = event.OccurredAt()
+}
+
+// EmailVerified メールアドレスが確認済みかどうか
+func (u *User) EmailVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
+
+// VerifyEmail メールアドレスを確認済みにする（確認済みの場合は何もしない）
+func (u *User) VerifyEmail(now time.Time) {
+	if u.EmailVerifiedAt != nil {
+		return
+	}
+	u.raise(UserEmailVerified{
+		eventTime: eventTime{Time: now},
+		UserID:    u.ID,
+		Email:     u.Email,
+	})
+}
+
+// EmailInvalid メールアドレスに配信できないと判定されているかどうか
+func (u *User) EmailInvalid() bool {
+	return u.EmailInvalidatedAt != nil
+}
+
+// InvalidateEmail メールアドレスに配信できないと記録する（判定済みの場合は何もしない）
+func (u *User) InvalidateEmail(reason string, now time.Time) {
+	if u.EmailInvalidatedAt != nil {
+		return
+	}
+	u.raise(UserEmailInvalidated{
+		eventTime: eventTime{Time: now},
+		UserID:    u.ID,
+		Email:     u.Email,
+		Reason:    reason,
+	})
+}
+
+// SetPassword パスワードを設定（ハッシュのみを保持する）
+func (u *User) SetPassword(password string) error {
+	hash, err := HashPassword(password)
+	if err != nil {
+		return err
+	}
+	u.raise(UserPasswordChanged{
+		eventTime:    eventTime{Time: time.Now()},
+		UserID:       u.ID,
+		PasswordHash: hash,
+	})
+	return nil
+}
+
+// HasPassword パスワードが設定されているかどうか
+func (u *User) HasPassword() bool {
+	return u.PasswordHash != ""
+}
+
+// VerifyPassword パスワードが一致するかどうか（パスワードが未設定の場合は常に false）
+func (u *User) VerifyPassword(password string) bool {
+	if !u.HasPassword() {
+		VerifyDummyPassword(password)
+		return false
+	}
+	return VerifyPassword(u.PasswordHash, password)
+}
+
+// NormalizeEmail メールアドレスを正規化（前後の空白を除去し、ドメイン部を小文字にする）
+// ローカル部の大文字小文字は保持するが、一意性は組織ごとに大文字小文字を区別せずに判定する（users の (organization_id, lower(email)) 一意インデックス）
+func NormalizeEmail(email string) string {
+	email = strings.TrimSpace(email)
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return email
+	}
+	return email[:at] + strings.ToLower(email[at:])
+}
+
+// EmailNormalization 保存するメールアドレスの正規化の方法
+type EmailNormalization struct {
+	// LowercaseLocalPart を有効にすると、ローカル部も小文字にして保存する（無効の場合は NormalizeEmail と同じくローカル部の大文字小文字を保持する）
+	LowercaseLocalPart bool
+}
+
+// Normalize メールアドレスを正規化（NewUser と Update の前に適用する）
+func (n EmailNormalization) Normalize(email string) string {
+	email = NormalizeEmail(email)
+	if n.LowercaseLocalPart {
+		return strings.ToLower(email)
+	}
+	return email
+}
+
+// SameEmail 2つのメールアドレスが同一アドレスとして扱われるかどうか（大文字小文字を区別しない）
+func SameEmail(a, b string) bool {
+	return strings.EqualFold(NormalizeEmail(a), NormalizeEmail(b))
+}
+
+// validateName 名前の長さを検証
+func validateName(name string) error {
+	if utf8.RuneCountInString(name) > UserNameMaxLength {
+		return ErrNameTooLong(UserNameMaxLength)
+	}
+	return nil
+}
+
+// validateEmail メールアドレスの形式を検証
+func validateEmail(email string) error {
+	if !emailPattern.MatchString(email) {
+		return ErrEmailInvalid(email)
 	}
-	u.UpdatedAt = time.Now()
 	return nil
 }
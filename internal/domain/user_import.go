@@ -0,0 +1,269 @@
+package domain
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/rand"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// UserImportFormat インポートファイルの形式
+type UserImportFormat string
+
+const (
+	// UserImportFormatCSV ヘッダー行付きCSV（name, email 列）
+	UserImportFormatCSV UserImportFormat = "csv"
+	// UserImportFormatNDJSON 1行1ユーザーのJSON（{"name": ..., "email": ...}）
+	UserImportFormatNDJSON UserImportFormat = "ndjson"
+)
+
+// UserImportStatus インポートの状態
+type UserImportStatus string
+
+const (
+	UserImportStatusPending    UserImportStatus = "pending"
+	UserImportStatusProcessing UserImportStatus = "processing"
+	UserImportStatusCompleted  UserImportStatus = "completed"
+	UserImportStatusFailed     UserImportStatus = "failed"
+)
+
+// UserImportRowStatus インポート行ごとの処理結果
+type UserImportRowStatus string
+
+const (
+	// UserImportRowStatusCreated ユーザーを作成した
+	UserImportRowStatusCreated UserImportRowStatus = "created"
+	// UserImportRowStatusSkippedDuplicate メールアドレスが既に存在するためスキップした
+	UserImportRowStatusSkippedDuplicate UserImportRowStatus = "skipped_duplicate"
+	// UserImportRowStatusInvalid 入力が不正なため作成しなかった
+	UserImportRowStatusInvalid UserImportRowStatus = "invalid"
+)
+
+// UserImportMaxRows 1回のインポートで受け付ける最大行数
+const UserImportMaxRows = 10000
+
+// UserImport ユーザー一括インポートのドメインモデル
+type UserImport struct {
+	ID          string
+	Format      UserImportFormat
+	Status      UserImportStatus
+	Source      []byte
+	TotalRows   int
+	LastError   string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	CompletedAt *time.Time
+}
+
+// UserImportRecord インポートファイルから読み取った1行分の入力
+type UserImportRecord struct {
+	// Line ファイル内の行番号（1始まり、CSVはヘッダー行を含む）
+	Line  int
+	Name  string
+	Email string
+	// ParseError 行を読み取れなかった場合の理由
+	ParseError string
+}
+
+// UserImportRow インポート行ごとの処理結果
+type UserImportRow struct {
+	ImportID  string
+	Line      int
+	Status    UserImportRowStatus
+	Name      string
+	Email     string
+	UserID    string
+	Reason    string
+	CreatedAt time.Time
+}
+
+// UserImportSummary インポート結果の件数集計
+type UserImportSummary struct {
+	Created          int
+	SkippedDuplicate int
+	Invalid          int
+}
+
+// NewUserImport インポートを作成（入力ファイルの形式も検証する）
+func NewUserImport(format UserImportFormat, source []byte) (*UserImport, []UserImportRecord, error) {
+	records, err := ParseUserImportRecords(format, source)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	return &UserImport{
+		ID:        ulid.MustNew(ulid.Timestamp(now), rand.Reader).String(),
+		Format:    format,
+		Status:    UserImportStatusPending,
+		Source:    source,
+		TotalRows: len(records),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, records, nil
+}
+
+// NewUserImportRow 行の処理結果を作成
+func NewUserImportRow(importID string, record UserImportRecord, status UserImportRowStatus, userID, reason string) *UserImportRow {
+	return &UserImportRow{
+		ImportID:  importID,
+		Line:      record.Line,
+		Status:    status,
+		Name:      record.Name,
+		Email:     record.Email,
+		UserID:    userID,
+		Reason:    reason,
+		CreatedAt: time.Now(),
+	}
+}
+
+// ParseUserImportRecords インポートファイルを行ごとの入力に分解
+// ファイル全体を読み取れない場合のみエラーを返し、個々の行の不備は ParseError に記録する
+func ParseUserImportRecords(format UserImportFormat, source []byte) ([]UserImportRecord, error) {
+	var (
+		records []UserImportRecord
+		err     error
+	)
+	switch format {
+	case UserImportFormatCSV:
+		records, err = parseUserImportCSV(source)
+	case UserImportFormatNDJSON:
+		records, err = parseUserImportNDJSON(source)
+	default:
+		return nil, ErrUserImportFormatUnsupported(string(format))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, ErrUserImportEmpty()
+	}
+	if len(records) > UserImportMaxRows {
+		return nil, ErrUserImportTooManyRows(UserImportMaxRows)
+	}
+	return records, nil
+}
+
+// parseUserImportCSV ヘッダー行で name, email 列を特定してCSVを読み取る
+func parseUserImportCSV(source []byte) ([]UserImportRecord, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(source, []byte("\uFEFF"))))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, ErrUserImportEmpty()
+	}
+	if err != nil {
+		return nil, ErrUserImportMalformed(err.Error())
+	}
+
+	nameIdx, emailIdx := -1, -1
+	for i, column := range header {
+		switch strings.ToLower(strings.TrimSpace(column)) {
+		case "name":
+			nameIdx = i
+		case "email":
+			emailIdx = i
+		}
+	}
+	if nameIdx < 0 || emailIdx < 0 {
+		return nil, ErrUserImportMalformed("CSV header must contain name and email columns")
+	}
+
+	records := make([]UserImportRecord, 0)
+	for {
+		fields, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, ErrUserImportMalformed(err.Error())
+		}
+		line, _ := reader.FieldPos(0)
+
+		record := UserImportRecord{Line: line}
+		if nameIdx >= len(fields) || emailIdx >= len(fields) {
+			record.ParseError = fmt.Sprintf("expected at least %d columns, got %d", max(nameIdx, emailIdx)+1, len(fields))
+		} else {
+			record.Name = strings.TrimSpace(fields[nameIdx])
+			record.Email = strings.TrimSpace(fields[emailIdx])
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// parseUserImportNDJSON 1行1オブジェクトのJSONを読み取る（空行は無視する）
+func parseUserImportNDJSON(source []byte) ([]UserImportRecord, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(source))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	records := make([]UserImportRecord, 0)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+
+		var row struct {
+			Name  string `json:"name"`
+			Email string `json:"email"`
+		}
+		record := UserImportRecord{Line: line}
+		if err := json.Unmarshal(text, &row); err != nil {
+			record.ParseError = fmt.Sprintf("invalid JSON: %v", err)
+		} else {
+			record.Name = strings.TrimSpace(row.Name)
+			record.Email = strings.TrimSpace(row.Email)
+		}
+		records = append(records, record)
+	}
+	if err := scanner.Err(); err != nil {
+		if errors.Is(err, bufio.ErrTooLong) {
+			return nil, ErrUserImportMalformed(fmt.Sprintf("line %d is too long", line+1))
+		}
+		return nil, ErrUserImportMalformed(err.Error())
+	}
+	return records, nil
+}
+
+// Start インポートを処理中にする
+func (i *UserImport) Start() {
+	i.Status = UserImportStatusProcessing
+	i.LastError = ""
+	i.UpdatedAt = time.Now()
+}
+
+// Complete インポートを完了にする
+func (i *UserImport) Complete() {
+	now := time.Now()
+	i.Status = UserImportStatusCompleted
+	i.UpdatedAt = now
+	i.CompletedAt = &now
+}
+
+// Fail インポートを失敗にする
+func (i *UserImport) Fail(reason string) {
+	now := time.Now()
+	i.Status = UserImportStatusFailed
+	i.LastError = reason
+	i.UpdatedAt = now
+	i.CompletedAt = &now
+}
+
+// Finished 処理が終了しているかどうか
+func (i *UserImport) Finished() bool {
+	return i.Status == UserImportStatusCompleted || i.Status == UserImportStatusFailed
+}
@@ -0,0 +1,160 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseUserImportRecords(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  UserImportFormat
+		source  string
+		want    []UserImportRecord
+		wantErr bool
+	}{
+		{
+			name:   "csv",
+			format: UserImportFormatCSV,
+			source: "name,email\nJohn Doe,john@example.com\nJane Doe,jane@example.com\n",
+			want: []UserImportRecord{
+				{Line: 2, Name: "John Doe", Email: "john@example.com"},
+				{Line: 3, Name: "Jane Doe", Email: "jane@example.com"},
+			},
+		},
+		{
+			name:   "csv with reordered columns, BOM and extra column",
+			format: UserImportFormatCSV,
+			source: "\uFEFFEmail, Role, Name\njohn@example.com, admin, John Doe\n",
+			want: []UserImportRecord{
+				{Line: 2, Name: "John Doe", Email: "john@example.com"},
+			},
+		},
+		{
+			name:   "csv row with missing columns",
+			format: UserImportFormatCSV,
+			source: "name,email\nJohn Doe\n",
+			want: []UserImportRecord{
+				{Line: 2, ParseError: "expected at least 2 columns, got 1"},
+			},
+		},
+		{
+			name:    "csv without email column",
+			format:  UserImportFormatCSV,
+			source:  "name,mail\nJohn Doe,john@example.com\n",
+			wantErr: true,
+		},
+		{
+			name:    "csv with header only",
+			format:  UserImportFormatCSV,
+			source:  "name,email\n",
+			wantErr: true,
+		},
+		{
+			name:   "ndjson",
+			format: UserImportFormatNDJSON,
+			source: "{\"name\":\"John Doe\",\"email\":\"john@example.com\"}\n\n{\"name\":\"Jane Doe\",\"email\":\"jane@example.com\"}",
+			want: []UserImportRecord{
+				{Line: 1, Name: "John Doe", Email: "john@example.com"},
+				{Line: 3, Name: "Jane Doe", Email: "jane@example.com"},
+			},
+		},
+		{
+			name:    "empty ndjson",
+			format:  UserImportFormatNDJSON,
+			source:  "\n\n",
+			wantErr: true,
+		},
+		{
+			name:    "unsupported format",
+			format:  UserImportFormat("xml"),
+			source:  "<users/>",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			records, err := ParseUserImportRecords(tt.format, []byte(tt.source))
+
+			if tt.wantErr {
+				var validationErr *ValidationError
+				if !errors.As(err, &validationErr) {
+					t.Errorf("ParseUserImportRecords() expected ValidationError, got %v", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseUserImportRecords() unexpected error: %v", err)
+			}
+
+			if len(records) != len(tt.want) {
+				t.Fatalf("ParseUserImportRecords() returned %d records, want %d", len(records), len(tt.want))
+			}
+			for i, want := range tt.want {
+				if records[i] != want {
+					t.Errorf("ParseUserImportRecords()[%d] = %+v, want %+v", i, records[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestParseUserImportRecords_InvalidJSONLine(t *testing.T) {
+	source := "{\"name\":\"John Doe\",\"email\":\"john@example.com\"}\nnot json\n"
+
+	records, err := ParseUserImportRecords(UserImportFormatNDJSON, []byte(source))
+	if err != nil {
+		t.Fatalf("ParseUserImportRecords() unexpected error: %v", err)
+	}
+
+	if len(records) != 2 {
+		t.Fatalf("ParseUserImportRecords() returned %d records, want 2", len(records))
+	}
+	if records[0].ParseError != "" {
+		t.Errorf("records[0].ParseError = %q, want empty", records[0].ParseError)
+	}
+	if records[1].Line != 2 || !strings.HasPrefix(records[1].ParseError, "invalid JSON") {
+		t.Errorf("records[1] = %+v, want line 2 with invalid JSON error", records[1])
+	}
+}
+
+func TestParseUserImportRecords_TooManyRows(t *testing.T) {
+	source := "name,email\n" + strings.Repeat("John Doe,john@example.com\n", UserImportMaxRows+1)
+
+	if _, err := ParseUserImportRecords(UserImportFormatCSV, []byte(source)); err == nil {
+		t.Error("ParseUserImportRecords() expected error, got nil")
+	}
+}
+
+func TestNewUserImport(t *testing.T) {
+	userImport, records, err := NewUserImport(UserImportFormatCSV, []byte("name,email\nJohn Doe,john@example.com\n"))
+	if err != nil {
+		t.Fatalf("NewUserImport() unexpected error: %v", err)
+	}
+
+	if userImport.ID == "" {
+		t.Error("NewUserImport() ID should not be empty")
+	}
+	if userImport.Status != UserImportStatusPending {
+		t.Errorf("NewUserImport() status = %v, want %v", userImport.Status, UserImportStatusPending)
+	}
+	if userImport.TotalRows != 1 || len(records) != 1 {
+		t.Errorf("NewUserImport() total rows = %d, records = %d, want 1", userImport.TotalRows, len(records))
+	}
+	if userImport.Finished() {
+		t.Error("NewUserImport() should not be finished")
+	}
+
+	userImport.Start()
+	if userImport.Status != UserImportStatusProcessing {
+		t.Errorf("Start() status = %v, want %v", userImport.Status, UserImportStatusProcessing)
+	}
+
+	userImport.Complete()
+	if !userImport.Finished() || userImport.CompletedAt == nil {
+		t.Error("Complete() should finish the import and set CompletedAt")
+	}
+}
@@ -13,16 +13,41 @@ type UserLogAction string
 const (
 	// UserLogActionCreated ユーザー作成
 	UserLogActionCreated UserLogAction = "created"
+	// UserLogActionUpdated ユーザー更新
+	UserLogActionUpdated UserLogAction = "updated"
 	// UserLogActionDeleted ユーザー削除
 	UserLogActionDeleted UserLogAction = "deleted"
 )
 
+// UserFieldChange 1項目の変更前後の値
+type UserFieldChange struct {
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// UserChanges 変更された項目（キーは項目名: "name", "email"）
+type UserChanges map[string]UserFieldChange
+
 // UserLog ユーザーログのドメインモデル
 type UserLog struct {
-	ID        string
-	UserID    string
-	Action    UserLogAction
+	ID     string
+	UserID string
+	// OrganizationID ユーザーが所属する組織（テナント、保存時に設定される）
+	OrganizationID string
+	Action         UserLogAction
+	// Changes 更新で変更された項目（updated のみ）
+	Changes UserChanges
+	// Actor 操作を行った主体（Principal.String() の形式）
+	Actor string
+	// RequestID 操作を行ったリクエストのID
+	RequestID string
 	CreatedAt time.Time
+	// Seq ハッシュチェーン上の位置（チェーン導入前のログは0）
+	Seq int64
+	// PrevHash チェーン上の1つ前のログのハッシュ
+	PrevHash string
+	// Hash ログの内容と PrevHash のHMAC（ChainTo で設定）
+	Hash string
 }
 
 // NewUserLog ユーザーログを作成
@@ -32,6 +57,33 @@ func NewUserLog(userID string, action UserLogAction) *UserLog {
 		ID:        ulid.MustNew(ulid.Timestamp(now), rand.Reader).String(),
 		UserID:    userID,
 		Action:    action,
+		Changes:   UserChanges{},
 		CreatedAt: now,
 	}
 }
+
+// NewUserUpdatedLog ユーザー更新ログを作成
+func NewUserUpdatedLog(userID string, changes UserChanges) *UserLog {
+	log := NewUserLog(userID, UserLogActionUpdated)
+	log.Changes = changes
+	return log
+}
+
+// AttributeTo 操作を行った主体とリクエストIDを記録
+func (l *UserLog) AttributeTo(principal Principal, requestID string) *UserLog {
+	l.Actor = principal.String()
+	l.RequestID = requestID
+	return l
+}
+
+// DiffUsers 更新前後のユーザーを比較し、変更された項目を返す
+func DiffUsers(before, after *User) UserChanges {
+	changes := UserChanges{}
+	if before.Name != after.Name {
+		changes["name"] = UserFieldChange{Before: before.Name, After: after.Name}
+	}
+	if before.Email != after.Email {
+		changes["email"] = UserFieldChange{Before: before.Email, After: after.Email}
+	}
+	return changes
+}
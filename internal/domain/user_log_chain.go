@@ -0,0 +1,209 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// userLogCreatedAtLayout ハッシュ計算に使う作成日時の形式（DBの TIMESTAMP と同じマイクロ秒精度・タイムゾーンなし）
+const userLogCreatedAtLayout = "2006-01-02T15:04:05.000000"
+
+// UserLogHashKeyMinLength ハッシュチェーンのHMACキーの最小の長さ（バイト、SHA-256の出力と同じ）
+const UserLogHashKeyMinLength = 32
+
+// publishedUserLogHashKeys リポジトリで公開されていたため使用できないキー
+var publishedUserLogHashKeys = []string{"local-development-user-log-hash-key"}
+
+// UserLogHashKey ユーザーログのハッシュチェーンに使うHMACキー
+type UserLogHashKey []byte
+
+// NewUserLogHashKey 設定値からHMACキーを作成（空・短すぎる・公開済みのキーはエラー）
+func NewUserLogHashKey(key string) (UserLogHashKey, error) {
+	if key == "" {
+		return nil, errors.New("user log hash key is not configured")
+	}
+	for _, published := range publishedUserLogHashKeys {
+		if hmac.Equal([]byte(key), []byte(published)) {
+			return nil, errors.New("user log hash key must not be the published default value")
+		}
+	}
+	if len(key) < UserLogHashKeyMinLength {
+		return nil, fmt.Errorf("user log hash key must be at least %d bytes", UserLogHashKeyMinLength)
+	}
+	return UserLogHashKey(key), nil
+}
+
+// UserLogChainHead ユーザーログのハッシュチェーン（組織ごと）の末尾
+type UserLogChainHead struct {
+	Seq  int64
+	Hash string
+	// Signature 組織・位置・ハッシュのHMAC（キーを知らずに末尾を巻き戻せないようにする）
+	Signature string
+}
+
+// ChainTo チェーンの末尾に続くログとして位置とハッシュを設定し、署名した新しい末尾を返す
+// 作成日時はDBに保存される精度（マイクロ秒）に揃えてからハッシュを計算する
+func (l *UserLog) ChainTo(key UserLogHashKey, head UserLogChainHead) UserLogChainHead {
+	l.CreatedAt = l.CreatedAt.Truncate(time.Microsecond)
+	l.Seq = head.Seq + 1
+	l.PrevHash = head.Hash
+	l.Hash = ComputeUserLogHash(key, l)
+	next := UserLogChainHead{Seq: l.Seq, Hash: l.Hash}
+	next.Signature = ComputeUserLogChainHeadSignature(key, l.OrganizationID, next)
+	return next
+}
+
+// ComputeUserLogChainHeadSignature チェーンの末尾（組織・位置・ハッシュ）のHMAC-SHA256を計算する（16進文字列）
+// 末尾のログを削除して末尾を書き戻しても、署名を作り直せないため検証で検出できる
+func ComputeUserLogChainHeadSignature(key UserLogHashKey, organizationID string, head UserLogChainHead) string {
+	content, _ := json.Marshal(struct {
+		OrganizationID string `json:"organizationId"`
+		Seq            int64  `json:"seq"`
+		Hash           string `json:"hash"`
+	}{
+		OrganizationID: organizationID,
+		Seq:            head.Seq,
+		Hash:           head.Hash,
+	})
+
+	// ログのハッシュと同じキーを使うため、ログのハッシュと取り違えられないよう用途を区別する
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("user_log_chain_head:"))
+	mac.Write(content)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// ComputeUserLogHash ログの内容（組織を含む）と PrevHash からHMAC-SHA256を計算する（16進文字列）
+// 組織を含めるため、ログを他の組織のチェーンに移し替えるとハッシュが一致しなくなる
+func ComputeUserLogHash(key UserLogHashKey, l *UserLog) string {
+	changes := l.Changes
+	if changes == nil {
+		changes = UserChanges{}
+	}
+	// フィールドの順序を固定するため構造体でシリアライズする（changes のキーは json.Marshal でソートされる）
+	content, _ := json.Marshal(struct {
+		Seq            int64         `json:"seq"`
+		PrevHash       string        `json:"prevHash"`
+		ID             string        `json:"id"`
+		OrganizationID string        `json:"organizationId"`
+		UserID         string        `json:"userId"`
+		Action         UserLogAction `json:"action"`
+		Changes        UserChanges   `json:"changes"`
+		Actor          string        `json:"actor"`
+		RequestID      string        `json:"requestId"`
+		CreatedAt      string        `json:"createdAt"`
+	}{
+		Seq:            l.Seq,
+		PrevHash:       l.PrevHash,
+		ID:             l.ID,
+		OrganizationID: l.OrganizationID,
+		UserID:         l.UserID,
+		Action:         l.Action,
+		Changes:        changes,
+		Actor:          l.Actor,
+		RequestID:      l.RequestID,
+		CreatedAt:      l.CreatedAt.Format(userLogCreatedAtLayout),
+	})
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write(content)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// UserLogChainBreakReason ハッシュチェーンが途切れている理由
+type UserLogChainBreakReason string
+
+const (
+	// UserLogChainBreakHashMismatch ログの内容が書き換えられている
+	UserLogChainBreakHashMismatch UserLogChainBreakReason = "hash_mismatch"
+	// UserLogChainBreakPrevHashMismatch 1つ前のログが差し替えられている
+	UserLogChainBreakPrevHashMismatch UserLogChainBreakReason = "prev_hash_mismatch"
+	// UserLogChainBreakSequenceGap 途中のログが削除されている
+	UserLogChainBreakSequenceGap UserLogChainBreakReason = "sequence_gap"
+	// UserLogChainBreakHeadMismatch 末尾のログが削除されている、またはチェーンの末尾が書き換えられている
+	UserLogChainBreakHeadMismatch UserLogChainBreakReason = "head_mismatch"
+	// UserLogChainBreakHeadSignatureMismatch チェーンの末尾が署名なしで書き換えられている（末尾のログとともに巻き戻された場合を含む）
+	UserLogChainBreakHeadSignatureMismatch UserLogChainBreakReason = "head_signature_mismatch"
+)
+
+// UserLogChainBreak ハッシュチェーンの最初の切れ目
+type UserLogChainBreak struct {
+	// Seq 切れ目の位置
+	Seq int64
+	// LogID 切れ目で見つかったログのID（末尾のログが削除された場合は空）
+	LogID  string
+	Reason UserLogChainBreakReason
+}
+
+// UserLogChainVerification ハッシュチェーンの検証結果
+type UserLogChainVerification struct {
+	// CheckedCount 検証したログの件数
+	CheckedCount int
+	// Head 検証時点のチェーンの末尾
+	Head UserLogChainHead
+	// Break 最初の切れ目（チェーンが正しい場合は nil）
+	Break *UserLogChainBreak
+}
+
+// Valid チェーンが途切れていないかどうか
+func (v *UserLogChainVerification) Valid() bool {
+	return v.Break == nil
+}
+
+// UserLogChainVerifier ハッシュチェーンを先頭から順に検証する
+type UserLogChainVerifier struct {
+	key      UserLogHashKey
+	prevSeq  int64
+	prevHash string
+	checked  int
+}
+
+// NewUserLogChainVerifier UserLogChainVerifierのコンストラクタ
+func NewUserLogChainVerifier(key UserLogHashKey) *UserLogChainVerifier {
+	return &UserLogChainVerifier{key: key}
+}
+
+// Verify 次のログ（Seq の昇順）を検証し、切れ目があれば返す
+func (v *UserLogChainVerifier) Verify(l *UserLog) *UserLogChainBreak {
+	v.checked++
+	if l.Seq != v.prevSeq+1 {
+		return &UserLogChainBreak{Seq: v.prevSeq + 1, LogID: l.ID, Reason: UserLogChainBreakSequenceGap}
+	}
+	if l.PrevHash != v.prevHash {
+		return &UserLogChainBreak{Seq: l.Seq, LogID: l.ID, Reason: UserLogChainBreakPrevHashMismatch}
+	}
+	if !hmac.Equal([]byte(l.Hash), []byte(ComputeUserLogHash(v.key, l))) {
+		return &UserLogChainBreak{Seq: l.Seq, LogID: l.ID, Reason: UserLogChainBreakHashMismatch}
+	}
+	v.prevSeq = l.Seq
+	v.prevHash = l.Hash
+	return nil
+}
+
+// VerifyHeadSignature ログを検証する前に、チェーンの末尾の署名を検証する
+// 署名が一致しない末尾はどこまでがチェーンなのかを信頼できないため、ログの検証より先に切れ目として返す
+func (v *UserLogChainVerifier) VerifyHeadSignature(organizationID string, head UserLogChainHead) *UserLogChainBreak {
+	want := ComputeUserLogChainHeadSignature(v.key, organizationID, head)
+	if !hmac.Equal([]byte(head.Signature), []byte(want)) {
+		return &UserLogChainBreak{Seq: head.Seq, Reason: UserLogChainBreakHeadSignatureMismatch}
+	}
+	return nil
+}
+
+// VerifyHead すべてのログを検証した後、最後のログがチェーンの末尾と一致するか検証する
+func (v *UserLogChainVerifier) VerifyHead(head UserLogChainHead) *UserLogChainBreak {
+	if head.Seq != v.prevSeq || head.Hash != v.prevHash {
+		return &UserLogChainBreak{Seq: v.prevSeq + 1, Reason: UserLogChainBreakHeadMismatch}
+	}
+	return nil
+}
+
+// CheckedCount 検証したログの件数
+func (v *UserLogChainVerifier) CheckedCount() int {
+	return v.checked
+}
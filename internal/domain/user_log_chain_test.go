@@ -0,0 +1,240 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+var testUserLogHashKey = UserLogHashKey("test-key")
+
+// newTestUserLogChain 3件のログからなる正しいハッシュチェーンを作成する
+func newTestUserLogChain() ([]*UserLog, UserLogChainHead) {
+	createdAt := time.Date(2026, 1, 2, 3, 4, 5, 123456789, time.UTC)
+	logs := []*UserLog{
+		{ID: "01ARZ3NDEKTSV4RRFFQ69G5FB1", UserID: "01ARZ3NDEKTSV4RRFFQ69G5FAV", Action: UserLogActionCreated, Actor: "anonymous", CreatedAt: createdAt},
+		{
+			ID: "01ARZ3NDEKTSV4RRFFQ69G5FB2", UserID: "01ARZ3NDEKTSV4RRFFQ69G5FAV", Action: UserLogActionUpdated, Actor: "anonymous",
+			Changes:   UserChanges{"name": {Before: "John", After: "Jane"}},
+			CreatedAt: createdAt.Add(time.Second),
+		},
+		{ID: "01ARZ3NDEKTSV4RRFFQ69G5FB3", UserID: "01ARZ3NDEKTSV4RRFFQ69G5FAV", Action: UserLogActionDeleted, Actor: "anonymous", CreatedAt: createdAt.Add(2 * time.Second)},
+	}
+
+	var head UserLogChainHead
+	for _, l := range logs {
+		l.OrganizationID = DefaultOrganizationID
+		head = l.ChainTo(testUserLogHashKey, head)
+	}
+	return logs, head
+}
+
+// verifyTestUserLogChain ログを順に検証し、最初の切れ目を返す
+func verifyTestUserLogChain(key UserLogHashKey, logs []*UserLog, head UserLogChainHead) *UserLogChainBreak {
+	verifier := NewUserLogChainVerifier(key)
+	for _, l := range logs {
+		if chainBreak := verifier.Verify(l); chainBreak != nil {
+			return chainBreak
+		}
+	}
+	return verifier.VerifyHead(head)
+}
+
+func TestUserLog_ChainTo(t *testing.T) {
+	logs, head := newTestUserLogChain()
+
+	for i, l := range logs {
+		if l.Seq != int64(i+1) {
+			t.Errorf("log %d: expected seq %d, got %d", i, i+1, l.Seq)
+		}
+		if l.CreatedAt.Nanosecond()%1000 != 0 {
+			t.Errorf("log %d: expected createdAt truncated to microseconds, got %v", i, l.CreatedAt)
+		}
+	}
+	if logs[0].PrevHash != "" {
+		t.Errorf("expected first log to have empty prevHash, got %q", logs[0].PrevHash)
+	}
+	if logs[1].PrevHash != logs[0].Hash {
+		t.Error("expected second log to chain to the first")
+	}
+	if head.Seq != 3 || head.Hash != logs[2].Hash {
+		t.Errorf("unexpected head %+v", head)
+	}
+}
+
+func TestComputeUserLogHash_IndependentOfTimeZone(t *testing.T) {
+	logs, _ := newTestUserLogChain()
+	l := *logs[0]
+	want := l.Hash
+
+	// DBから読み込んだ TIMESTAMP はタイムゾーン情報を持たないため、同じ壁時計の時刻で同じハッシュになる
+	wall := l.CreatedAt
+	l.CreatedAt = time.Date(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), wall.Nanosecond(), time.FixedZone("JST", 9*60*60))
+
+	if got := ComputeUserLogHash(testUserLogHashKey, &l); got != want {
+		t.Errorf("expected hash %s, got %s", want, got)
+	}
+}
+
+func TestUserLogChainVerifier(t *testing.T) {
+	tests := []struct {
+		name       string
+		tamper     func(logs []*UserLog) []*UserLog
+		key        UserLogHashKey
+		wantSeq    int64
+		wantLogID  string
+		wantReason UserLogChainBreakReason
+	}{
+		{
+			name:   "intact chain",
+			tamper: func(logs []*UserLog) []*UserLog { return logs },
+		},
+		{
+			name: "edited row",
+			tamper: func(logs []*UserLog) []*UserLog {
+				logs[1].Actor = "user:01ARZ3NDEKTSV4RRFFQ69G5FAW"
+				return logs
+			},
+			wantSeq:    2,
+			wantLogID:  "01ARZ3NDEKTSV4RRFFQ69G5FB2",
+			wantReason: UserLogChainBreakHashMismatch,
+		},
+		{
+			name: "row moved to another organization",
+			tamper: func(logs []*UserLog) []*UserLog {
+				logs[1].OrganizationID = "01ARZ3NDEKTSV4RRFFQ69G5FO2"
+				return logs
+			},
+			wantSeq:    2,
+			wantLogID:  "01ARZ3NDEKTSV4RRFFQ69G5FB2",
+			wantReason: UserLogChainBreakHashMismatch,
+		},
+		{
+			name: "deleted row in the middle",
+			tamper: func(logs []*UserLog) []*UserLog {
+				return []*UserLog{logs[0], logs[2]}
+			},
+			wantSeq:    2,
+			wantLogID:  "01ARZ3NDEKTSV4RRFFQ69G5FB3",
+			wantReason: UserLogChainBreakSequenceGap,
+		},
+		{
+			name: "deleted and renumbered row",
+			tamper: func(logs []*UserLog) []*UserLog {
+				logs[2].Seq = 2
+				return []*UserLog{logs[0], logs[2]}
+			},
+			wantSeq:    2,
+			wantLogID:  "01ARZ3NDEKTSV4RRFFQ69G5FB3",
+			wantReason: UserLogChainBreakPrevHashMismatch,
+		},
+		{
+			name: "deleted last row",
+			tamper: func(logs []*UserLog) []*UserLog {
+				return logs[:2]
+			},
+			wantSeq:    3,
+			wantReason: UserLogChainBreakHeadMismatch,
+		},
+		{
+			name:       "different key",
+			tamper:     func(logs []*UserLog) []*UserLog { return logs },
+			key:        UserLogHashKey("other-key"),
+			wantSeq:    1,
+			wantLogID:  "01ARZ3NDEKTSV4RRFFQ69G5FB1",
+			wantReason: UserLogChainBreakHashMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logs, head := newTestUserLogChain()
+			logs = tt.tamper(logs)
+			key := tt.key
+			if key == nil {
+				key = testUserLogHashKey
+			}
+
+			got := verifyTestUserLogChain(key, logs, head)
+			if tt.wantReason == "" {
+				if got != nil {
+					t.Fatalf("expected intact chain, got %+v", got)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatal("expected broken chain, got nil")
+			}
+			if got.Seq != tt.wantSeq || got.LogID != tt.wantLogID || got.Reason != tt.wantReason {
+				t.Errorf("expected {%d %s %s}, got %+v", tt.wantSeq, tt.wantLogID, tt.wantReason, got)
+			}
+		})
+	}
+}
+
+func TestUserLogChainVerifier_VerifyHeadSignature(t *testing.T) {
+	logs, head := newTestUserLogChain()
+	rewound := UserLogChainHead{Seq: logs[1].Seq, Hash: logs[1].Hash, Signature: head.Signature}
+
+	tests := []struct {
+		name           string
+		head           UserLogChainHead
+		organizationID string
+		key            UserLogHashKey
+		wantBreak      bool
+	}{
+		{name: "signed head", head: head, organizationID: DefaultOrganizationID, key: testUserLogHashKey},
+		{name: "rewound head", head: rewound, organizationID: DefaultOrganizationID, key: testUserLogHashKey, wantBreak: true},
+		{name: "unsigned head", head: UserLogChainHead{Seq: head.Seq, Hash: head.Hash}, organizationID: DefaultOrganizationID, key: testUserLogHashKey, wantBreak: true},
+		{name: "head copied from another organization", head: head, organizationID: "01ARZ3NDEKTSV4RRFFQ69G5FO2", key: testUserLogHashKey, wantBreak: true},
+		{name: "different key", head: head, organizationID: DefaultOrganizationID, key: UserLogHashKey("other-key"), wantBreak: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewUserLogChainVerifier(tt.key).VerifyHeadSignature(tt.organizationID, tt.head)
+			if !tt.wantBreak {
+				if got != nil {
+					t.Fatalf("expected valid signature, got %+v", got)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatal("expected broken chain, got nil")
+			}
+			if got.Seq != tt.head.Seq || got.Reason != UserLogChainBreakHeadSignatureMismatch {
+				t.Errorf("expected {%d %s}, got %+v", tt.head.Seq, UserLogChainBreakHeadSignatureMismatch, got)
+			}
+		})
+	}
+}
+
+func TestNewUserLogHashKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		wantErr bool
+	}{
+		{name: "valid", key: "0123456789abcdef0123456789abcdef"},
+		{name: "empty", key: "", wantErr: true},
+		{name: "too short", key: "0123456789abcdef", wantErr: true},
+		{name: "published default", key: "local-development-user-log-hash-key", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := NewUserLogHashKey(tt.key)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(key) != tt.key {
+				t.Errorf("expected key %q, got %q", tt.key, key)
+			}
+		})
+	}
+}
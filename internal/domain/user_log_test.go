@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDiffUsers(t *testing.T) {
+	before := &User{ID: "01ARZ3NDEKTSV4RRFFQ69G5FAV", Name: "John Doe", Email: "john@example.com"}
+
+	tests := []struct {
+		name  string
+		after User
+		want  UserChanges
+	}{
+		{
+			name:  "no changes",
+			after: *before,
+			want:  UserChanges{},
+		},
+		{
+			name:  "email changed",
+			after: User{ID: before.ID, Name: before.Name, Email: "john.doe@example.com"},
+			want: UserChanges{
+				"email": {Before: "john@example.com", After: "john.doe@example.com"},
+			},
+		},
+		{
+			name:  "name and email changed",
+			after: User{ID: before.ID, Name: "Jane Doe", Email: "jane@example.com"},
+			want: UserChanges{
+				"name":  {Before: "John Doe", After: "Jane Doe"},
+				"email": {Before: "john@example.com", After: "jane@example.com"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DiffUsers(before, &tt.after)
+			if len(got) != len(tt.want) {
+				t.Fatalf("DiffUsers() = %v, want %v", got, tt.want)
+			}
+			for field, change := range tt.want {
+				if got[field] != change {
+					t.Errorf("DiffUsers()[%q] = %v, want %v", field, got[field], change)
+				}
+			}
+		})
+	}
+}
+
+func TestNewUserUpdatedLog(t *testing.T) {
+	changes := UserChanges{"email": {Before: "john@example.com", After: "john.doe@example.com"}}
+
+	log := NewUserUpdatedLog("01ARZ3NDEKTSV4RRFFQ69G5FAV", changes).
+		AttributeTo(NewUserPrincipal("01ARZ3NDEKTSV4RRFFQ69G5FAW"), "request-1")
+
+	if log.Action != UserLogActionUpdated {
+		t.Errorf("NewUserUpdatedLog() action = %v, want %v", log.Action, UserLogActionUpdated)
+	}
+	if log.Changes["email"].After != "john.doe@example.com" {
+		t.Errorf("NewUserUpdatedLog() changes = %v", log.Changes)
+	}
+	if log.Actor != "user:01ARZ3NDEKTSV4RRFFQ69G5FAW" {
+		t.Errorf("AttributeTo() actor = %q", log.Actor)
+	}
+	if log.RequestID != "request-1" {
+		t.Errorf("AttributeTo() request ID = %q", log.RequestID)
+	}
+}
+
+func TestPrincipalFromContext(t *testing.T) {
+	if got := PrincipalFromContext(context.Background()); got.Type != PrincipalTypeAnonymous || got.ID != "" {
+		t.Errorf("PrincipalFromContext() = %v, want anonymous", got)
+	}
+	if got := AnonymousPrincipal.String(); got != "anonymous" {
+		t.Errorf("AnonymousPrincipal.String() = %q, want %q", got, "anonymous")
+	}
+
+	ctx := WithPrincipal(context.Background(), NewSystemPrincipal("process_user_import"))
+	if got := PrincipalFromContext(ctx).String(); got != "system:process_user_import" {
+		t.Errorf("PrincipalFromContext() = %q, want %q", got, "system:process_user_import")
+	}
+}
@@ -1,7 +1,9 @@
 package domain
 
 import (
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestNewUser(t *testing.T) {
@@ -29,6 +31,18 @@ func TestNewUser(t *testing.T) {
 			email:    "",
 			wantErr:  true,
 		},
+		{
+			name:     "invalid email",
+			userName: "John Doe",
+			email:    "john.example.com",
+			wantErr:  true,
+		},
+		{
+			name:     "name too long",
+			userName: strings.Repeat("a", UserNameMaxLength+1),
+			email:    "john@example.com",
+			wantErr:  true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -121,3 +135,152 @@ func TestUser_Update(t *testing.T) {
 		})
 	}
 }
+
+func TestUser_Update_InvalidEmail(t *testing.T) {
+	user, err := NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+
+	if err := user.Update("", "not-an-email"); err == nil {
+		t.Error("Update() expected error, got nil")
+	}
+
+	if user.Email != "john@example.com" {
+		t.Errorf("Update() email = %v, want unchanged %v", user.Email, "john@example.com")
+	}
+}
+
+func TestNormalizeEmail(t *testing.T) {
+	tests := []struct {
+		email string
+		want  string
+	}{
+		{email: "john@example.com", want: "john@example.com"},
+		{email: "  John.Doe@Example.COM ", want: "John.Doe@example.com"},
+		{email: "not-an-email", want: "not-an-email"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.email, func(t *testing.T) {
+			if got := NormalizeEmail(tt.email); got != tt.want {
+				t.Errorf("NormalizeEmail(%q) = %q, want %q", tt.email, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEmailNormalization_Normalize(t *testing.T) {
+	tests := []struct {
+		name          string
+		normalization EmailNormalization
+		email         string
+		want          string
+	}{
+		{name: "domain only", email: "  John.Doe@Example.COM ", want: "John.Doe@example.com"},
+		{name: "full lowercase", normalization: EmailNormalization{LowercaseLocalPart: true}, email: "  John.Doe@Example.COM ", want: "john.doe@example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.normalization.Normalize(tt.email); got != tt.want {
+				t.Errorf("Normalize(%q) = %q, want %q", tt.email, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSameEmail(t *testing.T) {
+	if !SameEmail("Alice@Example.com", " alice@example.COM") {
+		t.Error("SameEmail() expected addresses differing only in case to be the same")
+	}
+	if SameEmail("alice@example.com", "bob@example.com") {
+		t.Error("SameEmail() expected different addresses not to be the same")
+	}
+}
+
+func TestNewUser_NormalizesEmail(t *testing.T) {
+	user, err := NewUser("Alice", " Alice@Example.COM ")
+	if err != nil {
+		t.Fatalf("NewUser() unexpected error: %v", err)
+	}
+	if user.Email != "Alice@example.com" {
+		t.Errorf("NewUser() email = %q, want %q", user.Email, "Alice@example.com")
+	}
+
+	if err := user.Update("", "ALICE@EXAMPLE.ORG"); err != nil {
+		t.Fatalf("Update() unexpected error: %v", err)
+	}
+	if user.Email != "ALICE@example.org" {
+		t.Errorf("Update() email = %q, want %q", user.Email, "ALICE@example.org")
+	}
+}
+
+func TestUser_EmailVerification(t *testing.T) {
+	user, err := NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	if user.EmailVerified() {
+		t.Fatal("expected a new user to be unverified")
+	}
+
+	now := time.Now()
+	user.VerifyEmail(now)
+	if !user.EmailVerified() || !user.EmailVerifiedAt.Equal(now) {
+		t.Fatalf("expected email to be verified at %v, got %v", now, user.EmailVerifiedAt)
+	}
+	user.VerifyEmail(now.Add(time.Minute))
+	if !user.EmailVerifiedAt.Equal(now) {
+		t.Error("expected VerifyEmail to keep the first verification time")
+	}
+
+	// 名前の変更や大文字小文字だけの変更では確認を取り消さない
+	if err := user.Update("Jane Doe", "JOHN@EXAMPLE.COM"); err != nil {
+		t.Fatalf("Update() unexpected error: %v", err)
+	}
+	if !user.EmailVerified() {
+		t.Error("expected verification to be kept when the address is unchanged")
+	}
+
+	if err := user.Update("", "jane@example.com"); err != nil {
+		t.Fatalf("Update() unexpected error: %v", err)
+	}
+	if user.EmailVerified() {
+		t.Error("expected verification to be cleared when the address changes")
+	}
+}
+
+func TestUser_InvalidateEmail(t *testing.T) {
+	user, err := NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	user.PullEvents()
+
+	now := time.Now()
+	user.InvalidateEmail("hard_bounce", now)
+	if !user.EmailInvalid() || !user.EmailInvalidatedAt.Equal(now) {
+		t.Fatalf("expected email to be invalidated at %v, got %v", now, user.EmailInvalidatedAt)
+	}
+	user.InvalidateEmail("hard_bounce", now.Add(time.Minute))
+	events := user.PullEvents()
+	if len(events) != 1 || events[0].EventType() != EventTypeUserEmailInvalidated {
+		t.Fatalf("expected a single %s event, got %v", EventTypeUserEmailInvalidated, events)
+	}
+
+	// 大文字小文字だけの変更では判定を解除しない
+	if err := user.Update("", "JOHN@EXAMPLE.COM"); err != nil {
+		t.Fatalf("Update() unexpected error: %v", err)
+	}
+	if !user.EmailInvalid() {
+		t.Error("expected the invalidation to be kept when the address is unchanged")
+	}
+
+	if err := user.Update("", "jane@example.com"); err != nil {
+		t.Fatalf("Update() unexpected error: %v", err)
+	}
+	if user.EmailInvalid() {
+		t.Error("expected the invalidation to be cleared when the address changes")
+	}
+}
@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// UserTokenPurpose ユーザートークンの用途
+type UserTokenPurpose string
+
+const (
+	// UserTokenPurposeEmailVerification メールアドレスの確認
+	UserTokenPurposeEmailVerification UserTokenPurpose = "email_verification"
+	// UserTokenPurposePasswordReset パスワードの再設定
+	UserTokenPurposePasswordReset UserTokenPurpose = "password_reset"
+)
+
+const (
+	// EmailVerificationTokenTTL メール確認トークンの有効期間
+	EmailVerificationTokenTTL = 48 * time.Hour
+	// PasswordResetTokenTTL パスワード再設定トークンの有効期間（メールの盗み見による悪用を防ぐため短くする）
+	PasswordResetTokenTTL = time.Hour
+)
+
+// userTokenPrefix ユーザートークンの先頭に付ける識別子
+const userTokenPrefix = "cqt_"
+
+// UserToken メールで送付する使い捨てトークンのドメインモデル
+// セッションと同様にハッシュのみを保持し、平文はメール送信時に一度だけ使う
+type UserToken struct {
+	ID     string
+	UserID string
+	// OrganizationID ユーザーが所属する組織のID（トークンを使用する処理はこの組織に限定される）
+	OrganizationID string
+	Purpose        UserTokenPurpose
+	// TokenHash トークンのSHA-256（16進文字列）
+	TokenHash string
+	// Email 送信先のメールアドレス（送信後にアドレスが変更された場合はトークンを無効とする）
+	Email     string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+// NewUserToken ユーザートークンを作成し、トークンと平文のトークンを返す
+func NewUserToken(user *User, purpose UserTokenPurpose) (*UserToken, string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", err
+	}
+	token := userTokenPrefix + base64.RawURLEncoding.EncodeToString(secret)
+
+	now := time.Now()
+	return &UserToken{
+		ID:        ulid.MustNew(ulid.Timestamp(now), rand.Reader).String(),
+		UserID:    user.ID,
+		Purpose:   purpose,
+		TokenHash: HashUserToken(token),
+		Email:     user.Email,
+		ExpiresAt: now.Add(purpose.ttl()),
+		CreatedAt: now,
+	}, token, nil
+}
+
+// HashUserToken トークンのSHA-256を16進文字列で返す
+func HashUserToken(token string) string {
+	return HashSessionToken(token)
+}
+
+// Usable 指定した用途・時刻で利用できるかどうか（用途が一致し、未使用かつ期限内）
+func (t *UserToken) Usable(purpose UserTokenPurpose, now time.Time) bool {
+	return t.Purpose == purpose && t.UsedAt == nil && now.Before(t.ExpiresAt)
+}
+
+// IssuedFor トークンがユーザーの現在のメールアドレス宛てに発行されたものかどうか
+func (t *UserToken) IssuedFor(user *User) bool {
+	return t.UserID == user.ID && SameEmail(t.Email, user.Email)
+}
+
+// MarkUsed トークンを使用済みにする
+func (t *UserToken) MarkUsed(now time.Time) {
+	if t.UsedAt == nil {
+		t.UsedAt = &now
+	}
+}
+
+// ttl 用途ごとの有効期間
+func (p UserTokenPurpose) ttl() time.Duration {
+	if p == UserTokenPurposePasswordReset {
+		return PasswordResetTokenTTL
+	}
+	return EmailVerificationTokenTTL
+}
@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewUserToken(t *testing.T) {
+	user, err := NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		purpose UserTokenPurpose
+		ttl     time.Duration
+	}{
+		{purpose: UserTokenPurposeEmailVerification, ttl: EmailVerificationTokenTTL},
+		{purpose: UserTokenPurposePasswordReset, ttl: PasswordResetTokenTTL},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.purpose), func(t *testing.T) {
+			userToken, token, err := NewUserToken(user, tt.purpose)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !strings.HasPrefix(token, userTokenPrefix) {
+				t.Errorf("expected token to start with %s, got %s", userTokenPrefix, token)
+			}
+			if userToken.TokenHash != HashUserToken(token) {
+				t.Error("expected the token hash to match the token")
+			}
+			if userToken.UserID != user.ID || userToken.Email != user.Email {
+				t.Errorf("unexpected owner %s %s", userToken.UserID, userToken.Email)
+			}
+			if got := userToken.ExpiresAt.Sub(userToken.CreatedAt); got != tt.ttl {
+				t.Errorf("expected ttl %s, got %s", tt.ttl, got)
+			}
+		})
+	}
+}
+
+func TestUserToken_Usable(t *testing.T) {
+	user, err := NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	userToken, _, err := NewUserToken(user, UserTokenPurposePasswordReset)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	now := userToken.CreatedAt
+
+	if !userToken.Usable(UserTokenPurposePasswordReset, now) {
+		t.Error("expected a new token to be usable")
+	}
+	if userToken.Usable(UserTokenPurposeEmailVerification, now) {
+		t.Error("expected a token not to be usable for another purpose")
+	}
+	if userToken.Usable(UserTokenPurposePasswordReset, userToken.ExpiresAt) {
+		t.Error("expected the token to expire at ExpiresAt")
+	}
+
+	userToken.MarkUsed(now)
+	if userToken.Usable(UserTokenPurposePasswordReset, now) {
+		t.Error("expected a used token not to be usable")
+	}
+}
+
+func TestUserToken_IssuedFor(t *testing.T) {
+	user, err := NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	userToken, _, err := NewUserToken(user, UserTokenPurposeEmailVerification)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !userToken.IssuedFor(user) {
+		t.Error("expected the token to be issued for its user")
+	}
+
+	other, err := NewUser("Jane Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if userToken.IssuedFor(other) {
+		t.Error("expected the token not to be issued for another user")
+	}
+
+	if err := user.Update("", "jane@example.com"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if userToken.IssuedFor(user) {
+		t.Error("expected the token to be invalid after the email changed")
+	}
+}
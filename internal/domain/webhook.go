@@ -0,0 +1,284 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// 送信するWebhookのリクエストヘッダー
+const (
+	// WebhookIDHeader 配信するイベントのID（再送でも変わらないため、受信側の重複排除に使える）
+	WebhookIDHeader = "X-Webhook-ID"
+	// WebhookEventHeader 配信するイベントの種類
+	WebhookEventHeader = "X-Webhook-Event"
+	// WebhookTimestampHeader 署名した日時（Unix秒）
+	WebhookTimestampHeader = "X-Webhook-Timestamp"
+	// WebhookSignatureHeader 署名（"v1=" + "<タイムスタンプ>.<ボディ>" のHMAC-SHA256の16進文字列）
+	WebhookSignatureHeader = "X-Webhook-Signature"
+)
+
+const (
+	// WebhookURLMaxLength WebhookのURLの最大文字数
+	WebhookURLMaxLength = 2048
+	// WebhookSignatureTolerance 受信側が許容する署名日時のずれ（これより古いリクエストはリプレイとみなす）
+	WebhookSignatureTolerance = 5 * time.Minute
+	// WebhookMaxConsecutiveFailures 連続して失敗した配信がこの回数に達したWebhookは自動的に無効にする
+	WebhookMaxConsecutiveFailures = 20
+)
+
+// webhookSecretPrefix Webhookの署名シークレットの先頭に付ける識別子
+const webhookSecretPrefix = "whsec_"
+
+// webhookSignatureVersion 署名の形式のバージョン
+const webhookSignatureVersion = "v1"
+
+// WebhookEventTypes Webhookで購読できるイベントの種類（ユーザーのドメインイベント）
+var WebhookEventTypes = []string{
+	EventTypeUserCreated,
+	EventTypeUserRenamed,
+	EventTypeUserEmailChanged,
+	EventTypeUserEmailVerified,
+	EventTypeUserEmailInvalidated,
+	EventTypeUserPasswordChanged,
+	EventTypeUserDeleted,
+}
+
+// WebhookSubscription 外部へのWebhookの購読のドメインモデル
+// 購読したイベントが発生すると、ワーカーのジョブが署名付きのリクエストをURLへ送信する
+type WebhookSubscription struct {
+	ID string
+	// OrganizationID 購読を作成した組織のID（この組織のイベントのみを配信する）
+	OrganizationID string
+	URL            string
+	// Secret 署名に使うシークレット（作成時に一度だけ利用者に返す）
+	Secret     string
+	EventTypes []string
+	// ConsecutiveFailures 最後に成功してから連続して失敗した配信の回数
+	ConsecutiveFailures int
+	// DisabledAt 無効にされた日時（nil の場合は有効）
+	DisabledAt *time.Time
+	// DisabledReason 自動で無効にされた理由（最後の配信のエラー。手動で無効にした場合は空）
+	DisabledReason string
+	// CreatedBy 作成した主体（Principal.String() の形式）
+	CreatedBy string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewWebhookSubscription Webhookの購読を作成し、署名シークレットを生成する
+func NewWebhookSubscription(rawURL string, eventTypes []string, createdBy Principal) (*WebhookSubscription, error) {
+	normalizedURL, err := normalizeWebhookURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	normalizedEventTypes, err := normalizeWebhookEventTypes(eventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	secretBytes := make([]byte, 32)
+	if _, err := rand.Read(secretBytes); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &WebhookSubscription{
+		ID:         ulid.MustNew(ulid.Timestamp(now), rand.Reader).String(),
+		URL:        normalizedURL,
+		Secret:     webhookSecretPrefix + base64.RawURLEncoding.EncodeToString(secretBytes),
+		EventTypes: normalizedEventTypes,
+		CreatedBy:  createdBy.String(),
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}, nil
+}
+
+// Update URL・イベントの種類・有効かどうかを変更する（nil・空の項目は変更しない）
+// 有効に戻した場合は連続した失敗の回数をリセットする
+func (s *WebhookSubscription) Update(rawURL string, eventTypes []string, active *bool, now time.Time) error {
+	if rawURL != "" {
+		normalizedURL, err := normalizeWebhookURL(rawURL)
+		if err != nil {
+			return err
+		}
+		s.URL = normalizedURL
+	}
+	if eventTypes != nil {
+		normalizedEventTypes, err := normalizeWebhookEventTypes(eventTypes)
+		if err != nil {
+			return err
+		}
+		s.EventTypes = normalizedEventTypes
+	}
+	if active != nil && *active != s.Active() {
+		if *active {
+			s.DisabledAt = nil
+			s.DisabledReason = ""
+			s.ConsecutiveFailures = 0
+		} else {
+			s.DisabledAt = &now
+			s.DisabledReason = ""
+		}
+	}
+	s.UpdatedAt = now
+	return nil
+}
+
+// Active 配信するかどうか（無効にされていない）
+func (s *WebhookSubscription) Active() bool {
+	return s.DisabledAt == nil
+}
+
+// Subscribes イベントの種類を購読しているかどうか
+func (s *WebhookSubscription) Subscribes(eventType string) bool {
+	return slices.Contains(s.EventTypes, eventType)
+}
+
+// RecordDeliverySuccess 配信の成功を記録し、連続した失敗の回数をリセットする
+func (s *WebhookSubscription) RecordDeliverySuccess(now time.Time) {
+	s.ConsecutiveFailures = 0
+	s.UpdatedAt = now
+}
+
+// RecordDeliveryFailure 配信の失敗を記録し、失敗が続いている場合は無効にする（無効にした場合は true）
+func (s *WebhookSubscription) RecordDeliveryFailure(reason string, now time.Time) bool {
+	s.ConsecutiveFailures++
+	s.UpdatedAt = now
+	if !s.Active() || s.ConsecutiveFailures < WebhookMaxConsecutiveFailures {
+		return false
+	}
+	s.DisabledAt = &now
+	s.DisabledReason = reason
+	return true
+}
+
+// SignWebhook 署名の日時とボディからWebhookの署名を作成する
+func SignWebhook(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return webhookSignatureVersion + "=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyWebhookSignature Webhookの署名を検証する（受信側の実装例・テスト用）
+// 署名の日時が now から WebhookSignatureTolerance 以上ずれている場合は、リプレイとみなして拒否する
+func VerifyWebhookSignature(secret, timestamp, signature string, body []byte, now time.Time) bool {
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+	signedAt := time.Unix(unix, 0)
+	if now.Sub(signedAt).Abs() > WebhookSignatureTolerance {
+		return false
+	}
+	return hmac.Equal([]byte(SignWebhook(secret, signedAt, body)), []byte(signature))
+}
+
+// WebhookRequest 送信する署名付きのWebhookのリクエスト
+type WebhookRequest struct {
+	URL       string
+	EventID   string
+	EventType string
+	// Timestamp 署名した日時（受信側はこの日時でリプレイを検出する）
+	Timestamp time.Time
+	Signature string
+	Body      []byte
+}
+
+// NewWebhookRequest Webhookのシークレットでボディに署名したリクエストを作成する
+// 再送のたびに署名し直すため、受信側はタイムスタンプではなく EventID で重複を判定する
+func NewWebhookRequest(subscription *WebhookSubscription, eventID, eventType string, body []byte, now time.Time) WebhookRequest {
+	return WebhookRequest{
+		URL:       subscription.URL,
+		EventID:   eventID,
+		EventType: eventType,
+		Timestamp: now,
+		Signature: SignWebhook(subscription.Secret, now, body),
+		Body:      body,
+	}
+}
+
+// normalizeWebhookURL URLを検証する（http・https の絶対URLのみ）
+func normalizeWebhookURL(rawURL string) (string, error) {
+	rawURL = strings.TrimSpace(rawURL)
+	if rawURL == "" || len(rawURL) > WebhookURLMaxLength {
+		return "", ErrWebhookURLInvalid()
+	}
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" || parsed.User != nil {
+		return "", ErrWebhookURLInvalid()
+	}
+	return parsed.String(), nil
+}
+
+// normalizeWebhookEventTypes イベントの種類を検証し、重複を除く
+func normalizeWebhookEventTypes(eventTypes []string) ([]string, error) {
+	var normalized []string
+	for _, eventType := range eventTypes {
+		eventType = strings.TrimSpace(eventType)
+		if !slices.Contains(WebhookEventTypes, eventType) {
+			return nil, ErrWebhookEventTypeInvalid(eventType)
+		}
+		if !slices.Contains(normalized, eventType) {
+			normalized = append(normalized, eventType)
+		}
+	}
+	if len(normalized) == 0 {
+		return nil, ErrWebhookEventTypesRequired()
+	}
+	return normalized, nil
+}
+
+// WebhookDelivery Webhookの配信の記録（試行ごとに1件）
+type WebhookDelivery struct {
+	ID             string
+	SubscriptionID string
+	OrganizationID string
+	// EventID 配信したアウトボックスのイベントのID
+	EventID   string
+	EventType string
+	// Attempt 同じイベントの何回目の配信か（1から）
+	Attempt int
+	// StatusCode 受信側が返したHTTPステータス（応答がなかった場合は0）
+	StatusCode int
+	// Error 失敗した理由（成功した場合は空）
+	Error       string
+	Duration    time.Duration
+	DeliveredAt time.Time
+}
+
+// NewWebhookDelivery 配信の記録を作成する
+func NewWebhookDelivery(subscription *WebhookSubscription, eventID, eventType string, attempt, statusCode int, deliveryErr error, duration time.Duration) *WebhookDelivery {
+	now := time.Now()
+	delivery := &WebhookDelivery{
+		ID:             ulid.MustNew(ulid.Timestamp(now), rand.Reader).String(),
+		SubscriptionID: subscription.ID,
+		OrganizationID: subscription.OrganizationID,
+		EventID:        eventID,
+		EventType:      eventType,
+		Attempt:        attempt,
+		StatusCode:     statusCode,
+		Duration:       duration,
+		DeliveredAt:    now,
+	}
+	if deliveryErr != nil {
+		delivery.Error = deliveryErr.Error()
+	}
+	return delivery
+}
+
+// Succeeded 配信に成功したかどうか
+func (d *WebhookDelivery) Succeeded() bool {
+	return d.Error == ""
+}
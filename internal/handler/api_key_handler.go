@@ -0,0 +1,157 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+// APIKeyHandler APIキー関連のHTTPハンドラー（ServerInterface のうち ApiKeys を実装）
+type APIKeyHandler struct {
+	createAPIKey *usecase.CreateAPIKeyUsecase
+	findAPIKey   *usecase.FindAPIKeyUsecase
+	listAPIKeys  *usecase.ListAPIKeysUsecase
+	revokeAPIKey *usecase.RevokeAPIKeyUsecase
+}
+
+// NewAPIKeyHandler APIKeyHandlerのコンストラクタ
+func NewAPIKeyHandler(
+	createAPIKey *usecase.CreateAPIKeyUsecase,
+	findAPIKey *usecase.FindAPIKeyUsecase,
+	listAPIKeys *usecase.ListAPIKeysUsecase,
+	revokeAPIKey *usecase.RevokeAPIKeyUsecase,
+) *APIKeyHandler {
+	return &APIKeyHandler{
+		createAPIKey: createAPIKey,
+		findAPIKey:   findAPIKey,
+		listAPIKeys:  listAPIKeys,
+		revokeAPIKey: revokeAPIKey,
+	}
+}
+
+// ApiKeysCreateApiKey APIキーを作成し、シークレットを一度だけ返す（OpenAPI ServerInterface実装）
+func (h *APIKeyHandler) ApiKeysCreateApiKey(w http.ResponseWriter, r *http.Request) {
+	var req openapi.CreateApiKeyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストの形式が不正です")
+		return
+	}
+
+	var scopes []string
+	if req.Scopes != nil {
+		scopes = *req.Scopes
+	}
+
+	ctx := r.Context()
+	apiKey, token, err := h.createAPIKey.Execute(ctx, req.Name, scopes, req.ExpiresAt)
+	if err != nil {
+		HandleError(w, err, logger.FromContext(ctx))
+		return
+	}
+
+	resp := toAPIKeyResponse(apiKey)
+	w.Header().Set("Location", apiKeyLocation(apiKey.ID))
+	// シークレットを含むため、キャッシュや Idempotency-Key の記録に残さない
+	w.Header().Set("Cache-Control", "no-store")
+	respondJSON(w, http.StatusCreated, openapi.CreatedApiKey{
+		Id:         resp.Id,
+		Name:       resp.Name,
+		Prefix:     resp.Prefix,
+		Scopes:     resp.Scopes,
+		ExpiresAt:  resp.ExpiresAt,
+		LastUsedAt: resp.LastUsedAt,
+		RevokedAt:  resp.RevokedAt,
+		CreatedBy:  resp.CreatedBy,
+		CreatedAt:  resp.CreatedAt,
+		Secret:     token,
+	})
+}
+
+// ApiKeysGetApiKey APIキーを取得（OpenAPI ServerInterface実装）
+func (h *APIKeyHandler) ApiKeysGetApiKey(w http.ResponseWriter, r *http.Request, apiKeyId string) {
+	ctx := r.Context()
+	apiKey, err := h.findAPIKey.Execute(ctx, apiKeyId)
+	if err != nil {
+		HandleError(w, err, logger.FromContext(ctx))
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toAPIKeyResponse(apiKey))
+}
+
+// ApiKeysListApiKeys APIキー一覧を取得（OpenAPI ServerInterface実装）
+func (h *APIKeyHandler) ApiKeysListApiKeys(w http.ResponseWriter, r *http.Request, params openapi.ApiKeysListApiKeysParams) {
+	ctx := r.Context()
+
+	// デフォルト値の設定
+	limit := 10
+	offset := 0
+	includeRevoked := false
+
+	if params.Limit != nil {
+		if *params.Limit > 0 && *params.Limit <= 100 {
+			limit = int(*params.Limit)
+		}
+	}
+	if params.Offset != nil && *params.Offset >= 0 {
+		offset = int(*params.Offset)
+	}
+	if params.IncludeRevoked != nil {
+		includeRevoked = *params.IncludeRevoked
+	}
+
+	apiKeys, total, err := h.listAPIKeys.Execute(ctx, includeRevoked, limit, offset)
+	if err != nil {
+		HandleError(w, err, logger.FromContext(ctx))
+		return
+	}
+
+	apiKeyResponses := make([]openapi.ApiKey, 0, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		apiKeyResponses = append(apiKeyResponses, toAPIKeyResponse(apiKey))
+	}
+
+	respondJSON(w, http.StatusOK, openapi.ApiKeyList{
+		ApiKeys: apiKeyResponses,
+		Total:   int32(total),
+	})
+}
+
+// ApiKeysRevokeApiKey APIキーを失効させる（OpenAPI ServerInterface実装）
+func (h *APIKeyHandler) ApiKeysRevokeApiKey(w http.ResponseWriter, r *http.Request, apiKeyId string) {
+	ctx := r.Context()
+	if err := h.revokeAPIKey.Execute(ctx, apiKeyId); err != nil {
+		HandleError(w, err, logger.FromContext(ctx))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// apiKeyLocation 作成したAPIキーを指すLocationヘッダーの値を返す
+func apiKeyLocation(id string) string {
+	return "/api/v1/api-keys/" + id
+}
+
+// toAPIKeyResponse domain.APIKeyをAPIレスポンスのApiKeyに変換（シークレットのハッシュは含めない）
+func toAPIKeyResponse(apiKey *domain.APIKey) openapi.ApiKey {
+	scopes := apiKey.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+	return openapi.ApiKey{
+		Id:         apiKey.ID,
+		Name:       apiKey.Name,
+		Prefix:     apiKey.DisplayPrefix(),
+		Scopes:     scopes,
+		ExpiresAt:  apiKey.ExpiresAt,
+		LastUsedAt: apiKey.LastUsedAt,
+		RevokedAt:  apiKey.RevokedAt,
+		CreatedBy:  apiKey.CreatedBy,
+		CreatedAt:  apiKey.CreatedAt,
+	}
+}
@@ -0,0 +1,195 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+// mockAPIKeyQuery はテスト用のAPIKeyQueryRepositoryモック
+type mockAPIKeyQuery struct {
+	apiKeys []*domain.APIKey
+}
+
+func (m *mockAPIKeyQuery) filter(includeRevoked bool) []*domain.APIKey {
+	var result []*domain.APIKey
+	for _, k := range m.apiKeys {
+		if !includeRevoked && k.RevokedAt != nil {
+			continue
+		}
+		result = append(result, k)
+	}
+	return result
+}
+
+func (m *mockAPIKeyQuery) FindByID(_ context.Context, id string) (*domain.APIKey, error) {
+	for _, k := range m.apiKeys {
+		if k.ID == id {
+			return k, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockAPIKeyQuery) FindByPrefix(_ context.Context, prefix string) (*domain.APIKey, error) {
+	for _, k := range m.apiKeys {
+		if k.Prefix == prefix {
+			return k, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockAPIKeyQuery) FindAll(_ context.Context, includeRevoked bool, limit, offset int) ([]*domain.APIKey, error) {
+	apiKeys := m.filter(includeRevoked)
+	if offset >= len(apiKeys) {
+		return nil, nil
+	}
+	return apiKeys[offset:min(offset+limit, len(apiKeys))], nil
+}
+
+func (m *mockAPIKeyQuery) Count(_ context.Context, includeRevoked bool) (int, error) {
+	return len(m.filter(includeRevoked)), nil
+}
+
+func newAPIKeysTestHandler() *APIKeyHandler {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	query := &mockAPIKeyQuery{apiKeys: []*domain.APIKey{
+		{
+			ID: "01ARZ3NDEKTSV4RRFFQ69G5FC1", Name: "nightly-sync", Prefix: "0123456789abcdef", SecretHash: "secret-hash",
+			Scopes: []string{"users:read"}, CreatedBy: "user:01ARZ3NDEKTSV4RRFFQ69G5FAV", CreatedAt: now,
+		},
+		{
+			ID: "01ARZ3NDEKTSV4RRFFQ69G5FC0", Name: "old-sync", Prefix: "fedcba9876543210", SecretHash: "secret-hash",
+			Scopes: []string{}, RevokedAt: &now, CreatedBy: "user:01ARZ3NDEKTSV4RRFFQ69G5FAV", CreatedAt: now.Add(-time.Hour),
+		},
+	}}
+	return &APIKeyHandler{
+		createAPIKey: usecase.NewCreateAPIKeyUsecase(nil),
+		findAPIKey:   usecase.NewFindAPIKeyUsecase(query),
+		listAPIKeys:  usecase.NewListAPIKeysUsecase(query),
+	}
+}
+
+func TestApiKeysListApiKeys(t *testing.T) {
+	includeRevoked := true
+
+	tests := []struct {
+		name      string
+		params    openapi.ApiKeysListApiKeysParams
+		wantTotal int32
+	}{
+		{name: "active keys", wantTotal: 1},
+		{name: "including revoked keys", params: openapi.ApiKeysListApiKeysParams{IncludeRevoked: &includeRevoked}, wantTotal: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newAPIKeysTestHandler()
+
+			req := newAdminRequest(http.MethodGet, "/api-keys", nil)
+			rec := httptest.NewRecorder()
+
+			h.ApiKeysListApiKeys(rec, req, tt.params)
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+			}
+			if strings.Contains(rec.Body.String(), "secret") {
+				t.Errorf("expected response not to contain secrets, got %s", rec.Body.String())
+			}
+
+			var resp openapi.ApiKeyList
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if resp.Total != tt.wantTotal {
+				t.Errorf("expected total %d, got %d", tt.wantTotal, resp.Total)
+			}
+			if resp.ApiKeys[0].Prefix != "cqk_0123456789abcdef" {
+				t.Errorf("expected prefix cqk_0123456789abcdef, got %s", resp.ApiKeys[0].Prefix)
+			}
+		})
+	}
+}
+
+func TestApiKeysGetApiKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		apiKeyID   string
+		wantStatus int
+	}{
+		{name: "existing key", apiKeyID: "01ARZ3NDEKTSV4RRFFQ69G5FC1", wantStatus: http.StatusOK},
+		{name: "unknown key", apiKeyID: "01ARZ3NDEKTSV4RRFFQ69G5FC9", wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newAPIKeysTestHandler()
+
+			req := newAdminRequest(http.MethodGet, "/api-keys/"+tt.apiKeyID, nil)
+			rec := httptest.NewRecorder()
+
+			h.ApiKeysGetApiKey(rec, req, tt.apiKeyID)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, rec.Code)
+			}
+		})
+	}
+}
+
+func TestApiKeysCreateApiKey_ScopeNotHeld(t *testing.T) {
+	// api_keys:manage だけを持つ主体は、自分が持たない権限をAPIキーに付与できない
+	principal := domain.NewAPIKeyPrincipal("01ARZ3NDEKTSV4RRFFQ69G5FC1").WithScopes("api_keys:manage", "users:read")
+
+	for _, scopes := range []string{`["users:delete"]`, `["users:*"]`, `["audit:read"]`} {
+		t.Run(scopes, func(t *testing.T) {
+			h := newAPIKeysTestHandler()
+
+			req := newRequestAs(principal, http.MethodPost, "/api-keys", strings.NewReader(`{"name":"nightly-sync","scopes":`+scopes+`}`))
+			rec := httptest.NewRecorder()
+
+			h.ApiKeysCreateApiKey(rec, req)
+
+			if rec.Code != http.StatusForbidden {
+				t.Errorf("expected status %d, got %d", http.StatusForbidden, rec.Code)
+			}
+		})
+	}
+}
+
+func TestApiKeysCreateApiKey_Validation(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "malformed body", body: `{`},
+		{name: "invalid scope", body: `{"name":"nightly-sync","scopes":["Users Read"]}`},
+		{name: "unknown scope", body: `{"name":"nightly-sync","scopes":["users:write"]}`},
+		{name: "expiry in past", body: `{"name":"nightly-sync","expiresAt":"2020-01-01T00:00:00Z"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newAPIKeysTestHandler()
+
+			req := newAdminRequest(http.MethodPost, "/api-keys", strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+
+			h.ApiKeysCreateApiKey(rec, req)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Errorf("expected status %d, got %d", http.StatusBadRequest, rec.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+// AuditEventHandler 監査関連のHTTPハンドラー（ServerInterface のうち AuditEvents・UserLogs を実装）
+type AuditEventHandler struct {
+	listAuditEvents    *usecase.ListAuditEventsUsecase
+	verifyUserLogChain *usecase.VerifyUserLogChainUsecase
+}
+
+// NewAuditEventHandler AuditEventHandlerのコンストラクタ
+func NewAuditEventHandler(
+	listAuditEvents *usecase.ListAuditEventsUsecase,
+	verifyUserLogChain *usecase.VerifyUserLogChainUsecase,
+) *AuditEventHandler {
+	return &AuditEventHandler{
+		listAuditEvents:    listAuditEvents,
+		verifyUserLogChain: verifyUserLogChain,
+	}
+}
+
+// AuditEventsListAuditEvents 監査イベントを検索（OpenAPI ServerInterface実装）
+func (h *AuditEventHandler) AuditEventsListAuditEvents(w http.ResponseWriter, r *http.Request, params openapi.AuditEventsListAuditEventsParams) {
+	ctx := r.Context()
+
+	// デフォルト値の設定
+	limit := 10
+	offset := 0
+
+	if params.Limit != nil {
+		if *params.Limit > 0 && *params.Limit <= 100 {
+			limit = int(*params.Limit)
+		}
+	}
+
+	if params.Offset != nil && *params.Offset >= 0 {
+		offset = int(*params.Offset)
+	}
+
+	var filter domain.AuditEventFilter
+	if params.AggregateType != nil {
+		filter.AggregateType = domain.AuditAggregateType(*params.AggregateType)
+	}
+	if params.AggregateId != nil {
+		filter.AggregateID = *params.AggregateId
+	}
+	if params.Action != nil {
+		filter.Action = *params.Action
+	}
+	if params.Actor != nil {
+		filter.Actor = *params.Actor
+	}
+	if params.Since != nil {
+		filter.Since = *params.Since
+	}
+	if params.Until != nil {
+		filter.Until = *params.Until
+	}
+
+	events, total, err := h.listAuditEvents.Execute(ctx, filter, limit, offset)
+	if err != nil {
+		HandleError(w, err, logger.FromContext(ctx))
+		return
+	}
+
+	eventResponses := make([]openapi.AuditEvent, 0, len(events))
+	for _, e := range events {
+		eventResponses = append(eventResponses, toAuditEventResponse(e))
+	}
+
+	respondJSON(w, http.StatusOK, openapi.AuditEventList{
+		Events: eventResponses,
+		Total:  int32(total),
+	})
+}
+
+// UserLogsVerifyUserLogChain ユーザーログのハッシュチェーンを検証（OpenAPI ServerInterface実装）
+func (h *AuditEventHandler) UserLogsVerifyUserLogChain(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	result, err := h.verifyUserLogChain.Execute(ctx)
+	if err != nil {
+		HandleError(w, err, logger.FromContext(ctx))
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toUserLogChainVerificationResponse(result))
+}
+
+// toUserLogChainVerificationResponse domain.UserLogChainVerificationをAPIレスポンスに変換
+func toUserLogChainVerificationResponse(v *domain.UserLogChainVerification) openapi.UserLogChainVerification {
+	resp := openapi.UserLogChainVerification{
+		Valid:        v.Valid(),
+		CheckedCount: int32(v.CheckedCount),
+		HeadSeq:      v.Head.Seq,
+	}
+	if v.Break != nil {
+		chainBreak := &openapi.UserLogChainBreak{
+			Seq:    v.Break.Seq,
+			Reason: openapi.UserLogChainBreakReason(v.Break.Reason),
+		}
+		if v.Break.LogID != "" {
+			chainBreak.LogId = &v.Break.LogID
+		}
+		resp.Break = chainBreak
+	}
+	return resp
+}
+
+// toAuditEventResponse domain.AuditEventをAPIレスポンスのAuditEventに変換
+func toAuditEventResponse(e *domain.AuditEvent) openapi.AuditEvent {
+	// Payload は保存時にJSONオブジェクトであることを検証済み
+	payload := map[string]interface{}{}
+	_ = json.Unmarshal(e.Payload, &payload)
+
+	metadata := openapi.AuditRequestMetadata{}
+	if e.Metadata.RemoteAddr != "" {
+		metadata.RemoteAddr = &e.Metadata.RemoteAddr
+	}
+	if e.Metadata.UserAgent != "" {
+		metadata.UserAgent = &e.Metadata.UserAgent
+	}
+
+	return openapi.AuditEvent{
+		Id:            e.ID,
+		AggregateType: string(e.AggregateType),
+		AggregateId:   e.AggregateID,
+		Action:        e.Action,
+		Actor:         e.Actor,
+		RequestId:     e.RequestID,
+		Metadata:      metadata,
+		Payload:       payload,
+		CreatedAt:     e.CreatedAt,
+	}
+}
@@ -0,0 +1,157 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+// mockAuditEventQuery はテスト用のAuditEventQueryRepositoryモック
+type mockAuditEventQuery struct {
+	events []*domain.AuditEvent
+}
+
+func (m *mockAuditEventQuery) filter(filter domain.AuditEventFilter) []*domain.AuditEvent {
+	var result []*domain.AuditEvent
+	for _, e := range m.events {
+		if filter.AggregateType != "" && e.AggregateType != filter.AggregateType {
+			continue
+		}
+		if filter.Actor != "" && e.Actor != filter.Actor {
+			continue
+		}
+		if !filter.Since.IsZero() && e.CreatedAt.Before(filter.Since) {
+			continue
+		}
+		result = append(result, e)
+	}
+	return result
+}
+
+func (m *mockAuditEventQuery) FindAll(_ context.Context, filter domain.AuditEventFilter, limit, offset int) ([]*domain.AuditEvent, error) {
+	events := m.filter(filter)
+	if offset >= len(events) {
+		return nil, nil
+	}
+	return events[offset:min(offset+limit, len(events))], nil
+}
+
+func (m *mockAuditEventQuery) Count(_ context.Context, filter domain.AuditEventFilter) (int, error) {
+	return len(m.filter(filter)), nil
+}
+
+func newAuditEventsTestHandler() *AuditEventHandler {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	query := &mockAuditEventQuery{events: []*domain.AuditEvent{
+		{
+			ID: "01ARZ3NDEKTSV4RRFFQ69G5FB3", AggregateType: domain.AuditAggregateTypeUserImport, AggregateID: "01ARZ3NDEKTSV4RRFFQ69G5FB0",
+			Action: "completed", Actor: "system:process_user_import", Payload: json.RawMessage(`{"totalRows":2}`), CreatedAt: now.Add(time.Hour),
+		},
+		{
+			ID: "01ARZ3NDEKTSV4RRFFQ69G5FB2", AggregateType: domain.AuditAggregateTypeUser, AggregateID: testActiveUserID,
+			Action: "created", Actor: "anonymous", RequestID: "req-1",
+			Metadata: domain.RequestMetadata{RemoteAddr: "192.0.2.1", UserAgent: "curl/8.0"},
+			Payload:  json.RawMessage(`{"name":"John Doe","email":"john@example.com"}`), CreatedAt: now,
+		},
+	}}
+	return &AuditEventHandler{listAuditEvents: usecase.NewListAuditEventsUsecase(query)}
+}
+
+func TestAuditEventsListAuditEvents(t *testing.T) {
+	aggregateType := string(domain.AuditAggregateTypeUser)
+	since := time.Date(2026, 1, 2, 4, 0, 0, 0, time.UTC)
+	until := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		params     openapi.AuditEventsListAuditEventsParams
+		wantStatus int
+		wantTotal  int32
+	}{
+		{
+			name:       "all events",
+			wantStatus: http.StatusOK,
+			wantTotal:  2,
+		},
+		{
+			name:       "filtered by aggregate type",
+			params:     openapi.AuditEventsListAuditEventsParams{AggregateType: &aggregateType},
+			wantStatus: http.StatusOK,
+			wantTotal:  1,
+		},
+		{
+			name:       "filtered by period",
+			params:     openapi.AuditEventsListAuditEventsParams{Since: &since},
+			wantStatus: http.StatusOK,
+			wantTotal:  1,
+		},
+		{
+			name:       "invalid period",
+			params:     openapi.AuditEventsListAuditEventsParams{Since: &since, Until: &until},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newAuditEventsTestHandler()
+
+			req := newAdminRequest(http.MethodGet, "/audit-events", nil)
+			rec := httptest.NewRecorder()
+
+			h.AuditEventsListAuditEvents(rec, req, tt.params)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, rec.Code)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			var resp openapi.AuditEventList
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if resp.Total != tt.wantTotal {
+				t.Errorf("expected total %d, got %d", tt.wantTotal, resp.Total)
+			}
+			if len(resp.Events) != int(tt.wantTotal) {
+				t.Errorf("expected %d events, got %d", tt.wantTotal, len(resp.Events))
+			}
+		})
+	}
+}
+
+func TestToAuditEventResponse(t *testing.T) {
+	h := newAuditEventsTestHandler()
+	req := newAdminRequest(http.MethodGet, "/audit-events", nil)
+	rec := httptest.NewRecorder()
+	aggregateType := string(domain.AuditAggregateTypeUser)
+
+	h.AuditEventsListAuditEvents(rec, req, openapi.AuditEventsListAuditEventsParams{AggregateType: &aggregateType})
+
+	var resp openapi.AuditEventList
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(resp.Events) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(resp.Events))
+	}
+	event := resp.Events[0]
+	if event.Payload["email"] != "john@example.com" {
+		t.Errorf("expected payload email john@example.com, got %v", event.Payload["email"])
+	}
+	if event.Metadata.RemoteAddr == nil || *event.Metadata.RemoteAddr != "192.0.2.1" {
+		t.Errorf("expected metadata remoteAddr 192.0.2.1, got %v", event.Metadata.RemoteAddr)
+	}
+	if event.RequestId != "req-1" {
+		t.Errorf("expected requestId req-1, got %s", event.RequestId)
+	}
+}
@@ -0,0 +1,188 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+// SessionCookieName セッショントークンを格納するCookieの名前（OpenAPIの SessionCookieAuth と同じ）
+const SessionCookieName = "session_id"
+
+// AuthHandler ログイン・セッション関連のHTTPハンドラー（ServerInterface のうち Auth を実装）
+type AuthHandler struct {
+	login         *usecase.LoginUsecase
+	logout        *usecase.LogoutUsecase
+	listSessions  *usecase.ListSessionsUsecase
+	revokeSession *usecase.RevokeSessionUsecase
+	verifyEmail   *usecase.VerifyEmailUsecase
+	requestReset  *usecase.RequestPasswordResetUsecase
+	resetPassword *usecase.ResetPasswordUsecase
+
+	// cookieSecure セッションCookieに Secure 属性を付けるかどうか
+	cookieSecure bool
+}
+
+// NewAuthHandler AuthHandlerのコンストラクタ
+func NewAuthHandler(
+	login *usecase.LoginUsecase,
+	logout *usecase.LogoutUsecase,
+	listSessions *usecase.ListSessionsUsecase,
+	revokeSession *usecase.RevokeSessionUsecase,
+	verifyEmail *usecase.VerifyEmailUsecase,
+	requestReset *usecase.RequestPasswordResetUsecase,
+	resetPassword *usecase.ResetPasswordUsecase,
+	cookieSecure bool,
+) *AuthHandler {
+	return &AuthHandler{
+		login:         login,
+		logout:        logout,
+		listSessions:  listSessions,
+		revokeSession: revokeSession,
+		verifyEmail:   verifyEmail,
+		requestReset:  requestReset,
+		resetPassword: resetPassword,
+		cookieSecure:  cookieSecure,
+	}
+}
+
+// AuthLogin ログインしてセッションCookieを設定（OpenAPI ServerInterface実装）
+func (h *AuthHandler) AuthLogin(w http.ResponseWriter, r *http.Request) {
+	var req openapi.LoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストの形式が不正です")
+		return
+	}
+
+	ctx := r.Context()
+	session, token, err := h.login.Execute(ctx, string(req.Email), req.Password)
+	if err != nil {
+		HandleError(w, err, logger.FromContext(ctx))
+		return
+	}
+
+	http.SetCookie(w, h.sessionCookie(token, session.ExpiresAt))
+	respondJSON(w, http.StatusOK, toSessionResponse(session, session.ID))
+}
+
+// AuthLogout セッションを失効させてセッションCookieを削除（OpenAPI ServerInterface実装）
+func (h *AuthHandler) AuthLogout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if err := h.logout.Execute(ctx); err != nil {
+		HandleError(w, err, logger.FromContext(ctx))
+		return
+	}
+
+	cookie := h.sessionCookie("", time.Unix(0, 0))
+	cookie.MaxAge = -1
+	http.SetCookie(w, cookie)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// AuthListSessions ログイン中のユーザーのセッション一覧を取得（OpenAPI ServerInterface実装）
+func (h *AuthHandler) AuthListSessions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	sessions, err := h.listSessions.Execute(ctx)
+	if err != nil {
+		HandleError(w, err, logger.FromContext(ctx))
+		return
+	}
+
+	currentSessionID := domain.PrincipalFromContext(ctx).SessionID
+	resp := openapi.SessionList{Sessions: make([]openapi.Session, len(sessions))}
+	for i, session := range sessions {
+		resp.Sessions[i] = toSessionResponse(session, currentSessionID)
+	}
+	respondJSON(w, http.StatusOK, resp)
+}
+
+// AuthRevokeSession セッションを失効（OpenAPI ServerInterface実装）
+func (h *AuthHandler) AuthRevokeSession(w http.ResponseWriter, r *http.Request, sessionId string) {
+	ctx := r.Context()
+	if err := h.revokeSession.Execute(ctx, sessionId); err != nil {
+		HandleError(w, err, logger.FromContext(ctx))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// AuthVerifyEmail 確認メールのトークンでメールアドレスを確認（OpenAPI ServerInterface実装）
+func (h *AuthHandler) AuthVerifyEmail(w http.ResponseWriter, r *http.Request) {
+	var req openapi.VerifyEmailRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストの形式が不正です")
+		return
+	}
+
+	ctx := r.Context()
+	if err := h.verifyEmail.Execute(ctx, req.Token); err != nil {
+		HandleError(w, err, logger.FromContext(ctx))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// AuthRequestPasswordReset パスワード再設定メールを送信（OpenAPI ServerInterface実装）
+func (h *AuthHandler) AuthRequestPasswordReset(w http.ResponseWriter, r *http.Request) {
+	var req openapi.PasswordResetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストの形式が不正です")
+		return
+	}
+
+	ctx := r.Context()
+	if err := h.requestReset.Execute(ctx, string(req.Email)); err != nil {
+		HandleError(w, err, logger.FromContext(ctx))
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// AuthResetPassword パスワード再設定メールのトークンで新しいパスワードを設定（OpenAPI ServerInterface実装）
+func (h *AuthHandler) AuthResetPassword(w http.ResponseWriter, r *http.Request) {
+	var req openapi.ResetPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストの形式が不正です")
+		return
+	}
+
+	ctx := r.Context()
+	if err := h.resetPassword.Execute(ctx, req.Token, req.NewPassword); err != nil {
+		HandleError(w, err, logger.FromContext(ctx))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// sessionCookie セッショントークンを格納するCookie（JavaScriptから読めず、クロスサイトのPOSTでは送信されない）
+func (h *AuthHandler) sessionCookie(token string, expiresAt time.Time) *http.Cookie {
+	return &http.Cookie{
+		Name:     SessionCookieName,
+		Value:    token,
+		Path:     "/",
+		Expires:  expiresAt,
+		HttpOnly: true,
+		Secure:   h.cookieSecure,
+		SameSite: http.SameSiteLaxMode,
+	}
+}
+
+// toSessionResponse domain.Sessionをレスポンス用の型に変換
+func toSessionResponse(s *domain.Session, currentSessionID string) openapi.Session {
+	return openapi.Session{
+		Id:             s.ID,
+		UserId:         s.UserID,
+		OrganizationId: s.OrganizationID,
+		RemoteAddr:     s.RemoteAddr,
+		UserAgent:      s.UserAgent,
+		CreatedAt:      s.CreatedAt,
+		LastSeenAt:     s.LastSeenAt,
+		ExpiresAt:      s.ExpiresAt,
+		Current:        s.ID == currentSessionID,
+	}
+}
@@ -0,0 +1,271 @@
+package handler
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+// mockSessionQuery はテスト用のSessionQueryRepositoryモック
+type mockSessionQuery struct {
+	usecase.SessionQueryRepository
+	sessions []*domain.Session
+}
+
+func (m *mockSessionQuery) FindActiveByUserID(_ context.Context, userID string, now time.Time) ([]*domain.Session, error) {
+	var result []*domain.Session
+	for _, s := range m.sessions {
+		if s.UserID == userID && s.Active(now) {
+			result = append(result, s)
+		}
+	}
+	return result, nil
+}
+
+// testLoginLockout ログインのテストで使うアカウントロックの設定
+var testLoginLockout = domain.LoginLockoutPolicy{MaxFailures: 3, Lockout: 15 * time.Minute}
+
+// newLoginTestDB パスワードを設定したユーザーと、ログイン失敗を保持する fakeDB を作成する
+func newLoginTestDB(t *testing.T) (*fakeDB, *infrastructure.TransactionManager) {
+	t.Helper()
+	user, err := domain.NewUser("John Doe", "john@example.com")
+	if err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	if err := user.SetPassword("correct horse battery staple"); err != nil {
+		t.Fatalf("failed to set password: %v", err)
+	}
+
+	db, txManager := newFakeDB(t)
+	db.handle("GetUserByEmailForUpdate", func(args []driver.Value) ([][]driver.Value, error) {
+		if args[0] != domain.DefaultOrganizationID || !strings.EqualFold(args[1].(string), user.Email) {
+			return nil, nil
+		}
+		return [][]driver.Value{{user.ID, domain.DefaultOrganizationID, user.Name, user.Email, nil, nil, user.PasswordHash, user.CreatedAt, user.UpdatedAt}}, nil
+	})
+
+	// (organization_id, email) ごとのログイン失敗
+	failures := map[string][]driver.Value{}
+	db.handle("GetLoginFailuresForUpdate", func(args []driver.Value) ([][]driver.Value, error) {
+		if row, ok := failures[fmt.Sprint(args[0], ":", args[1])]; ok {
+			return [][]driver.Value{row}, nil
+		}
+		return nil, nil
+	})
+	db.handle("UpsertLoginFailures", func(args []driver.Value) ([][]driver.Value, error) {
+		failures[fmt.Sprint(args[0], ":", args[1])] = args
+		return nil, nil
+	})
+	db.handle("DeleteLoginFailures", func(args []driver.Value) ([][]driver.Value, error) {
+		delete(failures, fmt.Sprint(args[0], ":", args[1]))
+		return nil, nil
+	})
+	return db, txManager
+}
+
+func newLoginTestHandler(txManager usecase.TransactionManager) *AuthHandler {
+	return &AuthHandler{login: usecase.NewLoginUsecase(txManager, command.UserEventSourcing{}, testLoginLockout, time.Hour)}
+}
+
+func postLogin(h *AuthHandler, email, password string) *httptest.ResponseRecorder {
+	body, _ := json.Marshal(map[string]string{"email": email, "password": password})
+	req := httptest.NewRequest(http.MethodPost, "/auth/login", strings.NewReader(string(body)))
+	req = req.WithContext(domain.WithTenant(req.Context(), domain.DefaultOrganizationID))
+	rec := httptest.NewRecorder()
+	h.AuthLogin(rec, req)
+	return rec
+}
+
+func TestAuthLogin_InvalidCredentials(t *testing.T) {
+	_, txManager := newLoginTestDB(t)
+	h := newLoginTestHandler(txManager)
+
+	tests := []struct {
+		name     string
+		email    string
+		password string
+	}{
+		{name: "wrong password", email: "john@example.com", password: "wrong password"},
+		{name: "unknown email", email: "jane@example.com", password: "correct horse battery staple"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := postLogin(h, tt.email, tt.password)
+			if rec.Code != http.StatusUnauthorized {
+				t.Fatalf("expected status %d, got %d (%s)", http.StatusUnauthorized, rec.Code, rec.Body.String())
+			}
+			var resp openapi.Error
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			// 登録済みかどうかを区別しないメッセージ
+			if resp.Message != "メールアドレスまたはパスワードが正しくありません" {
+				t.Errorf("unexpected message %q", resp.Message)
+			}
+			if rec.Header().Get("Set-Cookie") != "" {
+				t.Error("expected no session cookie")
+			}
+		})
+	}
+}
+
+func TestAuthLogin_Success(t *testing.T) {
+	db, txManager := newLoginTestDB(t)
+	h := newLoginTestHandler(txManager)
+
+	if rec := postLogin(h, "john@example.com", "wrong password"); rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected status %d, got %d", http.StatusUnauthorized, rec.Code)
+	}
+	rec := postLogin(h, "John@Example.com", "correct horse battery staple")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d (%s)", http.StatusOK, rec.Code, rec.Body.String())
+	}
+	cookies := rec.Result().Cookies()
+	if len(cookies) != 1 || cookies[0].Name != SessionCookieName || cookies[0].Value == "" {
+		t.Fatalf("expected a session cookie, got %v", cookies)
+	}
+
+	// 認証情報はトランザクション内でロックして読み、成功したら失敗の記録を消去する
+	if got := db.count("GetUserByEmailForUpdate"); got != 2 {
+		t.Errorf("expected credentials to be read in the transaction twice, got %d", got)
+	}
+	if db.count("DeleteLoginFailures") != 1 || db.count("CreateSession") != 1 {
+		t.Errorf("expected failures to be cleared and a session to be saved, got %v", db.executed)
+	}
+	if db.commits != 2 {
+		t.Errorf("expected 2 commits, got %d", db.commits)
+	}
+}
+
+func TestAuthLogin_Lockout(t *testing.T) {
+	db, txManager := newLoginTestDB(t)
+	h := newLoginTestHandler(txManager)
+
+	for i := range 3 {
+		if rec := postLogin(h, "john@example.com", "wrong password"); rec.Code != http.StatusUnauthorized {
+			t.Fatalf("attempt %d: expected status %d, got %d", i+1, http.StatusUnauthorized, rec.Code)
+		}
+	}
+	// 失敗はロールバックせずに記録する
+	if db.commits != 3 {
+		t.Errorf("expected failures to be committed, got %d commits", db.commits)
+	}
+
+	// 上限に達した後は、ほかのサーバー（同じデータベースを使う別のハンドラー）でも
+	// 正しいパスワード（大文字小文字の異なるメールアドレス）を拒否する
+	other := newLoginTestHandler(txManager)
+	rec := postLogin(other, "John@Example.com", "correct horse battery staple")
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected status %d, got %d (%s)", http.StatusTooManyRequests, rec.Code, rec.Body.String())
+	}
+	retryAfter, err := strconv.Atoi(rec.Header().Get("Retry-After"))
+	if err != nil || retryAfter <= 0 || retryAfter > int(testLoginLockout.Lockout.Seconds()) {
+		t.Errorf("expected Retry-After within the lockout, got %q", rec.Header().Get("Retry-After"))
+	}
+	if db.count("CreateSession") != 0 {
+		t.Error("expected no session to be created while locked out")
+	}
+
+	// 他のメールアドレスには影響しない
+	if rec := postLogin(h, "jane@example.com", "wrong password"); rec.Code != http.StatusUnauthorized {
+		t.Errorf("expected status %d for other email, got %d", http.StatusUnauthorized, rec.Code)
+	}
+}
+
+func TestAuthListSessions(t *testing.T) {
+	now := time.Now()
+	sessionQuery := &mockSessionQuery{sessions: []*domain.Session{
+		{ID: "01ARZ3NDEKTSV4RRFFQ69G5FB2", UserID: testActiveUserID, CreatedAt: now, LastSeenAt: now, ExpiresAt: now.Add(time.Hour)},
+		{ID: "01ARZ3NDEKTSV4RRFFQ69G5FB1", UserID: testActiveUserID, CreatedAt: now, LastSeenAt: now, ExpiresAt: now.Add(time.Hour)},
+		{ID: "01ARZ3NDEKTSV4RRFFQ69G5FB0", UserID: testActiveUserID, CreatedAt: now, LastSeenAt: now, ExpiresAt: now.Add(-time.Hour)},
+		{ID: "01ARZ3NDEKTSV4RRFFQ69G5FB3", UserID: testAdminUserID, CreatedAt: now, LastSeenAt: now, ExpiresAt: now.Add(time.Hour)},
+	}}
+	h := &AuthHandler{listSessions: usecase.NewListSessionsUsecase(sessionQuery)}
+
+	t.Run("own sessions", func(t *testing.T) {
+		principal := domain.NewUserPrincipal(testActiveUserID).WithSession("01ARZ3NDEKTSV4RRFFQ69G5FB1")
+		rec := httptest.NewRecorder()
+		h.AuthListSessions(rec, newRequestAs(principal, http.MethodGet, "/auth/sessions", nil))
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d (%s)", http.StatusOK, rec.Code, rec.Body.String())
+		}
+		var resp openapi.SessionList
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if len(resp.Sessions) != 2 {
+			t.Fatalf("expected 2 active sessions, got %d", len(resp.Sessions))
+		}
+		for _, s := range resp.Sessions {
+			if want := s.Id == "01ARZ3NDEKTSV4RRFFQ69G5FB1"; s.Current != want {
+				t.Errorf("session %s: expected current=%v", s.Id, want)
+			}
+		}
+	})
+
+	t.Run("without login", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		h.AuthListSessions(rec, newRequestAs(domain.NewAPIKeyPrincipal("01ARZ3NDEKTSV4RRFFQ69G5FAW"), http.MethodGet, "/auth/sessions", nil))
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("expected status %d, got %d", http.StatusUnauthorized, rec.Code)
+		}
+	})
+}
+
+func TestAuthLogout_WithoutSession(t *testing.T) {
+	h := &AuthHandler{logout: usecase.NewLogoutUsecase(nil), cookieSecure: true}
+
+	rec := httptest.NewRecorder()
+	h.AuthLogout(rec, newAdminRequest(http.MethodPost, "/auth/logout", nil))
+
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("expected status %d, got %d (%s)", http.StatusNoContent, rec.Code, rec.Body.String())
+	}
+	cookie := rec.Result().Cookies()
+	if len(cookie) != 1 || cookie[0].Name != SessionCookieName || cookie[0].MaxAge >= 0 {
+		t.Fatalf("expected the session cookie to be cleared, got %v", cookie)
+	}
+	if !cookie[0].HttpOnly || !cookie[0].Secure || cookie[0].SameSite != http.SameSiteLaxMode {
+		t.Errorf("unexpected cookie attributes %+v", cookie[0])
+	}
+}
+
+func TestAuthRequestPasswordReset_UnknownEmail(t *testing.T) {
+	userQuery := &mockUserQuery{}
+	h := &AuthHandler{requestReset: usecase.NewRequestPasswordResetUsecase(userQuery, nil)}
+
+	// 登録済みかどうかを推測されないよう、存在しないメールアドレスでも受け付ける
+	body := strings.NewReader(`{"email":"nobody@example.com"}`)
+	rec := httptest.NewRecorder()
+	h.AuthRequestPasswordReset(rec, httptest.NewRequest(http.MethodPost, "/auth/password-reset", body))
+
+	if rec.Code != http.StatusAccepted {
+		t.Errorf("expected status %d, got %d (%s)", http.StatusAccepted, rec.Code, rec.Body.String())
+	}
+}
+
+func TestAuthVerifyEmail_MalformedBody(t *testing.T) {
+	h := &AuthHandler{verifyEmail: usecase.NewVerifyEmailUsecase(nil, command.UserEventSourcing{})}
+
+	rec := httptest.NewRecorder()
+	h.AuthVerifyEmail(rec, httptest.NewRequest(http.MethodPost, "/auth/email-verification/confirm", strings.NewReader("{")))
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, rec.Code)
+	}
+}
@@ -0,0 +1,50 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/handler"
+	"github.com/example/go-react-cqrs-template/internal/handler/middleware"
+)
+
+// revokedSessions どのトークンも有効なセッションとして扱わない（失効・期限切れのセッション）
+type revokedSessions struct{}
+
+func (revokedSessions) Execute(context.Context, string) (*domain.Session, *domain.Membership, error) {
+	return nil, nil, nil
+}
+
+func TestAuthLogin_WithStaleSessionCookie(t *testing.T) {
+	h := handler.NewLoginTestHandler(t)
+	authentication := middleware.Authentication(middleware.AuthenticationConfig{},
+		middleware.NewSessionAuthenticator(handler.SessionCookieName, true, revokedSessions{}))
+	server := authentication(http.HandlerFunc(h.AuthLogin))
+
+	req := httptest.NewRequest(http.MethodPost, "/auth/login",
+		strings.NewReader(`{"email":"john@example.com","password":"correct horse battery staple"}`))
+	req = req.WithContext(domain.WithTenant(req.Context(), domain.DefaultOrganizationID))
+	req.AddCookie(&http.Cookie{Name: handler.SessionCookieName, Value: "cqs_revoked"})
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, req)
+
+	// 失効したセッションのCookieがあってもログインできる
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d (%s)", http.StatusOK, rec.Code, rec.Body.String())
+	}
+	// 古いCookieの削除の後に新しいセッションのCookieを設定する（ブラウザは後のものを採用する）
+	cookies := rec.Result().Cookies()
+	if len(cookies) != 2 {
+		t.Fatalf("expected 2 cookies, got %v", cookies)
+	}
+	if cookies[0].Name != handler.SessionCookieName || cookies[0].MaxAge >= 0 {
+		t.Errorf("expected the stale session cookie to be cleared first, got %v", cookies[0])
+	}
+	if cookies[1].Name != handler.SessionCookieName || cookies[1].Value == "" || cookies[1].Value == "cqs_revoked" || cookies[1].MaxAge < 0 {
+		t.Errorf("expected a new session cookie, got %v", cookies[1])
+	}
+}
@@ -3,7 +3,9 @@ package handler
 import (
 	"errors"
 	"log/slog"
+	"math"
 	"net/http"
+	"strconv"
 
 	"github.com/example/go-react-cqrs-template/internal/domain"
 	apperrors "github.com/example/go-react-cqrs-template/internal/pkg/errors"
@@ -15,6 +17,13 @@ import (
 func HandleError(w http.ResponseWriter, err error, log *slog.Logger) {
 	appErr := ToAppError(err)
 
+	// 再試行できるようになるまでの時間を通知
+	var tooManyErr *domain.TooManyRequestsError
+	if errors.As(err, &tooManyErr) {
+		retryAfter := max(1, int(math.Ceil(tooManyErr.RetryAfter.Seconds())))
+		w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+	}
+
 	// ログ出力
 	if log != nil {
 		logger.LogError(log, appErr, "request error")
@@ -65,6 +74,33 @@ func ToAppError(err error) *apperrors.AppError {
 		)
 	}
 
+	// ForbiddenError の場合
+	var forbiddenErr *domain.ForbiddenError
+	if errors.As(err, &forbiddenErr) {
+		return apperrors.Forbidden(
+			forbiddenErr.Message,
+			forbiddenErr.UserMessage,
+		)
+	}
+
+	// UnauthorizedError の場合
+	var unauthorizedErr *domain.UnauthorizedError
+	if errors.As(err, &unauthorizedErr) {
+		return apperrors.Unauthorized(
+			unauthorizedErr.Message,
+			unauthorizedErr.UserMessage,
+		)
+	}
+
+	// TooManyRequestsError の場合
+	var tooManyErr *domain.TooManyRequestsError
+	if errors.As(err, &tooManyErr) {
+		return apperrors.TooManyRequests(
+			tooManyErr.Message,
+			tooManyErr.UserMessage,
+		)
+	}
+
 	// DomainError の場合（基底型）
 	var domainErr *domain.DomainError
 	if errors.As(err, &domainErr) {
@@ -75,6 +111,12 @@ func ToAppError(err error) *apperrors.AppError {
 			return apperrors.NotFound("resource", domainErr.UserMessage)
 		case domain.ErrCodeConflict:
 			return apperrors.Conflict(domainErr.Message, domainErr.UserMessage)
+		case domain.ErrCodeForbidden:
+			return apperrors.Forbidden(domainErr.Message, domainErr.UserMessage)
+		case domain.ErrCodeUnauthorized:
+			return apperrors.Unauthorized(domainErr.Message, domainErr.UserMessage)
+		case domain.ErrCodeTooManyRequests:
+			return apperrors.TooManyRequests(domainErr.Message, domainErr.UserMessage)
 		default:
 			return apperrors.Internal(err, domainErr.UserMessage)
 		}
@@ -0,0 +1,159 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+// eventStreamResyncEvent 再開位置より後の変更通知が削除されていることを伝えるイベント名
+const eventStreamResyncEvent = "resync"
+
+// eventStreamSettleRetry 確定していない変更通知が残っている場合に読み直すまでの間隔
+// より小さいIDの変更を保存したトランザクションの終了は通知されないため、通知を待たずに読み直す
+const eventStreamSettleRetry = 200 * time.Millisecond
+
+// changeEventResponse 変更通知のイベントのデータ（APIのChangeEvent）
+type changeEventResponse struct {
+	AggregateType string    `json:"aggregateType"`
+	AggregateID   string    `json:"aggregateId"`
+	ChangeType    string    `json:"changeType"`
+	OccurredAt    time.Time `json:"occurredAt"`
+}
+
+// EventHandler イベントストリームのHTTPハンドラー（ServerInterface のうち Events を実装）
+type EventHandler struct {
+	streamChanges *usecase.StreamChangesUsecase
+	heartbeat     time.Duration
+}
+
+// NewEventHandler EventHandlerのコンストラクタ
+// heartbeat ごとにコメント行を送り、プロキシに接続を切断されないようにする
+func NewEventHandler(streamChanges *usecase.StreamChangesUsecase, heartbeat time.Duration) *EventHandler {
+	return &EventHandler{
+		streamChanges: streamChanges,
+		heartbeat:     heartbeat,
+	}
+}
+
+// EventsStreamEvents ユーザーの変更を Server-Sent Events で配信（OpenAPI ServerInterface実装）
+func (h *EventHandler) EventsStreamEvents(w http.ResponseWriter, r *http.Request, params openapi.EventsStreamEventsParams) {
+	ctx := r.Context()
+	log := logger.FromContext(ctx)
+
+	var lastEventID *int64
+	if params.LastEventID != nil {
+		id, err := strconv.ParseInt(*params.LastEventID, 10, 64)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "Last-Event-IDが不正です")
+			return
+		}
+		lastEventID = &id
+	}
+
+	stream, err := h.streamChanges.Execute(ctx, lastEventID)
+	if err != nil {
+		HandleError(w, err, log)
+		return
+	}
+	defer stream.Close()
+
+	rc := http.NewResponseController(w)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-store")
+	// nginx などのプロキシにレスポンスをバッファさせない
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	// 再開位置を進めるためIDを付け、再接続のたびに読み直しを求めないようにする
+	if stream.Resync {
+		fmt.Fprintf(w, "id: %d\nevent: %s\ndata: {}\n\n", stream.Cursor(), eventStreamResyncEvent)
+	}
+	// 取りこぼしがないよう、再開位置より後の変更通知を先に送る
+	if err := h.sendChanges(ctx, w, rc, stream); err != nil {
+		if ctx.Err() == nil {
+			log.Warn("event stream closed", slog.String("error", err.Error()))
+		}
+		return
+	}
+
+	ticker := time.NewTicker(h.heartbeat)
+	defer ticker.Stop()
+	for {
+		var retry <-chan time.Time
+		if stream.Pending() {
+			retry = time.After(eventStreamSettleRetry)
+		}
+		select {
+		case _, ok := <-stream.Wake:
+			// サーバーの停止時は接続を閉じ、クライアントにほかのサーバーへ再接続させる
+			if !ok {
+				return
+			}
+			if err := h.sendChanges(ctx, w, rc, stream); err != nil {
+				if ctx.Err() == nil {
+					log.Warn("event stream closed", slog.String("error", err.Error()))
+				}
+				return
+			}
+		case <-retry:
+			if err := h.sendChanges(ctx, w, rc, stream); err != nil {
+				if ctx.Err() == nil {
+					log.Warn("event stream closed", slog.String("error", err.Error()))
+				}
+				return
+			}
+		case <-ticker.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+			if err := flushResponse(rc); err != nil {
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// sendChanges 未送信の確定した変更通知をすべてイベントとして書き出し、クライアントへ送る
+func (h *EventHandler) sendChanges(ctx context.Context, w http.ResponseWriter, rc *http.ResponseController, stream *usecase.ChangeStream) error {
+	for {
+		changes, err := stream.Next(ctx)
+		if err != nil {
+			return err
+		}
+		for _, change := range changes {
+			if err := writeChangeEvent(w, change); err != nil {
+				return err
+			}
+		}
+		if len(changes) == 0 || stream.Pending() {
+			return flushResponse(rc)
+		}
+	}
+}
+
+// writeChangeEvent 変更通知を1つのイベントとして書き出す
+func writeChangeEvent(w http.ResponseWriter, change *domain.Change) error {
+	data, err := json.Marshal(changeEventResponse{
+		AggregateType: string(change.AggregateType),
+		AggregateID:   change.AggregateID,
+		ChangeType:    string(change.Type),
+		OccurredAt:    change.OccurredAt,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", change.ID, change.EventName(), data)
+	return err
+}
@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+type mockChangeQuery struct {
+	changes []*domain.Change
+}
+
+func (m *mockChangeQuery) FindAfter(_ context.Context, afterID int64, limit int) ([]*domain.Change, error) {
+	var result []*domain.Change
+	for _, change := range m.changes {
+		if change.ID > afterID && len(result) < limit {
+			result = append(result, change)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockChangeQuery) LatestID(_ context.Context) (int64, error) {
+	if len(m.changes) == 0 {
+		return 0, nil
+	}
+	return m.changes[len(m.changes)-1].ID, nil
+}
+
+func (m *mockChangeQuery) OldestID(_ context.Context) (int64, error) {
+	if len(m.changes) == 0 {
+		return 0, nil
+	}
+	return m.changes[0].ID, nil
+}
+
+// closedChangeNotifier はすぐに閉じられるチャンネルを返す（未送信の変更通知を送った後でストリームを終了させる）
+type closedChangeNotifier struct{}
+
+func (closedChangeNotifier) Subscribe(string) (<-chan struct{}, func()) {
+	ch := make(chan struct{})
+	close(ch)
+	return ch, func() {}
+}
+
+func newEventTestHandler() *EventHandler {
+	occurredAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	query := &mockChangeQuery{changes: []*domain.Change{
+		{ID: 10, AggregateType: domain.AggregateTypeUser, AggregateID: testActiveUserID, Type: domain.ChangeTypeCreated, OccurredAt: occurredAt},
+		{ID: 11, AggregateType: domain.AggregateTypeUser, AggregateID: testActiveUserID, Type: domain.ChangeTypeUpdated, OccurredAt: occurredAt},
+		{ID: 12, AggregateType: domain.AggregateTypeUser, AggregateID: testDeletedUserID, Type: domain.ChangeTypeDeleted, OccurredAt: occurredAt},
+	}}
+	return NewEventHandler(usecase.NewStreamChangesUsecase(query, closedChangeNotifier{}), time.Minute)
+}
+
+func newEventStreamRequest(principal domain.Principal) *http.Request {
+	req := newRequestAs(principal, http.MethodGet, "/events/stream", nil)
+	return req.WithContext(domain.WithTenant(req.Context(), domain.DefaultOrganizationID))
+}
+
+func TestEventsStreamEvents(t *testing.T) {
+	admin := domain.NewUserPrincipal(testAdminUserID).WithRoles(domain.RoleAdmin)
+
+	t.Run("resumes after Last-Event-ID", func(t *testing.T) {
+		lastEventID := "10"
+		rec := httptest.NewRecorder()
+		newEventTestHandler().EventsStreamEvents(rec, newEventStreamRequest(admin), openapi.EventsStreamEventsParams{LastEventID: &lastEventID})
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+		}
+		if got := rec.Header().Get("Content-Type"); got != "text/event-stream" {
+			t.Errorf("expected Content-Type text/event-stream, got %s", got)
+		}
+		want := "id: 11\nevent: user.updated\n" +
+			`data: {"aggregateType":"user","aggregateId":"` + testActiveUserID + `","changeType":"updated","occurredAt":"2026-01-02T03:04:05Z"}` + "\n\n" +
+			"id: 12\nevent: user.deleted\n" +
+			`data: {"aggregateType":"user","aggregateId":"` + testDeletedUserID + `","changeType":"deleted","occurredAt":"2026-01-02T03:04:05Z"}` + "\n\n"
+		if got := rec.Body.String(); got != want {
+			t.Errorf("unexpected stream:\n%s\nwant:\n%s", got, want)
+		}
+	})
+
+	t.Run("stops before a change that is not settled yet", func(t *testing.T) {
+		// ID 11 を保存したトランザクションがまだ終わっていない可能性があり、ID 12 の後で 11 がコミットされうる
+		occurredAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+		query := &mockChangeQuery{changes: []*domain.Change{
+			{ID: 10, AggregateType: domain.AggregateTypeUser, AggregateID: testActiveUserID, Type: domain.ChangeTypeCreated, OccurredAt: occurredAt},
+			{ID: 11, AggregateType: domain.AggregateTypeUser, AggregateID: testActiveUserID, Type: domain.ChangeTypeUpdated, OccurredAt: occurredAt, Pending: true},
+			{ID: 12, AggregateType: domain.AggregateTypeUser, AggregateID: testDeletedUserID, Type: domain.ChangeTypeDeleted, OccurredAt: occurredAt},
+		}}
+		h := NewEventHandler(usecase.NewStreamChangesUsecase(query, closedChangeNotifier{}), time.Minute)
+		lastEventID := "9"
+		rec := httptest.NewRecorder()
+		h.EventsStreamEvents(rec, newEventStreamRequest(admin), openapi.EventsStreamEventsParams{LastEventID: &lastEventID})
+
+		want := "id: 10\nevent: user.created\n" +
+			`data: {"aggregateType":"user","aggregateId":"` + testActiveUserID + `","changeType":"created","occurredAt":"2026-01-02T03:04:05Z"}` + "\n\n"
+		if got := rec.Body.String(); got != want {
+			t.Errorf("unexpected stream:\n%s\nwant:\n%s", got, want)
+		}
+	})
+
+	t.Run("starts at the latest change without Last-Event-ID", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		newEventTestHandler().EventsStreamEvents(rec, newEventStreamRequest(admin), openapi.EventsStreamEventsParams{})
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+		}
+		if got := rec.Body.String(); got != "" {
+			t.Errorf("expected no events, got %q", got)
+		}
+	})
+
+	t.Run("asks for a resync when changes are no longer retained", func(t *testing.T) {
+		lastEventID := "5"
+		rec := httptest.NewRecorder()
+		newEventTestHandler().EventsStreamEvents(rec, newEventStreamRequest(admin), openapi.EventsStreamEventsParams{LastEventID: &lastEventID})
+
+		if got := rec.Body.String(); got != "id: 12\nevent: resync\ndata: {}\n\n" {
+			t.Errorf("expected a resync event, got %q", got)
+		}
+	})
+
+	t.Run("invalid Last-Event-ID", func(t *testing.T) {
+		lastEventID := "abc"
+		rec := httptest.NewRecorder()
+		newEventTestHandler().EventsStreamEvents(rec, newEventStreamRequest(admin), openapi.EventsStreamEventsParams{LastEventID: &lastEventID})
+
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("expected status %d, got %d", http.StatusBadRequest, rec.Code)
+		}
+	})
+
+	t.Run("requires users:read", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		newEventTestHandler().EventsStreamEvents(rec, newEventStreamRequest(domain.NewUserPrincipal(testAdminUserID)), openapi.EventsStreamEventsParams{})
+
+		if rec.Code != http.StatusForbidden {
+			t.Errorf("expected status %d, got %d", http.StatusForbidden, rec.Code)
+		}
+		if strings.Contains(rec.Header().Get("Content-Type"), "text/event-stream") {
+			t.Error("expected an error response instead of a stream")
+		}
+	})
+}
@@ -0,0 +1,11 @@
+package handler
+
+import "testing"
+
+// NewLoginTestHandler ミドルウェアと組み合わせるテスト（handler_test パッケージ）用に、
+// パスワードでログインできるユーザー（john@example.com）を持つ AuthHandler を作成する
+func NewLoginTestHandler(t *testing.T) *AuthHandler {
+	t.Helper()
+	_, txManager := newLoginTestDB(t)
+	return newLoginTestHandler(txManager)
+}
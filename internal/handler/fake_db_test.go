@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+// fakeQueryFunc クエリの引数から返す行を作る
+type fakeQueryFunc func(args []driver.Value) ([][]driver.Value, error)
+
+// fakeDB トランザクションを使うユースケースのテスト用のメモリ上のデータベース
+// クエリは sqlc が生成するSQLの先頭の "-- name: クエリ名" で判別し、登録されていないクエリは行を返さずに成功する
+type fakeDB struct {
+	mu      sync.Mutex
+	queries map[string]fakeQueryFunc
+	// executed 実行されたクエリ名（実行順）
+	executed []string
+	// commits コミットされたトランザクションの数
+	commits int
+}
+
+// newFakeDB fakeDBと、それを使う TransactionManager を作成する
+func newFakeDB(t *testing.T) (*fakeDB, *infrastructure.TransactionManager) {
+	t.Helper()
+	fake := &fakeDB{queries: map[string]fakeQueryFunc{}}
+	db := sql.OpenDB(fake)
+	t.Cleanup(func() { _ = db.Close() })
+	return fake, infrastructure.NewTransactionManager(db)
+}
+
+// handle クエリ名の処理を登録する（ExecContext でも呼び出され、返した行は捨てられる）
+func (f *fakeDB) handle(name string, fn fakeQueryFunc) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.queries[name] = fn
+}
+
+// count 実行されたクエリの回数
+func (f *fakeDB) count(name string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	n := 0
+	for _, executed := range f.executed {
+		if executed == name {
+			n++
+		}
+	}
+	return n
+}
+
+func (f *fakeDB) run(query string, named []driver.NamedValue) ([][]driver.Value, error) {
+	f.mu.Lock()
+	fields := strings.Fields(query)
+	name := ""
+	if len(fields) >= 3 {
+		name = fields[2]
+	}
+	f.executed = append(f.executed, name)
+	fn := f.queries[name]
+	f.mu.Unlock()
+
+	if fn == nil {
+		return nil, nil
+	}
+	args := make([]driver.Value, len(named))
+	for i, v := range named {
+		args[i] = v.Value
+	}
+	return fn(args)
+}
+
+func (f *fakeDB) Connect(context.Context) (driver.Conn, error) { return fakeConn{f}, nil }
+func (f *fakeDB) Driver() driver.Driver                        { return nil }
+
+type fakeConn struct{ db *fakeDB }
+
+func (c fakeConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepare is not supported")
+}
+func (c fakeConn) Close() error              { return nil }
+func (c fakeConn) Begin() (driver.Tx, error) { return fakeTx(c), nil }
+
+func (c fakeConn) ExecContext(_ context.Context, query string, named []driver.NamedValue) (driver.Result, error) {
+	if _, err := c.db.run(query, named); err != nil {
+		return nil, err
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (c fakeConn) QueryContext(_ context.Context, query string, named []driver.NamedValue) (driver.Rows, error) {
+	values, err := c.db.run(query, named)
+	if err != nil {
+		return nil, err
+	}
+	return &fakeRows{values: values}, nil
+}
+
+type fakeTx struct{ db *fakeDB }
+
+func (t fakeTx) Commit() error {
+	t.db.mu.Lock()
+	defer t.db.mu.Unlock()
+	t.db.commits++
+	return nil
+}
+func (t fakeTx) Rollback() error { return nil }
+
+type fakeRows struct {
+	values [][]driver.Value
+	next   int
+}
+
+func (r *fakeRows) Columns() []string {
+	if len(r.values) == 0 {
+		return nil
+	}
+	return make([]string, len(r.values[0]))
+}
+func (r *fakeRows) Close() error { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.values) {
+		return io.EOF
+	}
+	copy(dest, r.values[r.next])
+	r.next++
+	return nil
+}
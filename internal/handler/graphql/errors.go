@@ -0,0 +1,54 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/example/go-react-cqrs-template/internal/handler"
+	apperrors "github.com/example/go-react-cqrs-template/internal/pkg/errors"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// resolverError リゾルバーが返すエラー（REST APIと同じユーザー向けのメッセージと、HTTPのステータスに対応するコードを返す）
+//
+//	{"message": "ユーザーが見つかりません", "path": ["user"], "extensions": {"code": "NOT_FOUND", "status": 404}}
+type resolverError struct {
+	appErr *apperrors.AppError
+}
+
+// toResolverError エラーを handler.ToAppError で分類し、ログに出力して resolverError に変換する
+func toResolverError(ctx context.Context, err error) error {
+	appErr := handler.ToAppError(err)
+	logger.LogError(logger.FromContext(ctx), appErr, "graphql resolver error")
+	return &resolverError{appErr: appErr}
+}
+
+// Error errorインターフェースを実装（レスポンスの message になる）
+func (e *resolverError) Error() string {
+	return e.appErr.UserMessage()
+}
+
+// Extensions レスポンスの extensions に含める値
+func (e *resolverError) Extensions() map[string]any {
+	status := e.appErr.StatusCode()
+	return map[string]any{
+		"code":   strings.ToUpper(strings.ReplaceAll(http.StatusText(status), " ", "_")),
+		"status": status,
+	}
+}
+
+// Unwrap 元のエラーを返す
+func (e *resolverError) Unwrap() error {
+	return e.appErr
+}
+
+// panicLogger リゾルバーのpanicをリクエストのロガーに出力する
+type panicLogger struct{}
+
+// LogPanic log.Loggerインターフェースを実装
+func (panicLogger) LogPanic(ctx context.Context, value any) {
+	logger.FromContext(ctx).Error("graphql resolver panic", slog.String("panic", fmt.Sprint(value)))
+}
@@ -0,0 +1,98 @@
+// Package graphql はユーザーとその操作履歴を1回のリクエストで取得するGraphQLの読み取りAPIを提供する
+// クエリはREST APIと同じユースケースで解決し、エラーは handler.ToAppError と同じ分類で返す
+package graphql
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+	graphqlgo "github.com/graph-gophers/graphql-go"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+)
+
+// schemaSDL GraphQLのスキーマ
+//
+//go:embed schema.graphql
+var schemaSDL string
+
+// maxRequestBodyBytes 受け付けるリクエストのボディの最大サイズ
+const maxRequestBodyBytes = 1 << 20
+
+// maxQueryDepth クエリのフィールドの入れ子の最大の深さ
+const maxQueryDepth = 10
+
+// Handler GraphQLのHTTPハンドラー
+type Handler struct {
+	schema *graphqlgo.Schema
+}
+
+// NewHandler Handlerのコンストラクタ
+func NewHandler(
+	findUser *usecase.FindUserUsecase,
+	listUsers *usecase.ListUsersUsecase,
+	listUserLogsByUserIDs *usecase.ListUserLogsByUserIDsUsecase,
+) (*Handler, error) {
+	resolver := &Resolver{
+		findUser:              findUser,
+		listUsers:             listUsers,
+		listUserLogsByUserIDs: listUserLogsByUserIDs,
+	}
+	schema, err := graphqlgo.ParseSchema(schemaSDL, resolver,
+		graphqlgo.UseStringDescriptions(),
+		graphqlgo.MaxDepth(maxQueryDepth),
+		graphqlgo.Logger(panicLogger{}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse graphql schema: %w", err)
+	}
+	return &Handler{schema: schema}, nil
+}
+
+// request GraphQLのリクエストのボディ
+type request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+// ServeHTTP POSTされたクエリを実行する
+// 実行したクエリの結果は、リゾルバーのエラーを含む場合も 200 OK の data・errors として返す
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req request
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBodyBytes)).Decode(&req); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			respondErrors(w, http.StatusRequestEntityTooLarge, "リクエストのボディのサイズが大きすぎます")
+			return
+		}
+		respondErrors(w, http.StatusBadRequest, "リクエストの形式が不正です")
+		return
+	}
+	if req.Query == "" {
+		respondErrors(w, http.StatusBadRequest, "query を指定してください")
+		return
+	}
+
+	resp := h.schema.Exec(r.Context(), req.Query, req.OperationName, req.Variables)
+	respondJSON(w, http.StatusOK, resp)
+}
+
+// respondJSON JSONレスポンスを返す
+func respondJSON(w http.ResponseWriter, status int, data any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// respondErrors クエリを実行できないリクエストにGraphQLの errors の形式でエラーを返す
+func respondErrors(w http.ResponseWriter, status int, message string) {
+	respondJSON(w, status, graphqlgo.Response{
+		Errors: []*gqlerrors.QueryError{{Message: message}},
+	})
+}
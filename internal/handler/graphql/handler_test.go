@@ -0,0 +1,445 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+// mockUserQuery はテスト用のUserQueryRepositoryモック
+type mockUserQuery struct {
+	users []*domain.User
+}
+
+func (m *mockUserQuery) FindByID(_ context.Context, id string) (*domain.User, error) {
+	for _, u := range m.users {
+		if u.ID == id {
+			return u, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockUserQuery) FindByEmail(_ context.Context, email string) (*domain.User, error) {
+	for _, u := range m.users {
+		if u.Email == email {
+			return u, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockUserQuery) FindAll(_ context.Context, limit, offset int) ([]*domain.User, error) {
+	if offset >= len(m.users) {
+		return nil, nil
+	}
+	return m.users[offset:min(offset+limit, len(m.users))], nil
+}
+
+func (m *mockUserQuery) Count(_ context.Context) (int, error) {
+	return len(m.users), nil
+}
+
+func (m *mockUserQuery) StreamAll(_ context.Context, fn func(*domain.User) error) error {
+	for _, u := range m.users {
+		if err := fn(u); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mockUserSummaryQuery はテスト用のUserSummaryQueryRepositoryモック
+type mockUserSummaryQuery struct {
+	users []*domain.User
+}
+
+func (m *mockUserSummaryQuery) FindAll(_ context.Context, limit, offset int) ([]*domain.UserSummary, error) {
+	if offset >= len(m.users) {
+		return nil, nil
+	}
+	var summaries []*domain.UserSummary
+	for _, u := range m.users[offset:min(offset+limit, len(m.users))] {
+		summaries = append(summaries, &domain.UserSummary{User: u})
+	}
+	return summaries, nil
+}
+
+func (m *mockUserSummaryQuery) Count(_ context.Context) (int, error) {
+	return len(m.users), nil
+}
+
+// mockUserLogQuery はテスト用のUserLogQueryRepositoryモック（まとめて読み込むクエリの回数を数える）
+type mockUserLogQuery struct {
+	logs []*domain.UserLog
+
+	// 取得条件ごとの読み込みは並行して実行される
+	batchFinds  atomic.Int32
+	batchCounts atomic.Int32
+}
+
+func (m *mockUserLogQuery) filter(userID string, action domain.UserLogAction) []*domain.UserLog {
+	var result []*domain.UserLog
+	for _, l := range m.logs {
+		if l.UserID == userID && (action == "" || l.Action == action) {
+			result = append(result, l)
+		}
+	}
+	return result
+}
+
+func (m *mockUserLogQuery) FindByUserID(_ context.Context, userID string, action domain.UserLogAction, limit, offset int) ([]*domain.UserLog, error) {
+	logs := m.filter(userID, action)
+	if offset >= len(logs) {
+		return nil, nil
+	}
+	return logs[offset:min(offset+limit, len(logs))], nil
+}
+
+func (m *mockUserLogQuery) CountByUserID(_ context.Context, userID string, action domain.UserLogAction) (int, error) {
+	return len(m.filter(userID, action)), nil
+}
+
+func (m *mockUserLogQuery) FindByUserIDs(ctx context.Context, userIDs []string, action domain.UserLogAction, limit, offset int) (map[string][]*domain.UserLog, error) {
+	m.batchFinds.Add(1)
+	result := make(map[string][]*domain.UserLog, len(userIDs))
+	for _, userID := range userIDs {
+		if logs, _ := m.FindByUserID(ctx, userID, action, limit, offset); len(logs) > 0 {
+			result[userID] = logs
+		}
+	}
+	return result, nil
+}
+
+func (m *mockUserLogQuery) CountByUserIDs(_ context.Context, userIDs []string, action domain.UserLogAction) (map[string]int, error) {
+	m.batchCounts.Add(1)
+	result := make(map[string]int, len(userIDs))
+	for _, userID := range userIDs {
+		if count := len(m.filter(userID, action)); count > 0 {
+			result[userID] = count
+		}
+	}
+	return result, nil
+}
+
+const (
+	testAliceID = "01ARZ3NDEKTSV4RRFFQ69G5FA1"
+	testBobID   = "01ARZ3NDEKTSV4RRFFQ69G5FA2"
+	testCarolID = "01ARZ3NDEKTSV4RRFFQ69G5FA3"
+	testAdminID = "01ARZ3NDEKTSV4RRFFQ69G5FAZ"
+)
+
+func newTestHandler(t *testing.T) (*Handler, *mockUserLogQuery) {
+	t.Helper()
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	users := []*domain.User{
+		{ID: testAliceID, OrganizationID: domain.DefaultOrganizationID, Name: "Alice", Email: "alice@example.com", EmailVerifiedAt: &now, CreatedAt: now, UpdatedAt: now},
+		{ID: testBobID, OrganizationID: domain.DefaultOrganizationID, Name: "Bob", Email: "bob@example.com", CreatedAt: now, UpdatedAt: now},
+		{ID: testCarolID, OrganizationID: domain.DefaultOrganizationID, Name: "Carol", Email: "carol@example.com", CreatedAt: now, UpdatedAt: now},
+	}
+	userLogQuery := &mockUserLogQuery{logs: []*domain.UserLog{
+		{
+			ID: "01ARZ3NDEKTSV4RRFFQ69G5FB2", UserID: testAliceID, Action: domain.UserLogActionUpdated,
+			Changes: domain.UserChanges{
+				"name":  {Before: "Alicia", After: "Alice"},
+				"email": {Before: "alicia@example.com", After: "alice@example.com"},
+			},
+			Actor: "user:" + testAdminID, RequestID: "req-2", CreatedAt: now,
+		},
+		{ID: "01ARZ3NDEKTSV4RRFFQ69G5FB1", UserID: testAliceID, Action: domain.UserLogActionCreated, Changes: domain.UserChanges{}, Actor: "anonymous", RequestID: "req-1", CreatedAt: now.Add(-time.Hour)},
+		{ID: "01ARZ3NDEKTSV4RRFFQ69G5FB3", UserID: testBobID, Action: domain.UserLogActionCreated, Changes: domain.UserChanges{}, Actor: "anonymous", RequestID: "req-3", CreatedAt: now},
+	}}
+
+	userQuery := &mockUserQuery{users: users}
+	h, err := NewHandler(
+		usecase.NewFindUserUsecase(userQuery),
+		usecase.NewListUsersUsecase(&mockUserSummaryQuery{users: users}),
+		usecase.NewListUserLogsByUserIDsUsecase(userLogQuery),
+	)
+	if err != nil {
+		t.Fatalf("failed to create handler: %v", err)
+	}
+	return h, userLogQuery
+}
+
+// graphqlResponse はテスト用のGraphQLのレスポンス
+type graphqlResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message    string         `json:"message"`
+		Path       []any          `json:"path"`
+		Extensions map[string]any `json:"extensions"`
+	} `json:"errors"`
+}
+
+// execute は主体を指定してクエリを実行する
+func execute(t *testing.T, h *Handler, principal domain.Principal, body string) (*httptest.ResponseRecorder, graphqlResponse) {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body))
+	ctx := domain.WithTenant(req.Context(), domain.DefaultOrganizationID)
+	req = req.WithContext(domain.WithPrincipal(ctx, principal))
+	rec := httptest.NewRecorder()
+
+	h.ServeHTTP(rec, req)
+
+	var resp graphqlResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to decode response: %v: %s", err, rec.Body.String())
+	}
+	return rec, resp
+}
+
+func queryBody(query string, variables map[string]any) string {
+	body, _ := json.Marshal(map[string]any{"query": query, "variables": variables})
+	return string(body)
+}
+
+func adminPrincipal() domain.Principal {
+	return domain.NewUserPrincipal(testAdminID).WithRoles(domain.RoleAdmin)
+}
+
+func TestGraphQL_UsersWithLogsAreBatched(t *testing.T) {
+	h, userLogQuery := newTestHandler(t)
+
+	query := `query {
+		users(limit: 10) {
+			total
+			users {
+				id
+				name
+				recent: logs(limit: 1) { total logs { action actor changes { field before after } } }
+				created: logs(action: created) { total }
+			}
+		}
+	}`
+	rec, resp := execute(t, h, adminPrincipal(), queryBody(query, nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+	}
+	if len(resp.Errors) > 0 {
+		t.Fatalf("expected no errors, got %+v", resp.Errors)
+	}
+
+	var data struct {
+		Users struct {
+			Total int `json:"total"`
+			Users []struct {
+				ID     string `json:"id"`
+				Name   string `json:"name"`
+				Recent struct {
+					Total int `json:"total"`
+					Logs  []struct {
+						Action  string `json:"action"`
+						Actor   string `json:"actor"`
+						Changes []struct {
+							Field  string `json:"field"`
+							Before string `json:"before"`
+							After  string `json:"after"`
+						} `json:"changes"`
+					} `json:"logs"`
+				} `json:"recent"`
+				Created struct {
+					Total int `json:"total"`
+				} `json:"created"`
+			} `json:"users"`
+		} `json:"users"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		t.Fatalf("failed to decode data: %v", err)
+	}
+	if data.Users.Total != 3 || len(data.Users.Users) != 3 {
+		t.Fatalf("expected 3 users, got %+v", data.Users)
+	}
+
+	alice, bob, carol := data.Users.Users[0], data.Users.Users[1], data.Users.Users[2]
+	if alice.Recent.Total != 2 || len(alice.Recent.Logs) != 1 || alice.Recent.Logs[0].Action != "updated" {
+		t.Errorf("unexpected logs for alice: %+v", alice.Recent)
+	}
+	if changes := alice.Recent.Logs[0].Changes; len(changes) != 2 || changes[0].Field != "email" || changes[1].Field != "name" || changes[1].Before != "Alicia" {
+		t.Errorf("expected changes sorted by field, got %+v", changes)
+	}
+	if alice.Created.Total != 1 || bob.Recent.Total != 1 || bob.Created.Total != 1 {
+		t.Errorf("unexpected totals: alice=%+v bob=%+v", alice, bob)
+	}
+	if carol.Recent.Total != 0 || carol.Recent.Logs == nil || len(carol.Recent.Logs) != 0 {
+		t.Errorf("expected an empty log list for carol, got %+v", carol.Recent)
+	}
+
+	// ユーザーの人数によらず、取得条件（エイリアス）ごとに1回だけ読み込む
+	if counts, finds := userLogQuery.batchCounts.Load(), userLogQuery.batchFinds.Load(); counts != 2 || finds != 2 {
+		t.Errorf("expected 2 batched count and find queries, got %d and %d", counts, finds)
+	}
+}
+
+func TestGraphQL_FieldSelection(t *testing.T) {
+	h, userLogQuery := newTestHandler(t)
+
+	_, resp := execute(t, h, adminPrincipal(), queryBody(`{ users(limit: 2, offset: 1) { users { name } } }`, nil))
+
+	if len(resp.Errors) > 0 {
+		t.Fatalf("expected no errors, got %+v", resp.Errors)
+	}
+	if got, want := string(resp.Data), `{"users":{"users":[{"name":"Bob"},{"name":"Carol"}]}}`; got != want {
+		t.Errorf("expected data %s, got %s", want, got)
+	}
+	if userLogQuery.batchCounts.Load() != 0 || userLogQuery.batchFinds.Load() != 0 {
+		t.Errorf("expected logs not to be loaded when not selected")
+	}
+}
+
+func TestGraphQL_User(t *testing.T) {
+	h, _ := newTestHandler(t)
+
+	query := `query($id: ID!) { user(id: $id) { email emailVerifiedAt emailInvalidatedAt logs { total } } }`
+	_, resp := execute(t, h, adminPrincipal(), queryBody(query, map[string]any{"id": testAliceID}))
+
+	if len(resp.Errors) > 0 {
+		t.Fatalf("expected no errors, got %+v", resp.Errors)
+	}
+	want := `{"user":{"email":"alice@example.com","emailVerifiedAt":"2026-01-02T03:04:05Z","emailInvalidatedAt":null,"logs":{"total":2}}}`
+	if got := string(resp.Data); got != want {
+		t.Errorf("expected data %s, got %s", want, got)
+	}
+}
+
+func TestGraphQL_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		principal  domain.Principal
+		query      string
+		variables  map[string]any
+		wantCode   string
+		wantStatus float64
+	}{
+		{
+			name:       "unknown user",
+			principal:  adminPrincipal(),
+			query:      `query($id: ID!) { user(id: $id) { id } }`,
+			variables:  map[string]any{"id": "01ARZ3NDEKTSV4RRFFQ69G5FA9"},
+			wantCode:   "NOT_FOUND",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "malformed user id",
+			principal:  adminPrincipal(),
+			query:      `query($id: ID!) { user(id: $id) { id } }`,
+			variables:  map[string]any{"id": "not-a-ulid"},
+			wantCode:   "BAD_REQUEST",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "limit out of range",
+			principal:  adminPrincipal(),
+			query:      `{ users(limit: 101) { total } }`,
+			wantCode:   "BAD_REQUEST",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "log offset out of range",
+			principal:  adminPrincipal(),
+			query:      `{ users { users { logs(offset: -1) { total } } } }`,
+			wantCode:   "BAD_REQUEST",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "requires users:read",
+			principal:  domain.NewUserPrincipal(testBobID),
+			query:      `{ users { total } }`,
+			wantCode:   "FORBIDDEN",
+			wantStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, _ := newTestHandler(t)
+
+			rec, resp := execute(t, h, tt.principal, queryBody(tt.query, tt.variables))
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+			}
+			if len(resp.Errors) == 0 {
+				t.Fatalf("expected errors, got data %s", resp.Data)
+			}
+			ext := resp.Errors[0].Extensions
+			if ext["code"] != tt.wantCode || ext["status"] != tt.wantStatus {
+				t.Errorf("expected code %s and status %v, got %+v", tt.wantCode, tt.wantStatus, resp.Errors[0])
+			}
+			if resp.Errors[0].Message == "" || len(resp.Errors[0].Path) == 0 {
+				t.Errorf("expected a message and a path, got %+v", resp.Errors[0])
+			}
+		})
+	}
+}
+
+func TestGraphQL_OwnUserWithoutPermission(t *testing.T) {
+	h, _ := newTestHandler(t)
+
+	// 本人のユーザーとログは権限がなくても取得できる
+	query := `query($id: ID!) { user(id: $id) { name logs { total } } }`
+	_, resp := execute(t, h, domain.NewUserPrincipal(testBobID), queryBody(query, map[string]any{"id": testBobID}))
+
+	if len(resp.Errors) > 0 {
+		t.Fatalf("expected no errors, got %+v", resp.Errors)
+	}
+	if got, want := string(resp.Data), `{"user":{"name":"Bob","logs":{"total":1}}}`; got != want {
+		t.Errorf("expected data %s, got %s", want, got)
+	}
+}
+
+func TestGraphQL_InvalidRequest(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       io.Reader
+		wantStatus int
+	}{
+		{name: "malformed body", body: strings.NewReader(`{`), wantStatus: http.StatusBadRequest},
+		{name: "missing query", body: strings.NewReader(`{"variables":{}}`), wantStatus: http.StatusBadRequest},
+		{name: "too large body", body: strings.NewReader(`{"query":"` + strings.Repeat("a", maxRequestBodyBytes) + `"}`), wantStatus: http.StatusRequestEntityTooLarge},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, _ := newTestHandler(t)
+
+			req := httptest.NewRequest(http.MethodPost, "/graphql", tt.body)
+			rec := httptest.NewRecorder()
+
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, rec.Code)
+			}
+			var resp graphqlResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil || len(resp.Errors) != 1 {
+				t.Errorf("expected a graphql error response, got %s", rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestGraphQL_QueryValidation(t *testing.T) {
+	h, _ := newTestHandler(t)
+
+	// スキーマにないフィールド・列挙型にない値はクエリの検証で拒否される
+	for _, query := range []string{
+		`{ users { users { password } } }`,
+		`{ users { users { logs(action: renamed) { total } } } }`,
+	} {
+		_, resp := execute(t, h, adminPrincipal(), queryBody(query, nil))
+		if len(resp.Errors) == 0 || resp.Data != nil {
+			t.Errorf("expected query %q to be rejected, got data %s", query, resp.Data)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package graphql
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/handler"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+	graphqlgo "github.com/graph-gophers/graphql-go"
+)
+
+// Resolver Queryのリゾルバー（REST APIと同じユースケースで解決する）
+type Resolver struct {
+	findUser              *usecase.FindUserUsecase
+	listUsers             *usecase.ListUsersUsecase
+	listUserLogsByUserIDs *usecase.ListUserLogsByUserIDsUsecase
+}
+
+// User ユーザーを取得
+func (r *Resolver) User(ctx context.Context, args struct{ ID graphqlgo.ID }) (*userResolver, error) {
+	id := string(args.ID)
+	if err := handler.ValidateID("id", id); err != nil {
+		return nil, toResolverError(ctx, err)
+	}
+
+	user, err := r.findUser.Execute(ctx, id)
+	if err != nil {
+		return nil, toResolverError(ctx, err)
+	}
+	return &userResolver{user: user, logs: r.newUserLogLoader([]string{user.ID})}, nil
+}
+
+// Users ユーザー一覧を取得
+func (r *Resolver) Users(ctx context.Context, args struct{ Limit, Offset int32 }) (*userListResolver, error) {
+	if err := handler.ValidatePagination(int(args.Limit), int(args.Offset)); err != nil {
+		return nil, toResolverError(ctx, err)
+	}
+
+	summaries, total, err := r.listUsers.Execute(ctx, int(args.Limit), int(args.Offset))
+	if err != nil {
+		return nil, toResolverError(ctx, err)
+	}
+
+	userIDs := make([]string, len(summaries))
+	for i, s := range summaries {
+		userIDs[i] = s.User.ID
+	}
+	// ログは選択された場合に、一覧のユーザーの分をまとめて読み込む
+	logs := r.newUserLogLoader(userIDs)
+
+	users := make([]*userResolver, len(summaries))
+	for i, s := range summaries {
+		users[i] = &userResolver{user: s.User, logs: logs}
+	}
+	return &userListResolver{users: users, total: int32(total)}, nil
+}
+
+// newUserLogLoader 指定したユーザーのログを読み込む userLogLoader を作成
+func (r *Resolver) newUserLogLoader(userIDs []string) *userLogLoader {
+	return newUserLogLoader(r.listUserLogsByUserIDs, userIDs)
+}
+
+// userListResolver UserListのリゾルバー
+type userListResolver struct {
+	users []*userResolver
+	total int32
+}
+
+func (r *userListResolver) Users() []*userResolver { return r.users }
+
+func (r *userListResolver) Total() int32 { return r.total }
+
+// userResolver Userのリゾルバー
+type userResolver struct {
+	user *domain.User
+	// logs 同じ一覧のユーザーのログをまとめて読み込むローダー
+	logs *userLogLoader
+}
+
+func (r *userResolver) ID() graphqlgo.ID { return graphqlgo.ID(r.user.ID) }
+
+func (r *userResolver) OrganizationID() graphqlgo.ID { return graphqlgo.ID(r.user.OrganizationID) }
+
+func (r *userResolver) Name() string { return r.user.Name }
+
+func (r *userResolver) Email() string { return r.user.Email }
+
+func (r *userResolver) EmailVerifiedAt() *graphqlgo.Time { return toTime(r.user.EmailVerifiedAt) }
+
+func (r *userResolver) EmailInvalidatedAt() *graphqlgo.Time { return toTime(r.user.EmailInvalidatedAt) }
+
+func (r *userResolver) CreatedAt() graphqlgo.Time { return graphqlgo.Time{Time: r.user.CreatedAt} }
+
+func (r *userResolver) UpdatedAt() graphqlgo.Time { return graphqlgo.Time{Time: r.user.UpdatedAt} }
+
+// Logs ユーザーの操作履歴を新しい順に取得（同じ一覧のユーザーの分と1回のクエリでまとめて読み込む）
+func (r *userResolver) Logs(ctx context.Context, args struct {
+	Action        *string
+	Limit, Offset int32
+}) (*userLogListResolver, error) {
+	if err := handler.ValidatePagination(int(args.Limit), int(args.Offset)); err != nil {
+		return nil, toResolverError(ctx, err)
+	}
+
+	var action domain.UserLogAction
+	if args.Action != nil {
+		action = domain.UserLogAction(*args.Action)
+	}
+	logs, total, err := r.logs.Load(ctx, r.user.ID, userLogsKey{action: action, limit: int(args.Limit), offset: int(args.Offset)})
+	if err != nil {
+		return nil, toResolverError(ctx, err)
+	}
+
+	resolvers := make([]*userLogResolver, len(logs))
+	for i, l := range logs {
+		resolvers[i] = &userLogResolver{log: l}
+	}
+	return &userLogListResolver{logs: resolvers, total: int32(total)}, nil
+}
+
+// userLogListResolver UserLogListのリゾルバー
+type userLogListResolver struct {
+	logs  []*userLogResolver
+	total int32
+}
+
+func (r *userLogListResolver) Logs() []*userLogResolver { return r.logs }
+
+func (r *userLogListResolver) Total() int32 { return r.total }
+
+// userLogResolver UserLogのリゾルバー
+type userLogResolver struct {
+	log *domain.UserLog
+}
+
+func (r *userLogResolver) ID() graphqlgo.ID { return graphqlgo.ID(r.log.ID) }
+
+func (r *userLogResolver) UserID() graphqlgo.ID { return graphqlgo.ID(r.log.UserID) }
+
+func (r *userLogResolver) Action() string { return string(r.log.Action) }
+
+// Changes 変更された項目（GraphQLにはマップの型がないため、項目名の順に並べる）
+func (r *userLogResolver) Changes() []*userFieldChangeResolver {
+	fields := make([]string, 0, len(r.log.Changes))
+	for field := range r.log.Changes {
+		fields = append(fields, field)
+	}
+	slices.Sort(fields)
+
+	changes := make([]*userFieldChangeResolver, len(fields))
+	for i, field := range fields {
+		changes[i] = &userFieldChangeResolver{field: field, change: r.log.Changes[field]}
+	}
+	return changes
+}
+
+func (r *userLogResolver) Actor() string { return r.log.Actor }
+
+func (r *userLogResolver) RequestID() string { return r.log.RequestID }
+
+func (r *userLogResolver) CreatedAt() graphqlgo.Time { return graphqlgo.Time{Time: r.log.CreatedAt} }
+
+// userFieldChangeResolver UserFieldChangeのリゾルバー
+type userFieldChangeResolver struct {
+	field  string
+	change domain.UserFieldChange
+}
+
+func (r *userFieldChangeResolver) Field() string { return r.field }
+
+func (r *userFieldChangeResolver) Before() string { return r.change.Before }
+
+func (r *userFieldChangeResolver) After() string { return r.change.After }
+
+// toTime 日時をGraphQLのTimeに変換（nil はそのまま）
+func toTime(t *time.Time) *graphqlgo.Time {
+	if t == nil {
+		return nil
+	}
+	return &graphqlgo.Time{Time: *t}
+}
@@ -0,0 +1,63 @@
+package graphql
+
+import (
+	"context"
+	"sync"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+// userLogsKey ログの取得条件（同じ条件で選択されたユーザーのログをまとめて読み込む）
+type userLogsKey struct {
+	action domain.UserLogAction
+	limit  int
+	offset int
+}
+
+// userLogBatch ある取得条件でまとめて読み込んだログ
+type userLogBatch struct {
+	once   sync.Once
+	logs   map[string][]*domain.UserLog
+	totals map[string]int
+	err    error
+}
+
+// userLogLoader 一覧のユーザーのログを、ユーザーごとではなく1回のクエリで読み込む（N+1を避ける）
+// リクエストごとに作成し、各ユーザーの logs フィールドが最初に解決されたときに一覧の全員の分を読み込む
+// エイリアスで取得条件の異なる logs を選択した場合は、条件ごとに1回ずつ読み込む
+type userLogLoader struct {
+	listUserLogsByUserIDs *usecase.ListUserLogsByUserIDsUsecase
+	userIDs               []string
+
+	mu      sync.Mutex
+	batches map[userLogsKey]*userLogBatch
+}
+
+// newUserLogLoader userLogLoaderのコンストラクタ
+func newUserLogLoader(listUserLogsByUserIDs *usecase.ListUserLogsByUserIDsUsecase, userIDs []string) *userLogLoader {
+	return &userLogLoader{
+		listUserLogsByUserIDs: listUserLogsByUserIDs,
+		userIDs:               userIDs,
+		batches:               make(map[userLogsKey]*userLogBatch),
+	}
+}
+
+// Load ユーザーのログと件数を取得（同じ条件の2人目以降は最初に読み込んだ結果を使う）
+func (l *userLogLoader) Load(ctx context.Context, userID string, key userLogsKey) ([]*domain.UserLog, int, error) {
+	l.mu.Lock()
+	batch, ok := l.batches[key]
+	if !ok {
+		batch = &userLogBatch{}
+		l.batches[key] = batch
+	}
+	l.mu.Unlock()
+
+	batch.once.Do(func() {
+		batch.logs, batch.totals, batch.err = l.listUserLogsByUserIDs.Execute(ctx, l.userIDs, key.action, key.limit, key.offset)
+	})
+	if batch.err != nil {
+		return nil, 0, batch.err
+	}
+	return batch.logs[userID], batch.totals[userID], nil
+}
@@ -0,0 +1,70 @@
+package inbound
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+)
+
+// emailEventsBody メール配信サービスの通知のボディ
+//
+//	{"events": [{"id": "evt_1", "type": "bounce", "bounceType": "hard", "email": "alice@example.com"}]}
+type emailEventsBody struct {
+	Events []json.RawMessage `json:"events"`
+}
+
+// emailEvent メール配信サービスの通知に含まれるイベント
+type emailEvent struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	// BounceType バウンスの種類（"hard"・"permanent" は恒久的、それ以外は一時的なバウンスとして扱う）
+	BounceType string `json:"bounceType"`
+	Email      string `json:"email"`
+}
+
+// ParseEmailEvents メール配信サービスの通知をイベントに変換する（Parser として使う）
+// バウンスと苦情は正規化した種類（domain.InboundEventTypeEmailHardBounced など）、それ以外は "email.<type>" とする
+// 各イベントは受信したJSONのまま保存する
+var ParseEmailEvents ParserFunc = func(provider string, body []byte) ([]*domain.InboundEvent, error) {
+	var decoded emailEventsBody
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		return nil, domain.ErrInboundWebhookPayloadInvalid(err.Error())
+	}
+	if len(decoded.Events) == 0 {
+		return nil, domain.ErrInboundWebhookPayloadInvalid("no events")
+	}
+
+	events := make([]*domain.InboundEvent, 0, len(decoded.Events))
+	for _, raw := range decoded.Events {
+		var e emailEvent
+		if err := json.Unmarshal(raw, &e); err != nil {
+			return nil, domain.ErrInboundWebhookPayloadInvalid(err.Error())
+		}
+		event, err := domain.NewInboundEvent(provider, e.ID, emailEventType(e), domain.NormalizeEmail(e.Email), raw)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// emailEventType メール配信サービスのイベントの種類を正規化する
+func emailEventType(e emailEvent) string {
+	switch strings.ToLower(strings.TrimSpace(e.Type)) {
+	case "":
+		return ""
+	case "bounce":
+		switch strings.ToLower(e.BounceType) {
+		case "hard", "permanent":
+			return domain.InboundEventTypeEmailHardBounced
+		default:
+			return domain.InboundEventTypeEmailSoftBounced
+		}
+	case "complaint":
+		return domain.InboundEventTypeEmailComplained
+	default:
+		return "email." + strings.ToLower(strings.TrimSpace(e.Type))
+	}
+}
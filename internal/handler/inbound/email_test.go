@@ -0,0 +1,72 @@
+package inbound
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+)
+
+func TestParseEmailEvents(t *testing.T) {
+	body := []byte(`{"events":[
+		{"id":"evt_1","type":"bounce","bounceType":"hard","email":" Alice@Example.com "},
+		{"id":"evt_2","type":"bounce","bounceType":"transient","email":"bob@example.com"},
+		{"id":"evt_3","type":"complaint","email":"carol@example.com"},
+		{"id":"evt_4","type":"Delivered","email":"dave@example.com"}
+	]}`)
+
+	events, err := ParseEmailEvents.Parse("email", body)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	want := []struct {
+		providerEventID string
+		eventType       string
+		subject         string
+	}{
+		{providerEventID: "evt_1", eventType: domain.InboundEventTypeEmailHardBounced, subject: "Alice@example.com"},
+		{providerEventID: "evt_2", eventType: domain.InboundEventTypeEmailSoftBounced, subject: "bob@example.com"},
+		{providerEventID: "evt_3", eventType: domain.InboundEventTypeEmailComplained, subject: "carol@example.com"},
+		{providerEventID: "evt_4", eventType: "email.delivered", subject: "dave@example.com"},
+	}
+	if len(events) != len(want) {
+		t.Fatalf("expected %d events, got %d", len(want), len(events))
+	}
+	for i, w := range want {
+		e := events[i]
+		if e.Provider != "email" || e.ProviderEventID != w.providerEventID || e.EventType != w.eventType || e.Subject != w.subject {
+			t.Errorf("event %d: unexpected %+v", i, e)
+		}
+		if e.Status != domain.InboundEventStatusPending {
+			t.Errorf("event %d: expected status pending, got %s", i, e.Status)
+		}
+		var raw map[string]interface{}
+		if err := json.Unmarshal(e.Payload, &raw); err != nil || raw["id"] != w.providerEventID {
+			t.Errorf("event %d: expected the raw event to be kept, got %s", i, e.Payload)
+		}
+	}
+}
+
+func TestParseEmailEvents_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "malformed body", body: `{`},
+		{name: "no events", body: `{"events":[]}`},
+		{name: "missing event id", body: `{"events":[{"type":"bounce","bounceType":"hard","email":"alice@example.com"}]}`},
+		{name: "missing event type", body: `{"events":[{"id":"evt_1","email":"alice@example.com"}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseEmailEvents.Parse("email", []byte(tt.body))
+			var validationErr *domain.ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Errorf("expected ValidationError, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+// Package inbound は外部のサービスから受信するWebhookのプロバイダー（署名の検証とペイロードの解釈）を定義する
+package inbound
+
+import (
+	"slices"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+)
+
+// Parser 受信したボディをプロバイダーのイベントごとの domain.InboundEvent に変換する
+// 解釈できないボディは domain.ErrInboundWebhookPayloadInvalid を返す
+type Parser interface {
+	Parse(provider string, body []byte) ([]*domain.InboundEvent, error)
+}
+
+// ParserFunc 関数を Parser として使うためのアダプター
+type ParserFunc func(provider string, body []byte) ([]*domain.InboundEvent, error)
+
+// Parse Parserインターフェースを実装
+func (f ParserFunc) Parse(provider string, body []byte) ([]*domain.InboundEvent, error) {
+	return f(provider, body)
+}
+
+// Provider Webhookを送信する外部のサービス
+type Provider struct {
+	// Name プロバイダーの名前（受信するURLのパス /inbound-webhooks/{provider} に使う）
+	Name     string
+	Verifier Verifier
+	Parser   Parser
+}
+
+// Registry 受信するプロバイダーの一覧
+type Registry struct {
+	providers map[string]Provider
+}
+
+// NewRegistry Registryのコンストラクタ（同じ名前のプロバイダーは後に指定したものを使う）
+func NewRegistry(providers ...Provider) *Registry {
+	r := &Registry{providers: make(map[string]Provider, len(providers))}
+	for _, provider := range providers {
+		r.providers[provider.Name] = provider
+	}
+	return r
+}
+
+// Lookup 名前でプロバイダーを取得する
+func (r *Registry) Lookup(name string) (Provider, bool) {
+	provider, ok := r.providers[name]
+	return provider, ok
+}
+
+// Names 登録されているプロバイダーの名前（ログ用）
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.providers))
+	for name := range r.providers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
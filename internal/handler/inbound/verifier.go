@@ -0,0 +1,68 @@
+package inbound
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+)
+
+// Verifier 受信したWebhookのリクエストがプロバイダーから送信されたものであることを検証する
+// 検証に失敗した場合は domain.ErrInboundWebhookSignatureInvalid を返す
+type Verifier interface {
+	Verify(header http.Header, body []byte, now time.Time) error
+}
+
+// HMACVerifier 送信するWebhookと同じ形式（"v1=" + "<タイムスタンプ>.<ボディ>" のHMAC-SHA256）の署名を検証する
+// 署名の日時が domain.WebhookSignatureTolerance 以上ずれているリクエストはリプレイとみなして拒否する
+type HMACVerifier struct {
+	// Secrets 署名のシークレット（シークレットを切り替える間は複数を指定し、いずれかで署名されていればよい）
+	Secrets []string
+	// SignatureHeader 署名のヘッダー（カンマ区切りで複数の署名を含めてもよい）
+	SignatureHeader string
+	// TimestampHeader 署名した日時（Unix秒）のヘッダー
+	TimestampHeader string
+}
+
+// NewHMACVerifier 送信するWebhookと同じヘッダー（X-Webhook-Signature・X-Webhook-Timestamp）で署名を検証する HMACVerifier を作成
+func NewHMACVerifier(secrets ...string) *HMACVerifier {
+	return &HMACVerifier{
+		Secrets:         secrets,
+		SignatureHeader: domain.WebhookSignatureHeader,
+		TimestampHeader: domain.WebhookTimestampHeader,
+	}
+}
+
+// Verify Verifierインターフェースを実装
+func (v *HMACVerifier) Verify(header http.Header, body []byte, now time.Time) error {
+	timestamp := header.Get(v.TimestampHeader)
+	for _, signature := range strings.Split(header.Get(v.SignatureHeader), ",") {
+		signature = strings.TrimSpace(signature)
+		if signature == "" {
+			continue
+		}
+		for _, secret := range v.Secrets {
+			if secret != "" && domain.VerifyWebhookSignature(secret, timestamp, signature, body, now) {
+				return nil
+			}
+		}
+	}
+	return domain.ErrInboundWebhookSignatureInvalid()
+}
+
+// TokenVerifier ヘッダーに共有のトークンが含まれていることを検証する（署名に対応していないプロバイダー用）
+// 例えば Header に "Authorization"、Token に "Bearer <トークン>" を指定する
+type TokenVerifier struct {
+	Header string
+	Token  string
+}
+
+// Verify Verifierインターフェースを実装
+func (v *TokenVerifier) Verify(header http.Header, _ []byte, _ time.Time) error {
+	if v.Token == "" || subtle.ConstantTimeCompare([]byte(header.Get(v.Header)), []byte(v.Token)) != 1 {
+		return domain.ErrInboundWebhookSignatureInvalid()
+	}
+	return nil
+}
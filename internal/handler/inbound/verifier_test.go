@@ -0,0 +1,85 @@
+package inbound
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+)
+
+func TestHMACVerifier_Verify(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	body := []byte(`{"events":[]}`)
+
+	signedHeader := func(secret string, signedAt time.Time) http.Header {
+		header := http.Header{}
+		header.Set(domain.WebhookTimestampHeader, strconv.FormatInt(signedAt.Unix(), 10))
+		header.Set(domain.WebhookSignatureHeader, domain.SignWebhook(secret, signedAt, body))
+		return header
+	}
+
+	tests := []struct {
+		name    string
+		secrets []string
+		header  http.Header
+		wantErr bool
+	}{
+		{name: "valid signature", secrets: []string{"secret"}, header: signedHeader("secret", now)},
+		{name: "signed with a rotated secret", secrets: []string{"new-secret", "secret"}, header: signedHeader("secret", now)},
+		{name: "wrong secret", secrets: []string{"secret"}, header: signedHeader("other", now), wantErr: true},
+		{name: "expired timestamp", secrets: []string{"secret"}, header: signedHeader("secret", now.Add(-domain.WebhookSignatureTolerance-time.Second)), wantErr: true},
+		{name: "missing headers", secrets: []string{"secret"}, header: http.Header{}, wantErr: true},
+		{name: "no secrets", secrets: nil, header: signedHeader("", now), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewHMACVerifier(tt.secrets...).Verify(tt.header, body, now)
+			if tt.wantErr {
+				var unauthorizedErr *domain.UnauthorizedError
+				if !errors.As(err, &unauthorizedErr) {
+					t.Errorf("expected UnauthorizedError, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestHMACVerifier_MultipleSignatures(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	body := []byte(`{"events":[]}`)
+
+	header := http.Header{}
+	header.Set(domain.WebhookTimestampHeader, strconv.FormatInt(now.Unix(), 10))
+	header.Set(domain.WebhookSignatureHeader, domain.SignWebhook("old", now, body)+", "+domain.SignWebhook("secret", now, body))
+
+	if err := NewHMACVerifier("secret").Verify(header, body, now); err != nil {
+		t.Errorf("expected one of the signatures to match, got %v", err)
+	}
+}
+
+func TestTokenVerifier_Verify(t *testing.T) {
+	verifier := &TokenVerifier{Header: "Authorization", Token: "Bearer token"}
+
+	header := http.Header{}
+	header.Set("Authorization", "Bearer token")
+	if err := verifier.Verify(header, nil, time.Now()); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+
+	header.Set("Authorization", "Bearer other")
+	if err := verifier.Verify(header, nil, time.Now()); err == nil {
+		t.Error("expected an error for a wrong token")
+	}
+
+	if err := (&TokenVerifier{Header: "Authorization"}).Verify(http.Header{}, nil, time.Now()); err == nil {
+		t.Error("expected an error when no token is configured")
+	}
+}
@@ -0,0 +1,168 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/handler/inbound"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+// maxInboundWebhookBodyBytes 受信するWebhookのボディの最大サイズ
+const maxInboundWebhookBodyBytes = 1 << 20
+
+// InboundWebhookHandler 外部のサービスから受信するWebhook関連のHTTPハンドラー
+// 受信は ReceiveInboundWebhook（OpenAPI の対象外）、受信イベントの管理は ServerInterface のうち InboundEvents を実装する
+type InboundWebhookHandler struct {
+	providers            *inbound.Registry
+	receiveInboundEvents *usecase.ReceiveInboundEventsUsecase
+	listInboundEvents    *usecase.ListInboundEventsUsecase
+	replayInboundEvent   *usecase.ReplayInboundEventUsecase
+}
+
+// NewInboundWebhookHandler InboundWebhookHandlerのコンストラクタ
+func NewInboundWebhookHandler(
+	providers *inbound.Registry,
+	receiveInboundEvents *usecase.ReceiveInboundEventsUsecase,
+	listInboundEvents *usecase.ListInboundEventsUsecase,
+	replayInboundEvent *usecase.ReplayInboundEventUsecase,
+) *InboundWebhookHandler {
+	return &InboundWebhookHandler{
+		providers:            providers,
+		receiveInboundEvents: receiveInboundEvents,
+		listInboundEvents:    listInboundEvents,
+		replayInboundEvent:   replayInboundEvent,
+	}
+}
+
+// ReceiveInboundWebhook プロバイダーから受信したWebhookの署名を検証し、イベントを保存する
+// 認証情報の代わりにプロバイダーの署名で認証するため、認証ミドルウェアを通さないルートに登録する
+// イベントはプロバイダーが組織を区別しないため既定の組織に記録し、処理はワーカーのジョブが行う
+func (h *InboundWebhookHandler) ReceiveInboundWebhook(w http.ResponseWriter, r *http.Request, providerName string) {
+	ctx := r.Context()
+	log := logger.FromContext(ctx)
+
+	provider, ok := h.providers.Lookup(providerName)
+	if !ok {
+		HandleError(w, domain.ErrInboundWebhookProviderNotFound(providerName), log)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxInboundWebhookBodyBytes))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			respondError(w, http.StatusRequestEntityTooLarge, "Webhookのボディのサイズが大きすぎます")
+			return
+		}
+		respondError(w, http.StatusBadRequest, "リクエストの形式が不正です")
+		return
+	}
+
+	// 署名を検証するまではボディを解釈しない
+	if err := provider.Verifier.Verify(r.Header, body, time.Now()); err != nil {
+		HandleError(w, err, log)
+		return
+	}
+	events, err := provider.Parser.Parse(provider.Name, body)
+	if err != nil {
+		HandleError(w, err, log)
+		return
+	}
+
+	ctx = domain.WithTenant(ctx, domain.DefaultOrganizationID)
+	ctx = domain.WithPrincipal(ctx, domain.NewSystemPrincipal("inbound_webhook:"+provider.Name).WithOrganization(domain.DefaultOrganizationID))
+	if _, err := h.receiveInboundEvents.Execute(ctx, events); err != nil {
+		HandleError(w, err, log)
+		return
+	}
+
+	// 受信済みのイベントの再送にも成功を返し、プロバイダーに再送をやめさせる
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// InboundEventsListInboundEvents 受信イベントを新しい順に取得（OpenAPI ServerInterface実装）
+func (h *InboundWebhookHandler) InboundEventsListInboundEvents(w http.ResponseWriter, r *http.Request, params openapi.InboundEventsListInboundEventsParams) {
+	ctx := r.Context()
+
+	// デフォルト値の設定
+	limit := 10
+	offset := 0
+
+	if params.Limit != nil {
+		if *params.Limit > 0 && *params.Limit <= 100 {
+			limit = int(*params.Limit)
+		}
+	}
+
+	if params.Offset != nil && *params.Offset >= 0 {
+		offset = int(*params.Offset)
+	}
+
+	var filter domain.InboundEventFilter
+	if params.Provider != nil {
+		filter.Provider = *params.Provider
+	}
+	if params.Status != nil {
+		filter.Status = domain.InboundEventStatus(*params.Status)
+	}
+
+	events, total, err := h.listInboundEvents.Execute(ctx, filter, limit, offset)
+	if err != nil {
+		HandleError(w, err, logger.FromContext(ctx))
+		return
+	}
+
+	eventResponses := make([]openapi.InboundEvent, 0, len(events))
+	for _, e := range events {
+		eventResponses = append(eventResponses, toInboundEventResponse(e))
+	}
+
+	respondJSON(w, http.StatusOK, openapi.InboundEventList{
+		Events: eventResponses,
+		Total:  int32(total),
+	})
+}
+
+// InboundEventsReplayInboundEvent 受信イベントの再処理を指示する（OpenAPI ServerInterface実装）
+func (h *InboundWebhookHandler) InboundEventsReplayInboundEvent(w http.ResponseWriter, r *http.Request, inboundEventId string) {
+	ctx := r.Context()
+	event, err := h.replayInboundEvent.Execute(ctx, inboundEventId)
+	if err != nil {
+		HandleError(w, err, logger.FromContext(ctx))
+		return
+	}
+
+	respondJSON(w, http.StatusAccepted, toInboundEventResponse(event))
+}
+
+// toInboundEventResponse domain.InboundEventをAPIレスポンスのInboundEventに変換
+func toInboundEventResponse(e *domain.InboundEvent) openapi.InboundEvent {
+	// Payload は受信時にJSONオブジェクトとして解釈できたもの
+	payload := map[string]interface{}{}
+	_ = json.Unmarshal(e.Payload, &payload)
+
+	resp := openapi.InboundEvent{
+		Id:              e.ID,
+		Provider:        e.Provider,
+		ProviderEventId: e.ProviderEventID,
+		EventType:       e.EventType,
+		Payload:         payload,
+		Status:          openapi.InboundEventStatus(e.Status),
+		ReceivedAt:      e.ReceivedAt,
+		ProcessedAt:     e.ProcessedAt,
+	}
+	if e.Subject != "" {
+		resp.Subject = &e.Subject
+	}
+	if e.LastError != "" {
+		resp.LastError = &e.LastError
+	}
+	return resp
+}
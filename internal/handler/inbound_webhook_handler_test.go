@@ -0,0 +1,204 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/handler/inbound"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+// mockInboundEventQuery はテスト用のInboundEventQueryRepositoryモック
+type mockInboundEventQuery struct {
+	events []*domain.InboundEvent
+}
+
+func (m *mockInboundEventQuery) FindByID(_ context.Context, id string) (*domain.InboundEvent, error) {
+	for _, e := range m.events {
+		if e.ID == id {
+			return e, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockInboundEventQuery) filter(filter domain.InboundEventFilter) []*domain.InboundEvent {
+	var result []*domain.InboundEvent
+	for _, e := range m.events {
+		if filter.Provider != "" && e.Provider != filter.Provider {
+			continue
+		}
+		if filter.Status != "" && e.Status != filter.Status {
+			continue
+		}
+		result = append(result, e)
+	}
+	return result
+}
+
+func (m *mockInboundEventQuery) FindAll(_ context.Context, filter domain.InboundEventFilter, limit, offset int) ([]*domain.InboundEvent, error) {
+	events := m.filter(filter)
+	if offset >= len(events) {
+		return nil, nil
+	}
+	return events[offset:min(offset+limit, len(events))], nil
+}
+
+func (m *mockInboundEventQuery) Count(_ context.Context, filter domain.InboundEventFilter) (int, error) {
+	return len(m.filter(filter)), nil
+}
+
+func newInboundWebhookTestHandler() *InboundWebhookHandler {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	query := &mockInboundEventQuery{
+		events: []*domain.InboundEvent{
+			{
+				ID: "01ARZ3NDEKTSV4RRFFQ69G5FG1", OrganizationID: domain.DefaultOrganizationID, Provider: "email", ProviderEventID: "evt_2",
+				EventType: domain.InboundEventTypeEmailHardBounced, Subject: "alice@example.com",
+				Payload: []byte(`{"id":"evt_2","type":"bounce","bounceType":"hard","email":"alice@example.com"}`),
+				Status:  domain.InboundEventStatusProcessed, ReceivedAt: now, ProcessedAt: &now,
+			},
+			{
+				ID: "01ARZ3NDEKTSV4RRFFQ69G5FG0", OrganizationID: domain.DefaultOrganizationID, Provider: "email", ProviderEventID: "evt_1",
+				EventType: domain.InboundEventTypeEmailHardBounced, Subject: "bob@example.com",
+				Payload: []byte(`{"id":"evt_1","type":"bounce","bounceType":"hard","email":"bob@example.com"}`),
+				Status:  domain.InboundEventStatusFailed, LastError: "failed to find user: connection refused", ReceivedAt: now.Add(-time.Minute),
+			},
+		},
+	}
+	return &InboundWebhookHandler{
+		providers: inbound.NewRegistry(inbound.Provider{
+			Name:     "email",
+			Verifier: inbound.NewHMACVerifier("secret"),
+			Parser:   inbound.ParseEmailEvents,
+		}),
+		receiveInboundEvents: usecase.NewReceiveInboundEventsUsecase(nil),
+		listInboundEvents:    usecase.NewListInboundEventsUsecase(query),
+		replayInboundEvent:   usecase.NewReplayInboundEventUsecase(nil),
+	}
+}
+
+// newSignedInboundWebhookRequest はシークレットで署名したWebhookのリクエストを作成
+func newSignedInboundWebhookRequest(target, secret, body string) *http.Request {
+	now := time.Now()
+	req := httptest.NewRequest(http.MethodPost, target, strings.NewReader(body))
+	req.Header.Set(domain.WebhookTimestampHeader, strconv.FormatInt(now.Unix(), 10))
+	req.Header.Set(domain.WebhookSignatureHeader, domain.SignWebhook(secret, now, []byte(body)))
+	return req
+}
+
+func TestReceiveInboundWebhook_Rejected(t *testing.T) {
+	validBody := `{"events":[{"id":"evt_1","type":"bounce","bounceType":"hard","email":"alice@example.com"}]}`
+
+	tests := []struct {
+		name       string
+		provider   string
+		req        *http.Request
+		wantStatus int
+	}{
+		{
+			name:       "unknown provider",
+			provider:   "sms",
+			req:        newSignedInboundWebhookRequest("/inbound-webhooks/sms", "secret", validBody),
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "invalid signature",
+			provider:   "email",
+			req:        newSignedInboundWebhookRequest("/inbound-webhooks/email", "other", validBody),
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "unsigned request",
+			provider:   "email",
+			req:        httptest.NewRequest(http.MethodPost, "/inbound-webhooks/email", strings.NewReader(validBody)),
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "malformed payload",
+			provider:   "email",
+			req:        newSignedInboundWebhookRequest("/inbound-webhooks/email", "secret", `{"events":[{"type":"bounce"}]}`),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "too large body",
+			provider:   "email",
+			req:        newSignedInboundWebhookRequest("/inbound-webhooks/email", "secret", strings.Repeat("a", maxInboundWebhookBodyBytes+1)),
+			wantStatus: http.StatusRequestEntityTooLarge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newInboundWebhookTestHandler()
+			rec := httptest.NewRecorder()
+
+			h.ReceiveInboundWebhook(rec, tt.req, tt.provider)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d: %s", tt.wantStatus, rec.Code, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestInboundEventsListInboundEvents(t *testing.T) {
+	t.Run("filtered by status", func(t *testing.T) {
+		h := newInboundWebhookTestHandler()
+
+		status := openapi.InboundEventStatusFailed
+		req := newAdminRequest(http.MethodGet, "/inbound-events?status=failed", nil)
+		rec := httptest.NewRecorder()
+
+		h.InboundEventsListInboundEvents(rec, req, openapi.InboundEventsListInboundEventsParams{Status: &status})
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+		}
+		var resp openapi.InboundEventList
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if resp.Total != 1 || len(resp.Events) != 1 {
+			t.Fatalf("expected 1 event, got %+v", resp)
+		}
+		event := resp.Events[0]
+		if event.ProviderEventId != "evt_1" || event.LastError == nil || event.ProcessedAt != nil || event.Payload["email"] != "bob@example.com" {
+			t.Errorf("unexpected event: %+v", event)
+		}
+	})
+
+	t.Run("requires inbound_events:manage", func(t *testing.T) {
+		h := newInboundWebhookTestHandler()
+
+		req := newRequestAs(domain.NewUserPrincipal(testAdminUserID).WithRoles(domain.RoleUserManager), http.MethodGet, "/inbound-events", nil)
+		rec := httptest.NewRecorder()
+
+		h.InboundEventsListInboundEvents(rec, req, openapi.InboundEventsListInboundEventsParams{})
+
+		if rec.Code != http.StatusForbidden {
+			t.Errorf("expected status %d, got %d", http.StatusForbidden, rec.Code)
+		}
+	})
+}
+
+func TestInboundEventsReplayInboundEvent_Forbidden(t *testing.T) {
+	h := newInboundWebhookTestHandler()
+
+	req := newRequestAs(domain.NewUserPrincipal(testAdminUserID).WithRoles(domain.RoleAuditor), http.MethodPost, "/inbound-events/01ARZ3NDEKTSV4RRFFQ69G5FG0/replay", nil)
+	rec := httptest.NewRecorder()
+
+	h.InboundEventsReplayInboundEvent(rec, req, "01ARZ3NDEKTSV4RRFFQ69G5FG0")
+
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("expected status %d, got %d", http.StatusForbidden, rec.Code)
+	}
+}
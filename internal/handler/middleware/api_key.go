@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+)
+
+// APIKeyHeader is the request header carrying an API key.
+const APIKeyHeader = "X-API-Key"
+
+// APIKeyVerifier resolves an API key token to the active key it belongs to.
+// It returns nil without error when the token does not identify an active key.
+type APIKeyVerifier interface {
+	Execute(ctx context.Context, token string) (*domain.APIKey, error)
+}
+
+// APIKeyAuthenticator authenticates requests carrying an "X-API-Key" header.
+type APIKeyAuthenticator struct {
+	verifier APIKeyVerifier
+}
+
+// NewAPIKeyAuthenticator creates an APIKeyAuthenticator that verifies keys with verifier.
+func NewAPIKeyAuthenticator(verifier APIKeyVerifier) *APIKeyAuthenticator {
+	return &APIKeyAuthenticator{verifier: verifier}
+}
+
+// AuthenticateRequest implements Authenticator. The principal is the API key itself, granted the key's scopes
+// and bound to the organization the key was created in.
+func (a *APIKeyAuthenticator) AuthenticateRequest(r *http.Request) (domain.Principal, bool, error) {
+	token := strings.TrimSpace(r.Header.Get(APIKeyHeader))
+	if token == "" {
+		return domain.Principal{}, false, nil
+	}
+	apiKey, err := a.verifier.Execute(r.Context(), token)
+	if err != nil {
+		return domain.Principal{}, true, err
+	}
+	if apiKey == nil {
+		return domain.Principal{}, true, fmt.Errorf("%w: unknown, revoked or expired api key", errInvalidCredentials)
+	}
+	return domain.NewAPIKeyPrincipal(apiKey.ID).WithScopes(apiKey.Scopes...).WithOrganization(apiKey.OrganizationID), true, nil
+}
+
+// Scheme implements Authenticator.
+func (a *APIKeyAuthenticator) Scheme() string {
+	return "ApiKey"
+}
@@ -0,0 +1,110 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/handler"
+	apperrors "github.com/example/go-react-cqrs-template/internal/pkg/errors"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// errInvalidCredentials is returned by authenticators when the presented credentials are not valid.
+var errInvalidCredentials = errors.New("invalid credentials")
+
+// Authenticator authenticates a request with one kind of credentials.
+type Authenticator interface {
+	// AuthenticateRequest returns ok=false when the request carries no credentials of this kind.
+	// Credentials that are present but not valid are reported as an error wrapping errInvalidCredentials;
+	// any other error (e.g. a failed key lookup) is treated as an internal error.
+	AuthenticateRequest(r *http.Request) (principal domain.Principal, ok bool, err error)
+	// Scheme is the WWW-Authenticate challenge scheme for this kind of credentials.
+	Scheme() string
+}
+
+// credentialsClearer is implemented by authenticators whose credentials the client stores on the server's
+// instruction (e.g. a cookie). Invalid credentials of such an authenticator are cleared from the client and the
+// request is treated as carrying none of them, since the client cannot drop them by itself.
+type credentialsClearer interface {
+	ClearCredentials(w http.ResponseWriter)
+}
+
+// AuthenticationConfig holds the configuration for the Authentication middleware.
+type AuthenticationConfig struct {
+	// Required rejects requests without credentials. When false they proceed as anonymous.
+	Required bool
+	// AnonymousRoles are granted to requests without credentials when authentication is not required.
+	AnonymousRoles []domain.Role
+}
+
+// Authentication returns an HTTP middleware that authenticates requests with the given authenticators.
+// They are tried in order and the first one finding credentials in the request decides the outcome.
+//
+//   - Valid credentials set the principal in the request context.
+//   - Invalid, expired or revoked credentials receive 401 Unauthorized, except those stored by the client on the
+//     server's instruction (a session cookie): they are cleared and the request is treated as without credentials,
+//     so that e.g. POST /auth/login still works with a revoked session cookie.
+//   - A request without credentials receives 401 when authentication is required,
+//     and otherwise proceeds as anonymous with the configured anonymous roles.
+func Authentication(config AuthenticationConfig, authenticators ...Authenticator) func(http.Handler) http.Handler {
+	schemes := make([]string, len(authenticators))
+	for i, a := range authenticators {
+		schemes[i] = a.Scheme()
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			log := logger.FromContext(r.Context())
+
+			for _, a := range authenticators {
+				principal, ok, err := a.AuthenticateRequest(r)
+				if !ok {
+					continue
+				}
+				if errors.Is(err, errInvalidCredentials) {
+					if clearer, ok := a.(credentialsClearer); ok {
+						log.Debug("clearing invalid credentials", slog.String("scheme", a.Scheme()), slog.String("reason", err.Error()))
+						clearer.ClearCredentials(w)
+						continue
+					}
+					respondUnauthorized(w, log, []string{a.Scheme()}, err.Error(), "認証情報が無効です", "invalid_token")
+					return
+				}
+				if err != nil {
+					handler.HandleError(w, fmt.Errorf("failed to authenticate request: %w", err), log)
+					return
+				}
+
+				ctx := domain.WithPrincipal(r.Context(), principal)
+				next.ServeHTTP(w, r.WithContext(ctx))
+				return
+			}
+
+			if config.Required {
+				respondUnauthorized(w, log, schemes, "missing credentials", "", "")
+				return
+			}
+			if len(config.AnonymousRoles) > 0 {
+				anonymous := domain.AnonymousPrincipal.WithRoles(config.AnonymousRoles...)
+				r = r.WithContext(domain.WithPrincipal(r.Context(), anonymous))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// respondUnauthorized writes a 401 response through the AppError path with a
+// WWW-Authenticate challenge per scheme. errorCode is the RFC 6750 error code, if any.
+func respondUnauthorized(w http.ResponseWriter, log *slog.Logger, schemes []string, message, userMessage, errorCode string) {
+	for _, scheme := range schemes {
+		challenge := scheme
+		if errorCode != "" {
+			challenge += fmt.Sprintf(` error=%q`, errorCode)
+		}
+		w.Header().Add("WWW-Authenticate", challenge)
+	}
+	handler.HandleError(w, apperrors.Unauthorized(message, userMessage), log)
+}
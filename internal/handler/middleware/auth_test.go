@@ -0,0 +1,186 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// stubAPIKeyVerifier accepts a single token and optionally fails every lookup.
+type stubAPIKeyVerifier struct {
+	token string
+	err   error
+}
+
+func (v *stubAPIKeyVerifier) Execute(ctx context.Context, token string) (*domain.APIKey, error) {
+	if v.err != nil {
+		return nil, v.err
+	}
+	if token != v.token {
+		return nil, nil
+	}
+	return &domain.APIKey{ID: "01ARZ3NDEKTSV4RRFFQ69G5FAW"}, nil
+}
+
+func serveWithAuthentication(t *testing.T, mw func(http.Handler) http.Handler, headers map[string]string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	rec := httptest.NewRecorder()
+	mw(newPrincipalEchoHandler()).ServeHTTP(rec, req)
+	return rec
+}
+
+func TestAuthentication_APIKey(t *testing.T) {
+	keys := NewJWTKeys()
+	keys.AddHMACSecret("", testJWTSecret)
+	jwtAuth := NewJWTAuthenticator(JWTConfig{Keys: keys})
+	apiKeyAuth := NewAPIKeyAuthenticator(&stubAPIKeyVerifier{token: "cqk_valid"})
+	mw := Authentication(AuthenticationConfig{}, jwtAuth, apiKeyAuth)
+
+	tests := []struct {
+		name          string
+		headers       map[string]string
+		wantStatus    int
+		wantBody      string
+		wantChallenge string
+	}{
+		{
+			name:       "valid api key",
+			headers:    map[string]string{APIKeyHeader: "cqk_valid"},
+			wantStatus: http.StatusOK,
+			wantBody:   "api_key:01ARZ3NDEKTSV4RRFFQ69G5FAW",
+		},
+		{
+			name:          "unknown api key",
+			headers:       map[string]string{APIKeyHeader: "cqk_other"},
+			wantStatus:    http.StatusUnauthorized,
+			wantChallenge: `ApiKey error="invalid_token"`,
+		},
+		{
+			name: "bearer token takes precedence",
+			headers: map[string]string{
+				"Authorization": "Bearer " + signTestJWT(t, jwt.SigningMethodHS256, testJWTSecret, "", validTestClaims()),
+				APIKeyHeader:    "cqk_other",
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   "user:01ARZ3NDEKTSV4RRFFQ69G5FAV",
+		},
+		{
+			name:       "no credentials",
+			wantStatus: http.StatusOK,
+			wantBody:   "anonymous",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serveWithAuthentication(t, mw, tt.headers)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d (%s)", tt.wantStatus, rec.Code, rec.Body.String())
+			}
+			if tt.wantChallenge != "" {
+				if got := rec.Header().Get("WWW-Authenticate"); got != tt.wantChallenge {
+					t.Errorf("expected WWW-Authenticate %q, got %q", tt.wantChallenge, got)
+				}
+				return
+			}
+			if rec.Body.String() != tt.wantBody {
+				t.Errorf("expected principal %q, got %q", tt.wantBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestAuthentication_VerifierError(t *testing.T) {
+	apiKeyAuth := NewAPIKeyAuthenticator(&stubAPIKeyVerifier{err: errors.New("connection refused")})
+
+	rec := serveWithAuthentication(t, Authentication(AuthenticationConfig{}, apiKeyAuth), map[string]string{APIKeyHeader: "cqk_valid"})
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("expected status %d, got %d", http.StatusInternalServerError, rec.Code)
+	}
+}
+
+func TestAuthentication_Required(t *testing.T) {
+	keys := NewJWTKeys()
+	keys.AddHMACSecret("", testJWTSecret)
+	jwtAuth := NewJWTAuthenticator(JWTConfig{Keys: keys})
+	apiKeyAuth := NewAPIKeyAuthenticator(&stubAPIKeyVerifier{token: "cqk_valid"})
+	mw := Authentication(AuthenticationConfig{Required: true}, jwtAuth, apiKeyAuth)
+
+	rec := serveWithAuthentication(t, mw, nil)
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected status %d, got %d", http.StatusUnauthorized, rec.Code)
+	}
+	challenges := rec.Header().Values("WWW-Authenticate")
+	if len(challenges) != 2 || challenges[0] != "Bearer" || challenges[1] != "ApiKey" {
+		t.Errorf("unexpected WWW-Authenticate %q", challenges)
+	}
+
+	rec = serveWithAuthentication(t, mw, map[string]string{"Authorization": "Basic dXNlcjpwYXNz"})
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("expected status %d for non-bearer credentials, got %d", http.StatusUnauthorized, rec.Code)
+	}
+
+	rec = serveWithAuthentication(t, mw, map[string]string{APIKeyHeader: "cqk_valid"})
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected status %d with an api key, got %d", http.StatusOK, rec.Code)
+	}
+}
+
+func TestAuthentication_Permissions(t *testing.T) {
+	keys := NewJWTKeys()
+	keys.AddHMACSecret("", testJWTSecret)
+	jwtAuth := NewJWTAuthenticator(JWTConfig{Keys: keys})
+	mw := Authentication(AuthenticationConfig{AnonymousRoles: []domain.Role{domain.RoleViewer}}, jwtAuth)
+
+	// Echo whether the principal may delete users and read them
+	echo := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal := domain.PrincipalFromContext(r.Context())
+		_, _ = fmt.Fprintf(w, "%v,%v", principal.Can(domain.PermissionUsersDelete), principal.Can(domain.PermissionUsersRead))
+	})
+
+	signWithRoles := func(roles ...string) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwtClaims{RegisteredClaims: validTestClaims(), Roles: roles})
+		signed, err := token.SignedString(testJWTSecret)
+		if err != nil {
+			t.Fatalf("failed to sign token: %v", err)
+		}
+		return "Bearer " + signed
+	}
+
+	tests := []struct {
+		name          string
+		authorization string
+		want          string
+	}{
+		{name: "admin role", authorization: signWithRoles("admin"), want: "true,true"},
+		{name: "unknown role", authorization: signWithRoles("root"), want: "false,false"},
+		{name: "no roles", authorization: signWithRoles(), want: "false,false"},
+		{name: "anonymous", want: "false,true"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			rec := httptest.NewRecorder()
+			mw(echo).ServeHTTP(rec, req)
+
+			if rec.Body.String() != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,382 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/handler"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	apperrors "github.com/example/go-react-cqrs-template/internal/pkg/errors"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+const (
+	// IdempotencyKeyHeader is the request header carrying the client-supplied key.
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader is set on responses replayed from the store.
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+)
+
+// replayedHeaders lists the response headers recorded and replayed with a stored response.
+var replayedHeaders = []string{"Content-Type", "Location"}
+
+// IdempotencyStore persists idempotency keys together with the recorded response.
+// An acquired key is held under a lease (record.LeaseID) that must be extended while the request runs;
+// once the lease runs out, a retry of the same request takes the key over.
+type IdempotencyStore interface {
+	Acquire(ctx context.Context, key, fingerprint string, ttl, lease time.Duration) (*infrastructure.IdempotencyRecord, bool, error)
+	Extend(ctx context.Context, key, leaseID string, lease time.Duration) error
+	Complete(ctx context.Context, key, leaseID string, statusCode int, header map[string]string, body []byte) error
+	Release(ctx context.Context, key, leaseID string) error
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
+
+// IdempotencyConfig holds the configuration for the idempotency middleware.
+type IdempotencyConfig struct {
+	// TTL is how long a key and its recorded response are kept.
+	TTL time.Duration
+	// CleanupInterval is how often expired keys are purged from the store.
+	CleanupInterval time.Duration
+	// Lease is how long an in-flight key stays locked without being renewed. The lease is renewed
+	// while the request runs, so it only runs out when the server handling the request died;
+	// a retry with the same request then takes the key over instead of receiving 409 until TTL.
+	Lease time.Duration
+	// MaxBodyBytes is the largest request body buffered for fingerprinting. Larger requests
+	// receive 413 Request Entity Too Large. It must not be smaller than any handler's own limit.
+	MaxBodyBytes int64
+}
+
+// DefaultIdempotencyConfig returns an IdempotencyConfig with sensible defaults.
+func DefaultIdempotencyConfig() IdempotencyConfig {
+	return IdempotencyConfig{
+		TTL:             24 * time.Hour,
+		CleanupInterval: time.Hour,
+		Lease:           30 * time.Second,
+		// The user import accepts the largest bodies (10 MiB)
+		MaxBodyBytes: 10 << 20,
+	}
+}
+
+// Idempotency makes mutating requests carrying an Idempotency-Key header safe to retry.
+// The first request with a key is executed and its response recorded; later requests
+// with the same key and payload receive the recorded response instead of being executed again.
+type Idempotency struct {
+	store    IdempotencyStore
+	config   IdempotencyConfig
+	stopCh   chan struct{}
+	stopOnce sync.Once
+}
+
+// NewIdempotency creates a new Idempotency middleware with the given store and configuration.
+// It starts a background goroutine to purge expired keys.
+func NewIdempotency(store IdempotencyStore, config IdempotencyConfig) *Idempotency {
+	m := &Idempotency{
+		store:  store,
+		config: config,
+		stopCh: make(chan struct{}),
+	}
+
+	go m.cleanupLoop()
+
+	return m
+}
+
+// Stop stops the background cleanup goroutine.
+// It is safe to call Stop multiple times.
+func (m *Idempotency) Stop() {
+	m.stopOnce.Do(func() {
+		close(m.stopCh)
+	})
+}
+
+// cleanupLoop periodically removes expired keys from the store.
+func (m *Idempotency) cleanupLoop() {
+	ticker := time.NewTicker(m.config.CleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			deleted, err := m.store.DeleteExpired(context.Background(), time.Now())
+			if err != nil {
+				logger.Get().Error("failed to purge expired idempotency keys", slog.String("error", err.Error()))
+				continue
+			}
+			if deleted > 0 {
+				logger.Get().Info("purged expired idempotency keys", slog.Int64("deleted", deleted))
+			}
+		case <-m.stopCh:
+			return
+		}
+	}
+}
+
+// Handler returns an HTTP middleware that enforces Idempotency-Key semantics
+// for POST, PUT, PATCH and DELETE requests. Requests without the header pass through.
+//
+//   - A duplicate of a completed request replays the recorded response with an
+//     Idempotent-Replayed header.
+//   - A duplicate of a request that is still in flight receives 409 Conflict, unless the
+//     lease of the in-flight request ran out (its server died), in which case it takes over the key.
+//   - Reusing a key with a different method, path, query or body receives 422 Unprocessable Entity.
+//   - A body larger than MaxBodyBytes receives 413 Request Entity Too Large.
+//
+// Keys are scoped to the request's tenant and principal, so it must run after the
+// Authentication and Tenant middlewares. Requests without credentials pass through,
+// since anonymous callers cannot be told apart and could replay each other's responses.
+//
+// Responses with a 5xx status are not recorded so that the client can retry with the same key.
+// Neither are responses setting cookies (e.g. a login session): replaying them without the
+// cookie would silently drop the session, and recording the cookie would store the session token.
+// Responses marked "Cache-Control: no-store" (e.g. a created API key with its secret) are not
+// recorded either, so that secrets shown once are never persisted.
+func (m *Idempotency) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(IdempotencyKeyHeader)
+		if key == "" || !isMutatingMethod(r.Method) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := r.Context()
+		principal := domain.PrincipalFromContext(ctx)
+		if principal.Type == domain.PrincipalTypeAnonymous {
+			next.ServeHTTP(w, r)
+			return
+		}
+		log := logger.FromContext(ctx)
+
+		if len(key) > maxIdempotencyKeyLength {
+			handler.HandleError(w, apperrors.BadRequest(
+				"idempotency key too long",
+				"Idempotency-Keyは"+strconv.Itoa(maxIdempotencyKeyLength)+"文字以下で指定してください",
+			), log)
+			return
+		}
+
+		// Read the body so that it can be fingerprinted and passed on, without buffering more than any handler accepts
+		var body []byte
+		if r.Body != nil {
+			var err error
+			body, err = io.ReadAll(http.MaxBytesReader(w, r.Body, m.config.MaxBodyBytes))
+			if err != nil {
+				var maxBytesErr *http.MaxBytesError
+				if errors.As(err, &maxBytesErr) {
+					handler.HandleError(w, apperrors.New(
+						err.Error(),
+						"リクエストボディが大きすぎます",
+						http.StatusRequestEntityTooLarge,
+						apperrors.LevelInfo,
+					), log)
+					return
+				}
+				handler.HandleError(w, apperrors.BadRequest(err.Error(), "リクエストボディを読み込めませんでした"), log)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+		}
+		fingerprint := requestFingerprint(r, body)
+
+		// Keys are scoped to the tenant and principal so that callers cannot replay each other's responses
+		key = scopedIdempotencyKey(ctx, principal, key)
+
+		record, acquired, err := m.store.Acquire(ctx, key, fingerprint, m.config.TTL, m.config.Lease)
+		if err != nil {
+			handler.HandleError(w, apperrors.Internal(err, ""), log)
+			return
+		}
+
+		if !acquired {
+			m.respondExisting(w, record, fingerprint, log)
+			return
+		}
+
+		m.execute(w, r, next, record)
+	})
+}
+
+// respondExisting answers a request whose key has already been used.
+func (m *Idempotency) respondExisting(w http.ResponseWriter, record *infrastructure.IdempotencyRecord, fingerprint string, log *slog.Logger) {
+	if record.Fingerprint != fingerprint {
+		handler.HandleError(w, apperrors.UnprocessableEntity(
+			"idempotency key reused with a different request: "+record.Key,
+			"このIdempotency-Keyは異なるリクエストで既に使用されています",
+		), log)
+		return
+	}
+
+	if !record.Completed() {
+		handler.HandleError(w, apperrors.Conflict(
+			"request with idempotency key is still in progress: "+record.Key,
+			"同じIdempotency-Keyのリクエストを処理中です。しばらくしてから再試行してください",
+		), log)
+		return
+	}
+
+	for name, value := range record.Header {
+		w.Header().Set(name, value)
+	}
+	w.Header().Set(IdempotentReplayedHeader, "true")
+	w.WriteHeader(record.StatusCode)
+	_, _ = w.Write(record.Body)
+}
+
+// execute runs the request and records the response for the acquired key.
+func (m *Idempotency) execute(w http.ResponseWriter, r *http.Request, next http.Handler, record *infrastructure.IdempotencyRecord) {
+	// Record the response even if the client disconnects meanwhile
+	storeCtx := context.WithoutCancel(r.Context())
+	log := logger.FromContext(storeCtx)
+	key := record.Key
+
+	recorder := &recordingResponseWriter{ResponseWriter: w}
+
+	stopRenewing := m.renewLease(storeCtx, record)
+	completed := false
+	defer func() {
+		stopRenewing()
+		if completed {
+			return
+		}
+		// Release the key on 5xx, panics or store failures so the client can retry
+		if err := m.store.Release(storeCtx, key, record.LeaseID); err != nil {
+			log.Error("failed to release idempotency key", slog.String("error", err.Error()))
+		}
+	}()
+
+	next.ServeHTTP(recorder, r)
+	stopRenewing()
+
+	status := recorder.status()
+	if status >= http.StatusInternalServerError || !recordable(recorder.Header()) {
+		return
+	}
+
+	header := make(map[string]string, len(replayedHeaders))
+	for _, name := range replayedHeaders {
+		if value := recorder.Header().Get(name); value != "" {
+			header[name] = value
+		}
+	}
+
+	if err := m.store.Complete(storeCtx, key, record.LeaseID, status, header, recorder.body.Bytes()); err != nil {
+		log.Error("failed to record idempotent response", slog.String("error", err.Error()))
+		return
+	}
+	completed = true
+}
+
+// renewLease extends the lease of the acquired key until the returned function is called.
+// The returned function waits for a renewal in progress and may be called more than once.
+func (m *Idempotency) renewLease(ctx context.Context, record *infrastructure.IdempotencyRecord) func() {
+	stopCh := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(m.config.Lease / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := m.store.Extend(ctx, record.Key, record.LeaseID, m.config.Lease); err != nil {
+					logger.FromContext(ctx).Warn("failed to extend idempotency key lease", slog.String("error", err.Error()))
+				}
+			case <-stopCh:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { close(stopCh) })
+		<-done
+	}
+}
+
+// recordable reports whether a response with the given headers may be stored for replay.
+func recordable(header http.Header) bool {
+	if len(header.Values("Set-Cookie")) > 0 {
+		return false
+	}
+	for _, value := range header.Values("Cache-Control") {
+		for directive := range strings.SplitSeq(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(directive), "no-store") {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// scopedIdempotencyKey returns the stored key, "<organization_id>:<principal>:<key>".
+func scopedIdempotencyKey(ctx context.Context, principal domain.Principal, key string) string {
+	organizationID, _ := domain.TenantFromContext(ctx)
+	return organizationID + ":" + principal.String() + ":" + key
+}
+
+// requestFingerprint returns a hash identifying the method, path, query and body of the request.
+func requestFingerprint(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.Method))
+	h.Write([]byte{0})
+	h.Write([]byte(r.URL.Path))
+	if r.URL.RawQuery != "" {
+		h.Write([]byte("?" + r.URL.RawQuery))
+	}
+	h.Write([]byte{0})
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// isMutatingMethod reports whether the method is subject to idempotency handling.
+func isMutatingMethod(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// recordingResponseWriter passes the response through while keeping a copy of it.
+type recordingResponseWriter struct {
+	http.ResponseWriter
+	statusCode int
+	body       bytes.Buffer
+}
+
+func (rw *recordingResponseWriter) WriteHeader(code int) {
+	if rw.statusCode == 0 {
+		rw.statusCode = code
+	}
+	rw.ResponseWriter.WriteHeader(code)
+}
+
+func (rw *recordingResponseWriter) Write(b []byte) (int, error) {
+	if rw.statusCode == 0 {
+		rw.statusCode = http.StatusOK
+	}
+	rw.body.Write(b)
+	return rw.ResponseWriter.Write(b)
+}
+
+// status returns the status code written by the handler.
+func (rw *recordingResponseWriter) status() int {
+	if rw.statusCode == 0 {
+		return http.StatusOK
+	}
+	return rw.statusCode
+}
@@ -0,0 +1,558 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+// memoryIdempotencyStore is an in-memory IdempotencyStore for tests.
+type memoryIdempotencyStore struct {
+	mu      sync.Mutex
+	records map[string]*infrastructure.IdempotencyRecord
+	leases  int
+}
+
+func newMemoryIdempotencyStore() *memoryIdempotencyStore {
+	return &memoryIdempotencyStore{records: make(map[string]*infrastructure.IdempotencyRecord)}
+}
+
+func (s *memoryIdempotencyStore) Acquire(_ context.Context, key, fingerprint string, ttl, lease time.Duration) (*infrastructure.IdempotencyRecord, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if existing, ok := s.records[key]; ok && existing.ExpiresAt.After(now) {
+		// An in-flight key whose lease ran out is taken over by the same request
+		takeOver := !existing.Completed() && existing.Fingerprint == fingerprint && !existing.LockedUntil.After(now)
+		if !takeOver {
+			copied := *existing
+			return &copied, false, nil
+		}
+	}
+	s.leases++
+	record := &infrastructure.IdempotencyRecord{
+		Key:         key,
+		Fingerprint: fingerprint,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(ttl),
+		LeaseID:     fmt.Sprint("lease-", s.leases),
+		LockedUntil: now.Add(lease),
+	}
+	s.records[key] = record
+	copied := *record
+	return &copied, true, nil
+}
+
+func (s *memoryIdempotencyStore) Extend(_ context.Context, key, leaseID string, lease time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, ok := s.records[key]
+	if !ok || record.LeaseID != leaseID || record.Completed() {
+		return infrastructure.ErrIdempotencyLeaseLost
+	}
+	record.LockedUntil = time.Now().Add(lease)
+	return nil
+}
+
+func (s *memoryIdempotencyStore) Complete(_ context.Context, key, leaseID string, statusCode int, header map[string]string, body []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, ok := s.records[key]
+	if !ok || record.LeaseID != leaseID {
+		return infrastructure.ErrIdempotencyLeaseLost
+	}
+	record.StatusCode = statusCode
+	record.Header = header
+	record.Body = append([]byte(nil), body...)
+	record.LeaseID = ""
+	return nil
+}
+
+func (s *memoryIdempotencyStore) Release(_ context.Context, key, leaseID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if record, ok := s.records[key]; ok && record.LeaseID == leaseID {
+		delete(s.records, key)
+	}
+	return nil
+}
+
+func (s *memoryIdempotencyStore) DeleteExpired(_ context.Context, now time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var deleted int64
+	for key, record := range s.records {
+		if !record.ExpiresAt.After(now) {
+			delete(s.records, key)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+func newTestIdempotency(store IdempotencyStore) *Idempotency {
+	return NewIdempotency(store, IdempotencyConfig{
+		TTL:             time.Hour,
+		CleanupInterval: 10 * time.Minute,
+		Lease:           time.Minute,
+		MaxBodyBytes:    1 << 10,
+	})
+}
+
+// countingHandler responds 201 with a JSON body and counts its invocations.
+func countingHandler(calls *atomic.Int32, status int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Location", "/api/v1/users/01ARZ3NDEKTSV4RRFFQ69G5FAV")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"id":"01ARZ3NDEKTSV4RRFFQ69G5FAV"}`))
+	})
+}
+
+// testIdempotencyPrincipal is the authenticated caller of newIdempotentRequest.
+var testIdempotencyPrincipal = domain.NewUserPrincipal("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+
+func newIdempotentRequest(method, key, body string) *http.Request {
+	req := httptest.NewRequest(method, "/users", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if key != "" {
+		req.Header.Set(IdempotencyKeyHeader, key)
+	}
+	return req.WithContext(domain.WithPrincipal(req.Context(), testIdempotencyPrincipal))
+}
+
+func TestIdempotency_ReplaysCompletedResponse(t *testing.T) {
+	m := newTestIdempotency(newMemoryIdempotencyStore())
+	defer m.Stop()
+
+	var calls atomic.Int32
+	handler := m.Handler(countingHandler(&calls, http.StatusCreated))
+
+	body := `{"name":"John","email":"john@example.com"}`
+
+	first := httptest.NewRecorder()
+	handler.ServeHTTP(first, newIdempotentRequest(http.MethodPost, "key-1", body))
+
+	second := httptest.NewRecorder()
+	handler.ServeHTTP(second, newIdempotentRequest(http.MethodPost, "key-1", body))
+
+	if got := calls.Load(); got != 1 {
+		t.Fatalf("expected handler to run once, ran %d times", got)
+	}
+	if second.Code != http.StatusCreated {
+		t.Errorf("expected replayed status %d, got %d", http.StatusCreated, second.Code)
+	}
+	if second.Body.String() != first.Body.String() {
+		t.Errorf("expected replayed body %q, got %q", first.Body.String(), second.Body.String())
+	}
+	if got := second.Header().Get("Location"); got != first.Header().Get("Location") {
+		t.Errorf("expected replayed Location %q, got %q", first.Header().Get("Location"), got)
+	}
+	if got := second.Header().Get(IdempotentReplayedHeader); got != "true" {
+		t.Errorf("expected %s header 'true', got %q", IdempotentReplayedHeader, got)
+	}
+	if got := first.Header().Get(IdempotentReplayedHeader); got != "" {
+		t.Errorf("expected no %s header on first response, got %q", IdempotentReplayedHeader, got)
+	}
+}
+
+func TestIdempotency_RejectsKeyReuseWithDifferentBody(t *testing.T) {
+	m := newTestIdempotency(newMemoryIdempotencyStore())
+	defer m.Stop()
+
+	var calls atomic.Int32
+	handler := m.Handler(countingHandler(&calls, http.StatusCreated))
+
+	handler.ServeHTTP(httptest.NewRecorder(), newIdempotentRequest(http.MethodPost, "key-1", `{"name":"John"}`))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, newIdempotentRequest(http.MethodPost, "key-1", `{"name":"Jane"}`))
+
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Errorf("expected status %d, got %d", http.StatusUnprocessableEntity, w.Code)
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("expected handler to run once, ran %d times", got)
+	}
+}
+
+func TestIdempotency_KeysAreScopedToTenant(t *testing.T) {
+	m := newTestIdempotency(newMemoryIdempotencyStore())
+	defer m.Stop()
+
+	var calls atomic.Int32
+	handler := m.Handler(countingHandler(&calls, http.StatusCreated))
+
+	body := `{"name":"John","email":"john@example.com"}`
+	for _, organizationID := range []string{domain.DefaultOrganizationID, "01ARZ3NDEKTSV4RRFFQ69G5FD0"} {
+		req := newIdempotentRequest(http.MethodPost, "key-1", body)
+		req = req.WithContext(domain.WithTenant(req.Context(), organizationID))
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		if got := w.Header().Get(IdempotentReplayedHeader); got != "" {
+			t.Errorf("expected no replay for organization %s, got %s header %q", organizationID, IdempotentReplayedHeader, got)
+		}
+	}
+
+	if got := calls.Load(); got != 2 {
+		t.Errorf("expected handler to run once per organization, ran %d times", got)
+	}
+}
+
+func TestIdempotency_KeysAreScopedToPrincipal(t *testing.T) {
+	m := newTestIdempotency(newMemoryIdempotencyStore())
+	defer m.Stop()
+
+	var calls atomic.Int32
+	handler := m.Handler(countingHandler(&calls, http.StatusCreated))
+
+	body := `{"name":"John","email":"john@example.com"}`
+	principals := []domain.Principal{
+		testIdempotencyPrincipal,
+		domain.NewUserPrincipal("01ARZ3NDEKTSV4RRFFQ69G5FAW"),
+		domain.NewAPIKeyPrincipal("01ARZ3NDEKTSV4RRFFQ69G5FAV"),
+	}
+	for _, principal := range principals {
+		req := newIdempotentRequest(http.MethodPost, "key-1", body)
+		req = req.WithContext(domain.WithPrincipal(req.Context(), principal))
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		if got := w.Header().Get(IdempotentReplayedHeader); got != "" {
+			t.Errorf("expected no replay for %s, got %s header %q", principal, IdempotentReplayedHeader, got)
+		}
+	}
+
+	if got := calls.Load(); got != int32(len(principals)) {
+		t.Errorf("expected handler to run once per principal, ran %d times", got)
+	}
+}
+
+func TestIdempotency_PassesThroughAnonymousRequests(t *testing.T) {
+	store := newMemoryIdempotencyStore()
+	m := newTestIdempotency(store)
+	defer m.Stop()
+
+	var calls atomic.Int32
+	handler := m.Handler(countingHandler(&calls, http.StatusCreated))
+
+	for i := 0; i < 2; i++ {
+		req := newIdempotentRequest(http.MethodPost, "key-1", `{}`)
+		req = req.WithContext(domain.WithPrincipal(req.Context(), domain.AnonymousPrincipal))
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		if got := w.Header().Get(IdempotentReplayedHeader); got != "" {
+			t.Errorf("request %d: expected no replay, got %s header %q", i, IdempotentReplayedHeader, got)
+		}
+	}
+
+	if got := calls.Load(); got != 2 {
+		t.Errorf("expected handler to run twice, ran %d times", got)
+	}
+	if len(store.records) != 0 {
+		t.Errorf("expected no stored keys, got %d", len(store.records))
+	}
+}
+
+func TestIdempotency_InFlightDuplicateConflicts(t *testing.T) {
+	store := newMemoryIdempotencyStore()
+	m := newTestIdempotency(store)
+	defer m.Stop()
+
+	body := `{"name":"John"}`
+	key := scopedIdempotencyKey(context.Background(), testIdempotencyPrincipal, "key-1")
+	if _, acquired, _ := store.Acquire(context.Background(), key, requestFingerprint(newIdempotentRequest(http.MethodPost, "key-1", body), []byte(body)), time.Hour, time.Minute); !acquired {
+		t.Fatal("failed to acquire key")
+	}
+
+	var calls atomic.Int32
+	handler := m.Handler(countingHandler(&calls, http.StatusCreated))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, newIdempotentRequest(http.MethodPost, "key-1", body))
+
+	if w.Code != http.StatusConflict {
+		t.Errorf("expected status %d, got %d", http.StatusConflict, w.Code)
+	}
+	if got := calls.Load(); got != 0 {
+		t.Errorf("expected handler not to run, ran %d times", got)
+	}
+}
+
+func TestIdempotency_TakesOverKeyWithExpiredLease(t *testing.T) {
+	store := newMemoryIdempotencyStore()
+	m := newTestIdempotency(store)
+	defer m.Stop()
+
+	// The server handling the first request died without renewing its lease
+	body := `{"name":"John"}`
+	key := scopedIdempotencyKey(context.Background(), testIdempotencyPrincipal, "key-1")
+	if _, acquired, _ := store.Acquire(context.Background(), key, requestFingerprint(newIdempotentRequest(http.MethodPost, "key-1", body), []byte(body)), time.Hour, -time.Second); !acquired {
+		t.Fatal("failed to acquire key")
+	}
+
+	var calls atomic.Int32
+	handler := m.Handler(countingHandler(&calls, http.StatusCreated))
+
+	// A different request still cannot take the key over
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, newIdempotentRequest(http.MethodPost, "key-1", `{"name":"Jane"}`))
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Errorf("expected status %d, got %d", http.StatusUnprocessableEntity, w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, newIdempotentRequest(http.MethodPost, "key-1", body))
+	if w.Code != http.StatusCreated {
+		t.Errorf("expected status %d, got %d", http.StatusCreated, w.Code)
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("expected handler to run once, ran %d times", got)
+	}
+
+	// The response of the retry is recorded
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, newIdempotentRequest(http.MethodPost, "key-1", body))
+	if w.Header().Get(IdempotentReplayedHeader) != "true" {
+		t.Error("expected the response to be replayed")
+	}
+}
+
+func TestIdempotency_RenewsLeaseWhileInFlight(t *testing.T) {
+	store := newMemoryIdempotencyStore()
+	m := NewIdempotency(store, IdempotencyConfig{
+		TTL:             time.Hour,
+		CleanupInterval: 10 * time.Minute,
+		Lease:           30 * time.Millisecond,
+		MaxBodyBytes:    1 << 10,
+	})
+	defer m.Stop()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var calls atomic.Int32
+	handler := m.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			close(started)
+			<-release
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		handler.ServeHTTP(httptest.NewRecorder(), newIdempotentRequest(http.MethodPost, "key-1", `{}`))
+	}()
+	<-started
+
+	// A slow request keeps its key for longer than one lease
+	time.Sleep(100 * time.Millisecond)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, newIdempotentRequest(http.MethodPost, "key-1", `{}`))
+	close(release)
+	<-done
+
+	if w.Code != http.StatusConflict {
+		t.Errorf("expected status %d, got %d", http.StatusConflict, w.Code)
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("expected handler to run once, ran %d times", got)
+	}
+}
+
+func TestIdempotency_RejectsKeyReuseWithDifferentQuery(t *testing.T) {
+	m := newTestIdempotency(newMemoryIdempotencyStore())
+	defer m.Stop()
+
+	var calls atomic.Int32
+	handler := m.Handler(countingHandler(&calls, http.StatusCreated))
+
+	first := newIdempotentRequest(http.MethodPost, "key-1", `{}`)
+	first.URL.RawQuery = "dry_run=true"
+	handler.ServeHTTP(httptest.NewRecorder(), first)
+
+	second := newIdempotentRequest(http.MethodPost, "key-1", `{}`)
+	second.URL.RawQuery = "dry_run=false"
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, second)
+
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Errorf("expected status %d, got %d", http.StatusUnprocessableEntity, w.Code)
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("expected handler to run once, ran %d times", got)
+	}
+}
+
+func TestIdempotency_RejectsTooLargeBody(t *testing.T) {
+	store := newMemoryIdempotencyStore()
+	m := newTestIdempotency(store)
+	defer m.Stop()
+
+	var calls atomic.Int32
+	handler := m.Handler(countingHandler(&calls, http.StatusCreated))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, newIdempotentRequest(http.MethodPost, "key-1", `{"name":"`+strings.Repeat("a", 1<<10)+`"}`))
+
+	if w.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("expected status %d, got %d", http.StatusRequestEntityTooLarge, w.Code)
+	}
+	if got := calls.Load(); got != 0 {
+		t.Errorf("expected handler not to run, ran %d times", got)
+	}
+	if len(store.records) != 0 {
+		t.Errorf("expected no key to be stored, got %d", len(store.records))
+	}
+}
+
+func TestIdempotency_ServerErrorReleasesKey(t *testing.T) {
+	m := newTestIdempotency(newMemoryIdempotencyStore())
+	defer m.Stop()
+
+	var calls atomic.Int32
+	handler := m.Handler(countingHandler(&calls, http.StatusInternalServerError))
+
+	for i := 0; i < 2; i++ {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, newIdempotentRequest(http.MethodPost, "key-1", `{}`))
+		if w.Code != http.StatusInternalServerError {
+			t.Errorf("request %d: expected status %d, got %d", i, http.StatusInternalServerError, w.Code)
+		}
+	}
+
+	if got := calls.Load(); got != 2 {
+		t.Errorf("expected handler to run twice, ran %d times", got)
+	}
+}
+
+func TestIdempotency_ResponseSettingCookieIsNotRecorded(t *testing.T) {
+	store := newMemoryIdempotencyStore()
+	m := newTestIdempotency(store)
+	defer m.Stop()
+
+	var calls atomic.Int32
+	handler := m.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "secret-token"})
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	for i := 0; i < 2; i++ {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, newIdempotentRequest(http.MethodPost, "key-1", `{}`))
+		if w.Header().Get("Set-Cookie") == "" {
+			t.Errorf("request %d: expected Set-Cookie header", i)
+		}
+		if got := w.Header().Get(IdempotentReplayedHeader); got != "" {
+			t.Errorf("request %d: expected no replay, got %s header %q", i, IdempotentReplayedHeader, got)
+		}
+	}
+
+	if got := calls.Load(); got != 2 {
+		t.Errorf("expected handler to run twice, ran %d times", got)
+	}
+	if len(store.records) != 0 {
+		t.Errorf("expected the key to be released, got %d stored keys", len(store.records))
+	}
+}
+
+func TestIdempotency_NoStoreResponseIsNotRecorded(t *testing.T) {
+	store := newMemoryIdempotencyStore()
+	m := newTestIdempotency(store)
+	defer m.Stop()
+
+	var calls atomic.Int32
+	handler := m.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Cache-Control", "private, no-store")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"secret":"ak_live_secret"}`))
+	}))
+
+	for i := 0; i < 2; i++ {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, newIdempotentRequest(http.MethodPost, "key-1", `{}`))
+		if got := w.Header().Get(IdempotentReplayedHeader); got != "" {
+			t.Errorf("request %d: expected no replay, got %s header %q", i, IdempotentReplayedHeader, got)
+		}
+	}
+
+	if got := calls.Load(); got != 2 {
+		t.Errorf("expected handler to run twice, ran %d times", got)
+	}
+	if len(store.records) != 0 {
+		t.Errorf("expected the secret not to be stored, got %d stored keys", len(store.records))
+	}
+}
+
+func TestIdempotency_PassesThroughWithoutKey(t *testing.T) {
+	m := newTestIdempotency(newMemoryIdempotencyStore())
+	defer m.Stop()
+
+	var calls atomic.Int32
+	handler := m.Handler(countingHandler(&calls, http.StatusCreated))
+
+	for i := 0; i < 2; i++ {
+		handler.ServeHTTP(httptest.NewRecorder(), newIdempotentRequest(http.MethodPost, "", `{}`))
+	}
+
+	if got := calls.Load(); got != 2 {
+		t.Errorf("expected handler to run twice, ran %d times", got)
+	}
+}
+
+func TestIdempotency_IgnoresSafeMethods(t *testing.T) {
+	m := newTestIdempotency(newMemoryIdempotencyStore())
+	defer m.Stop()
+
+	var calls atomic.Int32
+	handler := m.Handler(countingHandler(&calls, http.StatusOK))
+
+	for i := 0; i < 2; i++ {
+		handler.ServeHTTP(httptest.NewRecorder(), newIdempotentRequest(http.MethodGet, "key-1", ""))
+	}
+
+	if got := calls.Load(); got != 2 {
+		t.Errorf("expected handler to run twice, ran %d times", got)
+	}
+}
+
+func TestIdempotency_KeyTooLong(t *testing.T) {
+	m := newTestIdempotency(newMemoryIdempotencyStore())
+	defer m.Stop()
+
+	var calls atomic.Int32
+	handler := m.Handler(countingHandler(&calls, http.StatusCreated))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, newIdempotentRequest(http.MethodPost, strings.Repeat("k", maxIdempotencyKeyLength+1), `{}`))
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+	if got := calls.Load(); got != 0 {
+		t.Errorf("expected handler not to run, ran %d times", got)
+	}
+}
+
+func TestIdempotency_StopIsIdempotent(t *testing.T) {
+	m := newTestIdempotency(newMemoryIdempotencyStore())
+	m.Stop()
+	m.Stop()
+}
@@ -0,0 +1,257 @@
+package middleware
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// defaultJWTLeeway is the clock skew tolerated when validating exp, nbf and iat.
+const defaultJWTLeeway = 30 * time.Second
+
+// JWTKeys holds the keys used to verify JWT signatures, indexed by key ID (kid).
+// Keys registered with an empty kid are used for tokens without a kid header.
+type JWTKeys struct {
+	hmac map[string][]byte
+	rsa  map[string]*rsa.PublicKey
+}
+
+// NewJWTKeys creates an empty key set.
+func NewJWTKeys() *JWTKeys {
+	return &JWTKeys{
+		hmac: make(map[string][]byte),
+		rsa:  make(map[string]*rsa.PublicKey),
+	}
+}
+
+// AddHMACSecret registers a shared secret for HS256 tokens.
+func (k *JWTKeys) AddHMACSecret(kid string, secret []byte) {
+	k.hmac[kid] = secret
+}
+
+// AddRSAPublicKeyPEM registers a PEM-encoded RSA public key for RS256 tokens.
+func (k *JWTKeys) AddRSAPublicKeyPEM(kid string, data []byte) error {
+	key, err := jwt.ParseRSAPublicKeyFromPEM(data)
+	if err != nil {
+		return fmt.Errorf("failed to parse RSA public key: %w", err)
+	}
+	k.rsa[kid] = key
+	return nil
+}
+
+// jwk is the subset of a JSON Web Key needed to verify HS256 and RS256 signatures.
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	// RSA public key
+	N string `json:"n"`
+	E string `json:"e"`
+	// Symmetric key
+	K string `json:"k"`
+}
+
+// AddJWKS registers the signing keys of a JSON Web Key Set document.
+// Keys that are not RSA or symmetric keys, or that are not meant for signatures, are ignored.
+func (k *JWTKeys) AddJWKS(data []byte) error {
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return fmt.Errorf("failed to parse JWKS: %w", err)
+	}
+
+	for _, key := range set.Keys {
+		if key.Use != "" && key.Use != "sig" {
+			continue
+		}
+		switch key.Kty {
+		case "RSA":
+			if key.Alg != "" && key.Alg != jwt.SigningMethodRS256.Alg() {
+				continue
+			}
+			publicKey, err := parseRSAJWK(key)
+			if err != nil {
+				return fmt.Errorf("failed to parse JWK %q: %w", key.Kid, err)
+			}
+			k.rsa[key.Kid] = publicKey
+		case "oct":
+			if key.Alg != "" && key.Alg != jwt.SigningMethodHS256.Alg() {
+				continue
+			}
+			secret, err := base64.RawURLEncoding.DecodeString(key.K)
+			if err != nil {
+				return fmt.Errorf("failed to parse JWK %q: %w", key.Kid, err)
+			}
+			k.hmac[key.Kid] = secret
+		}
+	}
+	return nil
+}
+
+// parseRSAJWK builds an RSA public key from the modulus and exponent of a JWK.
+func parseRSAJWK(key jwk) (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(key.N)
+	if err != nil {
+		return nil, fmt.Errorf("invalid modulus: %w", err)
+	}
+	e, err := base64.RawURLEncoding.DecodeString(key.E)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exponent: %w", err)
+	}
+	exponent := new(big.Int).SetBytes(e)
+	if len(n) == 0 || !exponent.IsInt64() || exponent.Int64() < 3 {
+		return nil, errors.New("invalid RSA key parameters")
+	}
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+}
+
+// Empty reports whether no key has been registered.
+func (k *JWTKeys) Empty() bool {
+	return len(k.hmac) == 0 && len(k.rsa) == 0
+}
+
+// lookup returns the verification key for the token's algorithm and kid.
+// HMAC secrets are only returned for HS256 and RSA keys only for RS256,
+// so a token cannot switch algorithms to have a public key used as an HMAC secret.
+func (k *JWTKeys) lookup(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+	switch token.Method {
+	case jwt.SigningMethodHS256:
+		if secret, ok := findKey(k.hmac, kid); ok {
+			return secret, nil
+		}
+	case jwt.SigningMethodRS256:
+		if key, ok := findKey(k.rsa, kid); ok {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("no %s key for kid %q", token.Method.Alg(), kid)
+}
+
+// findKey returns the key for kid. A token without kid matches the key registered
+// without kid, or the only key of its type when exactly one is registered.
+func findKey[T any](keys map[string]T, kid string) (T, bool) {
+	if key, ok := keys[kid]; ok {
+		return key, true
+	}
+	var zero T
+	if kid == "" && len(keys) == 1 {
+		for _, key := range keys {
+			return key, true
+		}
+	}
+	return zero, false
+}
+
+// JWTConfig holds the configuration for JWT authentication.
+type JWTConfig struct {
+	// Keys verify token signatures.
+	Keys *JWTKeys
+	// Issuer, when set, must match the iss claim.
+	Issuer string
+	// Audience, when set, must be contained in the aud claim.
+	Audience string
+	// Leeway is the clock skew tolerated when validating time-based claims.
+	Leeway time.Duration
+}
+
+// jwtClaims are the registered claims plus the roles granted to the subject.
+type jwtClaims struct {
+	jwt.RegisteredClaims
+	// Roles are role names such as "admin". Unknown roles are ignored.
+	Roles []string `json:"roles,omitempty"`
+	// OrganizationID binds the token, and the roles it grants, to one organization.
+	// Tokens without it are bound to the default organization.
+	OrganizationID string `json:"org_id,omitempty"`
+}
+
+// JWTAuthenticator authenticates requests carrying an "Authorization: Bearer <JWT>" header.
+type JWTAuthenticator struct {
+	keys   *JWTKeys
+	parser *jwt.Parser
+}
+
+// NewJWTAuthenticator creates a JWTAuthenticator with the given configuration.
+func NewJWTAuthenticator(config JWTConfig) *JWTAuthenticator {
+	keys := config.Keys
+	if keys == nil {
+		keys = NewJWTKeys()
+	}
+
+	leeway := config.Leeway
+	if leeway == 0 {
+		leeway = defaultJWTLeeway
+	}
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg(), jwt.SigningMethodRS256.Alg()}),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(leeway),
+	}
+	if config.Issuer != "" {
+		options = append(options, jwt.WithIssuer(config.Issuer))
+	}
+	if config.Audience != "" {
+		options = append(options, jwt.WithAudience(config.Audience))
+	}
+
+	return &JWTAuthenticator{
+		keys:   keys,
+		parser: jwt.NewParser(options...),
+	}
+}
+
+// Authenticate verifies a JWT and returns the principal it identifies,
+// with the roles listed in the roles claim and bound to the organization in the org_id claim.
+func (a *JWTAuthenticator) Authenticate(tokenString string) (domain.Principal, error) {
+	var claims jwtClaims
+	if _, err := a.parser.ParseWithClaims(tokenString, &claims, a.keys.lookup); err != nil {
+		return domain.Principal{}, err
+	}
+	if claims.Subject == "" {
+		return domain.Principal{}, errors.New("token has no subject")
+	}
+	principal := domain.NewUserPrincipal(claims.Subject).WithRoles(domain.ParseRoles(claims.Roles)...)
+	if claims.OrganizationID != "" {
+		principal = principal.WithOrganization(claims.OrganizationID)
+	}
+	return principal, nil
+}
+
+// AuthenticateRequest implements Authenticator. The principal is the user identified by the sub claim.
+func (a *JWTAuthenticator) AuthenticateRequest(r *http.Request) (domain.Principal, bool, error) {
+	tokenString, ok := bearerToken(r)
+	if !ok {
+		return domain.Principal{}, false, nil
+	}
+	principal, err := a.Authenticate(tokenString)
+	if err != nil {
+		return domain.Principal{}, true, fmt.Errorf("%w: bearer token: %v", errInvalidCredentials, err)
+	}
+	return principal, true, nil
+}
+
+// Scheme implements Authenticator.
+func (a *JWTAuthenticator) Scheme() string {
+	return "Bearer"
+}
+
+// bearerToken extracts the token from an "Authorization: Bearer" header.
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
@@ -0,0 +1,270 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var testJWTSecret = []byte("test-jwt-secret")
+
+// newPrincipalEchoHandler returns a handler that writes the principal found in the context.
+func newPrincipalEchoHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(domain.PrincipalFromContext(r.Context()).String()))
+	})
+}
+
+func signTestJWT(t *testing.T, method jwt.SigningMethod, key any, kid string, claims jwt.RegisteredClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return signed
+}
+
+func validTestClaims() jwt.RegisteredClaims {
+	return jwt.RegisteredClaims{
+		Subject:   "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+		Issuer:    "https://auth.example.com",
+		Audience:  jwt.ClaimStrings{"user-management-api"},
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		IssuedAt:  jwt.NewNumericDate(time.Now()),
+	}
+}
+
+func serveWithJWT(t *testing.T, auth *JWTAuthenticator, authorization string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	rec := httptest.NewRecorder()
+	Authentication(AuthenticationConfig{}, auth)(newPrincipalEchoHandler()).ServeHTTP(rec, req)
+	return rec
+}
+
+func TestJWTAuthenticator_HS256(t *testing.T) {
+	keys := NewJWTKeys()
+	keys.AddHMACSecret("", testJWTSecret)
+	auth := NewJWTAuthenticator(JWTConfig{
+		Keys:     keys,
+		Issuer:   "https://auth.example.com",
+		Audience: "user-management-api",
+	})
+
+	expired := validTestClaims()
+	expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+	noExpiry := validTestClaims()
+	noExpiry.ExpiresAt = nil
+	wrongIssuer := validTestClaims()
+	wrongIssuer.Issuer = "https://evil.example.com"
+	wrongAudience := validTestClaims()
+	wrongAudience.Audience = jwt.ClaimStrings{"other-api"}
+	noSubject := validTestClaims()
+	noSubject.Subject = ""
+
+	tests := []struct {
+		name          string
+		authorization string
+		wantStatus    int
+		wantBody      string
+	}{
+		{
+			name:          "valid token",
+			authorization: "Bearer " + signTestJWT(t, jwt.SigningMethodHS256, testJWTSecret, "", validTestClaims()),
+			wantStatus:    http.StatusOK,
+			wantBody:      "user:01ARZ3NDEKTSV4RRFFQ69G5FAV",
+		},
+		{
+			name:          "lowercase scheme",
+			authorization: "bearer " + signTestJWT(t, jwt.SigningMethodHS256, testJWTSecret, "", validTestClaims()),
+			wantStatus:    http.StatusOK,
+			wantBody:      "user:01ARZ3NDEKTSV4RRFFQ69G5FAV",
+		},
+		{
+			name:       "no credentials",
+			wantStatus: http.StatusOK,
+			wantBody:   "anonymous",
+		},
+		{
+			name:          "wrong secret",
+			authorization: "Bearer " + signTestJWT(t, jwt.SigningMethodHS256, []byte("other-secret"), "", validTestClaims()),
+			wantStatus:    http.StatusUnauthorized,
+		},
+		{
+			name:          "expired",
+			authorization: "Bearer " + signTestJWT(t, jwt.SigningMethodHS256, testJWTSecret, "", expired),
+			wantStatus:    http.StatusUnauthorized,
+		},
+		{
+			name:          "no expiry",
+			authorization: "Bearer " + signTestJWT(t, jwt.SigningMethodHS256, testJWTSecret, "", noExpiry),
+			wantStatus:    http.StatusUnauthorized,
+		},
+		{
+			name:          "wrong issuer",
+			authorization: "Bearer " + signTestJWT(t, jwt.SigningMethodHS256, testJWTSecret, "", wrongIssuer),
+			wantStatus:    http.StatusUnauthorized,
+		},
+		{
+			name:          "wrong audience",
+			authorization: "Bearer " + signTestJWT(t, jwt.SigningMethodHS256, testJWTSecret, "", wrongAudience),
+			wantStatus:    http.StatusUnauthorized,
+		},
+		{
+			name:          "no subject",
+			authorization: "Bearer " + signTestJWT(t, jwt.SigningMethodHS256, testJWTSecret, "", noSubject),
+			wantStatus:    http.StatusUnauthorized,
+		},
+		{
+			name:          "unsigned token",
+			authorization: "Bearer " + signTestJWT(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, "", validTestClaims()),
+			wantStatus:    http.StatusUnauthorized,
+		},
+		{
+			name:          "malformed token",
+			authorization: "Bearer not-a-jwt",
+			wantStatus:    http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serveWithJWT(t, auth, tt.authorization)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d (%s)", tt.wantStatus, rec.Code, rec.Body.String())
+			}
+			if tt.wantStatus == http.StatusUnauthorized {
+				if got := rec.Header().Get("WWW-Authenticate"); got != `Bearer error="invalid_token"` {
+					t.Errorf("unexpected WWW-Authenticate %q", got)
+				}
+				var body map[string]any
+				if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+					t.Fatalf("failed to decode error response: %v", err)
+				}
+				if body["message"] != "認証情報が無効です" {
+					t.Errorf("unexpected error message %v", body["message"])
+				}
+				return
+			}
+			if rec.Body.String() != tt.wantBody {
+				t.Errorf("expected principal %q, got %q", tt.wantBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+// newTestJWKS returns a JWKS document containing the public part of key under kid.
+func newTestJWKS(key *rsa.PrivateKey, kid string) []byte {
+	jwks := map[string]any{
+		"keys": []map[string]string{
+			{
+				"kty": "RSA",
+				"kid": kid,
+				"use": "sig",
+				"alg": "RS256",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			},
+			{"kty": "EC", "kid": "ignored", "crv": "P-256"},
+		},
+	}
+	data, _ := json.Marshal(jwks)
+	return data
+}
+
+func TestJWTAuthenticator_RS256WithJWKS(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	keys := NewJWTKeys()
+	if err := keys.AddJWKS(newTestJWKS(privateKey, "key-1")); err != nil {
+		t.Fatalf("failed to load JWKS: %v", err)
+	}
+	auth := NewJWTAuthenticator(JWTConfig{Keys: keys})
+
+	publicPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: mustMarshalPKIX(t, &privateKey.PublicKey)})
+
+	tests := []struct {
+		name       string
+		token      string
+		wantStatus int
+	}{
+		{name: "matching kid", token: signTestJWT(t, jwt.SigningMethodRS256, privateKey, "key-1", validTestClaims()), wantStatus: http.StatusOK},
+		{name: "without kid", token: signTestJWT(t, jwt.SigningMethodRS256, privateKey, "", validTestClaims()), wantStatus: http.StatusOK},
+		{name: "unknown kid", token: signTestJWT(t, jwt.SigningMethodRS256, privateKey, "key-2", validTestClaims()), wantStatus: http.StatusUnauthorized},
+		{name: "signed by other key", token: signTestJWT(t, jwt.SigningMethodRS256, otherKey, "key-1", validTestClaims()), wantStatus: http.StatusUnauthorized},
+		// The public key must never be accepted as an HMAC secret
+		{name: "algorithm confusion", token: signTestJWT(t, jwt.SigningMethodHS256, publicPEM, "key-1", validTestClaims()), wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serveWithJWT(t, auth, "Bearer "+tt.token)
+			if rec.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d (%s)", tt.wantStatus, rec.Code, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestJWTKeys_AddRSAPublicKeyPEM(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	publicPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: mustMarshalPKIX(t, &privateKey.PublicKey)})
+
+	keys := NewJWTKeys()
+	if err := keys.AddRSAPublicKeyPEM("", publicPEM); err != nil {
+		t.Fatalf("failed to add key: %v", err)
+	}
+	auth := NewJWTAuthenticator(JWTConfig{Keys: keys})
+
+	principal, err := auth.Authenticate(signTestJWT(t, jwt.SigningMethodRS256, privateKey, "", validTestClaims()))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if principal.String() != "user:01ARZ3NDEKTSV4RRFFQ69G5FAV" {
+		t.Errorf("unexpected principal %+v", principal)
+	}
+
+	if err := keys.AddRSAPublicKeyPEM("", []byte(strings.Repeat("x", 10))); err == nil {
+		t.Error("expected error for invalid PEM")
+	}
+}
+
+func mustMarshalPKIX(t *testing.T, key *rsa.PublicKey) []byte {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal public key: %v", err)
+	}
+	return der
+}
@@ -0,0 +1,102 @@
+package middleware
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+// ReadYourWritesCookieName is the cookie pinning a client's reads to the primary database
+// for a short time after it performed a command. Its value is the Unix time the pin expires.
+const ReadYourWritesCookieName = "read_primary_until"
+
+// ReadYourWritesConfig holds the configuration for the read-your-writes middleware.
+type ReadYourWritesConfig struct {
+	// Window is how long reads stay on the primary after a successful command.
+	// Pinning is disabled when it is zero.
+	Window time.Duration
+	// CookieSecure restricts the pin cookie to HTTPS.
+	CookieSecure bool
+}
+
+// ReadYourWrites returns an HTTP middleware that lets a client read its own writes while
+// query services are routed to read replicas that may lag behind the primary.
+//
+//   - POST, PUT, PATCH and DELETE requests read from the primary, so the checks a command
+//     makes before writing never see stale data.
+//   - A successful (non-4xx/5xx) command sets a cookie pinning the client's reads to the
+//     primary for Window.
+//   - Requests carrying an unexpired pin cookie read from the primary.
+//
+// Clients that do not keep cookies only get the first guarantee.
+func ReadYourWrites(config ReadYourWritesConfig) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			mutating := isMutatingMethod(r.Method)
+			if mutating || primaryPinned(r, time.Now()) {
+				ctx = infrastructure.WithPrimary(ctx)
+			}
+			if !mutating || config.Window <= 0 {
+				next.ServeHTTP(w, r.WithContext(ctx))
+				return
+			}
+
+			next.ServeHTTP(&pinningResponseWriter{ResponseWriter: w, config: config}, r.WithContext(ctx))
+		})
+	}
+}
+
+// primaryPinned reports whether the request carries a pin cookie that has not expired.
+func primaryPinned(r *http.Request, now time.Time) bool {
+	cookie, err := r.Cookie(ReadYourWritesCookieName)
+	if err != nil {
+		return false
+	}
+	until, err := strconv.ParseInt(cookie.Value, 10, 64)
+	if err != nil {
+		return false
+	}
+	return now.Unix() < until
+}
+
+// pinningResponseWriter sets the pin cookie when a command's response turns out successful.
+type pinningResponseWriter struct {
+	http.ResponseWriter
+	config      ReadYourWritesConfig
+	wroteHeader bool
+}
+
+func (w *pinningResponseWriter) WriteHeader(statusCode int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		if statusCode < http.StatusBadRequest {
+			until := time.Now().Add(w.config.Window)
+			http.SetCookie(w.ResponseWriter, &http.Cookie{
+				Name:     ReadYourWritesCookieName,
+				Value:    strconv.FormatInt(until.Unix()+1, 10),
+				Path:     "/",
+				MaxAge:   int(math.Ceil(w.config.Window.Seconds())),
+				HttpOnly: true,
+				Secure:   w.config.CookieSecure,
+				SameSite: http.SameSiteLaxMode,
+			})
+		}
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *pinningResponseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// Unwrap returns the underlying ResponseWriter for http.ResponseController.
+func (w *pinningResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -0,0 +1,81 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+func serveReadYourWrites(t *testing.T, req *http.Request, status int) (*httptest.ResponseRecorder, bool) {
+	t.Helper()
+	var primary bool
+	rec := httptest.NewRecorder()
+	ReadYourWrites(ReadYourWritesConfig{Window: 5 * time.Second})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		primary = infrastructure.PrimaryRequested(r.Context())
+		w.WriteHeader(status)
+	})).ServeHTTP(rec, req)
+	return rec, primary
+}
+
+func pinCookie(rec *httptest.ResponseRecorder) *http.Cookie {
+	for _, cookie := range rec.Result().Cookies() {
+		if cookie.Name == ReadYourWritesCookieName {
+			return cookie
+		}
+	}
+	return nil
+}
+
+func TestReadYourWrites(t *testing.T) {
+	t.Run("successful command pins reads", func(t *testing.T) {
+		rec, primary := serveReadYourWrites(t, httptest.NewRequest(http.MethodPost, "/users", nil), http.StatusCreated)
+		if !primary {
+			t.Error("expected the command to read from the primary")
+		}
+		cookie := pinCookie(rec)
+		if cookie == nil {
+			t.Fatal("expected a pin cookie")
+		}
+		if cookie.MaxAge != 5 || !cookie.HttpOnly {
+			t.Errorf("unexpected cookie attributes %+v", cookie)
+		}
+
+		// 続くクエリはプライマリから読む
+		req := httptest.NewRequest(http.MethodGet, "/users", nil)
+		req.AddCookie(cookie)
+		rec, primary = serveReadYourWrites(t, req, http.StatusOK)
+		if !primary {
+			t.Error("expected a pinned query to read from the primary")
+		}
+		if pinCookie(rec) != nil {
+			t.Error("expected queries not to extend the pin")
+		}
+	})
+
+	t.Run("failed command does not pin", func(t *testing.T) {
+		rec, _ := serveReadYourWrites(t, httptest.NewRequest(http.MethodPut, "/users/1", nil), http.StatusUnprocessableEntity)
+		if pinCookie(rec) != nil {
+			t.Error("expected no pin cookie for a failed command")
+		}
+	})
+
+	t.Run("query without pin reads from replicas", func(t *testing.T) {
+		_, primary := serveReadYourWrites(t, httptest.NewRequest(http.MethodGet, "/users", nil), http.StatusOK)
+		if primary {
+			t.Error("expected an unpinned query to be routed to replicas")
+		}
+	})
+
+	t.Run("expired pin reads from replicas", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/users", nil)
+		req.AddCookie(&http.Cookie{Name: ReadYourWritesCookieName, Value: strconv.FormatInt(time.Now().Add(-time.Second).Unix(), 10)})
+		_, primary := serveReadYourWrites(t, req, http.StatusOK)
+		if primary {
+			t.Error("expected an expired pin to be ignored")
+		}
+	})
+}
@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+)
+
+// userAgentMaxLength caps the User-Agent recorded in audit events.
+const userAgentMaxLength = 512
+
+// RequestMetadata returns a middleware that stores the client IP address and User-Agent
+// in the request context so that usecases can record them in audit events.
+// trustXFF has the same meaning as RateLimitConfig.TrustXForwardedFor.
+func RequestMetadata(trustXFF bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			userAgent := r.UserAgent()
+			if len(userAgent) > userAgentMaxLength {
+				userAgent = userAgent[:userAgentMaxLength]
+			}
+			ctx := domain.WithRequestMetadata(r.Context(), domain.RequestMetadata{
+				RemoteAddr: extractIP(r, trustXFF),
+				UserAgent:  userAgent,
+			})
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+)
+
+func TestRequestMetadata(t *testing.T) {
+	tests := []struct {
+		name     string
+		trustXFF bool
+		xff      string
+		want     string
+	}{
+		{name: "remote addr", trustXFF: false, xff: "203.0.113.1", want: "192.0.2.1"},
+		{name: "trusted X-Forwarded-For", trustXFF: true, xff: "203.0.113.1, 10.0.0.1", want: "203.0.113.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got domain.RequestMetadata
+			handler := RequestMetadata(tt.trustXFF)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = domain.RequestMetadataFromContext(r.Context())
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.RemoteAddr = "192.0.2.1:1234"
+			req.Header.Set("X-Forwarded-For", tt.xff)
+			req.Header.Set("User-Agent", strings.Repeat("a", userAgentMaxLength+10))
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+
+			if got.RemoteAddr != tt.want {
+				t.Errorf("expected RemoteAddr %s, got %s", tt.want, got.RemoteAddr)
+			}
+			if len(got.UserAgent) != userAgentMaxLength {
+				t.Errorf("expected User-Agent truncated to %d bytes, got %d", userAgentMaxLength, len(got.UserAgent))
+			}
+		})
+	}
+}
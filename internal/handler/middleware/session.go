@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+)
+
+// SessionVerifier resolves a session token to the active session and the user's membership
+// in the organization the session was opened in (nil when the user holds no roles there).
+// It returns a nil session without error when the token does not identify an active session.
+type SessionVerifier interface {
+	Execute(ctx context.Context, token string) (*domain.Session, *domain.Membership, error)
+}
+
+// SessionAuthenticator authenticates requests carrying a session cookie issued at login.
+type SessionAuthenticator struct {
+	cookieName string
+	// cookieSecure must match the Secure attribute the cookie was set with, or browsers ignore the clearing cookie.
+	cookieSecure bool
+	verifier     SessionVerifier
+}
+
+// NewSessionAuthenticator creates a SessionAuthenticator reading the token from cookieName.
+func NewSessionAuthenticator(cookieName string, cookieSecure bool, verifier SessionVerifier) *SessionAuthenticator {
+	return &SessionAuthenticator{cookieName: cookieName, cookieSecure: cookieSecure, verifier: verifier}
+}
+
+// AuthenticateRequest implements Authenticator. The principal is the session's user, bound to the session's
+// organization and granted the roles of the user's membership there.
+func (a *SessionAuthenticator) AuthenticateRequest(r *http.Request) (domain.Principal, bool, error) {
+	cookie, err := r.Cookie(a.cookieName)
+	if err != nil || cookie.Value == "" {
+		return domain.Principal{}, false, nil
+	}
+	session, membership, err := a.verifier.Execute(r.Context(), cookie.Value)
+	if err != nil {
+		return domain.Principal{}, true, err
+	}
+	if session == nil {
+		return domain.Principal{}, true, fmt.Errorf("%w: unknown, revoked or expired session", errInvalidCredentials)
+	}
+	principal := domain.NewUserPrincipal(session.UserID).WithSession(session.ID).WithOrganization(session.OrganizationID)
+	if membership != nil {
+		principal = principal.WithRoles(membership.Roles...)
+	}
+	return principal, true, nil
+}
+
+// ClearCredentials implements credentialsClearer by expiring the session cookie, so a browser whose session
+// was revoked or has expired stops sending it and can log in again.
+func (a *SessionAuthenticator) ClearCredentials(w http.ResponseWriter) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     a.cookieName,
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   a.cookieSecure,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// Scheme implements Authenticator.
+func (a *SessionAuthenticator) Scheme() string {
+	return "Session"
+}
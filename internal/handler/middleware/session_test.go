@@ -0,0 +1,116 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+)
+
+// stubSessionVerifier accepts a single token and optionally fails every lookup.
+type stubSessionVerifier struct {
+	token string
+	err   error
+}
+
+func (v *stubSessionVerifier) Execute(ctx context.Context, token string) (*domain.Session, *domain.Membership, error) {
+	if v.err != nil {
+		return nil, nil, v.err
+	}
+	if token != v.token {
+		return nil, nil, nil
+	}
+	return &domain.Session{ID: "01ARZ3NDEKTSV4RRFFQ69G5FB0", UserID: "01ARZ3NDEKTSV4RRFFQ69G5FAV", OrganizationID: domain.DefaultOrganizationID},
+		domain.NewMembership(domain.DefaultOrganizationID, "01ARZ3NDEKTSV4RRFFQ69G5FAV", []domain.Role{domain.RoleViewer}), nil
+}
+
+func serveWithSessionCookie(t *testing.T, mw func(http.Handler) http.Handler, token string) (*httptest.ResponseRecorder, domain.Principal) {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	if token != "" {
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: token})
+	}
+	var principal domain.Principal
+	rec := httptest.NewRecorder()
+	mw(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal = domain.PrincipalFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})).ServeHTTP(rec, req)
+	return rec, principal
+}
+
+func TestSessionAuthenticator(t *testing.T) {
+	mw := Authentication(AuthenticationConfig{}, NewSessionAuthenticator("session_id", true, &stubSessionVerifier{token: "cqs_valid"}))
+
+	t.Run("valid session", func(t *testing.T) {
+		rec, principal := serveWithSessionCookie(t, mw, "cqs_valid")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+		}
+		if principal.String() != "user:01ARZ3NDEKTSV4RRFFQ69G5FAV" {
+			t.Errorf("unexpected principal %s", principal)
+		}
+		if principal.SessionID != "01ARZ3NDEKTSV4RRFFQ69G5FB0" {
+			t.Errorf("unexpected session id %q", principal.SessionID)
+		}
+		if principal.OrganizationID != domain.DefaultOrganizationID {
+			t.Errorf("unexpected organization id %q", principal.OrganizationID)
+		}
+		if !principal.Can(domain.PermissionUsersRead) || principal.Can(domain.PermissionUsersDelete) {
+			t.Errorf("expected the member's roles to be granted, got %v", principal.Roles)
+		}
+	})
+
+	t.Run("unknown session", func(t *testing.T) {
+		// 失効したセッションのCookieは削除し、認証情報のないリクエストとして扱う（再ログインできるように）
+		rec, principal := serveWithSessionCookie(t, mw, "cqs_other")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+		}
+		if principal.Type != domain.PrincipalTypeAnonymous {
+			t.Errorf("expected anonymous principal, got %s", principal)
+		}
+		assertSessionCookieCleared(t, rec)
+	})
+
+	t.Run("unknown session when authentication is required", func(t *testing.T) {
+		required := Authentication(AuthenticationConfig{Required: true}, NewSessionAuthenticator("session_id", true, &stubSessionVerifier{token: "cqs_valid"}))
+		rec, _ := serveWithSessionCookie(t, required, "cqs_other")
+		if rec.Code != http.StatusUnauthorized {
+			t.Fatalf("expected status %d, got %d", http.StatusUnauthorized, rec.Code)
+		}
+		assertSessionCookieCleared(t, rec)
+	})
+
+	t.Run("no cookie", func(t *testing.T) {
+		rec, principal := serveWithSessionCookie(t, mw, "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+		}
+		if principal.Type != domain.PrincipalTypeAnonymous {
+			t.Errorf("expected anonymous principal, got %s", principal)
+		}
+	})
+
+	t.Run("verifier error", func(t *testing.T) {
+		failing := Authentication(AuthenticationConfig{}, NewSessionAuthenticator("session_id", true, &stubSessionVerifier{err: errors.New("connection refused")}))
+		rec, _ := serveWithSessionCookie(t, failing, "cqs_valid")
+		if rec.Code != http.StatusInternalServerError {
+			t.Errorf("expected status %d, got %d", http.StatusInternalServerError, rec.Code)
+		}
+	})
+}
+
+func assertSessionCookieCleared(t *testing.T, rec *httptest.ResponseRecorder) {
+	t.Helper()
+	cookies := rec.Result().Cookies()
+	if len(cookies) != 1 || cookies[0].Name != "session_id" || cookies[0].MaxAge >= 0 {
+		t.Fatalf("expected the session cookie to be cleared, got %v", cookies)
+	}
+	if cookies[0].Path != "/" || !cookies[0].HttpOnly || !cookies[0].Secure {
+		t.Errorf("unexpected cookie attributes %+v", cookies[0])
+	}
+}
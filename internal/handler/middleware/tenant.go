@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/handler"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// TenantHeader is the request header selecting the organization (tenant) a request acts on.
+const TenantHeader = "X-Organization-ID"
+
+// TenantResolver decides the tenant of a request from the principal in ctx and the
+// requested organization ID (empty when none was requested). It returns the principal
+// bound to the tenant, with the roles it holds there.
+type TenantResolver interface {
+	Execute(ctx context.Context, organizationID string) (domain.Principal, error)
+}
+
+// Tenant returns an HTTP middleware that scopes each request to one organization.
+// It must run after Authentication: the tenant is the organization the credentials are
+// bound to unless another one is requested with the X-Organization-ID header, which the
+// resolver may refuse (403) or fail to find (404).
+//
+// Both the tenant and the resolved principal are stored in the request context;
+// every query and command below filters by the tenant.
+func Tenant(resolver TenantResolver) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			principal, err := resolver.Execute(ctx, strings.TrimSpace(r.Header.Get(TenantHeader)))
+			if err != nil {
+				handler.HandleError(w, err, logger.FromContext(ctx))
+				return
+			}
+
+			ctx = domain.WithPrincipal(ctx, principal)
+			ctx = domain.WithTenant(ctx, principal.OrganizationID)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+const (
+	testOrganizationID = "01ARZ3NDEKTSV4RRFFQ69G5FD0"
+	testMemberID       = "01ARZ3NDEKTSV4RRFFQ69G5FAV"
+)
+
+// stubOrganizationQuery knows one organization with a single member.
+type stubOrganizationQuery struct {
+	usecase.OrganizationQueryRepository
+}
+
+func (q *stubOrganizationQuery) FindByID(ctx context.Context, id string) (*domain.Organization, error) {
+	if id != testOrganizationID {
+		return nil, nil
+	}
+	return &domain.Organization{ID: testOrganizationID, Name: "Acme Inc.", Slug: "acme"}, nil
+}
+
+func (q *stubOrganizationQuery) FindMembership(ctx context.Context, organizationID, userID string) (*domain.Membership, error) {
+	if organizationID != testOrganizationID || userID != testMemberID {
+		return nil, nil
+	}
+	return domain.NewMembership(testOrganizationID, testMemberID, []domain.Role{domain.RoleAuditor}), nil
+}
+
+func serveWithTenant(t *testing.T, principal domain.Principal, organizationID string) (*httptest.ResponseRecorder, domain.Principal, string) {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	if organizationID != "" {
+		req.Header.Set(TenantHeader, organizationID)
+	}
+	req = req.WithContext(domain.WithPrincipal(req.Context(), principal))
+
+	var resolved domain.Principal
+	var tenant string
+	rec := httptest.NewRecorder()
+	Tenant(usecase.NewResolveTenantUsecase(&stubOrganizationQuery{}))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resolved = domain.PrincipalFromContext(r.Context())
+		tenant, _ = domain.TenantFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})).ServeHTTP(rec, req)
+	return rec, resolved, tenant
+}
+
+func TestTenant(t *testing.T) {
+	t.Run("defaults to the default organization", func(t *testing.T) {
+		rec, principal, tenant := serveWithTenant(t, domain.NewUserPrincipal(testMemberID).WithRoles(domain.RoleViewer), "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+		}
+		if tenant != domain.DefaultOrganizationID || principal.OrganizationID != domain.DefaultOrganizationID {
+			t.Errorf("expected default tenant, got %q (principal %q)", tenant, principal.OrganizationID)
+		}
+		if !principal.Can(domain.PermissionUsersRead) {
+			t.Errorf("expected roles to be kept, got %v", principal.Roles)
+		}
+	})
+
+	t.Run("member gets the organization's roles", func(t *testing.T) {
+		rec, principal, tenant := serveWithTenant(t, domain.NewUserPrincipal(testMemberID).WithRoles(domain.RoleAdmin), testOrganizationID)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+		}
+		if tenant != testOrganizationID {
+			t.Errorf("expected tenant %s, got %q", testOrganizationID, tenant)
+		}
+		if !principal.Can(domain.PermissionAuditRead) || principal.Can(domain.PermissionUsersDelete) {
+			t.Errorf("expected only the member's roles, got %v", principal.Roles)
+		}
+	})
+
+	t.Run("non-member is forbidden", func(t *testing.T) {
+		rec, _, _ := serveWithTenant(t, domain.NewUserPrincipal("01ARZ3NDEKTSV4RRFFQ69G5FAW"), testOrganizationID)
+		if rec.Code != http.StatusForbidden {
+			t.Fatalf("expected status %d, got %d", http.StatusForbidden, rec.Code)
+		}
+	})
+
+	t.Run("api key bound to another organization is forbidden", func(t *testing.T) {
+		apiKey := domain.NewAPIKeyPrincipal("01ARZ3NDEKTSV4RRFFQ69G5FAW").WithOrganization(domain.DefaultOrganizationID)
+		rec, _, _ := serveWithTenant(t, apiKey, testOrganizationID)
+		if rec.Code != http.StatusForbidden {
+			t.Fatalf("expected status %d, got %d", http.StatusForbidden, rec.Code)
+		}
+	})
+
+	t.Run("api key acts on its own organization", func(t *testing.T) {
+		apiKey := domain.NewAPIKeyPrincipal("01ARZ3NDEKTSV4RRFFQ69G5FAW").WithOrganization(testOrganizationID)
+		rec, _, tenant := serveWithTenant(t, apiKey, "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+		}
+		if tenant != testOrganizationID {
+			t.Errorf("expected tenant %s, got %q", testOrganizationID, tenant)
+		}
+	})
+
+	t.Run("anonymous may select an existing organization", func(t *testing.T) {
+		rec, _, tenant := serveWithTenant(t, domain.Principal{Type: domain.PrincipalTypeAnonymous}, testOrganizationID)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+		}
+		if tenant != testOrganizationID {
+			t.Errorf("expected tenant %s, got %q", testOrganizationID, tenant)
+		}
+	})
+
+	t.Run("anonymous roles are not carried into another organization", func(t *testing.T) {
+		anonymous := domain.AnonymousPrincipal.WithRoles(domain.RoleAdmin)
+		rec, principal, _ := serveWithTenant(t, anonymous, testOrganizationID)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+		}
+		if len(principal.Roles) != 0 || principal.Can(domain.PermissionUsersRead) {
+			t.Errorf("expected no roles in another organization, got %v", principal.Roles)
+		}
+
+		_, principal, _ = serveWithTenant(t, anonymous, "")
+		if !principal.Can(domain.PermissionUsersDelete) {
+			t.Errorf("expected anonymous roles in the default organization, got %v", principal.Roles)
+		}
+	})
+
+	t.Run("unknown organization", func(t *testing.T) {
+		rec, _, _ := serveWithTenant(t, domain.Principal{Type: domain.PrincipalTypeAnonymous}, "01ARZ3NDEKTSV4RRFFQ69G5FD9")
+		if rec.Code != http.StatusNotFound {
+			t.Fatalf("expected status %d, got %d", http.StatusNotFound, rec.Code)
+		}
+	})
+}
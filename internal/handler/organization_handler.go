@@ -0,0 +1,182 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+// OrganizationHandler 組織関連のHTTPハンドラー（ServerInterface のうち Organizations を実装）
+type OrganizationHandler struct {
+	createOrganization      *usecase.CreateOrganizationUsecase
+	listOrganizations       *usecase.ListOrganizationsUsecase
+	findCurrentOrganization *usecase.FindCurrentOrganizationUsecase
+	listMembers             *usecase.ListMembersUsecase
+	setMemberRoles          *usecase.SetMemberRolesUsecase
+	removeMember            *usecase.RemoveMemberUsecase
+}
+
+// NewOrganizationHandler OrganizationHandlerのコンストラクタ
+func NewOrganizationHandler(
+	createOrganization *usecase.CreateOrganizationUsecase,
+	listOrganizations *usecase.ListOrganizationsUsecase,
+	findCurrentOrganization *usecase.FindCurrentOrganizationUsecase,
+	listMembers *usecase.ListMembersUsecase,
+	setMemberRoles *usecase.SetMemberRolesUsecase,
+	removeMember *usecase.RemoveMemberUsecase,
+) *OrganizationHandler {
+	return &OrganizationHandler{
+		createOrganization:      createOrganization,
+		listOrganizations:       listOrganizations,
+		findCurrentOrganization: findCurrentOrganization,
+		listMembers:             listMembers,
+		setMemberRoles:          setMemberRoles,
+		removeMember:            removeMember,
+	}
+}
+
+// OrganizationsCreateOrganization 組織を作成する（OpenAPI ServerInterface実装）
+func (h *OrganizationHandler) OrganizationsCreateOrganization(w http.ResponseWriter, r *http.Request) {
+	var req openapi.CreateOrganizationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストの形式が不正です")
+		return
+	}
+
+	ctx := r.Context()
+	organization, err := h.createOrganization.Execute(ctx, req.Name, req.Slug)
+	if err != nil {
+		HandleError(w, err, logger.FromContext(ctx))
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, toOrganizationResponse(organization))
+}
+
+// OrganizationsListOrganizations ログイン中のユーザーが所属する組織の一覧を取得（OpenAPI ServerInterface実装）
+func (h *OrganizationHandler) OrganizationsListOrganizations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	organizations, err := h.listOrganizations.Execute(ctx)
+	if err != nil {
+		HandleError(w, err, logger.FromContext(ctx))
+		return
+	}
+
+	organizationResponses := make([]openapi.Organization, 0, len(organizations))
+	for _, organization := range organizations {
+		organizationResponses = append(organizationResponses, toOrganizationResponse(organization))
+	}
+
+	respondJSON(w, http.StatusOK, openapi.OrganizationList{
+		Organizations: organizationResponses,
+	})
+}
+
+// OrganizationsGetCurrentOrganization リクエストのテナントの組織を取得（OpenAPI ServerInterface実装）
+func (h *OrganizationHandler) OrganizationsGetCurrentOrganization(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	organization, err := h.findCurrentOrganization.Execute(ctx)
+	if err != nil {
+		HandleError(w, err, logger.FromContext(ctx))
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toOrganizationResponse(organization))
+}
+
+// OrganizationsListMembers リクエストのテナントのメンバー一覧を取得（OpenAPI ServerInterface実装）
+func (h *OrganizationHandler) OrganizationsListMembers(w http.ResponseWriter, r *http.Request, params openapi.OrganizationsListMembersParams) {
+	ctx := r.Context()
+
+	// デフォルト値の設定
+	limit := 10
+	offset := 0
+
+	if params.Limit != nil {
+		if *params.Limit > 0 && *params.Limit <= 100 {
+			limit = int(*params.Limit)
+		}
+	}
+	if params.Offset != nil && *params.Offset >= 0 {
+		offset = int(*params.Offset)
+	}
+
+	memberships, total, err := h.listMembers.Execute(ctx, limit, offset)
+	if err != nil {
+		HandleError(w, err, logger.FromContext(ctx))
+		return
+	}
+
+	membershipResponses := make([]openapi.Membership, 0, len(memberships))
+	for _, membership := range memberships {
+		membershipResponses = append(membershipResponses, toMembershipResponse(membership))
+	}
+
+	respondJSON(w, http.StatusOK, openapi.MembershipList{
+		Members: membershipResponses,
+		Total:   int32(total),
+	})
+}
+
+// OrganizationsSetMember リクエストのテナントにメンバーを追加、またはロールを変更する（OpenAPI ServerInterface実装）
+func (h *OrganizationHandler) OrganizationsSetMember(w http.ResponseWriter, r *http.Request, userId string) {
+	var req openapi.SetMembershipRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストの形式が不正です")
+		return
+	}
+
+	roles := make([]domain.Role, 0, len(req.Roles))
+	for _, role := range req.Roles {
+		roles = append(roles, domain.Role(role))
+	}
+
+	ctx := r.Context()
+	membership, err := h.setMemberRoles.Execute(ctx, userId, roles)
+	if err != nil {
+		HandleError(w, err, logger.FromContext(ctx))
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toMembershipResponse(membership))
+}
+
+// OrganizationsRemoveMember リクエストのテナントからメンバーを外す（OpenAPI ServerInterface実装）
+func (h *OrganizationHandler) OrganizationsRemoveMember(w http.ResponseWriter, r *http.Request, userId string) {
+	ctx := r.Context()
+	if err := h.removeMember.Execute(ctx, userId); err != nil {
+		HandleError(w, err, logger.FromContext(ctx))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// toOrganizationResponse domain.OrganizationをAPIレスポンスのOrganizationに変換
+func toOrganizationResponse(organization *domain.Organization) openapi.Organization {
+	return openapi.Organization{
+		Id:        organization.ID,
+		Name:      organization.Name,
+		Slug:      organization.Slug,
+		CreatedAt: organization.CreatedAt,
+		UpdatedAt: organization.UpdatedAt,
+	}
+}
+
+// toMembershipResponse domain.MembershipをAPIレスポンスのMembershipに変換
+func toMembershipResponse(membership *domain.Membership) openapi.Membership {
+	roles := make([]openapi.Role, 0, len(membership.Roles))
+	for _, role := range membership.Roles {
+		roles = append(roles, openapi.Role(role))
+	}
+	return openapi.Membership{
+		UserId:    membership.UserID,
+		Roles:     roles,
+		CreatedAt: membership.CreatedAt,
+		UpdatedAt: membership.UpdatedAt,
+	}
+}
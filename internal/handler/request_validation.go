@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"fmt"
+	"regexp"
+
+	apperrors "github.com/example/go-react-cqrs-template/internal/pkg/errors"
+)
+
+// OpenAPI仕様のバリデーションミドルウェアを通らないAPI（GraphQL・RPC）で、REST APIと同じ条件を確認するための関数
+
+// idPattern リソースのIDの形式（OpenAPI仕様の ULID の pattern と同じ）
+var idPattern = regexp.MustCompile(`^[0-9A-HJKMNP-TV-Z]{26}$`)
+
+// MaxPageLimit 一覧で1回に取得できる最大件数（OpenAPI仕様の limit の maximum と同じ）
+const MaxPageLimit = 100
+
+// ValidateID IDがULIDの形式であることを確認（name はエラーメッセージに使うパラメーター名）
+func ValidateID(name, id string) error {
+	if !idPattern.MatchString(id) {
+		return apperrors.BadRequest(
+			fmt.Sprintf("invalid %s: %s", name, id),
+			fmt.Sprintf("%s の形式が正しくありません", name),
+		)
+	}
+	return nil
+}
+
+// ValidatePagination limit・offset がREST APIと同じ範囲であることを確認
+func ValidatePagination(limit, offset int) error {
+	if limit < 1 || limit > MaxPageLimit {
+		return apperrors.BadRequest(
+			fmt.Sprintf("limit out of range: %d", limit),
+			fmt.Sprintf("limit は1以上%d以下で指定してください", MaxPageLimit),
+		)
+	}
+	if offset < 0 {
+		return apperrors.BadRequest(
+			fmt.Sprintf("offset out of range: %d", offset),
+			"offset は0以上で指定してください",
+		)
+	}
+	return nil
+}
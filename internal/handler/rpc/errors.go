@@ -0,0 +1,73 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+
+	"connectrpc.com/connect"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/handler"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// ToConnectError はエラーを handler.ToAppError で分類し、HTTPのステータスに対応するRPCのコードの connect.Error に変換する
+// メッセージは REST API と同じユーザー向けのもの（内部エラーの詳細は含めない）
+func ToConnectError(err error) *connect.Error {
+	if err == nil {
+		return nil
+	}
+
+	// 既に connect.Error の場合はそのまま返す
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return connectErr
+	}
+
+	appErr := handler.ToAppError(err)
+	connectErr = connect.NewError(codeFromStatus(appErr.StatusCode()), errors.New(appErr.UserMessage()))
+
+	// 再試行できるようになるまでの時間を通知
+	var tooManyErr *domain.TooManyRequestsError
+	if errors.As(err, &tooManyErr) {
+		retryAfter := max(1, int(math.Ceil(tooManyErr.RetryAfter.Seconds())))
+		connectErr.Meta().Set("Retry-After", strconv.Itoa(retryAfter))
+	}
+	return connectErr
+}
+
+// codeFromStatus HTTPのステータスをRPCのコードに変換
+func codeFromStatus(status int) connect.Code {
+	switch status {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return connect.CodeInvalidArgument
+	case http.StatusUnauthorized:
+		return connect.CodeUnauthenticated
+	case http.StatusForbidden:
+		return connect.CodePermissionDenied
+	case http.StatusNotFound:
+		return connect.CodeNotFound
+	case http.StatusConflict:
+		return connect.CodeAlreadyExists
+	case http.StatusTooManyRequests:
+		return connect.CodeResourceExhausted
+	default:
+		return connect.CodeInternal
+	}
+}
+
+// errorInterceptor サービスのメソッドが返したエラーをログに出力し、ToConnectError で変換するインターセプター
+func errorInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			resp, err := next(ctx, req)
+			if err == nil {
+				return resp, nil
+			}
+			logger.LogError(logger.FromContext(ctx), handler.ToAppError(err), "rpc error")
+			return nil, ToConnectError(err)
+		}
+	}
+}
@@ -0,0 +1,222 @@
+// Package rpc はprotobufで定義した UserService を Connect（gRPC・gRPC-Web 互換）で提供する
+// 各メソッドはREST APIと同じユースケースを呼び出し、エラーは ToConnectError でRPCのコードに変換する
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/handler"
+	apperrors "github.com/example/go-react-cqrs-template/internal/pkg/errors"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+	userv1 "github.com/example/go-react-cqrs-template/pkg/generated/proto/user/v1"
+	"github.com/example/go-react-cqrs-template/pkg/generated/proto/user/v1/userv1connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// defaultPageLimit limit を指定しない（0 の）場合の件数（REST APIの limit のデフォルトと同じ）
+const defaultPageLimit = 10
+
+// コンパイル時に UserServiceHandler の実装を検証
+var _ userv1connect.UserServiceHandler = (*UserServer)(nil)
+
+// UserServer UserServiceの実装
+type UserServer struct {
+	createUser *usecase.CreateUserUsecase
+	findUser   *usecase.FindUserUsecase
+	listUsers  *usecase.ListUsersUsecase
+	updateUser *usecase.UpdateUserUsecase
+	deleteUser *usecase.DeleteUserUsecase
+	listLogs   *usecase.ListUserLogsUsecase
+}
+
+// NewUserServer UserServerのコンストラクタ
+func NewUserServer(
+	createUser *usecase.CreateUserUsecase,
+	findUser *usecase.FindUserUsecase,
+	listUsers *usecase.ListUsersUsecase,
+	updateUser *usecase.UpdateUserUsecase,
+	deleteUser *usecase.DeleteUserUsecase,
+	listLogs *usecase.ListUserLogsUsecase,
+) *UserServer {
+	return &UserServer{
+		createUser: createUser,
+		findUser:   findUser,
+		listUsers:  listUsers,
+		updateUser: updateUser,
+		deleteUser: deleteUser,
+		listLogs:   listLogs,
+	}
+}
+
+// Handler ルーターに登録するパス（"/user.v1.UserService/"）とハンドラーを返す
+func (s *UserServer) Handler() (string, http.Handler) {
+	return userv1connect.NewUserServiceHandler(s, connect.WithInterceptors(errorInterceptor()))
+}
+
+// GetUser ユーザーを取得
+func (s *UserServer) GetUser(ctx context.Context, req *connect.Request[userv1.GetUserRequest]) (*connect.Response[userv1.GetUserResponse], error) {
+	if err := handler.ValidateID("id", req.Msg.GetId()); err != nil {
+		return nil, err
+	}
+
+	user, err := s.findUser.Execute(ctx, req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&userv1.GetUserResponse{User: toUserMessage(user)}), nil
+}
+
+// ListUsers ユーザー一覧を取得
+func (s *UserServer) ListUsers(ctx context.Context, req *connect.Request[userv1.ListUsersRequest]) (*connect.Response[userv1.ListUsersResponse], error) {
+	limit, offset, err := pagination(req.Msg.GetLimit(), req.Msg.GetOffset())
+	if err != nil {
+		return nil, err
+	}
+
+	summaries, total, err := s.listUsers.Execute(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*userv1.UserSummary, 0, len(summaries))
+	for _, summary := range summaries {
+		users = append(users, &userv1.UserSummary{
+			User:           toUserMessage(summary.User),
+			LogCount:       int32(summary.LogCount),
+			LastActivityAt: toTimestamp(summary.LastActivityAt),
+		})
+	}
+	return connect.NewResponse(&userv1.ListUsersResponse{Users: users, Total: int32(total)}), nil
+}
+
+// CreateUser ユーザーを作成
+func (s *UserServer) CreateUser(ctx context.Context, req *connect.Request[userv1.CreateUserRequest]) (*connect.Response[userv1.CreateUserResponse], error) {
+	user, err := s.createUser.Execute(ctx, req.Msg.GetName(), req.Msg.GetEmail(), req.Msg.GetPassword())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&userv1.CreateUserResponse{User: toUserMessage(user)}), nil
+}
+
+// UpdateUser ユーザーを更新（指定しなかった項目は変更しない）
+func (s *UserServer) UpdateUser(ctx context.Context, req *connect.Request[userv1.UpdateUserRequest]) (*connect.Response[userv1.UpdateUserResponse], error) {
+	if err := handler.ValidateID("id", req.Msg.GetId()); err != nil {
+		return nil, err
+	}
+
+	if err := s.updateUser.Execute(ctx, req.Msg.GetId(), req.Msg.GetName(), req.Msg.GetEmail()); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&userv1.UpdateUserResponse{}), nil
+}
+
+// DeleteUser ユーザーを削除
+func (s *UserServer) DeleteUser(ctx context.Context, req *connect.Request[userv1.DeleteUserRequest]) (*connect.Response[userv1.DeleteUserResponse], error) {
+	if err := handler.ValidateID("id", req.Msg.GetId()); err != nil {
+		return nil, err
+	}
+
+	if err := s.deleteUser.Execute(ctx, req.Msg.GetId()); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&userv1.DeleteUserResponse{}), nil
+}
+
+// ListUserLogs ユーザーの操作履歴を新しい順に取得
+func (s *UserServer) ListUserLogs(ctx context.Context, req *connect.Request[userv1.ListUserLogsRequest]) (*connect.Response[userv1.ListUserLogsResponse], error) {
+	if err := handler.ValidateID("user_id", req.Msg.GetUserId()); err != nil {
+		return nil, err
+	}
+	limit, offset, err := pagination(req.Msg.GetLimit(), req.Msg.GetOffset())
+	if err != nil {
+		return nil, err
+	}
+	action, ok := userLogActions[req.Msg.GetAction()]
+	if !ok {
+		return nil, apperrors.BadRequest(
+			fmt.Sprintf("unknown user log action: %d", req.Msg.GetAction()),
+			"action の値が正しくありません",
+		)
+	}
+
+	logs, total, err := s.listLogs.Execute(ctx, req.Msg.GetUserId(), action, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]*userv1.UserLog, 0, len(logs))
+	for _, l := range logs {
+		messages = append(messages, toUserLogMessage(l))
+	}
+	return connect.NewResponse(&userv1.ListUserLogsResponse{Logs: messages, Total: int32(total)}), nil
+}
+
+// pagination limit（0 の場合はデフォルト）・offset をREST APIと同じ範囲で確認する
+func pagination(limit, offset int32) (int, int, error) {
+	if limit == 0 {
+		limit = defaultPageLimit
+	}
+	if err := handler.ValidatePagination(int(limit), int(offset)); err != nil {
+		return 0, 0, err
+	}
+	return int(limit), int(offset), nil
+}
+
+// userLogActions UserLogActionとdomain.UserLogActionの対応（UNSPECIFIED はすべてのアクション）
+var userLogActions = map[userv1.UserLogAction]domain.UserLogAction{
+	userv1.UserLogAction_USER_LOG_ACTION_UNSPECIFIED: "",
+	userv1.UserLogAction_USER_LOG_ACTION_CREATED:     domain.UserLogActionCreated,
+	userv1.UserLogAction_USER_LOG_ACTION_UPDATED:     domain.UserLogActionUpdated,
+	userv1.UserLogAction_USER_LOG_ACTION_DELETED:     domain.UserLogActionDeleted,
+}
+
+// toUserMessage domain.UserをUserに変換
+func toUserMessage(user *domain.User) *userv1.User {
+	return &userv1.User{
+		Id:                 user.ID,
+		OrganizationId:     user.OrganizationID,
+		Name:               user.Name,
+		Email:              user.Email,
+		EmailVerifiedAt:    toTimestamp(user.EmailVerifiedAt),
+		EmailInvalidatedAt: toTimestamp(user.EmailInvalidatedAt),
+		CreatedAt:          timestamppb.New(user.CreatedAt),
+		UpdatedAt:          timestamppb.New(user.UpdatedAt),
+	}
+}
+
+// toUserLogMessage domain.UserLogをUserLogに変換
+func toUserLogMessage(l *domain.UserLog) *userv1.UserLog {
+	changes := make(map[string]*userv1.UserFieldChange, len(l.Changes))
+	for field, change := range l.Changes {
+		changes[field] = &userv1.UserFieldChange{Before: change.Before, After: change.After}
+	}
+
+	var action userv1.UserLogAction
+	for a, domainAction := range userLogActions {
+		if domainAction == l.Action {
+			action = a
+		}
+	}
+	return &userv1.UserLog{
+		Id:        l.ID,
+		UserId:    l.UserID,
+		Action:    action,
+		Changes:   changes,
+		Actor:     l.Actor,
+		RequestId: l.RequestID,
+		CreatedAt: timestamppb.New(l.CreatedAt),
+	}
+}
+
+// toTimestamp 日時をTimestampに変換（nil はそのまま）
+func toTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
@@ -0,0 +1,385 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	apperrors "github.com/example/go-react-cqrs-template/internal/pkg/errors"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+	userv1 "github.com/example/go-react-cqrs-template/pkg/generated/proto/user/v1"
+	"github.com/example/go-react-cqrs-template/pkg/generated/proto/user/v1/userv1connect"
+)
+
+// mockUserQuery はテスト用のUserQueryRepositoryモック
+type mockUserQuery struct {
+	users []*domain.User
+}
+
+func (m *mockUserQuery) FindByID(_ context.Context, id string) (*domain.User, error) {
+	for _, u := range m.users {
+		if u.ID == id {
+			return u, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockUserQuery) FindByEmail(_ context.Context, email string) (*domain.User, error) {
+	for _, u := range m.users {
+		if u.Email == email {
+			return u, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockUserQuery) FindAll(_ context.Context, limit, offset int) ([]*domain.User, error) {
+	if offset >= len(m.users) {
+		return nil, nil
+	}
+	return m.users[offset:min(offset+limit, len(m.users))], nil
+}
+
+func (m *mockUserQuery) Count(_ context.Context) (int, error) {
+	return len(m.users), nil
+}
+
+func (m *mockUserQuery) StreamAll(_ context.Context, fn func(*domain.User) error) error {
+	for _, u := range m.users {
+		if err := fn(u); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mockUserSummaryQuery はテスト用のUserSummaryQueryRepositoryモック
+type mockUserSummaryQuery struct {
+	users []*domain.User
+}
+
+func (m *mockUserSummaryQuery) FindAll(_ context.Context, limit, offset int) ([]*domain.UserSummary, error) {
+	if offset >= len(m.users) {
+		return nil, nil
+	}
+	var summaries []*domain.UserSummary
+	for _, u := range m.users[offset:min(offset+limit, len(m.users))] {
+		summaries = append(summaries, &domain.UserSummary{User: u, LogCount: 2, LastActivityAt: &u.UpdatedAt})
+	}
+	return summaries, nil
+}
+
+func (m *mockUserSummaryQuery) Count(_ context.Context) (int, error) {
+	return len(m.users), nil
+}
+
+// mockUserLogQuery はテスト用のUserLogQueryRepositoryモック
+type mockUserLogQuery struct {
+	logs []*domain.UserLog
+}
+
+func (m *mockUserLogQuery) filter(userID string, action domain.UserLogAction) []*domain.UserLog {
+	var result []*domain.UserLog
+	for _, l := range m.logs {
+		if l.UserID == userID && (action == "" || l.Action == action) {
+			result = append(result, l)
+		}
+	}
+	return result
+}
+
+func (m *mockUserLogQuery) FindByUserID(_ context.Context, userID string, action domain.UserLogAction, limit, offset int) ([]*domain.UserLog, error) {
+	logs := m.filter(userID, action)
+	if offset >= len(logs) {
+		return nil, nil
+	}
+	return logs[offset:min(offset+limit, len(logs))], nil
+}
+
+func (m *mockUserLogQuery) CountByUserID(_ context.Context, userID string, action domain.UserLogAction) (int, error) {
+	return len(m.filter(userID, action)), nil
+}
+
+func (m *mockUserLogQuery) FindByUserIDs(_ context.Context, _ []string, _ domain.UserLogAction, _, _ int) (map[string][]*domain.UserLog, error) {
+	return nil, nil
+}
+
+func (m *mockUserLogQuery) CountByUserIDs(_ context.Context, _ []string, _ domain.UserLogAction) (map[string]int, error) {
+	return nil, nil
+}
+
+const (
+	testAliceID   = "01ARZ3NDEKTSV4RRFFQ69G5FA1"
+	testBobID     = "01ARZ3NDEKTSV4RRFFQ69G5FA2"
+	testAdminID   = "01ARZ3NDEKTSV4RRFFQ69G5FAZ"
+	testUnknownID = "01ARZ3NDEKTSV4RRFFQ69G5FA9"
+)
+
+// newTestClient はテスト用のサーバーを起動し、principal を主体としてリクエストするクライアントを返す
+// 認証・テナントのミドルウェアの代わりに、主体とテナントをコンテキストに設定する
+func newTestClient(t *testing.T, principal domain.Principal) userv1connect.UserServiceClient {
+	t.Helper()
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	users := []*domain.User{
+		{ID: testAliceID, OrganizationID: domain.DefaultOrganizationID, Name: "Alice", Email: "alice@example.com", EmailVerifiedAt: &now, CreatedAt: now, UpdatedAt: now},
+		{ID: testBobID, OrganizationID: domain.DefaultOrganizationID, Name: "Bob", Email: "bob@example.com", CreatedAt: now, UpdatedAt: now},
+	}
+	userLogQuery := &mockUserLogQuery{logs: []*domain.UserLog{
+		{
+			ID: "01ARZ3NDEKTSV4RRFFQ69G5FB2", UserID: testAliceID, Action: domain.UserLogActionUpdated,
+			Changes: domain.UserChanges{"name": {Before: "Alicia", After: "Alice"}},
+			Actor:   "user:" + testAdminID, RequestID: "req-2", CreatedAt: now,
+		},
+		{ID: "01ARZ3NDEKTSV4RRFFQ69G5FB1", UserID: testAliceID, Action: domain.UserLogActionCreated, Changes: domain.UserChanges{}, Actor: "anonymous", RequestID: "req-1", CreatedAt: now.Add(-time.Hour)},
+	}}
+
+	userQuery := &mockUserQuery{users: users}
+	server := NewUserServer(
+		nil,
+		usecase.NewFindUserUsecase(userQuery),
+		usecase.NewListUsersUsecase(&mockUserSummaryQuery{users: users}),
+		nil,
+		nil,
+		usecase.NewListUserLogsUsecase(userLogQuery, userQuery),
+	)
+	path, h := server.Handler()
+
+	mux := http.NewServeMux()
+	mux.Handle(path, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := domain.WithTenant(r.Context(), domain.DefaultOrganizationID)
+		h.ServeHTTP(w, r.WithContext(domain.WithPrincipal(ctx, principal)))
+	}))
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+
+	return userv1connect.NewUserServiceClient(ts.Client(), ts.URL)
+}
+
+func adminPrincipal() domain.Principal {
+	return domain.NewUserPrincipal(testAdminID).WithRoles(domain.RoleAdmin)
+}
+
+// assertCode はエラーが指定したRPCのコードであることを確認する
+func assertCode(t *testing.T, err error, want connect.Code) {
+	t.Helper()
+	if err == nil {
+		t.Fatalf("expected error with code %v, got nil", want)
+	}
+	if got := connect.CodeOf(err); got != want {
+		t.Errorf("expected code %v, got %v (%v)", want, got, err)
+	}
+}
+
+func TestUserServer_GetUser(t *testing.T) {
+	client := newTestClient(t, adminPrincipal())
+
+	resp, err := client.GetUser(context.Background(), connect.NewRequest(&userv1.GetUserRequest{Id: testAliceID}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	user := resp.Msg.GetUser()
+	if user.GetId() != testAliceID || user.GetName() != "Alice" || user.GetEmail() != "alice@example.com" {
+		t.Errorf("unexpected user: %+v", user)
+	}
+	if user.GetOrganizationId() != domain.DefaultOrganizationID {
+		t.Errorf("expected organization %s, got %s", domain.DefaultOrganizationID, user.GetOrganizationId())
+	}
+	if user.GetEmailVerifiedAt() == nil {
+		t.Error("expected email_verified_at to be set")
+	}
+	if user.GetEmailInvalidatedAt() != nil {
+		t.Errorf("expected email_invalidated_at to be unset, got %v", user.GetEmailInvalidatedAt())
+	}
+}
+
+func TestUserServer_GetUser_Errors(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal domain.Principal
+		id        string
+		want      connect.Code
+	}{
+		{name: "存在しないユーザー", principal: adminPrincipal(), id: testUnknownID, want: connect.CodeNotFound},
+		{name: "IDの形式が不正", principal: adminPrincipal(), id: "invalid", want: connect.CodeInvalidArgument},
+		{name: "権限がない", principal: domain.NewUserPrincipal(testBobID), id: testAliceID, want: connect.CodePermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, tt.principal)
+			_, err := client.GetUser(context.Background(), connect.NewRequest(&userv1.GetUserRequest{Id: tt.id}))
+			assertCode(t, err, tt.want)
+		})
+	}
+}
+
+func TestUserServer_GetUser_OwnUser(t *testing.T) {
+	// 本人は権限がなくても取得できる
+	client := newTestClient(t, domain.NewUserPrincipal(testBobID))
+
+	resp, err := client.GetUser(context.Background(), connect.NewRequest(&userv1.GetUserRequest{Id: testBobID}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Msg.GetUser().GetName() != "Bob" {
+		t.Errorf("expected Bob, got %s", resp.Msg.GetUser().GetName())
+	}
+}
+
+func TestUserServer_ListUsers(t *testing.T) {
+	client := newTestClient(t, adminPrincipal())
+
+	resp, err := client.ListUsers(context.Background(), connect.NewRequest(&userv1.ListUsersRequest{Limit: 1, Offset: 1}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Msg.GetTotal() != 2 {
+		t.Errorf("expected total 2, got %d", resp.Msg.GetTotal())
+	}
+	if len(resp.Msg.GetUsers()) != 1 || resp.Msg.GetUsers()[0].GetUser().GetId() != testBobID {
+		t.Fatalf("expected only Bob, got %+v", resp.Msg.GetUsers())
+	}
+	if summary := resp.Msg.GetUsers()[0]; summary.GetLogCount() != 2 || summary.GetLastActivityAt() == nil {
+		t.Errorf("unexpected summary: %+v", summary)
+	}
+
+	// limit を指定しない場合はデフォルトの件数
+	resp, err = client.ListUsers(context.Background(), connect.NewRequest(&userv1.ListUsersRequest{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Msg.GetUsers()) != 2 {
+		t.Errorf("expected 2 users, got %d", len(resp.Msg.GetUsers()))
+	}
+}
+
+func TestUserServer_ListUsers_InvalidPagination(t *testing.T) {
+	client := newTestClient(t, adminPrincipal())
+
+	tests := []struct {
+		name string
+		req  *userv1.ListUsersRequest
+	}{
+		{name: "limit が上限を超える", req: &userv1.ListUsersRequest{Limit: 101}},
+		{name: "limit が負", req: &userv1.ListUsersRequest{Limit: -1}},
+		{name: "offset が負", req: &userv1.ListUsersRequest{Offset: -1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.ListUsers(context.Background(), connect.NewRequest(tt.req))
+			assertCode(t, err, connect.CodeInvalidArgument)
+		})
+	}
+}
+
+func TestUserServer_ListUserLogs(t *testing.T) {
+	client := newTestClient(t, adminPrincipal())
+
+	resp, err := client.ListUserLogs(context.Background(), connect.NewRequest(&userv1.ListUserLogsRequest{UserId: testAliceID}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Msg.GetTotal() != 2 || len(resp.Msg.GetLogs()) != 2 {
+		t.Fatalf("expected 2 logs, got total %d, logs %d", resp.Msg.GetTotal(), len(resp.Msg.GetLogs()))
+	}
+
+	updated := resp.Msg.GetLogs()[0]
+	if updated.GetAction() != userv1.UserLogAction_USER_LOG_ACTION_UPDATED {
+		t.Errorf("expected UPDATED, got %v", updated.GetAction())
+	}
+	if change := updated.GetChanges()["name"]; change.GetBefore() != "Alicia" || change.GetAfter() != "Alice" {
+		t.Errorf("unexpected name change: %+v", change)
+	}
+	if updated.GetActor() != "user:"+testAdminID || updated.GetRequestId() != "req-2" {
+		t.Errorf("unexpected actor or request id: %s, %s", updated.GetActor(), updated.GetRequestId())
+	}
+
+	// アクションで絞り込み
+	resp, err = client.ListUserLogs(context.Background(), connect.NewRequest(&userv1.ListUserLogsRequest{
+		UserId: testAliceID,
+		Action: userv1.UserLogAction_USER_LOG_ACTION_CREATED,
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Msg.GetTotal() != 1 || resp.Msg.GetLogs()[0].GetAction() != userv1.UserLogAction_USER_LOG_ACTION_CREATED {
+		t.Errorf("expected only the created log, got %+v", resp.Msg.GetLogs())
+	}
+}
+
+func TestUserServer_ListUserLogs_Errors(t *testing.T) {
+	client := newTestClient(t, adminPrincipal())
+
+	tests := []struct {
+		name string
+		req  *userv1.ListUserLogsRequest
+		want connect.Code
+	}{
+		{name: "IDの形式が不正", req: &userv1.ListUserLogsRequest{UserId: "invalid"}, want: connect.CodeInvalidArgument},
+		{name: "未定義のアクション", req: &userv1.ListUserLogsRequest{UserId: testAliceID, Action: 99}, want: connect.CodeInvalidArgument},
+		{name: "limit が上限を超える", req: &userv1.ListUserLogsRequest{UserId: testAliceID, Limit: 101}, want: connect.CodeInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.ListUserLogs(context.Background(), connect.NewRequest(tt.req))
+			assertCode(t, err, tt.want)
+		})
+	}
+}
+
+func TestToConnectError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want connect.Code
+	}{
+		{name: "不正なリクエスト", err: apperrors.BadRequest("bad", "不正です"), want: connect.CodeInvalidArgument},
+		{name: "バリデーションエラー", err: domain.ErrEmailInvalid("invalid"), want: connect.CodeInvalidArgument},
+		{name: "未認証", err: domain.NewUnauthorizedError("no credentials", "認証が必要です"), want: connect.CodeUnauthenticated},
+		{name: "権限がない", err: domain.ErrPermissionDenied(domain.NewUserPrincipal(testBobID), domain.PermissionUsersRead), want: connect.CodePermissionDenied},
+		{name: "存在しない", err: domain.ErrUserNotFound(testUnknownID), want: connect.CodeNotFound},
+		{name: "重複", err: domain.ErrEmailAlreadyExists("alice@example.com"), want: connect.CodeAlreadyExists},
+		{name: "試行回数の超過", err: domain.NewTooManyRequestsError(1500*time.Millisecond, "too many", "しばらくしてから再試行してください"), want: connect.CodeResourceExhausted},
+		{name: "内部エラー", err: errors.New("connection refused"), want: connect.CodeInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ToConnectError(tt.err)
+			if got.Code() != tt.want {
+				t.Errorf("expected code %v, got %v", tt.want, got.Code())
+			}
+		})
+	}
+}
+
+func TestToConnectError_HidesInternalDetails(t *testing.T) {
+	got := ToConnectError(errors.New("pq: password authentication failed"))
+
+	if got.Message() == "pq: password authentication failed" {
+		t.Error("expected internal error details to be hidden")
+	}
+}
+
+func TestToConnectError_RetryAfter(t *testing.T) {
+	got := ToConnectError(domain.NewTooManyRequestsError(1500*time.Millisecond, "too many", "しばらくしてから再試行してください"))
+
+	if retryAfter := got.Meta().Get("Retry-After"); retryAfter != "2" {
+		t.Errorf("expected Retry-After 2, got %q", retryAfter)
+	}
+}
+
+func TestToConnectError_Nil(t *testing.T) {
+	if got := ToConnectError(nil); got != nil {
+		t.Errorf("expected nil, got %v", got)
+	}
+}
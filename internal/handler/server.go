@@ -0,0 +1,32 @@
+package handler
+
+import "github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+
+// コンパイル時に ServerInterface の実装を検証
+var _ openapi.ServerInterface = (*Server)(nil)
+
+// Server OpenAPI生成のServerInterfaceを実装（各ハンドラーを埋め込んで1つにまとめる）
+type Server struct {
+	*UserHandler
+	*AuditEventHandler
+	*APIKeyHandler
+	*AuthHandler
+	*OrganizationHandler
+	*EventHandler
+	*WebhookHandler
+	*InboundWebhookHandler
+}
+
+// NewServer Serverのコンストラクタ
+func NewServer(userHandler *UserHandler, auditEventHandler *AuditEventHandler, apiKeyHandler *APIKeyHandler, authHandler *AuthHandler, organizationHandler *OrganizationHandler, eventHandler *EventHandler, webhookHandler *WebhookHandler, inboundWebhookHandler *InboundWebhookHandler) *Server {
+	return &Server{
+		UserHandler:           userHandler,
+		AuditEventHandler:     auditEventHandler,
+		APIKeyHandler:         apiKeyHandler,
+		AuthHandler:           authHandler,
+		OrganizationHandler:   organizationHandler,
+		EventHandler:          eventHandler,
+		WebhookHandler:        webhookHandler,
+		InboundWebhookHandler: inboundWebhookHandler,
+	}
+}
@@ -0,0 +1,198 @@
+package handler
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// userExportFlushInterval 何件ごとにレスポンスをクライアントへ送り出すか
+const userExportFlushInterval = 500
+
+// userExportCSVHeader CSVエクスポートのヘッダー行（APIのUserと同じ項目名）
+var userExportCSVHeader = []string{"id", "name", "email", "createdAt", "updatedAt"}
+
+// userExportFormat エクスポート形式ごとの設定
+type userExportFormat struct {
+	contentType string
+	extension   string
+	newEncoder  func(w io.Writer) userExportEncoder
+}
+
+// userExportEncoder ユーザーを1件ずつ書き出すエンコーダー
+type userExportEncoder interface {
+	Encode(user *domain.User) error
+	Flush() error
+}
+
+// userExportFormats 対応するエクスポート形式（先頭がデフォルト）
+var userExportFormats = []userExportFormat{
+	{contentType: "text/csv", extension: "csv", newEncoder: newCSVUserEncoder},
+	{contentType: "application/x-ndjson", extension: "ndjson", newEncoder: newNDJSONUserEncoder},
+}
+
+// UsersExportUsers ユーザーをCSVまたはNDJSONでエクスポート（OpenAPI ServerInterface実装）
+func (h *UserHandler) UsersExportUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.FromContext(ctx)
+
+	format, ok := negotiateUserExportFormat(r.Header.Get("Accept"))
+	if !ok {
+		respondError(w, http.StatusNotAcceptable, "エクスポート形式はCSV（text/csv）またはNDJSON（application/x-ndjson）を指定してください")
+		return
+	}
+
+	rc := http.NewResponseController(w)
+	encoder := format.newEncoder(w)
+	started := false
+	count := 0
+
+	err := h.exportUsers.Execute(ctx, func(user *domain.User) error {
+		// 最初の1件を書き出すまではヘッダーを確定させず、エラー時は通常のエラーレスポンスを返せるようにする
+		if !started {
+			writeUserExportHeader(w, format)
+			started = true
+		}
+		if err := encoder.Encode(user); err != nil {
+			return err
+		}
+		count++
+		if count%userExportFlushInterval == 0 {
+			if err := encoder.Flush(); err != nil {
+				return err
+			}
+			return flushResponse(rc)
+		}
+		return nil
+	})
+	if err != nil {
+		if !started {
+			HandleError(w, err, log)
+			return
+		}
+		// 送信済みのレスポンスは取り消せないため、接続を切断して途中で終わったことをクライアントに伝える
+		log.Error("user export aborted", slog.Int("exported", count), slog.String("error", err.Error()))
+		panic(http.ErrAbortHandler)
+	}
+
+	if !started {
+		writeUserExportHeader(w, format)
+	}
+	if err := encoder.Flush(); err != nil {
+		log.Warn("failed to flush user export", slog.String("error", err.Error()))
+	}
+}
+
+// writeUserExportHeader エクスポートのレスポンスヘッダーを書き出す
+func writeUserExportHeader(w http.ResponseWriter, format userExportFormat) {
+	filename := fmt.Sprintf("users-%s.%s", time.Now().UTC().Format("20060102-150405"), format.extension)
+	w.Header().Set("Content-Type", format.contentType+"; charset=utf-8")
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+}
+
+// flushResponse バッファ済みのレスポンスをクライアントへ送る（Flush 非対応の場合は何もしない）
+func flushResponse(rc *http.ResponseController) error {
+	if err := rc.Flush(); err != nil && err != http.ErrNotSupported {
+		return err
+	}
+	return nil
+}
+
+// negotiateUserExportFormat Acceptヘッダーからエクスポート形式を決定する
+// 指定がない場合やワイルドカードの場合はCSVを返す
+func negotiateUserExportFormat(accept string) (userExportFormat, bool) {
+	if strings.TrimSpace(accept) == "" {
+		return userExportFormats[0], true
+	}
+
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil || params["q"] == "0" {
+			continue
+		}
+		switch mediaType {
+		case "*/*", "text/*":
+			return userExportFormats[0], true
+		}
+		for _, format := range userExportFormats {
+			if mediaType == format.contentType {
+				return format, true
+			}
+		}
+	}
+	return userExportFormat{}, false
+}
+
+// csvUserEncoder ユーザーをCSVの行として書き出す
+type csvUserEncoder struct {
+	writer        *csv.Writer
+	headerWritten bool
+}
+
+func newCSVUserEncoder(w io.Writer) userExportEncoder {
+	return &csvUserEncoder{writer: csv.NewWriter(w)}
+}
+
+func (e *csvUserEncoder) Encode(user *domain.User) error {
+	if !e.headerWritten {
+		if err := e.writer.Write(userExportCSVHeader); err != nil {
+			return err
+		}
+		e.headerWritten = true
+	}
+	return e.writer.Write([]string{
+		user.ID,
+		escapeCSVFormula(user.Name),
+		escapeCSVFormula(user.Email),
+		user.CreatedAt.UTC().Format(time.RFC3339),
+		user.UpdatedAt.UTC().Format(time.RFC3339),
+	})
+}
+
+func (e *csvUserEncoder) Flush() error {
+	// ユーザーが0件でもヘッダー行は出力する
+	if !e.headerWritten {
+		if err := e.writer.Write(userExportCSVHeader); err != nil {
+			return err
+		}
+		e.headerWritten = true
+	}
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+// escapeCSVFormula 表計算ソフトで数式として解釈される値の先頭に ' を付ける（CSVインジェクション対策）
+func escapeCSVFormula(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+// ndjsonUserEncoder ユーザーを1行1オブジェクトのJSONとして書き出す
+type ndjsonUserEncoder struct {
+	encoder *json.Encoder
+}
+
+func newNDJSONUserEncoder(w io.Writer) userExportEncoder {
+	return &ndjsonUserEncoder{encoder: json.NewEncoder(w)}
+}
+
+func (e *ndjsonUserEncoder) Encode(user *domain.User) error {
+	return e.encoder.Encode(toUserResponse(user))
+}
+
+func (e *ndjsonUserEncoder) Flush() error {
+	return nil
+}
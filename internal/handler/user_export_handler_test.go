@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+func newExportTestHandler(query *mockUserQuery) *UserHandler {
+	return &UserHandler{exportUsers: usecase.NewExportUsersUsecase(query)}
+}
+
+func exportTestUsers() []*domain.User {
+	createdAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	return []*domain.User{
+		{ID: "01ARZ3NDEKTSV4RRFFQ69G5FAV", Name: "John Doe", Email: "john@example.com", CreatedAt: createdAt, UpdatedAt: createdAt},
+		{ID: "01ARZ3NDEKTSV4RRFFQ69G5FAW", Name: "=HYPERLINK(\"x\")", Email: "jane@example.com", CreatedAt: createdAt, UpdatedAt: createdAt},
+	}
+}
+
+func TestUsersExportUsers_CSV(t *testing.T) {
+	h := newExportTestHandler(&mockUserQuery{users: exportTestUsers()})
+
+	req := newAdminRequest(http.MethodGet, "/users/export", nil)
+	rec := httptest.NewRecorder()
+
+	h.UsersExportUsers(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+	}
+	if got := rec.Header().Get("Content-Type"); got != "text/csv; charset=utf-8" {
+		t.Errorf("expected Content-Type text/csv; charset=utf-8, got %s", got)
+	}
+	if got := rec.Header().Get("Content-Disposition"); !strings.HasPrefix(got, "attachment; filename=users-") || !strings.HasSuffix(got, ".csv") {
+		t.Errorf("unexpected Content-Disposition %q", got)
+	}
+
+	records, err := csv.NewReader(rec.Body).ReadAll()
+	if err != nil {
+		t.Fatalf("failed to parse CSV: %v", err)
+	}
+	want := [][]string{
+		{"id", "name", "email", "createdAt", "updatedAt"},
+		{"01ARZ3NDEKTSV4RRFFQ69G5FAV", "John Doe", "john@example.com", "2026-01-02T03:04:05Z", "2026-01-02T03:04:05Z"},
+		{"01ARZ3NDEKTSV4RRFFQ69G5FAW", "'=HYPERLINK(\"x\")", "jane@example.com", "2026-01-02T03:04:05Z", "2026-01-02T03:04:05Z"},
+	}
+	if len(records) != len(want) {
+		t.Fatalf("expected %d CSV rows, got %d", len(want), len(records))
+	}
+	for i := range want {
+		if strings.Join(records[i], "|") != strings.Join(want[i], "|") {
+			t.Errorf("row %d: expected %v, got %v", i, want[i], records[i])
+		}
+	}
+}
+
+func TestUsersExportUsers_CSVWithoutUsers(t *testing.T) {
+	h := newExportTestHandler(&mockUserQuery{})
+
+	req := newAdminRequest(http.MethodGet, "/users/export", nil)
+	rec := httptest.NewRecorder()
+
+	h.UsersExportUsers(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+	}
+	if got := rec.Body.String(); got != "id,name,email,createdAt,updatedAt\n" {
+		t.Errorf("expected header row only, got %q", got)
+	}
+}
+
+func TestUsersExportUsers_NDJSON(t *testing.T) {
+	h := newExportTestHandler(&mockUserQuery{users: exportTestUsers()})
+
+	req := newAdminRequest(http.MethodGet, "/users/export", nil)
+	req.Header.Set("Accept", "application/x-ndjson")
+	rec := httptest.NewRecorder()
+
+	h.UsersExportUsers(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+	}
+	if got := rec.Header().Get("Content-Type"); got != "application/x-ndjson; charset=utf-8" {
+		t.Errorf("expected Content-Type application/x-ndjson; charset=utf-8, got %s", got)
+	}
+
+	scanner := bufio.NewScanner(rec.Body)
+	var users []openapi.User
+	for scanner.Scan() {
+		var user openapi.User
+		if err := json.Unmarshal(scanner.Bytes(), &user); err != nil {
+			t.Fatalf("failed to decode line %q: %v", scanner.Text(), err)
+		}
+		users = append(users, user)
+	}
+	if len(users) != 2 {
+		t.Fatalf("expected 2 users, got %d", len(users))
+	}
+	if users[1].Name != "=HYPERLINK(\"x\")" {
+		t.Errorf("expected NDJSON name to be unescaped, got %q", users[1].Name)
+	}
+}
+
+func TestUsersExportUsers_NotAcceptable(t *testing.T) {
+	h := newExportTestHandler(&mockUserQuery{users: exportTestUsers()})
+
+	req := newAdminRequest(http.MethodGet, "/users/export", nil)
+	req.Header.Set("Accept", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+	rec := httptest.NewRecorder()
+
+	h.UsersExportUsers(rec, req)
+
+	if rec.Code != http.StatusNotAcceptable {
+		t.Errorf("expected status %d, got %d", http.StatusNotAcceptable, rec.Code)
+	}
+}
+
+func TestUsersExportUsers_ErrorBeforeFirstRow(t *testing.T) {
+	h := newExportTestHandler(&mockUserQuery{err: errors.New("connection refused")})
+
+	req := newAdminRequest(http.MethodGet, "/users/export", nil)
+	rec := httptest.NewRecorder()
+
+	h.UsersExportUsers(rec, req)
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("expected status %d, got %d", http.StatusInternalServerError, rec.Code)
+	}
+	if got := rec.Header().Get("Content-Disposition"); got != "" {
+		t.Errorf("expected no Content-Disposition on error, got %q", got)
+	}
+}
+
+func TestUsersExportUsers_ErrorAfterFirstRowAborts(t *testing.T) {
+	h := newExportTestHandler(&mockUserQuery{users: exportTestUsers(), err: errors.New("connection reset")})
+
+	req := newAdminRequest(http.MethodGet, "/users/export", nil)
+	rec := httptest.NewRecorder()
+
+	defer func() {
+		if r := recover(); r != http.ErrAbortHandler {
+			t.Errorf("expected panic with http.ErrAbortHandler, got %v", r)
+		}
+	}()
+	h.UsersExportUsers(rec, req)
+}
+
+func TestNegotiateUserExportFormat(t *testing.T) {
+	tests := []struct {
+		accept string
+		want   string
+		ok     bool
+	}{
+		{accept: "", want: "text/csv", ok: true},
+		{accept: "*/*", want: "text/csv", ok: true},
+		{accept: "text/csv", want: "text/csv", ok: true},
+		{accept: "application/x-ndjson", want: "application/x-ndjson", ok: true},
+		{accept: "application/json, application/x-ndjson;q=0.9", want: "application/x-ndjson", ok: true},
+		{accept: "text/csv;q=0, application/x-ndjson", want: "application/x-ndjson", ok: true},
+		{accept: "application/json", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.accept, func(t *testing.T) {
+			format, ok := negotiateUserExportFormat(tt.accept)
+			if ok != tt.ok {
+				t.Fatalf("expected ok=%v, got %v", tt.ok, ok)
+			}
+			if ok && format.contentType != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, format.contentType)
+			}
+		})
+	}
+}
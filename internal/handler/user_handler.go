@@ -4,22 +4,28 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"github.com/example/go-react-cqrs-template/internal/domain"
 	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
 	"github.com/example/go-react-cqrs-template/internal/usecase"
 	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
-// コンパイル時に ServerInterface の実装を検証
-var _ openapi.ServerInterface = (*UserHandler)(nil)
-
-// UserHandler HTTPハンドラー（OpenAPI生成のServerInterfaceを実装）
+// UserHandler ユーザー関連のHTTPハンドラー（ServerInterface のうち Users・UserImports を実装）
 type UserHandler struct {
-	createUser *usecase.CreateUserUsecase
-	findUser   *usecase.FindUserUsecase
-	listUsers  *usecase.ListUsersUsecase
-	updateUser *usecase.UpdateUserUsecase
-	deleteUser *usecase.DeleteUserUsecase
+	createUser     *usecase.CreateUserUsecase
+	findUser       *usecase.FindUserUsecase
+	listUsers      *usecase.ListUsersUsecase
+	updateUser     *usecase.UpdateUserUsecase
+	deleteUser     *usecase.DeleteUserUsecase
+	changePassword *usecase.ChangeUserPasswordUsecase
+	verifyEmail    *usecase.RequestEmailVerificationUsecase
+	exportUsers    *usecase.ExportUsersUsecase
+	listLogs       *usecase.ListUserLogsUsecase
+
+	importUsers        *usecase.ImportUsersUsecase
+	findUserImport     *usecase.FindUserImportUsecase
+	listUserImportRows *usecase.ListUserImportRowsUsecase
 }
 
 // NewUserHandler UserHandlerのコンストラクタ
@@ -29,13 +35,27 @@ func NewUserHandler(
 	listUsers *usecase.ListUsersUsecase,
 	updateUser *usecase.UpdateUserUsecase,
 	deleteUser *usecase.DeleteUserUsecase,
+	changePassword *usecase.ChangeUserPasswordUsecase,
+	verifyEmail *usecase.RequestEmailVerificationUsecase,
+	exportUsers *usecase.ExportUsersUsecase,
+	listLogs *usecase.ListUserLogsUsecase,
+	importUsers *usecase.ImportUsersUsecase,
+	findUserImport *usecase.FindUserImportUsecase,
+	listUserImportRows *usecase.ListUserImportRowsUsecase,
 ) *UserHandler {
 	return &UserHandler{
-		createUser: createUser,
-		findUser:   findUser,
-		listUsers:  listUsers,
-		updateUser: updateUser,
-		deleteUser: deleteUser,
+		createUser:         createUser,
+		findUser:           findUser,
+		listUsers:          listUsers,
+		updateUser:         updateUser,
+		deleteUser:         deleteUser,
+		changePassword:     changePassword,
+		verifyEmail:        verifyEmail,
+		exportUsers:        exportUsers,
+		listLogs:           listLogs,
+		importUsers:        importUsers,
+		findUserImport:     findUserImport,
+		listUserImportRows: listUserImportRows,
 	}
 }
 
@@ -47,13 +67,20 @@ func (h *UserHandler) UsersCreateUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	var password string
+	if req.Password != nil {
+		password = *req.Password
+	}
+
 	ctx := r.Context()
-	if err := h.createUser.Execute(ctx, req.Name, string(req.Email)); err != nil {
+	user, err := h.createUser.Execute(ctx, req.Name, string(req.Email), password)
+	if err != nil {
 		HandleError(w, err, logger.FromContext(ctx))
 		return
 	}
 
-	w.WriteHeader(http.StatusCreated)
+	w.Header().Set("Location", userLocation(user.ID))
+	respondJSON(w, http.StatusCreated, toUserResponse(user))
 }
 
 // UsersGetUser ユーザーを取得（OpenAPI ServerInterface実装）
@@ -65,15 +92,7 @@ func (h *UserHandler) UsersGetUser(w http.ResponseWriter, r *http.Request, userI
 		return
 	}
 
-	response := openapi.User{
-		Id:        user.ID,
-		Name:      user.Name,
-		Email:     openapi_types.Email(user.Email),
-		CreatedAt: user.CreatedAt,
-		UpdatedAt: user.UpdatedAt,
-	}
-
-	respondJSON(w, http.StatusOK, response)
+	respondJSON(w, http.StatusOK, toUserResponse(user))
 }
 
 // UsersListUsers ユーザー一覧を取得（OpenAPI ServerInterface実装）
@@ -100,15 +119,9 @@ func (h *UserHandler) UsersListUsers(w http.ResponseWriter, r *http.Request, par
 		return
 	}
 
-	userResponses := make([]openapi.User, 0, len(users))
+	userResponses := make([]openapi.UserSummary, 0, len(users))
 	for _, user := range users {
-		userResponses = append(userResponses, openapi.User{
-			Id:        user.ID,
-			Name:      user.Name,
-			Email:     openapi_types.Email(user.Email),
-			CreatedAt: user.CreatedAt,
-			UpdatedAt: user.UpdatedAt,
-		})
+		userResponses = append(userResponses, toUserSummaryResponse(user))
 	}
 
 	response := openapi.UserList{
@@ -148,6 +161,39 @@ func (h *UserHandler) UsersUpdateUser(w http.ResponseWriter, r *http.Request, us
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// UsersChangeUserPassword ユーザーのパスワードを変更（OpenAPI ServerInterface実装）
+func (h *UserHandler) UsersChangeUserPassword(w http.ResponseWriter, r *http.Request, userId string) {
+	var req openapi.ChangePasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストの形式が不正です")
+		return
+	}
+
+	var currentPassword string
+	if req.CurrentPassword != nil {
+		currentPassword = *req.CurrentPassword
+	}
+
+	ctx := r.Context()
+	if err := h.changePassword.Execute(ctx, userId, currentPassword, req.NewPassword); err != nil {
+		HandleError(w, err, logger.FromContext(ctx))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// UsersRequestEmailVerification メールアドレスの確認メールを再送（OpenAPI ServerInterface実装）
+func (h *UserHandler) UsersRequestEmailVerification(w http.ResponseWriter, r *http.Request, userId string) {
+	ctx := r.Context()
+	if err := h.verifyEmail.Execute(ctx, userId); err != nil {
+		HandleError(w, err, logger.FromContext(ctx))
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
 // UsersDeleteUser ユーザーを削除（OpenAPI ServerInterface実装）
 func (h *UserHandler) UsersDeleteUser(w http.ResponseWriter, r *http.Request, userId string) {
 	ctx := r.Context()
@@ -159,6 +205,98 @@ func (h *UserHandler) UsersDeleteUser(w http.ResponseWriter, r *http.Request, us
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// UsersListUserLogs ユーザーの操作履歴を取得（OpenAPI ServerInterface実装）
+func (h *UserHandler) UsersListUserLogs(w http.ResponseWriter, r *http.Request, userId string, params openapi.UsersListUserLogsParams) {
+	ctx := r.Context()
+
+	// デフォルト値の設定
+	limit := 10
+	offset := 0
+
+	if params.Limit != nil {
+		if *params.Limit > 0 && *params.Limit <= 100 {
+			limit = int(*params.Limit)
+		}
+	}
+
+	if params.Offset != nil && *params.Offset >= 0 {
+		offset = int(*params.Offset)
+	}
+
+	var action domain.UserLogAction
+	if params.Action != nil {
+		action = domain.UserLogAction(*params.Action)
+	}
+
+	logs, total, err := h.listLogs.Execute(ctx, userId, action, limit, offset)
+	if err != nil {
+		HandleError(w, err, logger.FromContext(ctx))
+		return
+	}
+
+	logResponses := make([]openapi.UserLog, 0, len(logs))
+	for _, l := range logs {
+		logResponses = append(logResponses, toUserLogResponse(l))
+	}
+
+	respondJSON(w, http.StatusOK, openapi.UserLogList{
+		Logs:  logResponses,
+		Total: int32(total),
+	})
+}
+
+// userLocation 作成したユーザーを指すLocationヘッダーの値を返す
+func userLocation(id string) string {
+	return "/api/v1/users/" + id
+}
+
+// toUserResponse domain.UserをAPIレスポンスのUserに変換
+func toUserResponse(user *domain.User) openapi.User {
+	return openapi.User{
+		Id:                 user.ID,
+		OrganizationId:     user.OrganizationID,
+		Name:               user.Name,
+		Email:              openapi_types.Email(user.Email),
+		EmailVerifiedAt:    user.EmailVerifiedAt,
+		EmailInvalidatedAt: user.EmailInvalidatedAt,
+		CreatedAt:          user.CreatedAt,
+		UpdatedAt:          user.UpdatedAt,
+	}
+}
+
+// toUserSummaryResponse domain.UserSummaryをAPIレスポンスのUserSummaryに変換
+func toUserSummaryResponse(summary *domain.UserSummary) openapi.UserSummary {
+	return openapi.UserSummary{
+		Id:                 summary.User.ID,
+		OrganizationId:     summary.User.OrganizationID,
+		Name:               summary.User.Name,
+		Email:              openapi_types.Email(summary.User.Email),
+		EmailVerifiedAt:    summary.User.EmailVerifiedAt,
+		EmailInvalidatedAt: summary.User.EmailInvalidatedAt,
+		CreatedAt:          summary.User.CreatedAt,
+		UpdatedAt:          summary.User.UpdatedAt,
+		LogCount:           int32(summary.LogCount),
+		LastActivityAt:     summary.LastActivityAt,
+	}
+}
+
+// toUserLogResponse domain.UserLogをAPIレスポンスのUserLogに変換
+func toUserLogResponse(l *domain.UserLog) openapi.UserLog {
+	changes := make(map[string]openapi.UserFieldChange, len(l.Changes))
+	for field, change := range l.Changes {
+		changes[field] = openapi.UserFieldChange{Before: change.Before, After: change.After}
+	}
+	return openapi.UserLog{
+		Id:        l.ID,
+		UserId:    l.UserID,
+		Action:    openapi.UserLogAction(l.Action),
+		Changes:   changes,
+		Actor:     l.Actor,
+		RequestId: l.RequestID,
+		CreatedAt: l.CreatedAt,
+	}
+}
+
 // respondJSON JSONレスポンスを返す
 func respondJSON(w http.ResponseWriter, status int, data interface{}) {
 	w.Header().Set("Content-Type", "application/json")
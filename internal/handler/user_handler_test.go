@@ -0,0 +1,430 @@
+package handler
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+// mockUserQuery はテスト用のUserQueryRepositoryモック
+type mockUserQuery struct {
+	usecase.UserQueryRepository
+	users []*domain.User
+	err   error
+}
+
+func (m *mockUserQuery) FindByID(_ context.Context, id string) (*domain.User, error) {
+	for _, user := range m.users {
+		if user.ID == id {
+			return user, nil
+		}
+	}
+	return nil, m.err
+}
+
+func (m *mockUserQuery) FindByEmail(_ context.Context, email string) (*domain.User, error) {
+	for _, user := range m.users {
+		if domain.SameEmail(user.Email, email) {
+			return user, nil
+		}
+	}
+	return nil, m.err
+}
+
+func (m *mockUserQuery) StreamAll(_ context.Context, fn func(*domain.User) error) error {
+	for _, user := range m.users {
+		if err := fn(user); err != nil {
+			return err
+		}
+	}
+	return m.err
+}
+
+// mockUserSummaryQuery はテスト用のUserSummaryQueryRepositoryモック
+type mockUserSummaryQuery struct {
+	summaries []*domain.UserSummary
+}
+
+func (m *mockUserSummaryQuery) FindAll(_ context.Context, limit, offset int) ([]*domain.UserSummary, error) {
+	if offset >= len(m.summaries) {
+		return nil, nil
+	}
+	return m.summaries[offset:min(offset+limit, len(m.summaries))], nil
+}
+
+func (m *mockUserSummaryQuery) Count(_ context.Context) (int, error) {
+	return len(m.summaries), nil
+}
+
+// mockUserLogQuery はテスト用のUserLogQueryRepositoryモック
+type mockUserLogQuery struct {
+	logs []*domain.UserLog
+}
+
+func (m *mockUserLogQuery) filter(userID string, action domain.UserLogAction) []*domain.UserLog {
+	var result []*domain.UserLog
+	for _, l := range m.logs {
+		if l.UserID == userID && (action == "" || l.Action == action) {
+			result = append(result, l)
+		}
+	}
+	return result
+}
+
+func (m *mockUserLogQuery) FindByUserID(_ context.Context, userID string, action domain.UserLogAction, limit, offset int) ([]*domain.UserLog, error) {
+	logs := m.filter(userID, action)
+	if offset >= len(logs) {
+		return nil, nil
+	}
+	return logs[offset:min(offset+limit, len(logs))], nil
+}
+
+func (m *mockUserLogQuery) CountByUserID(_ context.Context, userID string, action domain.UserLogAction) (int, error) {
+	return len(m.filter(userID, action)), nil
+}
+
+func (m *mockUserLogQuery) FindByUserIDs(ctx context.Context, userIDs []string, action domain.UserLogAction, limit, offset int) (map[string][]*domain.UserLog, error) {
+	result := make(map[string][]*domain.UserLog, len(userIDs))
+	for _, userID := range userIDs {
+		if logs, _ := m.FindByUserID(ctx, userID, action, limit, offset); len(logs) > 0 {
+			result[userID] = logs
+		}
+	}
+	return result, nil
+}
+
+func (m *mockUserLogQuery) CountByUserIDs(_ context.Context, userIDs []string, action domain.UserLogAction) (map[string]int, error) {
+	result := make(map[string]int, len(userIDs))
+	for _, userID := range userIDs {
+		if count := len(m.filter(userID, action)); count > 0 {
+			result[userID] = count
+		}
+	}
+	return result, nil
+}
+
+const (
+	testActiveUserID  = "01ARZ3NDEKTSV4RRFFQ69G5FAV"
+	testDeletedUserID = "01ARZ3NDEKTSV4RRFFQ69G5FAW"
+	testUnknownUserID = "01ARZ3NDEKTSV4RRFFQ69G5FAX"
+	testAdminUserID   = "01ARZ3NDEKTSV4RRFFQ69G5FAZ"
+)
+
+// newRequestAs は指定した主体として認証済みのリクエストを作成
+func newRequestAs(principal domain.Principal, method, target string, body io.Reader) *http.Request {
+	req := httptest.NewRequest(method, target, body)
+	return req.WithContext(domain.WithPrincipal(req.Context(), principal))
+}
+
+// newAdminRequest は管理者として認証済みのリクエストを作成
+func newAdminRequest(method, target string, body io.Reader) *http.Request {
+	return newRequestAs(domain.NewUserPrincipal(testAdminUserID).WithRoles(domain.RoleAdmin), method, target, body)
+}
+
+func newUserLogsTestHandler() *UserHandler {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	userQuery := &mockUserQuery{users: []*domain.User{
+		{ID: testActiveUserID, Name: "John Doe", Email: "john@example.com", CreatedAt: now, UpdatedAt: now},
+	}}
+	userLogQuery := &mockUserLogQuery{logs: []*domain.UserLog{
+		{ID: "01ARZ3NDEKTSV4RRFFQ69G5FB2", UserID: testDeletedUserID, Action: domain.UserLogActionDeleted, CreatedAt: now.Add(time.Hour)},
+		{ID: "01ARZ3NDEKTSV4RRFFQ69G5FB1", UserID: testDeletedUserID, Action: domain.UserLogActionCreated, CreatedAt: now},
+	}}
+	return &UserHandler{listLogs: usecase.NewListUserLogsUsecase(userLogQuery, userQuery)}
+}
+
+func TestUsersListUserLogs(t *testing.T) {
+	action := openapi.UserLogActionDeleted
+
+	tests := []struct {
+		name       string
+		userID     string
+		params     openapi.UsersListUserLogsParams
+		wantStatus int
+		wantTotal  int32
+		wantLogs   int
+	}{
+		{
+			name:       "deleted user",
+			userID:     testDeletedUserID,
+			wantStatus: http.StatusOK,
+			wantTotal:  2,
+			wantLogs:   2,
+		},
+		{
+			name:       "filtered by action",
+			userID:     testDeletedUserID,
+			params:     openapi.UsersListUserLogsParams{Action: &action},
+			wantStatus: http.StatusOK,
+			wantTotal:  1,
+			wantLogs:   1,
+		},
+		{
+			name:       "existing user without matching logs",
+			userID:     testActiveUserID,
+			params:     openapi.UsersListUserLogsParams{Action: &action},
+			wantStatus: http.StatusOK,
+			wantTotal:  0,
+			wantLogs:   0,
+		},
+		{
+			name:       "unknown user",
+			userID:     testUnknownUserID,
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newUserLogsTestHandler()
+
+			req := newAdminRequest(http.MethodGet, "/users/"+tt.userID+"/logs", nil)
+			rec := httptest.NewRecorder()
+
+			h.UsersListUserLogs(rec, req, tt.userID, tt.params)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, rec.Code)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			var resp openapi.UserLogList
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if resp.Total != tt.wantTotal {
+				t.Errorf("expected total %d, got %d", tt.wantTotal, resp.Total)
+			}
+			if len(resp.Logs) != tt.wantLogs {
+				t.Errorf("expected %d logs, got %d", tt.wantLogs, len(resp.Logs))
+			}
+			if resp.Logs == nil {
+				t.Error("expected logs to be an empty array, got null")
+			}
+		})
+	}
+}
+
+func TestUsersListUserLogs_Authorization(t *testing.T) {
+	tests := []struct {
+		name       string
+		principal  domain.Principal
+		userID     string
+		wantStatus int
+	}{
+		{name: "own logs without role", principal: domain.NewUserPrincipal(testDeletedUserID), userID: testDeletedUserID, wantStatus: http.StatusOK},
+		{name: "other user's logs without role", principal: domain.NewUserPrincipal(testActiveUserID), userID: testDeletedUserID, wantStatus: http.StatusForbidden},
+		{name: "other user's logs as viewer", principal: domain.NewUserPrincipal(testActiveUserID).WithRoles(domain.RoleViewer), userID: testDeletedUserID, wantStatus: http.StatusOK},
+		{name: "anonymous", principal: domain.AnonymousPrincipal, userID: testDeletedUserID, wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newUserLogsTestHandler()
+
+			req := newRequestAs(tt.principal, http.MethodGet, "/users/"+tt.userID+"/logs", nil)
+			rec := httptest.NewRecorder()
+
+			h.UsersListUserLogs(rec, req, tt.userID, openapi.UsersListUserLogsParams{})
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, rec.Code)
+			}
+		})
+	}
+}
+
+// testUserLogHashKey ユーザーログのハッシュチェーンのテスト用のキー
+var testUserLogHashKey = domain.UserLogHashKey("0123456789abcdef0123456789abcdef")
+
+// newCreateUserTestHandler ユーザー作成のテスト用のハンドラー（existingEmail のユーザーが登録済みの fakeDB を使う）
+func newCreateUserTestHandler(t *testing.T, existingEmail string) (*UserHandler, *fakeDB) {
+	t.Helper()
+	db, txManager := newFakeDB(t)
+	db.handle("GetUserByEmailForUpdate", func(args []driver.Value) ([][]driver.Value, error) {
+		if existingEmail == "" || !strings.EqualFold(args[1].(string), existingEmail) {
+			return nil, nil
+		}
+		now := time.Now()
+		return [][]driver.Value{{testActiveUserID, args[0], "John Doe", existingEmail, nil, nil, "", now, now}}, nil
+	})
+	db.handle("ReserveChangeIDs", func([]driver.Value) ([][]driver.Value, error) {
+		return [][]driver.Value{{int64(1)}}, nil
+	})
+	db.handle("GetUserLogChainHeadForUpdate", func([]driver.Value) ([][]driver.Value, error) {
+		return [][]driver.Value{{int64(0), "", ""}}, nil
+	})
+	createUser := usecase.NewCreateUserUsecase(&mockUserQuery{}, txManager, command.UserEventSourcing{}, testUserLogHashKey, domain.EmailNormalization{})
+	return &UserHandler{createUser: createUser}, db
+}
+
+func TestUsersCreateUser(t *testing.T) {
+	h, db := newCreateUserTestHandler(t, "")
+
+	body := strings.NewReader(`{"name":"Jane Doe","email":"Jane@Example.COM","password":"correct horse battery staple"}`)
+	req := newAdminRequest(http.MethodPost, "/users", body)
+	req = req.WithContext(domain.WithTenant(req.Context(), domain.DefaultOrganizationID))
+	rec := httptest.NewRecorder()
+
+	h.UsersCreateUser(rec, req)
+
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d (%s)", http.StatusCreated, rec.Code, rec.Body.String())
+	}
+	var resp openapi.User
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.Id == "" || resp.Name != "Jane Doe" || resp.Email != "Jane@example.com" {
+		t.Errorf("unexpected user %+v", resp)
+	}
+	if resp.OrganizationId != domain.DefaultOrganizationID {
+		t.Errorf("expected organization %s, got %s", domain.DefaultOrganizationID, resp.OrganizationId)
+	}
+	if got := rec.Header().Get("Location"); got != "/api/v1/users/"+resp.Id {
+		t.Errorf("expected Location of the created user, got %q", got)
+	}
+	// パスワードのハッシュはレスポンスに含めない
+	if strings.Contains(rec.Body.String(), "argon2") || strings.Contains(rec.Body.String(), "password") {
+		t.Errorf("expected no password in response, got %s", rec.Body.String())
+	}
+	// ユーザー・ユーザーログ・監査イベントを1つのトランザクションで保存する
+	for _, name := range []string{"UpsertUser", "CreateUserLog", "CreateAuditEvent"} {
+		if db.count(name) != 1 {
+			t.Errorf("expected %s to be executed once, got %v", name, db.executed)
+		}
+	}
+	if db.commits != 1 {
+		t.Errorf("expected 1 commit, got %d", db.commits)
+	}
+}
+
+func TestUsersCreateUser_DuplicateEmail(t *testing.T) {
+	h, db := newCreateUserTestHandler(t, "jane@example.com")
+
+	req := newAdminRequest(http.MethodPost, "/users", strings.NewReader(`{"name":"Jane Doe","email":"JANE@example.com"}`))
+	req = req.WithContext(domain.WithTenant(req.Context(), domain.DefaultOrganizationID))
+	rec := httptest.NewRecorder()
+
+	h.UsersCreateUser(rec, req)
+
+	if rec.Code != http.StatusConflict {
+		t.Fatalf("expected status %d, got %d (%s)", http.StatusConflict, rec.Code, rec.Body.String())
+	}
+	if rec.Header().Get("Location") != "" {
+		t.Error("expected no Location header")
+	}
+	if db.commits != 0 {
+		t.Errorf("expected the transaction to be rolled back, got %d commits", db.commits)
+	}
+}
+
+func TestUsersDeleteUser_Forbidden(t *testing.T) {
+	// 権限の確認はトランザクションの開始前に行われるため、DBなしで確認できる
+	h := &UserHandler{deleteUser: usecase.NewDeleteUserUsecase(&mockUserQuery{}, nil, command.UserEventSourcing{}, nil)}
+
+	principals := []domain.Principal{
+		domain.NewUserPrincipal(testActiveUserID).WithRoles(domain.RoleViewer),
+		domain.NewUserPrincipal(testActiveUserID),
+		domain.NewAPIKeyPrincipal("01ARZ3NDEKTSV4RRFFQ69G5FC1").WithScopes("users:read"),
+	}
+	for _, principal := range principals {
+		req := newRequestAs(principal, http.MethodDelete, "/users/"+testActiveUserID, nil)
+		rec := httptest.NewRecorder()
+
+		h.UsersDeleteUser(rec, req, testActiveUserID)
+
+		if rec.Code != http.StatusForbidden {
+			t.Errorf("%s: expected status %d, got %d", principal, http.StatusForbidden, rec.Code)
+		}
+	}
+}
+
+func TestUsersRequestEmailVerification(t *testing.T) {
+	verifiedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	userQuery := &mockUserQuery{users: []*domain.User{
+		{ID: testActiveUserID, Name: "John Doe", Email: "john@example.com", EmailVerifiedAt: &verifiedAt},
+	}}
+	// 確認済みの場合と権限がない場合はジョブを登録しないため、DBなしで確認できる
+	h := &UserHandler{verifyEmail: usecase.NewRequestEmailVerificationUsecase(userQuery, nil)}
+
+	tests := []struct {
+		name       string
+		principal  domain.Principal
+		userID     string
+		wantStatus int
+	}{
+		{name: "already verified", principal: domain.NewUserPrincipal(testActiveUserID), userID: testActiveUserID, wantStatus: http.StatusAccepted},
+		{name: "other user", principal: domain.NewUserPrincipal(testAdminUserID), userID: testActiveUserID, wantStatus: http.StatusForbidden},
+		{name: "unknown user", principal: domain.NewUserPrincipal(testAdminUserID).WithRoles(domain.RoleAdmin), userID: testUnknownUserID, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := newRequestAs(tt.principal, http.MethodPost, "/users/"+tt.userID+"/email-verification", nil)
+			rec := httptest.NewRecorder()
+
+			h.UsersRequestEmailVerification(rec, req, tt.userID)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d (%s)", tt.wantStatus, rec.Code, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestToUserResponse_EmailVerifiedAt(t *testing.T) {
+	user := &domain.User{ID: testActiveUserID, Name: "John Doe", Email: "john@example.com"}
+	if resp := toUserResponse(user); resp.EmailVerifiedAt != nil {
+		t.Errorf("expected no emailVerifiedAt for an unverified user, got %v", resp.EmailVerifiedAt)
+	}
+
+	verifiedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	user.EmailVerifiedAt = &verifiedAt
+	if resp := toUserResponse(user); resp.EmailVerifiedAt == nil || !resp.EmailVerifiedAt.Equal(verifiedAt) {
+		t.Errorf("expected emailVerifiedAt %v, got %v", verifiedAt, resp.EmailVerifiedAt)
+	}
+}
+
+func TestUsersListUsers(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	lastActivityAt := now.Add(time.Hour)
+	h := &UserHandler{listUsers: usecase.NewListUsersUsecase(&mockUserSummaryQuery{summaries: []*domain.UserSummary{
+		{User: &domain.User{ID: testActiveUserID, Name: "John Doe", Email: "john@example.com", CreatedAt: now, UpdatedAt: now}, LogCount: 2, LastActivityAt: &lastActivityAt},
+		{User: &domain.User{ID: testAdminUserID, Name: "Jane Doe", Email: "jane@example.com", CreatedAt: now, UpdatedAt: now}},
+	}})}
+
+	rec := httptest.NewRecorder()
+	h.UsersListUsers(rec, newAdminRequest(http.MethodGet, "/users", nil), openapi.UsersListUsersParams{})
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d (%s)", http.StatusOK, rec.Code, rec.Body.String())
+	}
+	var resp openapi.UserList
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.Total != 2 || len(resp.Users) != 2 {
+		t.Fatalf("expected 2 users, got %d (total %d)", len(resp.Users), resp.Total)
+	}
+	if got := resp.Users[0]; got.LogCount != 2 || got.LastActivityAt == nil || !got.LastActivityAt.Equal(lastActivityAt) {
+		t.Errorf("unexpected activity for %s: logCount=%d lastActivityAt=%v", got.Id, got.LogCount, got.LastActivityAt)
+	}
+	// ログのないユーザーは lastActivityAt を含まない
+	if got := resp.Users[1]; got.LogCount != 0 || got.LastActivityAt != nil {
+		t.Errorf("unexpected activity for %s: logCount=%d lastActivityAt=%v", got.Id, got.LogCount, got.LastActivityAt)
+	}
+}
@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+// maxUserImportBodyBytes インポートファイルの最大サイズ
+const maxUserImportBodyBytes = 10 << 20
+
+// userImportFormats Content-Type とインポート形式の対応
+var userImportFormats = map[string]domain.UserImportFormat{
+	"text/csv":             domain.UserImportFormatCSV,
+	"application/x-ndjson": domain.UserImportFormatNDJSON,
+}
+
+// UserImportsCreateUserImport ユーザーを一括インポート（OpenAPI ServerInterface実装）
+func (h *UserHandler) UserImportsCreateUserImport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	format, ok := userImportFormats[mediaType]
+	if err != nil || !ok {
+		respondError(w, http.StatusUnsupportedMediaType, "CSV（text/csv）またはNDJSON（application/x-ndjson）を送信してください")
+		return
+	}
+
+	source, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxUserImportBodyBytes))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			respondError(w, http.StatusRequestEntityTooLarge, "インポートファイルのサイズが大きすぎます")
+			return
+		}
+		respondError(w, http.StatusBadRequest, "リクエストの形式が不正です")
+		return
+	}
+
+	importID, err := h.importUsers.Execute(ctx, format, source)
+	if err != nil {
+		HandleError(w, err, logger.FromContext(ctx))
+		return
+	}
+
+	userImport, summary, err := h.findUserImport.Execute(ctx, importID)
+	if err != nil {
+		HandleError(w, err, logger.FromContext(ctx))
+		return
+	}
+
+	w.Header().Set("Location", userImportLocation(importID))
+	respondJSON(w, http.StatusAccepted, toUserImportResponse(userImport, summary))
+}
+
+// UserImportsGetUserImport インポートの状態を取得（OpenAPI ServerInterface実装）
+func (h *UserHandler) UserImportsGetUserImport(w http.ResponseWriter, r *http.Request, importId string) {
+	ctx := r.Context()
+	userImport, summary, err := h.findUserImport.Execute(ctx, importId)
+	if err != nil {
+		HandleError(w, err, logger.FromContext(ctx))
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toUserImportResponse(userImport, summary))
+}
+
+// UserImportsListUserImportRows インポートの行ごとの結果を取得（OpenAPI ServerInterface実装）
+func (h *UserHandler) UserImportsListUserImportRows(w http.ResponseWriter, r *http.Request, importId string, params openapi.UserImportsListUserImportRowsParams) {
+	ctx := r.Context()
+
+	// デフォルト値の設定
+	limit := 100
+	offset := 0
+
+	if params.Limit != nil {
+		if *params.Limit > 0 && *params.Limit <= 1000 {
+			limit = int(*params.Limit)
+		}
+	}
+
+	if params.Offset != nil && *params.Offset >= 0 {
+		offset = int(*params.Offset)
+	}
+
+	var status domain.UserImportRowStatus
+	if params.Status != nil {
+		status = domain.UserImportRowStatus(*params.Status)
+	}
+
+	rows, total, err := h.listUserImportRows.Execute(ctx, importId, status, limit, offset)
+	if err != nil {
+		HandleError(w, err, logger.FromContext(ctx))
+		return
+	}
+
+	rowResponses := make([]openapi.UserImportRow, 0, len(rows))
+	for _, row := range rows {
+		rowResponses = append(rowResponses, toUserImportRowResponse(row))
+	}
+
+	respondJSON(w, http.StatusOK, openapi.UserImportRowList{
+		Rows:  rowResponses,
+		Total: int32(total),
+	})
+}
+
+// userImportLocation 作成したインポートを指すLocationヘッダーの値を返す
+func userImportLocation(id string) string {
+	return "/api/v1/users/imports/" + id
+}
+
+// toUserImportResponse domain.UserImportをAPIレスポンスのUserImportに変換
+func toUserImportResponse(userImport *domain.UserImport, summary *domain.UserImportSummary) openapi.UserImport {
+	response := openapi.UserImport{
+		Id:                    userImport.ID,
+		Format:                openapi.UserImportFormat(userImport.Format),
+		Status:                openapi.UserImportStatus(userImport.Status),
+		TotalRows:             int32(userImport.TotalRows),
+		CreatedCount:          int32(summary.Created),
+		SkippedDuplicateCount: int32(summary.SkippedDuplicate),
+		InvalidCount:          int32(summary.Invalid),
+		CreatedAt:             userImport.CreatedAt,
+		UpdatedAt:             userImport.UpdatedAt,
+		CompletedAt:           userImport.CompletedAt,
+	}
+	if userImport.LastError != "" {
+		response.Error = &userImport.LastError
+	}
+	return response
+}
+
+// toUserImportRowResponse domain.UserImportRowをAPIレスポンスのUserImportRowに変換
+func toUserImportRowResponse(row *domain.UserImportRow) openapi.UserImportRow {
+	response := openapi.UserImportRow{
+		Line:   int32(row.Line),
+		Status: openapi.UserImportRowStatus(row.Status),
+		Name:   row.Name,
+		Email:  row.Email,
+	}
+	if row.UserID != "" {
+		response.UserId = &row.UserID
+	}
+	if row.Reason != "" {
+		response.Reason = &row.Reason
+	}
+	return response
+}
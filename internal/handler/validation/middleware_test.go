@@ -2,6 +2,7 @@ package validation
 
 import (
 	"bytes"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -37,6 +38,21 @@ paths:
       responses:
         '200':
           description: OK
+  /users/imports:
+    post:
+      operationId: createUserImport
+      requestBody:
+        required: true
+        content:
+          text/csv:
+            schema:
+              type: string
+          application/x-ndjson:
+            schema:
+              type: string
+      responses:
+        '202':
+          description: Accepted
   /users/{userId}:
     get:
       operationId: getUser
@@ -259,3 +275,51 @@ func TestMiddleware_UnmatchedRoute(t *testing.T) {
 		t.Errorf("expected status %d, got %d", http.StatusNotFound, rec.Code)
 	}
 }
+
+func TestMiddleware_ImportBodyPassedThroughUnparsed(t *testing.T) {
+	middleware, err := NewMiddleware(testOpenAPISpec)
+	if err != nil {
+		t.Fatalf("failed to create middleware: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+	}{
+		{
+			name:        "csv with ragged rows",
+			contentType: "text/csv",
+			body:        "name,email\nJohn Doe\nJane Doe,jane@example.com,extra\n",
+		},
+		{
+			name:        "ndjson",
+			contentType: "application/x-ndjson",
+			body:        "{\"name\":\"John Doe\",\"email\":\"john@example.com\"}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var received string
+			handler := middleware.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				received = string(body)
+				w.WriteHeader(http.StatusAccepted)
+			}))
+
+			req := httptest.NewRequest(http.MethodPost, "/users/imports", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", tt.contentType)
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusAccepted {
+				t.Errorf("expected status %d, got %d: %s", http.StatusAccepted, rec.Code, rec.Body.String())
+			}
+			if received != tt.body {
+				t.Errorf("expected body %q to reach handler, got %q", tt.body, received)
+			}
+		})
+	}
+}
@@ -0,0 +1,247 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+// WebhookHandler Webhook関連のHTTPハンドラー（ServerInterface のうち Webhooks を実装）
+type WebhookHandler struct {
+	createWebhook         *usecase.CreateWebhookUsecase
+	findWebhook           *usecase.FindWebhookUsecase
+	listWebhooks          *usecase.ListWebhooksUsecase
+	updateWebhook         *usecase.UpdateWebhookUsecase
+	deleteWebhook         *usecase.DeleteWebhookUsecase
+	listWebhookDeliveries *usecase.ListWebhookDeliveriesUsecase
+}
+
+// NewWebhookHandler WebhookHandlerのコンストラクタ
+func NewWebhookHandler(
+	createWebhook *usecase.CreateWebhookUsecase,
+	findWebhook *usecase.FindWebhookUsecase,
+	listWebhooks *usecase.ListWebhooksUsecase,
+	updateWebhook *usecase.UpdateWebhookUsecase,
+	deleteWebhook *usecase.DeleteWebhookUsecase,
+	listWebhookDeliveries *usecase.ListWebhookDeliveriesUsecase,
+) *WebhookHandler {
+	return &WebhookHandler{
+		createWebhook:         createWebhook,
+		findWebhook:           findWebhook,
+		listWebhooks:          listWebhooks,
+		updateWebhook:         updateWebhook,
+		deleteWebhook:         deleteWebhook,
+		listWebhookDeliveries: listWebhookDeliveries,
+	}
+}
+
+// WebhooksCreateWebhook Webhookを作成し、署名シークレットを一度だけ返す（OpenAPI ServerInterface実装）
+func (h *WebhookHandler) WebhooksCreateWebhook(w http.ResponseWriter, r *http.Request) {
+	var req openapi.CreateWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストの形式が不正です")
+		return
+	}
+
+	ctx := r.Context()
+	subscription, err := h.createWebhook.Execute(ctx, req.Url, fromWebhookEventTypes(req.EventTypes))
+	if err != nil {
+		HandleError(w, err, logger.FromContext(ctx))
+		return
+	}
+
+	resp := toWebhookResponse(subscription)
+	w.Header().Set("Location", webhookLocation(subscription.ID))
+	// シークレットを含むため、キャッシュや Idempotency-Key の記録に残さない
+	w.Header().Set("Cache-Control", "no-store")
+	respondJSON(w, http.StatusCreated, openapi.CreatedWebhook{
+		Id:                  resp.Id,
+		Url:                 resp.Url,
+		EventTypes:          resp.EventTypes,
+		Active:              resp.Active,
+		ConsecutiveFailures: resp.ConsecutiveFailures,
+		DisabledAt:          resp.DisabledAt,
+		DisabledReason:      resp.DisabledReason,
+		CreatedBy:           resp.CreatedBy,
+		CreatedAt:           resp.CreatedAt,
+		UpdatedAt:           resp.UpdatedAt,
+		Secret:              subscription.Secret,
+	})
+}
+
+// WebhooksGetWebhook Webhookを取得（OpenAPI ServerInterface実装）
+func (h *WebhookHandler) WebhooksGetWebhook(w http.ResponseWriter, r *http.Request, webhookId string) {
+	ctx := r.Context()
+	subscription, err := h.findWebhook.Execute(ctx, webhookId)
+	if err != nil {
+		HandleError(w, err, logger.FromContext(ctx))
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toWebhookResponse(subscription))
+}
+
+// WebhooksListWebhooks Webhook一覧を取得（OpenAPI ServerInterface実装）
+func (h *WebhookHandler) WebhooksListWebhooks(w http.ResponseWriter, r *http.Request, params openapi.WebhooksListWebhooksParams) {
+	ctx := r.Context()
+
+	// デフォルト値の設定
+	limit := 10
+	offset := 0
+
+	if params.Limit != nil {
+		if *params.Limit > 0 && *params.Limit <= 100 {
+			limit = int(*params.Limit)
+		}
+	}
+	if params.Offset != nil && *params.Offset >= 0 {
+		offset = int(*params.Offset)
+	}
+
+	subscriptions, total, err := h.listWebhooks.Execute(ctx, limit, offset)
+	if err != nil {
+		HandleError(w, err, logger.FromContext(ctx))
+		return
+	}
+
+	webhookResponses := make([]openapi.Webhook, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		webhookResponses = append(webhookResponses, toWebhookResponse(subscription))
+	}
+
+	respondJSON(w, http.StatusOK, openapi.WebhookList{
+		Webhooks: webhookResponses,
+		Total:    int32(total),
+	})
+}
+
+// WebhooksUpdateWebhook Webhookを更新（OpenAPI ServerInterface実装）
+func (h *WebhookHandler) WebhooksUpdateWebhook(w http.ResponseWriter, r *http.Request, webhookId string) {
+	var req openapi.UpdateWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "リクエストの形式が不正です")
+		return
+	}
+
+	var eventTypes *[]string
+	if req.EventTypes != nil {
+		converted := fromWebhookEventTypes(*req.EventTypes)
+		eventTypes = &converted
+	}
+
+	ctx := r.Context()
+	subscription, err := h.updateWebhook.Execute(ctx, webhookId, req.Url, eventTypes, req.Active)
+	if err != nil {
+		HandleError(w, err, logger.FromContext(ctx))
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toWebhookResponse(subscription))
+}
+
+// WebhooksDeleteWebhook Webhookを削除（OpenAPI ServerInterface実装）
+func (h *WebhookHandler) WebhooksDeleteWebhook(w http.ResponseWriter, r *http.Request, webhookId string) {
+	ctx := r.Context()
+	if err := h.deleteWebhook.Execute(ctx, webhookId); err != nil {
+		HandleError(w, err, logger.FromContext(ctx))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// WebhooksListWebhookDeliveries Webhookの配信の記録を取得（OpenAPI ServerInterface実装）
+func (h *WebhookHandler) WebhooksListWebhookDeliveries(w http.ResponseWriter, r *http.Request, webhookId string, params openapi.WebhooksListWebhookDeliveriesParams) {
+	ctx := r.Context()
+
+	// デフォルト値の設定
+	limit := 10
+	offset := 0
+
+	if params.Limit != nil {
+		if *params.Limit > 0 && *params.Limit <= 100 {
+			limit = int(*params.Limit)
+		}
+	}
+	if params.Offset != nil && *params.Offset >= 0 {
+		offset = int(*params.Offset)
+	}
+
+	deliveries, total, err := h.listWebhookDeliveries.Execute(ctx, webhookId, limit, offset)
+	if err != nil {
+		HandleError(w, err, logger.FromContext(ctx))
+		return
+	}
+
+	deliveryResponses := make([]openapi.WebhookDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		deliveryResponses = append(deliveryResponses, toWebhookDeliveryResponse(delivery))
+	}
+
+	respondJSON(w, http.StatusOK, openapi.WebhookDeliveryList{
+		Deliveries: deliveryResponses,
+		Total:      int32(total),
+	})
+}
+
+// webhookLocation 作成したWebhookを指すLocationヘッダーの値を返す
+func webhookLocation(id string) string {
+	return "/api/v1/webhooks/" + id
+}
+
+// fromWebhookEventTypes APIリクエストのイベントの種類を文字列に変換
+func fromWebhookEventTypes(eventTypes []openapi.WebhookEventType) []string {
+	result := make([]string, len(eventTypes))
+	for i, eventType := range eventTypes {
+		result[i] = string(eventType)
+	}
+	return result
+}
+
+// toWebhookResponse domain.WebhookSubscriptionをAPIレスポンスのWebhookに変換（シークレットは含めない）
+func toWebhookResponse(subscription *domain.WebhookSubscription) openapi.Webhook {
+	eventTypes := make([]openapi.WebhookEventType, len(subscription.EventTypes))
+	for i, eventType := range subscription.EventTypes {
+		eventTypes[i] = openapi.WebhookEventType(eventType)
+	}
+	resp := openapi.Webhook{
+		Id:                  subscription.ID,
+		Url:                 subscription.URL,
+		EventTypes:          eventTypes,
+		Active:              subscription.Active(),
+		ConsecutiveFailures: int32(subscription.ConsecutiveFailures),
+		DisabledAt:          subscription.DisabledAt,
+		CreatedBy:           subscription.CreatedBy,
+		CreatedAt:           subscription.CreatedAt,
+		UpdatedAt:           subscription.UpdatedAt,
+	}
+	if subscription.DisabledReason != "" {
+		resp.DisabledReason = &subscription.DisabledReason
+	}
+	return resp
+}
+
+// toWebhookDeliveryResponse domain.WebhookDeliveryをAPIレスポンスのWebhookDeliveryに変換
+func toWebhookDeliveryResponse(delivery *domain.WebhookDelivery) openapi.WebhookDelivery {
+	resp := openapi.WebhookDelivery{
+		Id:          delivery.ID,
+		EventId:     delivery.EventID,
+		EventType:   delivery.EventType,
+		Attempt:     int32(delivery.Attempt),
+		Succeeded:   delivery.Succeeded(),
+		DurationMs:  int32(delivery.Duration.Milliseconds()),
+		DeliveredAt: delivery.DeliveredAt,
+	}
+	if delivery.StatusCode != 0 {
+		statusCode := int32(delivery.StatusCode)
+		resp.StatusCode = &statusCode
+	}
+	if delivery.Error != "" {
+		resp.Error = &delivery.Error
+	}
+	return resp
+}
@@ -0,0 +1,227 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+	"github.com/example/go-react-cqrs-template/pkg/generated/openapi"
+)
+
+// mockWebhookQuery はテスト用のWebhookQueryRepositoryモック
+type mockWebhookQuery struct {
+	subscriptions []*domain.WebhookSubscription
+	deliveries    []*domain.WebhookDelivery
+}
+
+func (m *mockWebhookQuery) FindByID(_ context.Context, id string) (*domain.WebhookSubscription, error) {
+	for _, s := range m.subscriptions {
+		if s.ID == id {
+			return s, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockWebhookQuery) FindAll(_ context.Context, limit, offset int) ([]*domain.WebhookSubscription, error) {
+	if offset >= len(m.subscriptions) {
+		return nil, nil
+	}
+	return m.subscriptions[offset:min(offset+limit, len(m.subscriptions))], nil
+}
+
+func (m *mockWebhookQuery) Count(_ context.Context) (int, error) {
+	return len(m.subscriptions), nil
+}
+
+func (m *mockWebhookQuery) filterDeliveries(subscriptionID string) []*domain.WebhookDelivery {
+	var result []*domain.WebhookDelivery
+	for _, d := range m.deliveries {
+		if d.SubscriptionID == subscriptionID {
+			result = append(result, d)
+		}
+	}
+	return result
+}
+
+func (m *mockWebhookQuery) FindDeliveries(_ context.Context, subscriptionID string, limit, offset int) ([]*domain.WebhookDelivery, error) {
+	deliveries := m.filterDeliveries(subscriptionID)
+	if offset >= len(deliveries) {
+		return nil, nil
+	}
+	return deliveries[offset:min(offset+limit, len(deliveries))], nil
+}
+
+func (m *mockWebhookQuery) CountDeliveries(_ context.Context, subscriptionID string) (int, error) {
+	return len(m.filterDeliveries(subscriptionID)), nil
+}
+
+func newWebhooksTestHandler() *WebhookHandler {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	query := &mockWebhookQuery{
+		subscriptions: []*domain.WebhookSubscription{
+			{
+				ID: "01ARZ3NDEKTSV4RRFFQ69G5FD1", URL: "https://partner.example.com/hooks", Secret: "whsec_secret",
+				EventTypes: []string{domain.EventTypeUserCreated, domain.EventTypeUserDeleted},
+				CreatedBy:  "user:01ARZ3NDEKTSV4RRFFQ69G5FAV", CreatedAt: now, UpdatedAt: now,
+			},
+			{
+				ID: "01ARZ3NDEKTSV4RRFFQ69G5FD0", URL: "https://down.example.com/hooks", Secret: "whsec_secret",
+				EventTypes: []string{domain.EventTypeUserCreated}, ConsecutiveFailures: domain.WebhookMaxConsecutiveFailures,
+				DisabledAt: &now, DisabledReason: "webhook receiver responded with status 503",
+				CreatedBy: "user:01ARZ3NDEKTSV4RRFFQ69G5FAV", CreatedAt: now.Add(-time.Hour), UpdatedAt: now,
+			},
+		},
+		deliveries: []*domain.WebhookDelivery{
+			{
+				ID: "01ARZ3NDEKTSV4RRFFQ69G5FE1", SubscriptionID: "01ARZ3NDEKTSV4RRFFQ69G5FD1", EventID: "01ARZ3NDEKTSV4RRFFQ69G5FF1",
+				EventType: domain.EventTypeUserCreated, Attempt: 2, StatusCode: http.StatusOK, Duration: 120 * time.Millisecond, DeliveredAt: now,
+			},
+			{
+				ID: "01ARZ3NDEKTSV4RRFFQ69G5FE0", SubscriptionID: "01ARZ3NDEKTSV4RRFFQ69G5FD1", EventID: "01ARZ3NDEKTSV4RRFFQ69G5FF1",
+				EventType: domain.EventTypeUserCreated, Attempt: 1, Error: "failed to send webhook: connection refused", DeliveredAt: now.Add(-time.Minute),
+			},
+		},
+	}
+	return &WebhookHandler{
+		createWebhook:         usecase.NewCreateWebhookUsecase(nil),
+		findWebhook:           usecase.NewFindWebhookUsecase(query),
+		listWebhooks:          usecase.NewListWebhooksUsecase(query),
+		listWebhookDeliveries: usecase.NewListWebhookDeliveriesUsecase(query),
+	}
+}
+
+func TestWebhooksListWebhooks(t *testing.T) {
+	h := newWebhooksTestHandler()
+
+	req := newAdminRequest(http.MethodGet, "/webhooks", nil)
+	rec := httptest.NewRecorder()
+
+	h.WebhooksListWebhooks(rec, req, openapi.WebhooksListWebhooksParams{})
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+	}
+	if strings.Contains(rec.Body.String(), "whsec_") {
+		t.Errorf("expected response not to contain secrets, got %s", rec.Body.String())
+	}
+
+	var resp openapi.WebhookList
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.Total != 2 {
+		t.Fatalf("expected total 2, got %d", resp.Total)
+	}
+	if !resp.Webhooks[0].Active || len(resp.Webhooks[0].EventTypes) != 2 {
+		t.Errorf("unexpected first webhook: %+v", resp.Webhooks[0])
+	}
+	disabled := resp.Webhooks[1]
+	if disabled.Active || disabled.DisabledReason == nil || disabled.ConsecutiveFailures != int32(domain.WebhookMaxConsecutiveFailures) {
+		t.Errorf("expected the second webhook to be disabled with a reason, got %+v", disabled)
+	}
+}
+
+func TestWebhooksGetWebhook(t *testing.T) {
+	tests := []struct {
+		name       string
+		principal  domain.Principal
+		webhookID  string
+		wantStatus int
+	}{
+		{name: "existing webhook", principal: domain.NewUserPrincipal(testAdminUserID).WithRoles(domain.RoleAdmin), webhookID: "01ARZ3NDEKTSV4RRFFQ69G5FD1", wantStatus: http.StatusOK},
+		{name: "unknown webhook", principal: domain.NewUserPrincipal(testAdminUserID).WithRoles(domain.RoleAdmin), webhookID: "01ARZ3NDEKTSV4RRFFQ69G5FD9", wantStatus: http.StatusNotFound},
+		{name: "requires webhooks:manage", principal: domain.NewUserPrincipal(testAdminUserID).WithRoles(domain.RoleUserManager), webhookID: "01ARZ3NDEKTSV4RRFFQ69G5FD1", wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newWebhooksTestHandler()
+
+			req := newRequestAs(tt.principal, http.MethodGet, "/webhooks/"+tt.webhookID, nil)
+			rec := httptest.NewRecorder()
+
+			h.WebhooksGetWebhook(rec, req, tt.webhookID)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, rec.Code)
+			}
+		})
+	}
+}
+
+func TestWebhooksCreateWebhook_Validation(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "malformed body", body: `{`},
+		{name: "relative url", body: `{"url":"/hooks","eventTypes":["user.created"]}`},
+		{name: "unsupported scheme", body: `{"url":"ftp://example.com/hooks","eventTypes":["user.created"]}`},
+		{name: "no event types", body: `{"url":"https://example.com/hooks","eventTypes":[]}`},
+		{name: "unknown event type", body: `{"url":"https://example.com/hooks","eventTypes":["order.created"]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newWebhooksTestHandler()
+
+			req := newAdminRequest(http.MethodPost, "/webhooks", strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+
+			h.WebhooksCreateWebhook(rec, req)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Errorf("expected status %d, got %d", http.StatusBadRequest, rec.Code)
+			}
+		})
+	}
+}
+
+func TestWebhooksListWebhookDeliveries(t *testing.T) {
+	t.Run("delivery log", func(t *testing.T) {
+		h := newWebhooksTestHandler()
+
+		req := newAdminRequest(http.MethodGet, "/webhooks/01ARZ3NDEKTSV4RRFFQ69G5FD1/deliveries", nil)
+		rec := httptest.NewRecorder()
+
+		h.WebhooksListWebhookDeliveries(rec, req, "01ARZ3NDEKTSV4RRFFQ69G5FD1", openapi.WebhooksListWebhookDeliveriesParams{})
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+		}
+		var resp openapi.WebhookDeliveryList
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if resp.Total != 2 {
+			t.Fatalf("expected total 2, got %d", resp.Total)
+		}
+		succeeded, failed := resp.Deliveries[0], resp.Deliveries[1]
+		if !succeeded.Succeeded || succeeded.StatusCode == nil || *succeeded.StatusCode != http.StatusOK || succeeded.DurationMs != 120 {
+			t.Errorf("unexpected successful delivery: %+v", succeeded)
+		}
+		if failed.Succeeded || failed.StatusCode != nil || failed.Error == nil {
+			t.Errorf("expected a failed delivery without a status, got %+v", failed)
+		}
+	})
+
+	t.Run("unknown webhook", func(t *testing.T) {
+		h := newWebhooksTestHandler()
+
+		req := newAdminRequest(http.MethodGet, "/webhooks/01ARZ3NDEKTSV4RRFFQ69G5FD9/deliveries", nil)
+		rec := httptest.NewRecorder()
+
+		h.WebhooksListWebhookDeliveries(rec, req, "01ARZ3NDEKTSV4RRFFQ69G5FD9", openapi.WebhooksListWebhookDeliveriesParams{})
+
+		if rec.Code != http.StatusNotFound {
+			t.Errorf("expected status %d, got %d", http.StatusNotFound, rec.Code)
+		}
+	})
+}
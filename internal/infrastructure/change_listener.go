@@ -0,0 +1,241 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+	"github.com/lib/pq"
+)
+
+// ChangeFeedChannel 変更通知を保存したコマンドが NOTIFY するチャンネル（ペイロードは組織ID）
+const ChangeFeedChannel = "change_feed"
+
+const (
+	// changeListenerMinReconnect・changeListenerMaxReconnect LISTEN の接続が切れた場合に再接続する間隔
+	changeListenerMinReconnect = time.Second
+	changeListenerMaxReconnect = time.Minute
+	// changeListenerPingInterval 通知がない間も接続が生きていることを確認する間隔
+	changeListenerPingInterval = 90 * time.Second
+	// changeFeedCleanupInterval 保持期間を過ぎた変更通知を削除する間隔
+	changeFeedCleanupInterval = 5 * time.Minute
+)
+
+// changeNotificationListener LISTEN の接続（*pq.Listener、テストでは差し替える）
+// 再接続した場合、NotificationChannel は nil を送る
+type changeNotificationListener interface {
+	Listen(channel string) error
+	NotificationChannel() <-chan *pq.Notification
+	Ping() error
+	Close() error
+}
+
+// ChangeListener PostgreSQL の LISTEN で変更通知を受け取り、組織ごとの購読者を起こす
+// どのサーバーのコマンドが保存した変更でも通知されるため、複数のサーバーで同じ変更を配信できる
+// 通知は「変更があった」ことだけを伝え、購読者は変更通知のテーブルから続きを読む
+// 保持期間（retention）を過ぎた変更通知は定期的に削除する
+type ChangeListener struct {
+	listener  changeNotificationListener
+	queries   *dao.Queries
+	retention time.Duration
+	// cleanupInterval 保持期間を過ぎた変更通知を削除する間隔
+	cleanupInterval time.Duration
+	logger          *slog.Logger
+	mu              sync.Mutex
+	subscribers     map[string]map[chan struct{}]struct{}
+	// allSubscribers すべての組織の変更の購読者
+	allSubscribers map[chan struct{}]struct{}
+	closed         bool
+	stopCh         chan struct{}
+	stopOnce       sync.Once
+}
+
+// NewChangeListener ChangeListenerのコンストラクタ（Start を呼び出してから利用する）
+func NewChangeListener(cfg Config, db *sql.DB, retention time.Duration, logger *slog.Logger) *ChangeListener {
+	l := &ChangeListener{
+		queries:         dao.New(db),
+		retention:       retention,
+		cleanupInterval: changeFeedCleanupInterval,
+		logger:          logger,
+		subscribers:     make(map[string]map[chan struct{}]struct{}),
+		allSubscribers:  make(map[chan struct{}]struct{}),
+		stopCh:          make(chan struct{}),
+	}
+	l.listener = pq.NewListener(cfg.DSN(), changeListenerMinReconnect, changeListenerMaxReconnect, l.logConnectionEvent)
+	return l
+}
+
+// Start チャンネルを LISTEN し、通知を購読者へ配る
+func (l *ChangeListener) Start(ctx context.Context) error {
+	if err := l.listener.Listen(ChangeFeedChannel); err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", ChangeFeedChannel, err)
+	}
+
+	go func() {
+		ticker := time.NewTicker(changeListenerPingInterval)
+		defer ticker.Stop()
+		cleanup := time.NewTicker(l.cleanupInterval)
+		defer cleanup.Stop()
+		notify := l.listener.NotificationChannel()
+		for {
+			select {
+			case notification := <-notify:
+				// 再接続した場合は nil が届く（切断中の通知は失われるため、すべての購読者に読み直させる）
+				if notification == nil {
+					l.wakeAll()
+					continue
+				}
+				l.wake(notification.Extra)
+			case <-ticker.C:
+				go func() {
+					if err := l.listener.Ping(); err != nil {
+						l.logger.Warn("change listener ping failed", slog.String("error", err.Error()))
+					}
+				}()
+			case <-cleanup.C:
+				l.deleteExpired(ctx)
+			case <-l.stopCh:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// Close 通知の受け取りを停止し、LISTEN の接続を閉じる（何度呼び出してもよい）
+// 購読者のチャンネルを閉じるため、配信中のストリームは終了する（サーバーの停止を待たせない）
+func (l *ChangeListener) Close() error {
+	var err error
+	l.stopOnce.Do(func() {
+		close(l.stopCh)
+		err = l.listener.Close()
+
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		l.closed = true
+		for _, subscribers := range l.subscribers {
+			for ch := range subscribers {
+				close(ch)
+			}
+		}
+		l.subscribers = make(map[string]map[chan struct{}]struct{})
+		for ch := range l.allSubscribers {
+			close(ch)
+		}
+		l.allSubscribers = make(map[chan struct{}]struct{})
+	})
+	return err
+}
+
+// Subscribe 組織の変更を購読する
+// 返すチャンネルは変更があると値を受け取り（読む前に届いた複数の通知は1つにまとめる）、Close で閉じられる
+// 不要になったら返した関数で購読を解除する
+func (l *ChangeListener) Subscribe(organizationID string) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+
+	l.mu.Lock()
+	if l.closed {
+		l.mu.Unlock()
+		close(ch)
+		return ch, func() {}
+	}
+	if l.subscribers[organizationID] == nil {
+		l.subscribers[organizationID] = make(map[chan struct{}]struct{})
+	}
+	l.subscribers[organizationID][ch] = struct{}{}
+	l.mu.Unlock()
+
+	return ch, func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		delete(l.subscribers[organizationID], ch)
+		if len(l.subscribers[organizationID]) == 0 {
+			delete(l.subscribers, organizationID)
+		}
+	}
+}
+
+// SubscribeAll すべての組織の変更を購読する（ほかのプロセスでの変更をキャッシュに反映するために使う）
+// 返すチャンネルと関数は Subscribe と同じ
+func (l *ChangeListener) SubscribeAll() (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+
+	l.mu.Lock()
+	if l.closed {
+		l.mu.Unlock()
+		close(ch)
+		return ch, func() {}
+	}
+	l.allSubscribers[ch] = struct{}{}
+	l.mu.Unlock()
+
+	return ch, func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		delete(l.allSubscribers, ch)
+	}
+}
+
+// wake 組織の購読者と、すべての組織の変更の購読者を起こす
+func (l *ChangeListener) wake(organizationID string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for ch := range l.subscribers[organizationID] {
+		wakeSubscriber(ch)
+	}
+	for ch := range l.allSubscribers {
+		wakeSubscriber(ch)
+	}
+}
+
+// wakeAll すべての購読者を起こす
+func (l *ChangeListener) wakeAll() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, subscribers := range l.subscribers {
+		for ch := range subscribers {
+			wakeSubscriber(ch)
+		}
+	}
+	for ch := range l.allSubscribers {
+		wakeSubscriber(ch)
+	}
+}
+
+// deleteExpired 保持期間を過ぎた変更通知を削除する（複数のサーバーで実行しても問題ない）
+func (l *ChangeListener) deleteExpired(ctx context.Context) {
+	deleted, err := l.queries.DeleteChangesBefore(ctx, time.Now().Add(-l.retention))
+	if err != nil {
+		l.logger.Warn("failed to delete expired changes", slog.String("error", err.Error()))
+		return
+	}
+	if deleted > 0 {
+		l.logger.Debug("deleted expired changes", slog.Int64("count", deleted))
+	}
+}
+
+// logConnectionEvent LISTEN の接続の状態の変化をログに記録する
+func (l *ChangeListener) logConnectionEvent(event pq.ListenerEventType, err error) {
+	switch event {
+	case pq.ListenerEventDisconnected:
+		l.logger.Warn("change listener disconnected", slog.String("error", fmt.Sprint(err)))
+	case pq.ListenerEventReconnected:
+		l.logger.Info("change listener reconnected")
+	case pq.ListenerEventConnectionAttemptFailed:
+		l.logger.Warn("change listener failed to connect", slog.String("error", fmt.Sprint(err)))
+	}
+}
+
+// wakeSubscriber チャンネルに通知がまだなければ送る（受け取り側を待たない）
+func wakeSubscriber(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,222 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+	"github.com/lib/pq"
+)
+
+// fakeNotificationListener pq.Listener の代わり（テストから通知を送る）
+type fakeNotificationListener struct {
+	notify   chan *pq.Notification
+	listened []string
+}
+
+func (f *fakeNotificationListener) Listen(channel string) error {
+	f.listened = append(f.listened, channel)
+	return nil
+}
+func (f *fakeNotificationListener) NotificationChannel() <-chan *pq.Notification { return f.notify }
+func (f *fakeNotificationListener) Ping() error                                  { return nil }
+func (f *fakeNotificationListener) Close() error                                 { return nil }
+
+// fakeChangeFeedDB DeleteChangesBefore だけを実装したメモリ上のデータベース
+type fakeChangeFeedDB struct {
+	mu sync.Mutex
+	// deletedBefore DeleteChangesBefore に渡された日時
+	deletedBefore []time.Time
+	// deleteErr DeleteChangesBefore で返すエラー
+	deleteErr error
+}
+
+func (f *fakeChangeFeedDB) Connect(context.Context) (driver.Conn, error) {
+	return fakeChangeFeedConn{f}, nil
+}
+func (f *fakeChangeFeedDB) Driver() driver.Driver { return nil }
+
+func (f *fakeChangeFeedDB) cutoffs() []time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]time.Time(nil), f.deletedBefore...)
+}
+
+type fakeChangeFeedConn struct{ db *fakeChangeFeedDB }
+
+func (c fakeChangeFeedConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepare is not supported")
+}
+func (c fakeChangeFeedConn) Close() error { return nil }
+func (c fakeChangeFeedConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("begin is not supported")
+}
+
+func (c fakeChangeFeedConn) ExecContext(_ context.Context, query string, named []driver.NamedValue) (driver.Result, error) {
+	f := c.db
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if name := strings.Fields(query)[2]; name != "DeleteChangesBefore" {
+		return nil, errors.New("unexpected exec: " + name)
+	}
+	if f.deleteErr != nil {
+		return nil, f.deleteErr
+	}
+	f.deletedBefore = append(f.deletedBefore, named[0].Value.(time.Time))
+	return driver.RowsAffected(3), nil
+}
+
+func newTestChangeListener(t *testing.T, retention time.Duration) (*ChangeListener, *fakeNotificationListener, *fakeChangeFeedDB) {
+	t.Helper()
+	listener := &fakeNotificationListener{notify: make(chan *pq.Notification)}
+	fake := &fakeChangeFeedDB{}
+	db := sql.OpenDB(fake)
+	t.Cleanup(func() { _ = db.Close() })
+	l := &ChangeListener{
+		listener:        listener,
+		queries:         dao.New(db),
+		retention:       retention,
+		cleanupInterval: changeFeedCleanupInterval,
+		logger:          slog.New(slog.NewTextHandler(io.Discard, nil)),
+		subscribers:     make(map[string]map[chan struct{}]struct{}),
+		allSubscribers:  make(map[chan struct{}]struct{}),
+		stopCh:          make(chan struct{}),
+	}
+	t.Cleanup(func() { _ = l.Close() })
+	return l, listener, fake
+}
+
+// receiveWake 購読者が起こされるのを待つ
+func receiveWake(t *testing.T, ch <-chan struct{}, name string) {
+	t.Helper()
+	select {
+	case <-ch:
+	case <-time.After(time.Second):
+		t.Fatalf("expected %s to be woken", name)
+	}
+}
+
+// assertNotWoken 購読者が起こされていないことを確認する
+func assertNotWoken(t *testing.T, ch <-chan struct{}, name string) {
+	t.Helper()
+	select {
+	case <-ch:
+		t.Errorf("expected %s not to be woken", name)
+	default:
+	}
+}
+
+func TestChangeListener_WakesSubscribersOfOrganization(t *testing.T) {
+	l, listener, _ := newTestChangeListener(t, time.Hour)
+	if err := l.Start(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(listener.listened) != 1 || listener.listened[0] != ChangeFeedChannel {
+		t.Errorf("expected to listen on %s, got %v", ChangeFeedChannel, listener.listened)
+	}
+
+	org1, unsubscribe1 := l.Subscribe("org-1")
+	defer unsubscribe1()
+	org2, unsubscribe2 := l.Subscribe("org-2")
+	defer unsubscribe2()
+	all, unsubscribeAll := l.SubscribeAll()
+	defer unsubscribeAll()
+
+	listener.notify <- &pq.Notification{Channel: ChangeFeedChannel, Extra: "org-1"}
+	receiveWake(t, org1, "org-1 subscriber")
+	receiveWake(t, all, "all organizations subscriber")
+	// 通知を処理し終えた後で確認する（2回目の通知は前の通知の処理が終わってから受け取られる）
+	listener.notify <- &pq.Notification{Channel: ChangeFeedChannel, Extra: "org-3"}
+	receiveWake(t, all, "all organizations subscriber")
+	assertNotWoken(t, org2, "org-2 subscriber")
+}
+
+func TestChangeListener_WakesAllSubscribersOnReconnect(t *testing.T) {
+	l, listener, _ := newTestChangeListener(t, time.Hour)
+	if err := l.Start(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	org1, unsubscribe1 := l.Subscribe("org-1")
+	defer unsubscribe1()
+	org2, unsubscribe2 := l.Subscribe("org-2")
+	defer unsubscribe2()
+	all, unsubscribeAll := l.SubscribeAll()
+	defer unsubscribeAll()
+
+	// 再接続すると nil が届く（切断中の通知は失われるため、どの組織の購読者も読み直す）
+	listener.notify <- nil
+	receiveWake(t, org1, "org-1 subscriber")
+	receiveWake(t, org2, "org-2 subscriber")
+	receiveWake(t, all, "all organizations subscriber")
+}
+
+func TestChangeListener_DeletesExpiredChanges(t *testing.T) {
+	const retention = 24 * time.Hour
+	l, _, db := newTestChangeListener(t, retention)
+	l.cleanupInterval = 10 * time.Millisecond
+
+	before := time.Now()
+	if err := l.Start(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	deadline := time.Now().Add(time.Second)
+	for len(db.cutoffs()) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("expected expired changes to be deleted periodically")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	after := time.Now()
+
+	// 保持期間より前に保存された変更通知だけを削除する
+	cutoff := db.cutoffs()[0]
+	if cutoff.Before(before.Add(-retention)) || cutoff.After(after.Add(-retention)) {
+		t.Errorf("expected cutoff between %v and %v, got %v", before.Add(-retention), after.Add(-retention), cutoff)
+	}
+}
+
+func TestChangeListener_DeleteExpiredIgnoresErrors(t *testing.T) {
+	l, listener, db := newTestChangeListener(t, time.Hour)
+	db.deleteErr = errors.New("connection refused")
+
+	// 削除に失敗しても次の間隔で削除し直すため、ログに記録するだけで通知の配信は止めない
+	l.deleteExpired(context.Background())
+	if err := l.Start(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	org1, unsubscribe := l.Subscribe("org-1")
+	defer unsubscribe()
+	listener.notify <- &pq.Notification{Channel: ChangeFeedChannel, Extra: "org-1"}
+	receiveWake(t, org1, "org-1 subscriber")
+}
+
+func TestChangeListener_CloseClosesSubscriptions(t *testing.T) {
+	l, _, _ := newTestChangeListener(t, time.Hour)
+	if err := l.Start(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	org1, _ := l.Subscribe("org-1")
+	all, _ := l.SubscribeAll()
+
+	if err := l.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for name, ch := range map[string]<-chan struct{}{"org-1 subscriber": org1, "all organizations subscriber": all} {
+		if _, ok := <-ch; ok {
+			t.Errorf("expected %s to be closed", name)
+		}
+	}
+	// 停止後の購読はすぐに閉じられる
+	org2, _ := l.Subscribe("org-2")
+	if _, ok := <-org2; ok {
+		t.Error("expected subscription after Close to be closed")
+	}
+}
@@ -0,0 +1,155 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: aggregate_events.sql
+
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+const appendAggregateEvent = `-- name: AppendAggregateEvent :exec
+INSERT INTO aggregate_events (aggregate_type, aggregate_id, sequence, organization_id, event_type, payload, occurred_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+`
+
+type AppendAggregateEventParams struct {
+	AggregateType  string          `db:"aggregate_type" json:"aggregate_type"`
+	AggregateID    string          `db:"aggregate_id" json:"aggregate_id"`
+	Sequence       int32           `db:"sequence" json:"sequence"`
+	OrganizationID string          `db:"organization_id" json:"organization_id"`
+	EventType      string          `db:"event_type" json:"event_type"`
+	Payload        json.RawMessage `db:"payload" json:"payload"`
+	OccurredAt     time.Time       `db:"occurred_at" json:"occurred_at"`
+	CreatedAt      time.Time       `db:"created_at" json:"created_at"`
+}
+
+func (q *Queries) AppendAggregateEvent(ctx context.Context, arg AppendAggregateEventParams) error {
+	_, err := q.db.ExecContext(ctx, appendAggregateEvent,
+		arg.AggregateType,
+		arg.AggregateID,
+		arg.Sequence,
+		arg.OrganizationID,
+		arg.EventType,
+		arg.Payload,
+		arg.OccurredAt,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const getAggregateSnapshot = `-- name: GetAggregateSnapshot :one
+SELECT aggregate_type, aggregate_id, organization_id, sequence, state, created_at
+FROM aggregate_snapshots
+WHERE organization_id = $1
+  AND aggregate_type = $2
+  AND aggregate_id = $3
+`
+
+type GetAggregateSnapshotParams struct {
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	AggregateType  string `db:"aggregate_type" json:"aggregate_type"`
+	AggregateID    string `db:"aggregate_id" json:"aggregate_id"`
+}
+
+func (q *Queries) GetAggregateSnapshot(ctx context.Context, arg GetAggregateSnapshotParams) (AggregateSnapshot, error) {
+	row := q.db.QueryRowContext(ctx, getAggregateSnapshot, arg.OrganizationID, arg.AggregateType, arg.AggregateID)
+	var i AggregateSnapshot
+	err := row.Scan(
+		&i.AggregateType,
+		&i.AggregateID,
+		&i.OrganizationID,
+		&i.Sequence,
+		&i.State,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listAggregateEvents = `-- name: ListAggregateEvents :many
+SELECT aggregate_type, aggregate_id, sequence, organization_id, event_type, payload, occurred_at, created_at
+FROM aggregate_events
+WHERE organization_id = $1
+  AND aggregate_type = $2
+  AND aggregate_id = $3
+  AND sequence > $4
+ORDER BY sequence ASC
+`
+
+type ListAggregateEventsParams struct {
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	AggregateType  string `db:"aggregate_type" json:"aggregate_type"`
+	AggregateID    string `db:"aggregate_id" json:"aggregate_id"`
+	AfterSequence  int32  `db:"after_sequence" json:"after_sequence"`
+}
+
+// 指定した連番より後のイベントを連番順に取得する
+func (q *Queries) ListAggregateEvents(ctx context.Context, arg ListAggregateEventsParams) ([]AggregateEvent, error) {
+	rows, err := q.db.QueryContext(ctx, listAggregateEvents,
+		arg.OrganizationID,
+		arg.AggregateType,
+		arg.AggregateID,
+		arg.AfterSequence,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AggregateEvent{}
+	for rows.Next() {
+		var i AggregateEvent
+		if err := rows.Scan(
+			&i.AggregateType,
+			&i.AggregateID,
+			&i.Sequence,
+			&i.OrganizationID,
+			&i.EventType,
+			&i.Payload,
+			&i.OccurredAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertAggregateSnapshot = `-- name: UpsertAggregateSnapshot :exec
+INSERT INTO aggregate_snapshots (aggregate_type, aggregate_id, organization_id, sequence, state, created_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (aggregate_type, aggregate_id) DO UPDATE
+SET sequence = EXCLUDED.sequence, state = EXCLUDED.state, created_at = EXCLUDED.created_at
+WHERE aggregate_snapshots.sequence < EXCLUDED.sequence
+`
+
+type UpsertAggregateSnapshotParams struct {
+	AggregateType  string          `db:"aggregate_type" json:"aggregate_type"`
+	AggregateID    string          `db:"aggregate_id" json:"aggregate_id"`
+	OrganizationID string          `db:"organization_id" json:"organization_id"`
+	Sequence       int32           `db:"sequence" json:"sequence"`
+	State          json.RawMessage `db:"state" json:"state"`
+	CreatedAt      time.Time       `db:"created_at" json:"created_at"`
+}
+
+// 古いスナップショットで新しいものを上書きしない
+func (q *Queries) UpsertAggregateSnapshot(ctx context.Context, arg UpsertAggregateSnapshotParams) error {
+	_, err := q.db.ExecContext(ctx, upsertAggregateSnapshot,
+		arg.AggregateType,
+		arg.AggregateID,
+		arg.OrganizationID,
+		arg.Sequence,
+		arg.State,
+		arg.CreatedAt,
+	)
+	return err
+}
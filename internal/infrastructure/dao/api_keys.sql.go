@@ -0,0 +1,239 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: api_keys.sql
+
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const countAPIKeys = `-- name: CountAPIKeys :one
+SELECT COUNT(*) FROM api_keys
+WHERE organization_id = $1
+  AND ($2::boolean OR revoked_at IS NULL)
+`
+
+type CountAPIKeysParams struct {
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	IncludeRevoked bool   `db:"include_revoked" json:"include_revoked"`
+}
+
+func (q *Queries) CountAPIKeys(ctx context.Context, arg CountAPIKeysParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countAPIKeys, arg.OrganizationID, arg.IncludeRevoked)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createAPIKey = `-- name: CreateAPIKey :exec
+INSERT INTO api_keys (id, organization_id, name, prefix, secret_hash, scopes, expires_at, created_by, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+`
+
+type CreateAPIKeyParams struct {
+	ID             string       `db:"id" json:"id"`
+	OrganizationID string       `db:"organization_id" json:"organization_id"`
+	Name           string       `db:"name" json:"name"`
+	Prefix         string       `db:"prefix" json:"prefix"`
+	SecretHash     string       `db:"secret_hash" json:"secret_hash"`
+	Scopes         []string     `db:"scopes" json:"scopes"`
+	ExpiresAt      sql.NullTime `db:"expires_at" json:"expires_at"`
+	CreatedBy      string       `db:"created_by" json:"created_by"`
+	CreatedAt      time.Time    `db:"created_at" json:"created_at"`
+}
+
+func (q *Queries) CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) error {
+	_, err := q.db.ExecContext(ctx, createAPIKey,
+		arg.ID,
+		arg.OrganizationID,
+		arg.Name,
+		arg.Prefix,
+		arg.SecretHash,
+		pq.Array(arg.Scopes),
+		arg.ExpiresAt,
+		arg.CreatedBy,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const getAPIKeyByID = `-- name: GetAPIKeyByID :one
+SELECT id, organization_id, name, prefix, secret_hash, scopes, expires_at, last_used_at, revoked_at, created_by, created_at
+FROM api_keys
+WHERE organization_id = $1 AND id = $2
+`
+
+type GetAPIKeyByIDParams struct {
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	ID             string `db:"id" json:"id"`
+}
+
+func (q *Queries) GetAPIKeyByID(ctx context.Context, arg GetAPIKeyByIDParams) (ApiKey, error) {
+	row := q.db.QueryRowContext(ctx, getAPIKeyByID, arg.OrganizationID, arg.ID)
+	var i ApiKey
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.Prefix,
+		&i.SecretHash,
+		pq.Array(&i.Scopes),
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.RevokedAt,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getAPIKeyByIDForUpdate = `-- name: GetAPIKeyByIDForUpdate :one
+SELECT id, organization_id, name, prefix, secret_hash, scopes, expires_at, last_used_at, revoked_at, created_by, created_at
+FROM api_keys
+WHERE organization_id = $1 AND id = $2
+FOR UPDATE
+`
+
+type GetAPIKeyByIDForUpdateParams struct {
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	ID             string `db:"id" json:"id"`
+}
+
+func (q *Queries) GetAPIKeyByIDForUpdate(ctx context.Context, arg GetAPIKeyByIDForUpdateParams) (ApiKey, error) {
+	row := q.db.QueryRowContext(ctx, getAPIKeyByIDForUpdate, arg.OrganizationID, arg.ID)
+	var i ApiKey
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.Prefix,
+		&i.SecretHash,
+		pq.Array(&i.Scopes),
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.RevokedAt,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getAPIKeyByPrefix = `-- name: GetAPIKeyByPrefix :one
+SELECT id, organization_id, name, prefix, secret_hash, scopes, expires_at, last_used_at, revoked_at, created_by, created_at
+FROM api_keys
+WHERE prefix = $1
+`
+
+func (q *Queries) GetAPIKeyByPrefix(ctx context.Context, prefix string) (ApiKey, error) {
+	row := q.db.QueryRowContext(ctx, getAPIKeyByPrefix, prefix)
+	var i ApiKey
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.Prefix,
+		&i.SecretHash,
+		pq.Array(&i.Scopes),
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.RevokedAt,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listAPIKeys = `-- name: ListAPIKeys :many
+SELECT id, organization_id, name, prefix, secret_hash, scopes, expires_at, last_used_at, revoked_at, created_by, created_at
+FROM api_keys
+WHERE organization_id = $1
+  AND ($2::boolean OR revoked_at IS NULL)
+ORDER BY created_at DESC, id DESC
+LIMIT $4 OFFSET $3
+`
+
+type ListAPIKeysParams struct {
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	IncludeRevoked bool   `db:"include_revoked" json:"include_revoked"`
+	Offset         int32  `db:"offset" json:"offset"`
+	Limit          int32  `db:"limit" json:"limit"`
+}
+
+func (q *Queries) ListAPIKeys(ctx context.Context, arg ListAPIKeysParams) ([]ApiKey, error) {
+	rows, err := q.db.QueryContext(ctx, listAPIKeys,
+		arg.OrganizationID,
+		arg.IncludeRevoked,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ApiKey{}
+	for rows.Next() {
+		var i ApiKey
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.Name,
+			&i.Prefix,
+			&i.SecretHash,
+			pq.Array(&i.Scopes),
+			&i.ExpiresAt,
+			&i.LastUsedAt,
+			&i.RevokedAt,
+			&i.CreatedBy,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const revokeAPIKey = `-- name: RevokeAPIKey :exec
+UPDATE api_keys SET revoked_at = $1
+WHERE organization_id = $2 AND id = $3
+`
+
+type RevokeAPIKeyParams struct {
+	RevokedAt      sql.NullTime `db:"revoked_at" json:"revoked_at"`
+	OrganizationID string       `db:"organization_id" json:"organization_id"`
+	ID             string       `db:"id" json:"id"`
+}
+
+func (q *Queries) RevokeAPIKey(ctx context.Context, arg RevokeAPIKeyParams) error {
+	_, err := q.db.ExecContext(ctx, revokeAPIKey, arg.RevokedAt, arg.OrganizationID, arg.ID)
+	return err
+}
+
+const touchAPIKeyLastUsed = `-- name: TouchAPIKeyLastUsed :exec
+UPDATE api_keys SET last_used_at = $1
+WHERE id = $2
+  AND (last_used_at IS NULL OR last_used_at < $1::timestamp - INTERVAL '1 minute')
+`
+
+type TouchAPIKeyLastUsedParams struct {
+	UsedAt sql.NullTime `db:"used_at" json:"used_at"`
+	ID     string       `db:"id" json:"id"`
+}
+
+// 書き込みを減らすため、前回の記録から1分以上経っている場合のみ更新する
+func (q *Queries) TouchAPIKeyLastUsed(ctx context.Context, arg TouchAPIKeyLastUsedParams) error {
+	_, err := q.db.ExecContext(ctx, touchAPIKeyLastUsed, arg.UsedAt, arg.ID)
+	return err
+}
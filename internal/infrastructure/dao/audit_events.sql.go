@@ -0,0 +1,153 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: audit_events.sql
+
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+)
+
+const countAuditEvents = `-- name: CountAuditEvents :one
+SELECT COUNT(*) FROM audit_events
+WHERE organization_id = $1
+  AND ($2::varchar IS NULL OR aggregate_type = $2)
+  AND ($3::varchar IS NULL OR aggregate_id = $3)
+  AND ($4::varchar IS NULL OR action = $4)
+  AND ($5::varchar IS NULL OR actor = $5)
+  AND ($6::timestamp IS NULL OR created_at >= $6)
+  AND ($7::timestamp IS NULL OR created_at < $7)
+`
+
+type CountAuditEventsParams struct {
+	OrganizationID string         `db:"organization_id" json:"organization_id"`
+	AggregateType  sql.NullString `db:"aggregate_type" json:"aggregate_type"`
+	AggregateID    sql.NullString `db:"aggregate_id" json:"aggregate_id"`
+	Action         sql.NullString `db:"action" json:"action"`
+	Actor          sql.NullString `db:"actor" json:"actor"`
+	Since          sql.NullTime   `db:"since" json:"since"`
+	Until          sql.NullTime   `db:"until" json:"until"`
+}
+
+func (q *Queries) CountAuditEvents(ctx context.Context, arg CountAuditEventsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countAuditEvents,
+		arg.OrganizationID,
+		arg.AggregateType,
+		arg.AggregateID,
+		arg.Action,
+		arg.Actor,
+		arg.Since,
+		arg.Until,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createAuditEvent = `-- name: CreateAuditEvent :exec
+INSERT INTO audit_events (id, organization_id, aggregate_type, aggregate_id, action, actor, request_id, metadata, payload, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+`
+
+type CreateAuditEventParams struct {
+	ID             string          `db:"id" json:"id"`
+	OrganizationID string          `db:"organization_id" json:"organization_id"`
+	AggregateType  string          `db:"aggregate_type" json:"aggregate_type"`
+	AggregateID    string          `db:"aggregate_id" json:"aggregate_id"`
+	Action         string          `db:"action" json:"action"`
+	Actor          string          `db:"actor" json:"actor"`
+	RequestID      string          `db:"request_id" json:"request_id"`
+	Metadata       json.RawMessage `db:"metadata" json:"metadata"`
+	Payload        json.RawMessage `db:"payload" json:"payload"`
+	CreatedAt      time.Time       `db:"created_at" json:"created_at"`
+}
+
+func (q *Queries) CreateAuditEvent(ctx context.Context, arg CreateAuditEventParams) error {
+	_, err := q.db.ExecContext(ctx, createAuditEvent,
+		arg.ID,
+		arg.OrganizationID,
+		arg.AggregateType,
+		arg.AggregateID,
+		arg.Action,
+		arg.Actor,
+		arg.RequestID,
+		arg.Metadata,
+		arg.Payload,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const listAuditEvents = `-- name: ListAuditEvents :many
+SELECT id, organization_id, aggregate_type, aggregate_id, action, actor, request_id, metadata, payload, created_at
+FROM audit_events
+WHERE organization_id = $1
+  AND ($2::varchar IS NULL OR aggregate_type = $2)
+  AND ($3::varchar IS NULL OR aggregate_id = $3)
+  AND ($4::varchar IS NULL OR action = $4)
+  AND ($5::varchar IS NULL OR actor = $5)
+  AND ($6::timestamp IS NULL OR created_at >= $6)
+  AND ($7::timestamp IS NULL OR created_at < $7)
+ORDER BY created_at DESC, id DESC
+LIMIT $9 OFFSET $8
+`
+
+type ListAuditEventsParams struct {
+	OrganizationID string         `db:"organization_id" json:"organization_id"`
+	AggregateType  sql.NullString `db:"aggregate_type" json:"aggregate_type"`
+	AggregateID    sql.NullString `db:"aggregate_id" json:"aggregate_id"`
+	Action         sql.NullString `db:"action" json:"action"`
+	Actor          sql.NullString `db:"actor" json:"actor"`
+	Since          sql.NullTime   `db:"since" json:"since"`
+	Until          sql.NullTime   `db:"until" json:"until"`
+	Offset         int32          `db:"offset" json:"offset"`
+	Limit          int32          `db:"limit" json:"limit"`
+}
+
+func (q *Queries) ListAuditEvents(ctx context.Context, arg ListAuditEventsParams) ([]AuditEvent, error) {
+	rows, err := q.db.QueryContext(ctx, listAuditEvents,
+		arg.OrganizationID,
+		arg.AggregateType,
+		arg.AggregateID,
+		arg.Action,
+		arg.Actor,
+		arg.Since,
+		arg.Until,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AuditEvent{}
+	for rows.Next() {
+		var i AuditEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.AggregateType,
+			&i.AggregateID,
+			&i.Action,
+			&i.Actor,
+			&i.RequestID,
+			&i.Metadata,
+			&i.Payload,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
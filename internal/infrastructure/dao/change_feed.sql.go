@@ -0,0 +1,261 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: change_feed.sql
+
+package dao
+
+import (
+	"context"
+	"time"
+)
+
+const createChange = `-- name: CreateChange :exec
+INSERT INTO change_feed (id, organization_id, aggregate_type, aggregate_id, change_type, occurred_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+`
+
+type CreateChangeParams struct {
+	ID             int64     `db:"id" json:"id"`
+	OrganizationID string    `db:"organization_id" json:"organization_id"`
+	AggregateType  string    `db:"aggregate_type" json:"aggregate_type"`
+	AggregateID    string    `db:"aggregate_id" json:"aggregate_id"`
+	ChangeType     string    `db:"change_type" json:"change_type"`
+	OccurredAt     time.Time `db:"occurred_at" json:"occurred_at"`
+	CreatedAt      time.Time `db:"created_at" json:"created_at"`
+}
+
+// ReserveChangeIDs で採番したIDで保存する（settle_xid は既定値で記録する）
+func (q *Queries) CreateChange(ctx context.Context, arg CreateChangeParams) error {
+	_, err := q.db.ExecContext(ctx, createChange,
+		arg.ID,
+		arg.OrganizationID,
+		arg.AggregateType,
+		arg.AggregateID,
+		arg.ChangeType,
+		arg.OccurredAt,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const deleteChangesBefore = `-- name: DeleteChangesBefore :execrows
+DELETE FROM change_feed
+WHERE created_at < $1
+`
+
+func (q *Queries) DeleteChangesBefore(ctx context.Context, createdAt time.Time) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteChangesBefore, createdAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getLatestChangeID = `-- name: GetLatestChangeID :one
+SELECT COALESCE(MAX(id), 0)::bigint AS id
+FROM change_feed
+WHERE change_feed.organization_id = $1
+    AND change_feed.id < COALESCE((
+        SELECT MIN(pending.id) FROM change_feed pending
+        WHERE pending.organization_id = $1
+            AND pending.settle_xid > pg_snapshot_xmin(pg_current_snapshot())
+    ), 9223372036854775807)
+`
+
+// 組織の最新の確定した変更のID（変更がない場合は0）
+// 確定していない変更より前で止め、それより小さいIDでまだコミットされていない変更を読み飛ばさないようにする
+func (q *Queries) GetLatestChangeID(ctx context.Context, organizationID string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getLatestChangeID, organizationID)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const getLatestChangeIDInAllOrganizations = `-- name: GetLatestChangeIDInAllOrganizations :one
+SELECT COALESCE(MAX(id), 0)::bigint AS id
+FROM change_feed
+WHERE id < COALESCE((
+    SELECT MIN(pending.id) FROM change_feed pending
+    WHERE pending.settle_xid > pg_snapshot_xmin(pg_current_snapshot())
+), 9223372036854775807)
+`
+
+// すべての組織の最新の確定した変更のID（変更がない場合は0、確定の扱いは GetLatestChangeID と同じ）
+func (q *Queries) GetLatestChangeIDInAllOrganizations(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getLatestChangeIDInAllOrganizations)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const getOldestChangeID = `-- name: GetOldestChangeID :one
+SELECT COALESCE(MIN(id), 0)::bigint AS id
+FROM change_feed
+`
+
+// 保持している最も古い変更のID（変更がない場合は0）
+func (q *Queries) GetOldestChangeID(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getOldestChangeID)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const listChangesAfter = `-- name: ListChangesAfter :many
+SELECT id, organization_id, aggregate_type, aggregate_id, change_type, occurred_at, created_at,
+    (settle_xid <= pg_snapshot_xmin(pg_current_snapshot()))::boolean AS settled
+FROM change_feed
+WHERE organization_id = $1 AND id > $2
+ORDER BY id ASC
+LIMIT $3
+`
+
+type ListChangesAfterParams struct {
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	AfterID        int64  `db:"after_id" json:"after_id"`
+	Limit          int32  `db:"limit" json:"limit"`
+}
+
+type ListChangesAfterRow struct {
+	ID             int64     `db:"id" json:"id"`
+	OrganizationID string    `db:"organization_id" json:"organization_id"`
+	AggregateType  string    `db:"aggregate_type" json:"aggregate_type"`
+	AggregateID    string    `db:"aggregate_id" json:"aggregate_id"`
+	ChangeType     string    `db:"change_type" json:"change_type"`
+	OccurredAt     time.Time `db:"occurred_at" json:"occurred_at"`
+	CreatedAt      time.Time `db:"created_at" json:"created_at"`
+	Settled        bool      `db:"settled" json:"settled"`
+}
+
+// 指定したIDより後の変更を古い順に取得する
+// settled が偽の変更は、より小さいIDの変更がまだコミットされる可能性があるため、その変更から先は読み進めない
+func (q *Queries) ListChangesAfter(ctx context.Context, arg ListChangesAfterParams) ([]ListChangesAfterRow, error) {
+	rows, err := q.db.QueryContext(ctx, listChangesAfter, arg.OrganizationID, arg.AfterID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListChangesAfterRow{}
+	for rows.Next() {
+		var i ListChangesAfterRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.AggregateType,
+			&i.AggregateID,
+			&i.ChangeType,
+			&i.OccurredAt,
+			&i.CreatedAt,
+			&i.Settled,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listChangesAfterInAllOrganizations = `-- name: ListChangesAfterInAllOrganizations :many
+SELECT id, organization_id, aggregate_type, aggregate_id, change_type, occurred_at, created_at,
+    (settle_xid <= pg_snapshot_xmin(pg_current_snapshot()))::boolean AS settled
+FROM change_feed
+WHERE id > $1
+ORDER BY id ASC
+LIMIT $2
+`
+
+type ListChangesAfterInAllOrganizationsParams struct {
+	AfterID int64 `db:"after_id" json:"after_id"`
+	Limit   int32 `db:"limit" json:"limit"`
+}
+
+type ListChangesAfterInAllOrganizationsRow struct {
+	ID             int64     `db:"id" json:"id"`
+	OrganizationID string    `db:"organization_id" json:"organization_id"`
+	AggregateType  string    `db:"aggregate_type" json:"aggregate_type"`
+	AggregateID    string    `db:"aggregate_id" json:"aggregate_id"`
+	ChangeType     string    `db:"change_type" json:"change_type"`
+	OccurredAt     time.Time `db:"occurred_at" json:"occurred_at"`
+	CreatedAt      time.Time `db:"created_at" json:"created_at"`
+	Settled        bool      `db:"settled" json:"settled"`
+}
+
+// すべての組織の、指定したIDより後の変更を古い順に取得する（キャッシュの削除に使う、settled は ListChangesAfter と同じ）
+func (q *Queries) ListChangesAfterInAllOrganizations(ctx context.Context, arg ListChangesAfterInAllOrganizationsParams) ([]ListChangesAfterInAllOrganizationsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listChangesAfterInAllOrganizations, arg.AfterID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListChangesAfterInAllOrganizationsRow{}
+	for rows.Next() {
+		var i ListChangesAfterInAllOrganizationsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.AggregateType,
+			&i.AggregateID,
+			&i.ChangeType,
+			&i.OccurredAt,
+			&i.CreatedAt,
+			&i.Settled,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const notifyChangeFeed = `-- name: NotifyChangeFeed :exec
+SELECT pg_notify('change_feed', $1::text)
+`
+
+// 変更をほかのサーバーのリスナーに通知する（コミット時に配信される）
+func (q *Queries) NotifyChangeFeed(ctx context.Context, organizationID string) error {
+	_, err := q.db.ExecContext(ctx, notifyChangeFeed, organizationID)
+	return err
+}
+
+const reserveChangeIDs = `-- name: ReserveChangeIDs :many
+SELECT nextval(pg_get_serial_sequence('change_feed', 'id'))::bigint AS id
+FROM generate_series(1, $1::integer)
+`
+
+// 変更通知のIDを先に採番する
+// CreateChange の settle_xid を採番より後の文で記録し、採番より前に始まったトランザクションを必ず含めるため
+func (q *Queries) ReserveChangeIDs(ctx context.Context, count int32) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, reserveChangeIDs, count)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
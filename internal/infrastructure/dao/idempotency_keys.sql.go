@@ -0,0 +1,158 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: idempotency_keys.sql
+
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+)
+
+const acquireIdempotencyKey = `-- name: AcquireIdempotencyKey :execrows
+INSERT INTO idempotency_keys (idempotency_key, fingerprint, created_at, expires_at, lease_id, locked_until)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (idempotency_key) DO UPDATE SET
+    fingerprint = EXCLUDED.fingerprint,
+    status_code = NULL,
+    response_headers = '{}',
+    response_body = NULL,
+    created_at = EXCLUDED.created_at,
+    completed_at = NULL,
+    expires_at = EXCLUDED.expires_at,
+    lease_id = EXCLUDED.lease_id,
+    locked_until = EXCLUDED.locked_until
+WHERE idempotency_keys.expires_at <= EXCLUDED.created_at
+    OR (idempotency_keys.completed_at IS NULL
+        AND idempotency_keys.fingerprint = EXCLUDED.fingerprint
+        AND idempotency_keys.locked_until <= EXCLUDED.created_at)
+`
+
+type AcquireIdempotencyKeyParams struct {
+	IdempotencyKey string         `db:"idempotency_key" json:"idempotency_key"`
+	Fingerprint    string         `db:"fingerprint" json:"fingerprint"`
+	CreatedAt      time.Time      `db:"created_at" json:"created_at"`
+	ExpiresAt      time.Time      `db:"expires_at" json:"expires_at"`
+	LeaseID        sql.NullString `db:"lease_id" json:"lease_id"`
+	LockedUntil    sql.NullTime   `db:"locked_until" json:"locked_until"`
+}
+
+// 期限切れのキーと、同じリクエストでリースが切れた処理中のキーは上書きする
+func (q *Queries) AcquireIdempotencyKey(ctx context.Context, arg AcquireIdempotencyKeyParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, acquireIdempotencyKey,
+		arg.IdempotencyKey,
+		arg.Fingerprint,
+		arg.CreatedAt,
+		arg.ExpiresAt,
+		arg.LeaseID,
+		arg.LockedUntil,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const completeIdempotencyKey = `-- name: CompleteIdempotencyKey :execrows
+UPDATE idempotency_keys
+SET status_code = $3, response_headers = $4, response_body = $5, completed_at = NOW(), lease_id = NULL, locked_until = NULL
+WHERE idempotency_key = $1 AND lease_id = $2
+`
+
+type CompleteIdempotencyKeyParams struct {
+	IdempotencyKey  string          `db:"idempotency_key" json:"idempotency_key"`
+	LeaseID         sql.NullString  `db:"lease_id" json:"lease_id"`
+	StatusCode      sql.NullInt32   `db:"status_code" json:"status_code"`
+	ResponseHeaders json.RawMessage `db:"response_headers" json:"response_headers"`
+	ResponseBody    []byte          `db:"response_body" json:"response_body"`
+}
+
+// リースを持つリクエストのレスポンスを記録する（ほかのリクエストに引き継がれた場合は影響行数が0になる）
+func (q *Queries) CompleteIdempotencyKey(ctx context.Context, arg CompleteIdempotencyKeyParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, completeIdempotencyKey,
+		arg.IdempotencyKey,
+		arg.LeaseID,
+		arg.StatusCode,
+		arg.ResponseHeaders,
+		arg.ResponseBody,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteExpiredIdempotencyKeys = `-- name: DeleteExpiredIdempotencyKeys :execrows
+DELETE FROM idempotency_keys WHERE expires_at <= $1
+`
+
+func (q *Queries) DeleteExpiredIdempotencyKeys(ctx context.Context, expiresAt time.Time) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteExpiredIdempotencyKeys, expiresAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteIdempotencyKey = `-- name: DeleteIdempotencyKey :exec
+DELETE FROM idempotency_keys WHERE idempotency_key = $1 AND lease_id = $2
+`
+
+type DeleteIdempotencyKeyParams struct {
+	IdempotencyKey string         `db:"idempotency_key" json:"idempotency_key"`
+	LeaseID        sql.NullString `db:"lease_id" json:"lease_id"`
+}
+
+func (q *Queries) DeleteIdempotencyKey(ctx context.Context, arg DeleteIdempotencyKeyParams) error {
+	_, err := q.db.ExecContext(ctx, deleteIdempotencyKey, arg.IdempotencyKey, arg.LeaseID)
+	return err
+}
+
+const extendIdempotencyKeyLease = `-- name: ExtendIdempotencyKeyLease :execrows
+UPDATE idempotency_keys
+SET locked_until = $3
+WHERE idempotency_key = $1 AND lease_id = $2 AND completed_at IS NULL
+`
+
+type ExtendIdempotencyKeyLeaseParams struct {
+	IdempotencyKey string         `db:"idempotency_key" json:"idempotency_key"`
+	LeaseID        sql.NullString `db:"lease_id" json:"lease_id"`
+	LockedUntil    sql.NullTime   `db:"locked_until" json:"locked_until"`
+}
+
+// 処理中のキーのリースを延長する（ほかのリクエストに引き継がれた場合は影響行数が0になる）
+func (q *Queries) ExtendIdempotencyKeyLease(ctx context.Context, arg ExtendIdempotencyKeyLeaseParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, extendIdempotencyKeyLease, arg.IdempotencyKey, arg.LeaseID, arg.LockedUntil)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getIdempotencyKey = `-- name: GetIdempotencyKey :one
+SELECT idempotency_key, fingerprint, status_code, response_headers, response_body,
+       created_at, completed_at, expires_at, lease_id, locked_until
+FROM idempotency_keys
+WHERE idempotency_key = $1
+`
+
+func (q *Queries) GetIdempotencyKey(ctx context.Context, idempotencyKey string) (IdempotencyKey, error) {
+	row := q.db.QueryRowContext(ctx, getIdempotencyKey, idempotencyKey)
+	var i IdempotencyKey
+	err := row.Scan(
+		&i.IdempotencyKey,
+		&i.Fingerprint,
+		&i.StatusCode,
+		&i.ResponseHeaders,
+		&i.ResponseBody,
+		&i.CreatedAt,
+		&i.CompletedAt,
+		&i.ExpiresAt,
+		&i.LeaseID,
+		&i.LockedUntil,
+	)
+	return i, err
+}
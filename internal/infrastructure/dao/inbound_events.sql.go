@@ -0,0 +1,217 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: inbound_events.sql
+
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+)
+
+const countInboundEvents = `-- name: CountInboundEvents :one
+SELECT COUNT(*) FROM inbound_events
+WHERE organization_id = $1
+  AND ($2::varchar IS NULL OR provider = $2)
+  AND ($3::varchar IS NULL OR status = $3)
+`
+
+type CountInboundEventsParams struct {
+	OrganizationID string         `db:"organization_id" json:"organization_id"`
+	Provider       sql.NullString `db:"provider" json:"provider"`
+	Status         sql.NullString `db:"status" json:"status"`
+}
+
+func (q *Queries) CountInboundEvents(ctx context.Context, arg CountInboundEventsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countInboundEvents, arg.OrganizationID, arg.Provider, arg.Status)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createInboundEvent = `-- name: CreateInboundEvent :execrows
+INSERT INTO inbound_events (id, organization_id, provider, provider_event_id, event_type, subject, payload, status, received_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (provider, provider_event_id) DO NOTHING
+`
+
+type CreateInboundEventParams struct {
+	ID              string          `db:"id" json:"id"`
+	OrganizationID  string          `db:"organization_id" json:"organization_id"`
+	Provider        string          `db:"provider" json:"provider"`
+	ProviderEventID string          `db:"provider_event_id" json:"provider_event_id"`
+	EventType       string          `db:"event_type" json:"event_type"`
+	Subject         string          `db:"subject" json:"subject"`
+	Payload         json.RawMessage `db:"payload" json:"payload"`
+	Status          string          `db:"status" json:"status"`
+	ReceivedAt      time.Time       `db:"received_at" json:"received_at"`
+}
+
+// 同じプロバイダーの同じイベントIDを受信済みの場合は何もしない（影響行数が0になる）
+func (q *Queries) CreateInboundEvent(ctx context.Context, arg CreateInboundEventParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createInboundEvent,
+		arg.ID,
+		arg.OrganizationID,
+		arg.Provider,
+		arg.ProviderEventID,
+		arg.EventType,
+		arg.Subject,
+		arg.Payload,
+		arg.Status,
+		arg.ReceivedAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getInboundEventByID = `-- name: GetInboundEventByID :one
+SELECT id, organization_id, provider, provider_event_id, event_type, subject, payload, status, last_error, received_at, processed_at
+FROM inbound_events
+WHERE organization_id = $1 AND id = $2
+`
+
+type GetInboundEventByIDParams struct {
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	ID             string `db:"id" json:"id"`
+}
+
+func (q *Queries) GetInboundEventByID(ctx context.Context, arg GetInboundEventByIDParams) (InboundEvent, error) {
+	row := q.db.QueryRowContext(ctx, getInboundEventByID, arg.OrganizationID, arg.ID)
+	var i InboundEvent
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Provider,
+		&i.ProviderEventID,
+		&i.EventType,
+		&i.Subject,
+		&i.Payload,
+		&i.Status,
+		&i.LastError,
+		&i.ReceivedAt,
+		&i.ProcessedAt,
+	)
+	return i, err
+}
+
+const getInboundEventByIDForUpdate = `-- name: GetInboundEventByIDForUpdate :one
+SELECT id, organization_id, provider, provider_event_id, event_type, subject, payload, status, last_error, received_at, processed_at
+FROM inbound_events
+WHERE organization_id = $1 AND id = $2
+FOR UPDATE
+`
+
+type GetInboundEventByIDForUpdateParams struct {
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	ID             string `db:"id" json:"id"`
+}
+
+func (q *Queries) GetInboundEventByIDForUpdate(ctx context.Context, arg GetInboundEventByIDForUpdateParams) (InboundEvent, error) {
+	row := q.db.QueryRowContext(ctx, getInboundEventByIDForUpdate, arg.OrganizationID, arg.ID)
+	var i InboundEvent
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Provider,
+		&i.ProviderEventID,
+		&i.EventType,
+		&i.Subject,
+		&i.Payload,
+		&i.Status,
+		&i.LastError,
+		&i.ReceivedAt,
+		&i.ProcessedAt,
+	)
+	return i, err
+}
+
+const listInboundEvents = `-- name: ListInboundEvents :many
+SELECT id, organization_id, provider, provider_event_id, event_type, subject, payload, status, last_error, received_at, processed_at
+FROM inbound_events
+WHERE organization_id = $1
+  AND ($2::varchar IS NULL OR provider = $2)
+  AND ($3::varchar IS NULL OR status = $3)
+ORDER BY received_at DESC, id DESC
+LIMIT $5 OFFSET $4
+`
+
+type ListInboundEventsParams struct {
+	OrganizationID string         `db:"organization_id" json:"organization_id"`
+	Provider       sql.NullString `db:"provider" json:"provider"`
+	Status         sql.NullString `db:"status" json:"status"`
+	Offset         int32          `db:"offset" json:"offset"`
+	Limit          int32          `db:"limit" json:"limit"`
+}
+
+func (q *Queries) ListInboundEvents(ctx context.Context, arg ListInboundEventsParams) ([]InboundEvent, error) {
+	rows, err := q.db.QueryContext(ctx, listInboundEvents,
+		arg.OrganizationID,
+		arg.Provider,
+		arg.Status,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []InboundEvent{}
+	for rows.Next() {
+		var i InboundEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.Provider,
+			&i.ProviderEventID,
+			&i.EventType,
+			&i.Subject,
+			&i.Payload,
+			&i.Status,
+			&i.LastError,
+			&i.ReceivedAt,
+			&i.ProcessedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateInboundEventStatus = `-- name: UpdateInboundEventStatus :exec
+UPDATE inbound_events
+SET status = $1,
+    last_error = $2,
+    processed_at = $3
+WHERE organization_id = $4 AND id = $5
+`
+
+type UpdateInboundEventStatusParams struct {
+	Status         string       `db:"status" json:"status"`
+	LastError      string       `db:"last_error" json:"last_error"`
+	ProcessedAt    sql.NullTime `db:"processed_at" json:"processed_at"`
+	OrganizationID string       `db:"organization_id" json:"organization_id"`
+	ID             string       `db:"id" json:"id"`
+}
+
+func (q *Queries) UpdateInboundEventStatus(ctx context.Context, arg UpdateInboundEventStatusParams) error {
+	_, err := q.db.ExecContext(ctx, updateInboundEventStatus,
+		arg.Status,
+		arg.LastError,
+		arg.ProcessedAt,
+		arg.OrganizationID,
+		arg.ID,
+	)
+	return err
+}
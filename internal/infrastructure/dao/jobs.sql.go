@@ -35,24 +35,26 @@ func (q *Queries) DeleteCompletedJobsBefore(ctx context.Context, completedAt sql
 }
 
 const enqueueJob = `-- name: EnqueueJob :exec
-INSERT INTO jobs (id, job_type, payload, status, max_attempts, scheduled_at, created_at, updated_at)
-VALUES ($1, $2, $3, 'pending', $4, $5, $6, $7)
+INSERT INTO jobs (id, job_type, organization_id, payload, status, max_attempts, scheduled_at, created_at, updated_at)
+VALUES ($1, $2, $3, $4, 'pending', $5, $6, $7, $8)
 `
 
 type EnqueueJobParams struct {
-	ID          string          `db:"id" json:"id"`
-	JobType     string          `db:"job_type" json:"job_type"`
-	Payload     json.RawMessage `db:"payload" json:"payload"`
-	MaxAttempts int32           `db:"max_attempts" json:"max_attempts"`
-	ScheduledAt time.Time       `db:"scheduled_at" json:"scheduled_at"`
-	CreatedAt   time.Time       `db:"created_at" json:"created_at"`
-	UpdatedAt   time.Time       `db:"updated_at" json:"updated_at"`
+	ID             string          `db:"id" json:"id"`
+	JobType        string          `db:"job_type" json:"job_type"`
+	OrganizationID string          `db:"organization_id" json:"organization_id"`
+	Payload        json.RawMessage `db:"payload" json:"payload"`
+	MaxAttempts    int32           `db:"max_attempts" json:"max_attempts"`
+	ScheduledAt    time.Time       `db:"scheduled_at" json:"scheduled_at"`
+	CreatedAt      time.Time       `db:"created_at" json:"created_at"`
+	UpdatedAt      time.Time       `db:"updated_at" json:"updated_at"`
 }
 
 func (q *Queries) EnqueueJob(ctx context.Context, arg EnqueueJobParams) error {
 	_, err := q.db.ExecContext(ctx, enqueueJob,
 		arg.ID,
 		arg.JobType,
+		arg.OrganizationID,
 		arg.Payload,
 		arg.MaxAttempts,
 		arg.ScheduledAt,
@@ -63,7 +65,7 @@ func (q *Queries) EnqueueJob(ctx context.Context, arg EnqueueJobParams) error {
 }
 
 const fetchJobs = `-- name: FetchJobs :many
-SELECT id, job_type, payload, status, attempts, max_attempts, last_error,
+SELECT id, job_type, organization_id, payload, status, attempts, max_attempts, last_error,
        scheduled_at, started_at, completed_at, created_at, updated_at
 FROM jobs
 WHERE status IN ('pending', 'retryable')
@@ -85,6 +87,7 @@ func (q *Queries) FetchJobs(ctx context.Context, limit int32) ([]Job, error) {
 		if err := rows.Scan(
 			&i.ID,
 			&i.JobType,
+			&i.OrganizationID,
 			&i.Payload,
 			&i.Status,
 			&i.Attempts,
@@ -110,7 +113,7 @@ func (q *Queries) FetchJobs(ctx context.Context, limit int32) ([]Job, error) {
 }
 
 const getJobByID = `-- name: GetJobByID :one
-SELECT id, job_type, payload, status, attempts, max_attempts, last_error,
+SELECT id, job_type, organization_id, payload, status, attempts, max_attempts, last_error,
        scheduled_at, started_at, completed_at, created_at, updated_at
 FROM jobs
 WHERE id = $1
@@ -122,6 +125,7 @@ func (q *Queries) GetJobByID(ctx context.Context, id string) (Job, error) {
 	err := row.Scan(
 		&i.ID,
 		&i.JobType,
+		&i.OrganizationID,
 		&i.Payload,
 		&i.Status,
 		&i.Attempts,
@@ -137,7 +141,7 @@ func (q *Queries) GetJobByID(ctx context.Context, id string) (Job, error) {
 }
 
 const listJobsByStatus = `-- name: ListJobsByStatus :many
-SELECT id, job_type, payload, status, attempts, max_attempts, last_error,
+SELECT id, job_type, organization_id, payload, status, attempts, max_attempts, last_error,
        scheduled_at, started_at, completed_at, created_at, updated_at
 FROM jobs
 WHERE status = $1
@@ -163,6 +167,7 @@ func (q *Queries) ListJobsByStatus(ctx context.Context, arg ListJobsByStatusPara
 		if err := rows.Scan(
 			&i.ID,
 			&i.JobType,
+			&i.OrganizationID,
 			&i.Payload,
 			&i.Status,
 			&i.Attempts,
@@ -0,0 +1,107 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: login_failures.sql
+
+package dao
+
+import (
+	"context"
+	"time"
+)
+
+const createLoginFailuresIfNotExists = `-- name: CreateLoginFailuresIfNotExists :exec
+INSERT INTO login_failures (organization_id, email, failures, last_failed_at)
+VALUES ($1, $2, 0, $3)
+ON CONFLICT (organization_id, email) DO NOTHING
+`
+
+type CreateLoginFailuresIfNotExistsParams struct {
+	OrganizationID string    `db:"organization_id" json:"organization_id"`
+	Email          string    `db:"email" json:"email"`
+	LastFailedAt   time.Time `db:"last_failed_at" json:"last_failed_at"`
+}
+
+// 記録のないメールアドレスでも行ロックで同時のログインを順に処理できるよう、失敗回数0の行を作成する
+// （同じ行を同時に作成しようとしたトランザクションは、先に作成したトランザクションの終了を待つ）
+func (q *Queries) CreateLoginFailuresIfNotExists(ctx context.Context, arg CreateLoginFailuresIfNotExistsParams) error {
+	_, err := q.db.ExecContext(ctx, createLoginFailuresIfNotExists, arg.OrganizationID, arg.Email, arg.LastFailedAt)
+	return err
+}
+
+const deleteLoginFailures = `-- name: DeleteLoginFailures :exec
+DELETE FROM login_failures
+WHERE organization_id = $1 AND email = $2
+`
+
+type DeleteLoginFailuresParams struct {
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	Email          string `db:"email" json:"email"`
+}
+
+func (q *Queries) DeleteLoginFailures(ctx context.Context, arg DeleteLoginFailuresParams) error {
+	_, err := q.db.ExecContext(ctx, deleteLoginFailures, arg.OrganizationID, arg.Email)
+	return err
+}
+
+const deleteStaleLoginFailures = `-- name: DeleteStaleLoginFailures :execrows
+DELETE FROM login_failures WHERE last_failed_at < $1
+`
+
+// ロックの時間より前に最後に失敗した記録を削除する（ロック中の記録は削除されない）
+func (q *Queries) DeleteStaleLoginFailures(ctx context.Context, before time.Time) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteStaleLoginFailures, before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getLoginFailuresForUpdate = `-- name: GetLoginFailuresForUpdate :one
+SELECT organization_id, email, failures, last_failed_at
+FROM login_failures
+WHERE organization_id = $1 AND email = $2
+FOR UPDATE
+`
+
+type GetLoginFailuresForUpdateParams struct {
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	Email          string `db:"email" json:"email"`
+}
+
+func (q *Queries) GetLoginFailuresForUpdate(ctx context.Context, arg GetLoginFailuresForUpdateParams) (LoginFailure, error) {
+	row := q.db.QueryRowContext(ctx, getLoginFailuresForUpdate, arg.OrganizationID, arg.Email)
+	var i LoginFailure
+	err := row.Scan(
+		&i.OrganizationID,
+		&i.Email,
+		&i.Failures,
+		&i.LastFailedAt,
+	)
+	return i, err
+}
+
+const upsertLoginFailures = `-- name: UpsertLoginFailures :exec
+INSERT INTO login_failures (organization_id, email, failures, last_failed_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (organization_id, email) DO UPDATE SET
+    failures = EXCLUDED.failures,
+    last_failed_at = EXCLUDED.last_failed_at
+`
+
+type UpsertLoginFailuresParams struct {
+	OrganizationID string    `db:"organization_id" json:"organization_id"`
+	Email          string    `db:"email" json:"email"`
+	Failures       int32     `db:"failures" json:"failures"`
+	LastFailedAt   time.Time `db:"last_failed_at" json:"last_failed_at"`
+}
+
+func (q *Queries) UpsertLoginFailures(ctx context.Context, arg UpsertLoginFailuresParams) error {
+	_, err := q.db.ExecContext(ctx, upsertLoginFailures,
+		arg.OrganizationID,
+		arg.Email,
+		arg.Failures,
+		arg.LastFailedAt,
+	)
+	return err
+}
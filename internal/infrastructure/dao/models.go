@@ -10,32 +10,272 @@ import (
 	"time"
 )
 
+type AggregateEvent struct {
+	AggregateType  string          `db:"aggregate_type" json:"aggregate_type"`
+	AggregateID    string          `db:"aggregate_id" json:"aggregate_id"`
+	Sequence       int32           `db:"sequence" json:"sequence"`
+	OrganizationID string          `db:"organization_id" json:"organization_id"`
+	EventType      string          `db:"event_type" json:"event_type"`
+	Payload        json.RawMessage `db:"payload" json:"payload"`
+	OccurredAt     time.Time       `db:"occurred_at" json:"occurred_at"`
+	CreatedAt      time.Time       `db:"created_at" json:"created_at"`
+}
+
+type AggregateSnapshot struct {
+	AggregateType  string          `db:"aggregate_type" json:"aggregate_type"`
+	AggregateID    string          `db:"aggregate_id" json:"aggregate_id"`
+	OrganizationID string          `db:"organization_id" json:"organization_id"`
+	Sequence       int32           `db:"sequence" json:"sequence"`
+	State          json.RawMessage `db:"state" json:"state"`
+	CreatedAt      time.Time       `db:"created_at" json:"created_at"`
+}
+
+type ApiKey struct {
+	ID             string       `db:"id" json:"id"`
+	OrganizationID string       `db:"organization_id" json:"organization_id"`
+	Name           string       `db:"name" json:"name"`
+	Prefix         string       `db:"prefix" json:"prefix"`
+	SecretHash     string       `db:"secret_hash" json:"secret_hash"`
+	Scopes         []string     `db:"scopes" json:"scopes"`
+	ExpiresAt      sql.NullTime `db:"expires_at" json:"expires_at"`
+	LastUsedAt     sql.NullTime `db:"last_used_at" json:"last_used_at"`
+	RevokedAt      sql.NullTime `db:"revoked_at" json:"revoked_at"`
+	CreatedBy      string       `db:"created_by" json:"created_by"`
+	CreatedAt      time.Time    `db:"created_at" json:"created_at"`
+}
+
+type AuditEvent struct {
+	ID             string          `db:"id" json:"id"`
+	OrganizationID string          `db:"organization_id" json:"organization_id"`
+	AggregateType  string          `db:"aggregate_type" json:"aggregate_type"`
+	AggregateID    string          `db:"aggregate_id" json:"aggregate_id"`
+	Action         string          `db:"action" json:"action"`
+	Actor          string          `db:"actor" json:"actor"`
+	RequestID      string          `db:"request_id" json:"request_id"`
+	Metadata       json.RawMessage `db:"metadata" json:"metadata"`
+	Payload        json.RawMessage `db:"payload" json:"payload"`
+	CreatedAt      time.Time       `db:"created_at" json:"created_at"`
+}
+
+type ChangeFeed struct {
+	ID             int64       `db:"id" json:"id"`
+	OrganizationID string      `db:"organization_id" json:"organization_id"`
+	AggregateType  string      `db:"aggregate_type" json:"aggregate_type"`
+	AggregateID    string      `db:"aggregate_id" json:"aggregate_id"`
+	ChangeType     string      `db:"change_type" json:"change_type"`
+	OccurredAt     time.Time   `db:"occurred_at" json:"occurred_at"`
+	CreatedAt      time.Time   `db:"created_at" json:"created_at"`
+	SettleXid      interface{} `db:"settle_xid" json:"settle_xid"`
+}
+
+type IdempotencyKey struct {
+	IdempotencyKey  string          `db:"idempotency_key" json:"idempotency_key"`
+	Fingerprint     string          `db:"fingerprint" json:"fingerprint"`
+	StatusCode      sql.NullInt32   `db:"status_code" json:"status_code"`
+	ResponseHeaders json.RawMessage `db:"response_headers" json:"response_headers"`
+	ResponseBody    []byte          `db:"response_body" json:"response_body"`
+	CreatedAt       time.Time       `db:"created_at" json:"created_at"`
+	CompletedAt     sql.NullTime    `db:"completed_at" json:"completed_at"`
+	ExpiresAt       time.Time       `db:"expires_at" json:"expires_at"`
+	LeaseID         sql.NullString  `db:"lease_id" json:"lease_id"`
+	LockedUntil     sql.NullTime    `db:"locked_until" json:"locked_until"`
+}
+
+type InboundEvent struct {
+	ID              string          `db:"id" json:"id"`
+	OrganizationID  string          `db:"organization_id" json:"organization_id"`
+	Provider        string          `db:"provider" json:"provider"`
+	ProviderEventID string          `db:"provider_event_id" json:"provider_event_id"`
+	EventType       string          `db:"event_type" json:"event_type"`
+	Subject         string          `db:"subject" json:"subject"`
+	Payload         json.RawMessage `db:"payload" json:"payload"`
+	Status          string          `db:"status" json:"status"`
+	LastError       string          `db:"last_error" json:"last_error"`
+	ReceivedAt      time.Time       `db:"received_at" json:"received_at"`
+	ProcessedAt     sql.NullTime    `db:"processed_at" json:"processed_at"`
+}
+
 type Job struct {
-	ID          string          `db:"id" json:"id"`
-	JobType     string          `db:"job_type" json:"job_type"`
-	Payload     json.RawMessage `db:"payload" json:"payload"`
-	Status      string          `db:"status" json:"status"`
-	Attempts    int32           `db:"attempts" json:"attempts"`
-	MaxAttempts int32           `db:"max_attempts" json:"max_attempts"`
-	LastError   sql.NullString  `db:"last_error" json:"last_error"`
-	ScheduledAt time.Time       `db:"scheduled_at" json:"scheduled_at"`
-	StartedAt   sql.NullTime    `db:"started_at" json:"started_at"`
-	CompletedAt sql.NullTime    `db:"completed_at" json:"completed_at"`
-	CreatedAt   time.Time       `db:"created_at" json:"created_at"`
-	UpdatedAt   time.Time       `db:"updated_at" json:"updated_at"`
+	ID             string          `db:"id" json:"id"`
+	JobType        string          `db:"job_type" json:"job_type"`
+	OrganizationID string          `db:"organization_id" json:"organization_id"`
+	Payload        json.RawMessage `db:"payload" json:"payload"`
+	Status         string          `db:"status" json:"status"`
+	Attempts       int32           `db:"attempts" json:"attempts"`
+	MaxAttempts    int32           `db:"max_attempts" json:"max_attempts"`
+	LastError      sql.NullString  `db:"last_error" json:"last_error"`
+	ScheduledAt    time.Time       `db:"scheduled_at" json:"scheduled_at"`
+	StartedAt      sql.NullTime    `db:"started_at" json:"started_at"`
+	CompletedAt    sql.NullTime    `db:"completed_at" json:"completed_at"`
+	CreatedAt      time.Time       `db:"created_at" json:"created_at"`
+	UpdatedAt      time.Time       `db:"updated_at" json:"updated_at"`
 }
 
-type User struct {
+type LoginFailure struct {
+	OrganizationID string    `db:"organization_id" json:"organization_id"`
+	Email          string    `db:"email" json:"email"`
+	Failures       int32     `db:"failures" json:"failures"`
+	LastFailedAt   time.Time `db:"last_failed_at" json:"last_failed_at"`
+}
+
+type Organization struct {
 	ID        string    `db:"id" json:"id"`
 	Name      string    `db:"name" json:"name"`
-	Email     string    `db:"email" json:"email"`
+	Slug      string    `db:"slug" json:"slug"`
 	CreatedAt time.Time `db:"created_at" json:"created_at"`
 	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
 }
 
+type OrganizationMembership struct {
+	OrganizationID string    `db:"organization_id" json:"organization_id"`
+	UserID         string    `db:"user_id" json:"user_id"`
+	Roles          []string  `db:"roles" json:"roles"`
+	CreatedAt      time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt      time.Time `db:"updated_at" json:"updated_at"`
+}
+
+type OutboxEvent struct {
+	ID             string          `db:"id" json:"id"`
+	OrganizationID string          `db:"organization_id" json:"organization_id"`
+	AggregateType  string          `db:"aggregate_type" json:"aggregate_type"`
+	AggregateID    string          `db:"aggregate_id" json:"aggregate_id"`
+	EventType      string          `db:"event_type" json:"event_type"`
+	Payload        json.RawMessage `db:"payload" json:"payload"`
+	OccurredAt     time.Time       `db:"occurred_at" json:"occurred_at"`
+	PublishedAt    sql.NullTime    `db:"published_at" json:"published_at"`
+	Attempts       int32           `db:"attempts" json:"attempts"`
+	LastError      sql.NullString  `db:"last_error" json:"last_error"`
+	CreatedAt      time.Time       `db:"created_at" json:"created_at"`
+	TransactionID  int64           `db:"transaction_id" json:"transaction_id"`
+}
+
+type ProjectionCheckpoint struct {
+	Name              string    `db:"name" json:"name"`
+	LastTransactionID int64     `db:"last_transaction_id" json:"last_transaction_id"`
+	LastEventID       string    `db:"last_event_id" json:"last_event_id"`
+	UpdatedAt         time.Time `db:"updated_at" json:"updated_at"`
+}
+
+type Session struct {
+	ID             string       `db:"id" json:"id"`
+	UserID         string       `db:"user_id" json:"user_id"`
+	OrganizationID string       `db:"organization_id" json:"organization_id"`
+	TokenHash      string       `db:"token_hash" json:"token_hash"`
+	RemoteAddr     string       `db:"remote_addr" json:"remote_addr"`
+	UserAgent      string       `db:"user_agent" json:"user_agent"`
+	CreatedAt      time.Time    `db:"created_at" json:"created_at"`
+	LastSeenAt     time.Time    `db:"last_seen_at" json:"last_seen_at"`
+	ExpiresAt      time.Time    `db:"expires_at" json:"expires_at"`
+	RevokedAt      sql.NullTime `db:"revoked_at" json:"revoked_at"`
+}
+
+type User struct {
+	ID                 string       `db:"id" json:"id"`
+	OrganizationID     string       `db:"organization_id" json:"organization_id"`
+	Name               string       `db:"name" json:"name"`
+	Email              string       `db:"email" json:"email"`
+	EmailVerifiedAt    sql.NullTime `db:"email_verified_at" json:"email_verified_at"`
+	EmailInvalidatedAt sql.NullTime `db:"email_invalidated_at" json:"email_invalidated_at"`
+	PasswordHash       string       `db:"password_hash" json:"password_hash"`
+	CreatedAt          time.Time    `db:"created_at" json:"created_at"`
+	UpdatedAt          time.Time    `db:"updated_at" json:"updated_at"`
+}
+
+type UserImport struct {
+	ID             string         `db:"id" json:"id"`
+	OrganizationID string         `db:"organization_id" json:"organization_id"`
+	Format         string         `db:"format" json:"format"`
+	Status         string         `db:"status" json:"status"`
+	Source         []byte         `db:"source" json:"source"`
+	TotalRows      int32          `db:"total_rows" json:"total_rows"`
+	LastError      sql.NullString `db:"last_error" json:"last_error"`
+	CreatedAt      time.Time      `db:"created_at" json:"created_at"`
+	UpdatedAt      time.Time      `db:"updated_at" json:"updated_at"`
+	CompletedAt    sql.NullTime   `db:"completed_at" json:"completed_at"`
+}
+
+type UserImportRow struct {
+	ImportID  string         `db:"import_id" json:"import_id"`
+	Line      int32          `db:"line" json:"line"`
+	Status    string         `db:"status" json:"status"`
+	Name      string         `db:"name" json:"name"`
+	Email     string         `db:"email" json:"email"`
+	UserID    sql.NullString `db:"user_id" json:"user_id"`
+	Reason    sql.NullString `db:"reason" json:"reason"`
+	CreatedAt time.Time      `db:"created_at" json:"created_at"`
+}
+
 type UserLog struct {
-	ID        string    `db:"id" json:"id"`
-	UserID    string    `db:"user_id" json:"user_id"`
-	Action    string    `db:"action" json:"action"`
-	CreatedAt time.Time `db:"created_at" json:"created_at"`
+	ID             string          `db:"id" json:"id"`
+	UserID         string          `db:"user_id" json:"user_id"`
+	OrganizationID string          `db:"organization_id" json:"organization_id"`
+	Action         string          `db:"action" json:"action"`
+	Changes        json.RawMessage `db:"changes" json:"changes"`
+	Actor          string          `db:"actor" json:"actor"`
+	RequestID      string          `db:"request_id" json:"request_id"`
+	CreatedAt      time.Time       `db:"created_at" json:"created_at"`
+	Seq            int64           `db:"seq" json:"seq"`
+	PrevHash       string          `db:"prev_hash" json:"prev_hash"`
+	Hash           string          `db:"hash" json:"hash"`
+}
+
+type UserLogChain struct {
+	OrganizationID string    `db:"organization_id" json:"organization_id"`
+	Seq            int64     `db:"seq" json:"seq"`
+	Hash           string    `db:"hash" json:"hash"`
+	Signature      string    `db:"signature" json:"signature"`
+	UpdatedAt      time.Time `db:"updated_at" json:"updated_at"`
+}
+
+type UserSummary struct {
+	ID                 string       `db:"id" json:"id"`
+	OrganizationID     string       `db:"organization_id" json:"organization_id"`
+	Name               string       `db:"name" json:"name"`
+	Email              string       `db:"email" json:"email"`
+	EmailVerifiedAt    sql.NullTime `db:"email_verified_at" json:"email_verified_at"`
+	EmailInvalidatedAt sql.NullTime `db:"email_invalidated_at" json:"email_invalidated_at"`
+	CreatedAt          time.Time    `db:"created_at" json:"created_at"`
+	UpdatedAt          time.Time    `db:"updated_at" json:"updated_at"`
+	LogCount           int32        `db:"log_count" json:"log_count"`
+	LastActivityAt     sql.NullTime `db:"last_activity_at" json:"last_activity_at"`
+	ProjectedAt        time.Time    `db:"projected_at" json:"projected_at"`
+}
+
+type UserToken struct {
+	ID             string       `db:"id" json:"id"`
+	UserID         string       `db:"user_id" json:"user_id"`
+	OrganizationID string       `db:"organization_id" json:"organization_id"`
+	Purpose        string       `db:"purpose" json:"purpose"`
+	TokenHash      string       `db:"token_hash" json:"token_hash"`
+	Email          string       `db:"email" json:"email"`
+	ExpiresAt      time.Time    `db:"expires_at" json:"expires_at"`
+	UsedAt         sql.NullTime `db:"used_at" json:"used_at"`
+	CreatedAt      time.Time    `db:"created_at" json:"created_at"`
+}
+
+type WebhookDelivery struct {
+	ID             string    `db:"id" json:"id"`
+	OrganizationID string    `db:"organization_id" json:"organization_id"`
+	SubscriptionID string    `db:"subscription_id" json:"subscription_id"`
+	EventID        string    `db:"event_id" json:"event_id"`
+	EventType      string    `db:"event_type" json:"event_type"`
+	Attempt        int32     `db:"attempt" json:"attempt"`
+	StatusCode     int32     `db:"status_code" json:"status_code"`
+	Error          string    `db:"error" json:"error"`
+	DurationMs     int32     `db:"duration_ms" json:"duration_ms"`
+	DeliveredAt    time.Time `db:"delivered_at" json:"delivered_at"`
+}
+
+type WebhookSubscription struct {
+	ID                  string       `db:"id" json:"id"`
+	OrganizationID      string       `db:"organization_id" json:"organization_id"`
+	Url                 string       `db:"url" json:"url"`
+	Secret              string       `db:"secret" json:"secret"`
+	EventTypes          []string     `db:"event_types" json:"event_types"`
+	ConsecutiveFailures int32        `db:"consecutive_failures" json:"consecutive_failures"`
+	DisabledAt          sql.NullTime `db:"disabled_at" json:"disabled_at"`
+	DisabledReason      string       `db:"disabled_reason" json:"disabled_reason"`
+	CreatedBy           string       `db:"created_by" json:"created_by"`
+	CreatedAt           time.Time    `db:"created_at" json:"created_at"`
+	UpdatedAt           time.Time    `db:"updated_at" json:"updated_at"`
 }
@@ -0,0 +1,263 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: organizations.sql
+
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const countMemberships = `-- name: CountMemberships :one
+SELECT COUNT(*) FROM organization_memberships WHERE organization_id = $1
+`
+
+func (q *Queries) CountMemberships(ctx context.Context, organizationID string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countMemberships, organizationID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createOrganization = `-- name: CreateOrganization :exec
+INSERT INTO organizations (id, name, slug, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5)
+`
+
+type CreateOrganizationParams struct {
+	ID        string    `db:"id" json:"id"`
+	Name      string    `db:"name" json:"name"`
+	Slug      string    `db:"slug" json:"slug"`
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+}
+
+func (q *Queries) CreateOrganization(ctx context.Context, arg CreateOrganizationParams) error {
+	_, err := q.db.ExecContext(ctx, createOrganization,
+		arg.ID,
+		arg.Name,
+		arg.Slug,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	return err
+}
+
+const deleteMembership = `-- name: DeleteMembership :exec
+DELETE FROM organization_memberships WHERE organization_id = $1 AND user_id = $2
+`
+
+type DeleteMembershipParams struct {
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	UserID         string `db:"user_id" json:"user_id"`
+}
+
+func (q *Queries) DeleteMembership(ctx context.Context, arg DeleteMembershipParams) error {
+	_, err := q.db.ExecContext(ctx, deleteMembership, arg.OrganizationID, arg.UserID)
+	return err
+}
+
+const ensureOrganization = `-- name: EnsureOrganization :exec
+INSERT INTO organizations (id, name, slug, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT DO NOTHING
+`
+
+type EnsureOrganizationParams struct {
+	ID        string    `db:"id" json:"id"`
+	Name      string    `db:"name" json:"name"`
+	Slug      string    `db:"slug" json:"slug"`
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+}
+
+// 存在しない場合のみ作成する（既定の組織の作成に使用）
+func (q *Queries) EnsureOrganization(ctx context.Context, arg EnsureOrganizationParams) error {
+	_, err := q.db.ExecContext(ctx, ensureOrganization,
+		arg.ID,
+		arg.Name,
+		arg.Slug,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	return err
+}
+
+const getMembership = `-- name: GetMembership :one
+SELECT organization_id, user_id, roles, created_at, updated_at
+FROM organization_memberships
+WHERE organization_id = $1 AND user_id = $2
+`
+
+type GetMembershipParams struct {
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	UserID         string `db:"user_id" json:"user_id"`
+}
+
+func (q *Queries) GetMembership(ctx context.Context, arg GetMembershipParams) (OrganizationMembership, error) {
+	row := q.db.QueryRowContext(ctx, getMembership, arg.OrganizationID, arg.UserID)
+	var i OrganizationMembership
+	err := row.Scan(
+		&i.OrganizationID,
+		&i.UserID,
+		pq.Array(&i.Roles),
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getMembershipForUpdate = `-- name: GetMembershipForUpdate :one
+SELECT organization_id, user_id, roles, created_at, updated_at
+FROM organization_memberships
+WHERE organization_id = $1 AND user_id = $2
+FOR UPDATE
+`
+
+type GetMembershipForUpdateParams struct {
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	UserID         string `db:"user_id" json:"user_id"`
+}
+
+func (q *Queries) GetMembershipForUpdate(ctx context.Context, arg GetMembershipForUpdateParams) (OrganizationMembership, error) {
+	row := q.db.QueryRowContext(ctx, getMembershipForUpdate, arg.OrganizationID, arg.UserID)
+	var i OrganizationMembership
+	err := row.Scan(
+		&i.OrganizationID,
+		&i.UserID,
+		pq.Array(&i.Roles),
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getOrganizationByID = `-- name: GetOrganizationByID :one
+SELECT id, name, slug, created_at, updated_at
+FROM organizations
+WHERE id = $1
+`
+
+func (q *Queries) GetOrganizationByID(ctx context.Context, id string) (Organization, error) {
+	row := q.db.QueryRowContext(ctx, getOrganizationByID, id)
+	var i Organization
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Slug,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listMemberships = `-- name: ListMemberships :many
+SELECT organization_id, user_id, roles, created_at, updated_at
+FROM organization_memberships
+WHERE organization_id = $1
+ORDER BY created_at ASC, user_id ASC
+LIMIT $3 OFFSET $2
+`
+
+type ListMembershipsParams struct {
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	Offset         int32  `db:"offset" json:"offset"`
+	Limit          int32  `db:"limit" json:"limit"`
+}
+
+func (q *Queries) ListMemberships(ctx context.Context, arg ListMembershipsParams) ([]OrganizationMembership, error) {
+	rows, err := q.db.QueryContext(ctx, listMemberships, arg.OrganizationID, arg.Offset, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []OrganizationMembership{}
+	for rows.Next() {
+		var i OrganizationMembership
+		if err := rows.Scan(
+			&i.OrganizationID,
+			&i.UserID,
+			pq.Array(&i.Roles),
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOrganizationsByMember = `-- name: ListOrganizationsByMember :many
+SELECT o.id, o.name, o.slug, o.created_at, o.updated_at
+FROM organizations o
+JOIN organization_memberships m ON m.organization_id = o.id
+WHERE m.user_id = $1
+ORDER BY o.name ASC, o.id ASC
+`
+
+func (q *Queries) ListOrganizationsByMember(ctx context.Context, userID string) ([]Organization, error) {
+	rows, err := q.db.QueryContext(ctx, listOrganizationsByMember, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Organization{}
+	for rows.Next() {
+		var i Organization
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Slug,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertMembership = `-- name: UpsertMembership :exec
+INSERT INTO organization_memberships (organization_id, user_id, roles, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (organization_id, user_id) DO UPDATE SET
+    roles = EXCLUDED.roles,
+    updated_at = EXCLUDED.updated_at
+`
+
+type UpsertMembershipParams struct {
+	OrganizationID string    `db:"organization_id" json:"organization_id"`
+	UserID         string    `db:"user_id" json:"user_id"`
+	Roles          []string  `db:"roles" json:"roles"`
+	CreatedAt      time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt      time.Time `db:"updated_at" json:"updated_at"`
+}
+
+func (q *Queries) UpsertMembership(ctx context.Context, arg UpsertMembershipParams) error {
+	_, err := q.db.ExecContext(ctx, upsertMembership,
+		arg.OrganizationID,
+		arg.UserID,
+		pq.Array(arg.Roles),
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	return err
+}
@@ -0,0 +1,177 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: outbox_events.sql
+
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+)
+
+const createOutboxEvent = `-- name: CreateOutboxEvent :exec
+INSERT INTO outbox_events (id, organization_id, aggregate_type, aggregate_id, event_type, payload, occurred_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+`
+
+type CreateOutboxEventParams struct {
+	ID             string          `db:"id" json:"id"`
+	OrganizationID string          `db:"organization_id" json:"organization_id"`
+	AggregateType  string          `db:"aggregate_type" json:"aggregate_type"`
+	AggregateID    string          `db:"aggregate_id" json:"aggregate_id"`
+	EventType      string          `db:"event_type" json:"event_type"`
+	Payload        json.RawMessage `db:"payload" json:"payload"`
+	OccurredAt     time.Time       `db:"occurred_at" json:"occurred_at"`
+	CreatedAt      time.Time       `db:"created_at" json:"created_at"`
+}
+
+func (q *Queries) CreateOutboxEvent(ctx context.Context, arg CreateOutboxEventParams) error {
+	_, err := q.db.ExecContext(ctx, createOutboxEvent,
+		arg.ID,
+		arg.OrganizationID,
+		arg.AggregateType,
+		arg.AggregateID,
+		arg.EventType,
+		arg.Payload,
+		arg.OccurredAt,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const fetchPendingOutboxEvents = `-- name: FetchPendingOutboxEvents :many
+SELECT id, organization_id, aggregate_type, aggregate_id, event_type, payload, occurred_at,
+       published_at, attempts, last_error, created_at, transaction_id
+FROM outbox_events
+WHERE published_at IS NULL
+ORDER BY id ASC
+LIMIT $1
+FOR UPDATE SKIP LOCKED
+`
+
+// 配信されていないイベントを記録順に取得しロックする（複数のワーカーで同じイベントを配信しない）
+func (q *Queries) FetchPendingOutboxEvents(ctx context.Context, limit int32) ([]OutboxEvent, error) {
+	rows, err := q.db.QueryContext(ctx, fetchPendingOutboxEvents, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []OutboxEvent{}
+	for rows.Next() {
+		var i OutboxEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.AggregateType,
+			&i.AggregateID,
+			&i.EventType,
+			&i.Payload,
+			&i.OccurredAt,
+			&i.PublishedAt,
+			&i.Attempts,
+			&i.LastError,
+			&i.CreatedAt,
+			&i.TransactionID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOutboxEventsAfter = `-- name: ListOutboxEventsAfter :many
+SELECT id, organization_id, aggregate_type, aggregate_id, event_type, payload, occurred_at,
+       published_at, attempts, last_error, created_at, transaction_id
+FROM outbox_events
+WHERE (transaction_id, id) > ($1::bigint, $2::text)
+    AND transaction_id < pg_snapshot_xmin(pg_current_snapshot())::text::bigint
+ORDER BY transaction_id ASC, id ASC
+LIMIT $3
+`
+
+type ListOutboxEventsAfterParams struct {
+	AfterTransactionID int64  `db:"after_transaction_id" json:"after_transaction_id"`
+	AfterID            string `db:"after_id" json:"after_id"`
+	Limit              int32  `db:"limit" json:"limit"`
+}
+
+// 投影が読み進めるイベントを (transaction_id, id) の順に取得する（配信済みかどうかに関係なく）
+// 実行中のトランザクションのうち最も古いものより前のトランザクションが記録したイベントのみを返す
+// （それ以降のトランザクションはまだコミットされうるため、チェックポイントより前のイベントが後から現れないようにする）
+func (q *Queries) ListOutboxEventsAfter(ctx context.Context, arg ListOutboxEventsAfterParams) ([]OutboxEvent, error) {
+	rows, err := q.db.QueryContext(ctx, listOutboxEventsAfter, arg.AfterTransactionID, arg.AfterID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []OutboxEvent{}
+	for rows.Next() {
+		var i OutboxEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.AggregateType,
+			&i.AggregateID,
+			&i.EventType,
+			&i.Payload,
+			&i.OccurredAt,
+			&i.PublishedAt,
+			&i.Attempts,
+			&i.LastError,
+			&i.CreatedAt,
+			&i.TransactionID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markOutboxEventFailed = `-- name: MarkOutboxEventFailed :exec
+UPDATE outbox_events
+SET attempts = attempts + 1, last_error = $2
+WHERE id = $1
+`
+
+type MarkOutboxEventFailedParams struct {
+	ID        string         `db:"id" json:"id"`
+	LastError sql.NullString `db:"last_error" json:"last_error"`
+}
+
+func (q *Queries) MarkOutboxEventFailed(ctx context.Context, arg MarkOutboxEventFailedParams) error {
+	_, err := q.db.ExecContext(ctx, markOutboxEventFailed, arg.ID, arg.LastError)
+	return err
+}
+
+const markOutboxEventPublished = `-- name: MarkOutboxEventPublished :exec
+UPDATE outbox_events
+SET published_at = $2
+WHERE id = $1
+`
+
+type MarkOutboxEventPublishedParams struct {
+	ID          string       `db:"id" json:"id"`
+	PublishedAt sql.NullTime `db:"published_at" json:"published_at"`
+}
+
+func (q *Queries) MarkOutboxEventPublished(ctx context.Context, arg MarkOutboxEventPublishedParams) error {
+	_, err := q.db.ExecContext(ctx, markOutboxEventPublished, arg.ID, arg.PublishedAt)
+	return err
+}
@@ -0,0 +1,203 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: projections.sql
+
+package dao
+
+import (
+	"context"
+	"time"
+)
+
+const clearUserSummaries = `-- name: ClearUserSummaries :exec
+DELETE FROM user_summaries
+`
+
+func (q *Queries) ClearUserSummaries(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, clearUserSummaries)
+	return err
+}
+
+const countUserSummaries = `-- name: CountUserSummaries :one
+SELECT COUNT(*) FROM user_summaries WHERE organization_id = $1
+`
+
+func (q *Queries) CountUserSummaries(ctx context.Context, organizationID string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countUserSummaries, organizationID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deleteUserSummary = `-- name: DeleteUserSummary :exec
+DELETE FROM user_summaries WHERE organization_id = $1 AND id = $2
+`
+
+type DeleteUserSummaryParams struct {
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	ID             string `db:"id" json:"id"`
+}
+
+func (q *Queries) DeleteUserSummary(ctx context.Context, arg DeleteUserSummaryParams) error {
+	_, err := q.db.ExecContext(ctx, deleteUserSummary, arg.OrganizationID, arg.ID)
+	return err
+}
+
+const getProjectionCheckpointForUpdate = `-- name: GetProjectionCheckpointForUpdate :one
+SELECT last_transaction_id, last_event_id FROM projection_checkpoints WHERE name = $1 FOR UPDATE
+`
+
+type GetProjectionCheckpointForUpdateRow struct {
+	LastTransactionID int64  `db:"last_transaction_id" json:"last_transaction_id"`
+	LastEventID       string `db:"last_event_id" json:"last_event_id"`
+}
+
+// 同じ投影を複数のワーカーで同時に進めないよう行ロックする
+func (q *Queries) GetProjectionCheckpointForUpdate(ctx context.Context, name string) (GetProjectionCheckpointForUpdateRow, error) {
+	row := q.db.QueryRowContext(ctx, getProjectionCheckpointForUpdate, name)
+	var i GetProjectionCheckpointForUpdateRow
+	err := row.Scan(&i.LastTransactionID, &i.LastEventID)
+	return i, err
+}
+
+const initProjectionCheckpoint = `-- name: InitProjectionCheckpoint :execrows
+INSERT INTO projection_checkpoints (name, last_event_id, updated_at)
+VALUES ($1, '', $2)
+ON CONFLICT (name) DO NOTHING
+`
+
+type InitProjectionCheckpointParams struct {
+	Name      string    `db:"name" json:"name"`
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+}
+
+// 初めて実行する投影のチェックポイントを作成する（作成した場合は影響行数が1になる）
+func (q *Queries) InitProjectionCheckpoint(ctx context.Context, arg InitProjectionCheckpointParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, initProjectionCheckpoint, arg.Name, arg.UpdatedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const listUserSummaries = `-- name: ListUserSummaries :many
+SELECT id, organization_id, name, email, email_verified_at, email_invalidated_at, created_at, updated_at, log_count, last_activity_at, projected_at
+FROM user_summaries
+WHERE organization_id = $1
+ORDER BY created_at DESC
+LIMIT $3 OFFSET $2
+`
+
+type ListUserSummariesParams struct {
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	Offset         int32  `db:"offset" json:"offset"`
+	Limit          int32  `db:"limit" json:"limit"`
+}
+
+func (q *Queries) ListUserSummaries(ctx context.Context, arg ListUserSummariesParams) ([]UserSummary, error) {
+	rows, err := q.db.QueryContext(ctx, listUserSummaries, arg.OrganizationID, arg.Offset, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []UserSummary{}
+	for rows.Next() {
+		var i UserSummary
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.Name,
+			&i.Email,
+			&i.EmailVerifiedAt,
+			&i.EmailInvalidatedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.LogCount,
+			&i.LastActivityAt,
+			&i.ProjectedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const refreshUserSummary = `-- name: RefreshUserSummary :execrows
+INSERT INTO user_summaries (id, organization_id, name, email, email_verified_at, email_invalidated_at, created_at, updated_at, log_count, last_activity_at, projected_at)
+SELECT u.id, u.organization_id, u.name, u.email, u.email_verified_at, u.email_invalidated_at, u.created_at, u.updated_at,
+       COUNT(l.id)::INTEGER, MAX(l.created_at), $1
+FROM users u
+LEFT JOIN user_logs l ON l.organization_id = u.organization_id AND l.user_id = u.id
+WHERE u.organization_id = $2 AND u.id = $3
+GROUP BY u.id
+ON CONFLICT (id) DO UPDATE SET
+    name = EXCLUDED.name,
+    email = EXCLUDED.email,
+    email_verified_at = EXCLUDED.email_verified_at,
+    email_invalidated_at = EXCLUDED.email_invalidated_at,
+    updated_at = EXCLUDED.updated_at,
+    log_count = EXCLUDED.log_count,
+    last_activity_at = EXCLUDED.last_activity_at,
+    projected_at = EXCLUDED.projected_at
+`
+
+type RefreshUserSummaryParams struct {
+	ProjectedAt    time.Time `db:"projected_at" json:"projected_at"`
+	OrganizationID string    `db:"organization_id" json:"organization_id"`
+	ID             string    `db:"id" json:"id"`
+}
+
+// ユーザーとユーザーログの現在の状態から一覧の行を作り直す（ユーザーが存在しない場合は影響行数が0になる）
+func (q *Queries) RefreshUserSummary(ctx context.Context, arg RefreshUserSummaryParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, refreshUserSummary, arg.ProjectedAt, arg.OrganizationID, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const seedUserSummaries = `-- name: SeedUserSummaries :exec
+INSERT INTO user_summaries (id, organization_id, name, email, email_verified_at, email_invalidated_at, created_at, updated_at, log_count, last_activity_at, projected_at)
+SELECT u.id, u.organization_id, u.name, u.email, u.email_verified_at, u.email_invalidated_at, u.created_at, u.updated_at,
+       COUNT(l.id)::INTEGER, MAX(l.created_at), $1
+FROM users u
+LEFT JOIN user_logs l ON l.organization_id = u.organization_id AND l.user_id = u.id
+GROUP BY u.id
+`
+
+// すべての組織のユーザーの一覧の行を作る（投影を作り直す際に、イベントのない既存のユーザーも含めるため）
+func (q *Queries) SeedUserSummaries(ctx context.Context, projectedAt time.Time) error {
+	_, err := q.db.ExecContext(ctx, seedUserSummaries, projectedAt)
+	return err
+}
+
+const updateProjectionCheckpoint = `-- name: UpdateProjectionCheckpoint :exec
+UPDATE projection_checkpoints
+SET last_transaction_id = $2, last_event_id = $3, updated_at = $4
+WHERE name = $1
+`
+
+type UpdateProjectionCheckpointParams struct {
+	Name              string    `db:"name" json:"name"`
+	LastTransactionID int64     `db:"last_transaction_id" json:"last_transaction_id"`
+	LastEventID       string    `db:"last_event_id" json:"last_event_id"`
+	UpdatedAt         time.Time `db:"updated_at" json:"updated_at"`
+}
+
+func (q *Queries) UpdateProjectionCheckpoint(ctx context.Context, arg UpdateProjectionCheckpointParams) error {
+	_, err := q.db.ExecContext(ctx, updateProjectionCheckpoint,
+		arg.Name,
+		arg.LastTransactionID,
+		arg.LastEventID,
+		arg.UpdatedAt,
+	)
+	return err
+}
@@ -7,32 +7,183 @@ package dao
 import (
 	"context"
 	"database/sql"
+	"time"
 )
 
 type Querier interface {
+	// 期限切れのキーと、同じリクエストでリースが切れた処理中のキーは上書きする
+	AcquireIdempotencyKey(ctx context.Context, arg AcquireIdempotencyKeyParams) (int64, error)
+	AppendAggregateEvent(ctx context.Context, arg AppendAggregateEventParams) error
+	ClearUserSummaries(ctx context.Context) error
+	// リースを持つリクエストのレスポンスを記録する（ほかのリクエストに引き継がれた場合は影響行数が0になる）
+	CompleteIdempotencyKey(ctx context.Context, arg CompleteIdempotencyKeyParams) (int64, error)
+	CountAPIKeys(ctx context.Context, arg CountAPIKeysParams) (int64, error)
+	CountAuditEvents(ctx context.Context, arg CountAuditEventsParams) (int64, error)
+	CountInboundEvents(ctx context.Context, arg CountInboundEventsParams) (int64, error)
 	CountJobsByStatus(ctx context.Context, status string) (int64, error)
-	CountUserLogsByUserID(ctx context.Context, userID string) (int64, error)
-	CountUsers(ctx context.Context) (int64, error)
+	CountMemberships(ctx context.Context, organizationID string) (int64, error)
+	CountUserImportRows(ctx context.Context, arg CountUserImportRowsParams) (int64, error)
+	CountUserImportRowsByStatus(ctx context.Context, importID string) ([]CountUserImportRowsByStatusRow, error)
+	CountUserLogsByUserID(ctx context.Context, arg CountUserLogsByUserIDParams) (int64, error)
+	// ユーザーごとのログの件数（ログのないユーザーは含まれない）
+	CountUserLogsByUserIDs(ctx context.Context, arg CountUserLogsByUserIDsParams) ([]CountUserLogsByUserIDsRow, error)
+	CountUserSummaries(ctx context.Context, organizationID string) (int64, error)
+	CountUsers(ctx context.Context, organizationID string) (int64, error)
+	CountWebhookDeliveries(ctx context.Context, arg CountWebhookDeliveriesParams) (int64, error)
+	CountWebhookSubscriptions(ctx context.Context, organizationID string) (int64, error)
+	CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) error
+	CreateAuditEvent(ctx context.Context, arg CreateAuditEventParams) error
+	// ReserveChangeIDs で採番したIDで保存する（settle_xid は既定値で記録する）
+	CreateChange(ctx context.Context, arg CreateChangeParams) error
+	// 同じプロバイダーの同じイベントIDを受信済みの場合は何もしない（影響行数が0になる）
+	CreateInboundEvent(ctx context.Context, arg CreateInboundEventParams) (int64, error)
+	// 記録のないメールアドレスでも行ロックで同時のログインを順に処理できるよう、失敗回数0の行を作成する
+	// （同じ行を同時に作成しようとしたトランザクションは、先に作成したトランザクションの終了を待つ）
+	CreateLoginFailuresIfNotExists(ctx context.Context, arg CreateLoginFailuresIfNotExistsParams) error
+	CreateOrganization(ctx context.Context, arg CreateOrganizationParams) error
+	CreateOutboxEvent(ctx context.Context, arg CreateOutboxEventParams) error
+	CreateSession(ctx context.Context, arg CreateSessionParams) error
 	CreateUser(ctx context.Context, arg CreateUserParams) error
+	CreateUserImport(ctx context.Context, arg CreateUserImportParams) error
+	CreateUserImportRow(ctx context.Context, arg CreateUserImportRowParams) error
 	CreateUserLog(ctx context.Context, arg CreateUserLogParams) error
+	CreateUserToken(ctx context.Context, arg CreateUserTokenParams) error
+	CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) error
+	CreateWebhookSubscription(ctx context.Context, arg CreateWebhookSubscriptionParams) error
+	DeleteChangesBefore(ctx context.Context, createdAt time.Time) (int64, error)
 	DeleteCompletedJobsBefore(ctx context.Context, completedAt sql.NullTime) error
-	DeleteUser(ctx context.Context, id string) error
+	DeleteExpiredIdempotencyKeys(ctx context.Context, expiresAt time.Time) (int64, error)
+	DeleteIdempotencyKey(ctx context.Context, arg DeleteIdempotencyKeyParams) error
+	DeleteLoginFailures(ctx context.Context, arg DeleteLoginFailuresParams) error
+	DeleteMembership(ctx context.Context, arg DeleteMembershipParams) error
+	// ロックの時間より前に最後に失敗した記録を削除する（ロック中の記録は削除されない）
+	DeleteStaleLoginFailures(ctx context.Context, before time.Time) (int64, error)
+	DeleteUser(ctx context.Context, arg DeleteUserParams) error
+	DeleteUserSummary(ctx context.Context, arg DeleteUserSummaryParams) error
+	DeleteWebhookDeliveries(ctx context.Context, arg DeleteWebhookDeliveriesParams) error
+	DeleteWebhookSubscription(ctx context.Context, arg DeleteWebhookSubscriptionParams) error
 	EnqueueJob(ctx context.Context, arg EnqueueJobParams) error
+	// 存在しない場合のみ作成する（既定の組織の作成に使用）
+	EnsureOrganization(ctx context.Context, arg EnsureOrganizationParams) error
+	// 処理中のキーのリースを延長する（ほかのリクエストに引き継がれた場合は影響行数が0になる）
+	ExtendIdempotencyKeyLease(ctx context.Context, arg ExtendIdempotencyKeyLeaseParams) (int64, error)
 	FetchJobs(ctx context.Context, limit int32) ([]Job, error)
+	// 配信されていないイベントを記録順に取得しロックする（複数のワーカーで同じイベントを配信しない）
+	FetchPendingOutboxEvents(ctx context.Context, limit int32) ([]OutboxEvent, error)
+	GetAPIKeyByID(ctx context.Context, arg GetAPIKeyByIDParams) (ApiKey, error)
+	GetAPIKeyByIDForUpdate(ctx context.Context, arg GetAPIKeyByIDForUpdateParams) (ApiKey, error)
+	GetAPIKeyByPrefix(ctx context.Context, prefix string) (ApiKey, error)
+	GetAggregateSnapshot(ctx context.Context, arg GetAggregateSnapshotParams) (AggregateSnapshot, error)
+	GetIdempotencyKey(ctx context.Context, idempotencyKey string) (IdempotencyKey, error)
+	GetInboundEventByID(ctx context.Context, arg GetInboundEventByIDParams) (InboundEvent, error)
+	GetInboundEventByIDForUpdate(ctx context.Context, arg GetInboundEventByIDForUpdateParams) (InboundEvent, error)
 	GetJobByID(ctx context.Context, id string) (Job, error)
-	GetUserByEmail(ctx context.Context, email string) (User, error)
-	GetUserByEmailForUpdate(ctx context.Context, email string) (User, error)
-	GetUserByID(ctx context.Context, id string) (User, error)
-	GetUserByIDForUpdate(ctx context.Context, id string) (User, error)
+	// 組織の最新の確定した変更のID（変更がない場合は0）
+	// 確定していない変更より前で止め、それより小さいIDでまだコミットされていない変更を読み飛ばさないようにする
+	GetLatestChangeID(ctx context.Context, organizationID string) (int64, error)
+	// すべての組織の最新の確定した変更のID（変更がない場合は0、確定の扱いは GetLatestChangeID と同じ）
+	GetLatestChangeIDInAllOrganizations(ctx context.Context) (int64, error)
+	GetLoginFailuresForUpdate(ctx context.Context, arg GetLoginFailuresForUpdateParams) (LoginFailure, error)
+	GetMembership(ctx context.Context, arg GetMembershipParams) (OrganizationMembership, error)
+	GetMembershipForUpdate(ctx context.Context, arg GetMembershipForUpdateParams) (OrganizationMembership, error)
+	// 保持している最も古い変更のID（変更がない場合は0）
+	GetOldestChangeID(ctx context.Context) (int64, error)
+	GetOrganizationByID(ctx context.Context, id string) (Organization, error)
+	// 同じ投影を複数のワーカーで同時に進めないよう行ロックする
+	GetProjectionCheckpointForUpdate(ctx context.Context, name string) (GetProjectionCheckpointForUpdateRow, error)
+	GetSessionByID(ctx context.Context, arg GetSessionByIDParams) (Session, error)
+	GetSessionByIDForUpdate(ctx context.Context, arg GetSessionByIDForUpdateParams) (Session, error)
+	GetSessionByTokenHash(ctx context.Context, tokenHash string) (Session, error)
+	GetUserByEmail(ctx context.Context, arg GetUserByEmailParams) (User, error)
+	GetUserByEmailForUpdate(ctx context.Context, arg GetUserByEmailForUpdateParams) (User, error)
+	GetUserByID(ctx context.Context, arg GetUserByIDParams) (User, error)
+	GetUserByIDForUpdate(ctx context.Context, arg GetUserByIDForUpdateParams) (User, error)
+	GetUserImportByID(ctx context.Context, arg GetUserImportByIDParams) (UserImport, error)
+	GetUserImportByIDForUpdate(ctx context.Context, arg GetUserImportByIDForUpdateParams) (UserImport, error)
+	GetUserLogChainHead(ctx context.Context, organizationID string) (GetUserLogChainHeadRow, error)
+	GetUserLogChainHeadForUpdate(ctx context.Context, organizationID string) (GetUserLogChainHeadForUpdateRow, error)
 	GetUserLogsByUserID(ctx context.Context, arg GetUserLogsByUserIDParams) ([]UserLog, error)
+	GetUserTokenByHashForUpdate(ctx context.Context, tokenHash string) (UserToken, error)
+	// イベントの配信を試みた回数と、成功した配信があるかどうか
+	GetWebhookEventDeliveryState(ctx context.Context, arg GetWebhookEventDeliveryStateParams) (GetWebhookEventDeliveryStateRow, error)
+	GetWebhookSubscriptionByID(ctx context.Context, arg GetWebhookSubscriptionByIDParams) (WebhookSubscription, error)
+	GetWebhookSubscriptionByIDForUpdate(ctx context.Context, arg GetWebhookSubscriptionByIDForUpdateParams) (WebhookSubscription, error)
+	// 初めて実行する投影のチェックポイントを作成する（作成した場合は影響行数が1になる）
+	InitProjectionCheckpoint(ctx context.Context, arg InitProjectionCheckpointParams) (int64, error)
+	InitUserLogChainHead(ctx context.Context, organizationID string) error
+	// 未使用のトークンを使用済みにする（新しいトークンの発行時やパスワードの再設定後に古いリンクを無効にする）
+	InvalidateUserTokens(ctx context.Context, arg InvalidateUserTokensParams) error
+	ListAPIKeys(ctx context.Context, arg ListAPIKeysParams) ([]ApiKey, error)
+	ListActiveSessionsByUserID(ctx context.Context, arg ListActiveSessionsByUserIDParams) ([]Session, error)
+	ListActiveWebhookSubscriptionsByEventType(ctx context.Context, arg ListActiveWebhookSubscriptionsByEventTypeParams) ([]WebhookSubscription, error)
+	// 指定した連番より後のイベントを連番順に取得する
+	ListAggregateEvents(ctx context.Context, arg ListAggregateEventsParams) ([]AggregateEvent, error)
+	ListAuditEvents(ctx context.Context, arg ListAuditEventsParams) ([]AuditEvent, error)
+	// 指定したIDより後の変更を古い順に取得する
+	// settled が偽の変更は、より小さいIDの変更がまだコミットされる可能性があるため、その変更から先は読み進めない
+	ListChangesAfter(ctx context.Context, arg ListChangesAfterParams) ([]ListChangesAfterRow, error)
+	// すべての組織の、指定したIDより後の変更を古い順に取得する（キャッシュの削除に使う、settled は ListChangesAfter と同じ）
+	ListChangesAfterInAllOrganizations(ctx context.Context, arg ListChangesAfterInAllOrganizationsParams) ([]ListChangesAfterInAllOrganizationsRow, error)
+	ListInboundEvents(ctx context.Context, arg ListInboundEventsParams) ([]InboundEvent, error)
 	ListJobsByStatus(ctx context.Context, arg ListJobsByStatusParams) ([]Job, error)
+	ListMemberships(ctx context.Context, arg ListMembershipsParams) ([]OrganizationMembership, error)
+	ListOrganizationsByMember(ctx context.Context, userID string) ([]Organization, error)
+	// 投影が読み進めるイベントを (transaction_id, id) の順に取得する（配信済みかどうかに関係なく）
+	// 実行中のトランザクションのうち最も古いものより前のトランザクションが記録したイベントのみを返す
+	// （それ以降のトランザクションはまだコミットされうるため、チェックポイントより前のイベントが後から現れないようにする）
+	ListOutboxEventsAfter(ctx context.Context, arg ListOutboxEventsAfterParams) ([]OutboxEvent, error)
+	ListUserImportRowLines(ctx context.Context, importID string) ([]int32, error)
+	ListUserImportRows(ctx context.Context, arg ListUserImportRowsParams) ([]UserImportRow, error)
+	ListUserLogChain(ctx context.Context, arg ListUserLogChainParams) ([]UserLog, error)
+	// ユーザーごとにログを新しい順に並べ、それぞれの offset 件目から limit 件を取得（複数のユーザーのログを1回で読む）
+	ListUserLogsByUserIDs(ctx context.Context, arg ListUserLogsByUserIDsParams) ([]UserLog, error)
+	// すべての組織からメールアドレスが一致するユーザーの組織を探す（組織を区別しない外部からの通知の処理用）
+	ListUserOrganizationIDsByEmail(ctx context.Context, email string) ([]string, error)
+	ListUserSummaries(ctx context.Context, arg ListUserSummariesParams) ([]UserSummary, error)
 	ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error)
+	// 一覧と同じ順序（作成日時の降順、同じ日時はIDの降順）で先頭から取得する（キーセットページネーションの最初のページ）
+	ListUsersForStream(ctx context.Context, arg ListUsersForStreamParams) ([]User, error)
+	// ListUsersForStream の続きとして、指定したユーザーより後のユーザーを取得する
+	ListUsersForStreamAfter(ctx context.Context, arg ListUsersForStreamAfterParams) ([]User, error)
+	ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]WebhookDelivery, error)
+	ListWebhookSubscriptions(ctx context.Context, arg ListWebhookSubscriptionsParams) ([]WebhookSubscription, error)
 	MarkJobCompleted(ctx context.Context, id string) error
 	MarkJobDead(ctx context.Context, arg MarkJobDeadParams) error
 	MarkJobProcessing(ctx context.Context, id string) error
 	MarkJobRetryable(ctx context.Context, arg MarkJobRetryableParams) error
+	MarkOutboxEventFailed(ctx context.Context, arg MarkOutboxEventFailedParams) error
+	MarkOutboxEventPublished(ctx context.Context, arg MarkOutboxEventPublishedParams) error
+	// 変更をほかのサーバーのリスナーに通知する（コミット時に配信される）
+	NotifyChangeFeed(ctx context.Context, organizationID string) error
+	// ユーザーとユーザーログの現在の状態から一覧の行を作り直す（ユーザーが存在しない場合は影響行数が0になる）
+	RefreshUserSummary(ctx context.Context, arg RefreshUserSummaryParams) (int64, error)
+	// 変更通知のIDを先に採番する
+	// CreateChange の settle_xid を採番より後の文で記録し、採番より前に始まったトランザクションを必ず含めるため
+	ReserveChangeIDs(ctx context.Context, count int32) ([]int64, error)
+	RevokeAPIKey(ctx context.Context, arg RevokeAPIKeyParams) error
+	RevokeSession(ctx context.Context, arg RevokeSessionParams) error
+	// except_id のセッション（操作中のセッションなど）は失効させない
+	RevokeUserSessions(ctx context.Context, arg RevokeUserSessionsParams) error
+	// すべての組織のユーザーの一覧の行を作る（投影を作り直す際に、イベントのない既存のユーザーも含めるため）
+	SeedUserSummaries(ctx context.Context, projectedAt time.Time) error
+	// ユーザーごとのログの件数と最新の日時（ログのないユーザーは含まれない）
+	SummarizeUserLogsByUserIDs(ctx context.Context, arg SummarizeUserLogsByUserIDsParams) ([]SummarizeUserLogsByUserIDsRow, error)
+	// 書き込みを減らすため、前回の記録から1分以上経っている場合のみ更新する
+	TouchAPIKeyLastUsed(ctx context.Context, arg TouchAPIKeyLastUsedParams) error
+	// 書き込みを減らすため、前回の記録から1分以上経っている場合のみ更新する
+	TouchSessionLastSeen(ctx context.Context, arg TouchSessionLastSeenParams) error
+	UpdateInboundEventStatus(ctx context.Context, arg UpdateInboundEventStatusParams) error
+	UpdateProjectionCheckpoint(ctx context.Context, arg UpdateProjectionCheckpointParams) error
 	UpdateUser(ctx context.Context, arg UpdateUserParams) error
-	UpsertUser(ctx context.Context, arg UpsertUserParams) error
+	UpdateUserImportStatus(ctx context.Context, arg UpdateUserImportStatusParams) error
+	UpdateUserLogChainHead(ctx context.Context, arg UpdateUserLogChainHeadParams) error
+	UpdateWebhookSubscription(ctx context.Context, arg UpdateWebhookSubscriptionParams) error
+	// 古いスナップショットで新しいものを上書きしない
+	UpsertAggregateSnapshot(ctx context.Context, arg UpsertAggregateSnapshotParams) error
+	UpsertLoginFailures(ctx context.Context, arg UpsertLoginFailuresParams) error
+	UpsertMembership(ctx context.Context, arg UpsertMembershipParams) error
+	// 別の組織の同じIDのユーザーは上書きしない（影響行数が0になる）
+	UpsertUser(ctx context.Context, arg UpsertUserParams) (int64, error)
 }
 
 var _ Querier = (*Queries)(nil)
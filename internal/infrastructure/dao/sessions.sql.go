@@ -0,0 +1,236 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: sessions.sql
+
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const createSession = `-- name: CreateSession :exec
+INSERT INTO sessions (id, user_id, organization_id, token_hash, remote_addr, user_agent, created_at, last_seen_at, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+`
+
+type CreateSessionParams struct {
+	ID             string    `db:"id" json:"id"`
+	UserID         string    `db:"user_id" json:"user_id"`
+	OrganizationID string    `db:"organization_id" json:"organization_id"`
+	TokenHash      string    `db:"token_hash" json:"token_hash"`
+	RemoteAddr     string    `db:"remote_addr" json:"remote_addr"`
+	UserAgent      string    `db:"user_agent" json:"user_agent"`
+	CreatedAt      time.Time `db:"created_at" json:"created_at"`
+	LastSeenAt     time.Time `db:"last_seen_at" json:"last_seen_at"`
+	ExpiresAt      time.Time `db:"expires_at" json:"expires_at"`
+}
+
+func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) error {
+	_, err := q.db.ExecContext(ctx, createSession,
+		arg.ID,
+		arg.UserID,
+		arg.OrganizationID,
+		arg.TokenHash,
+		arg.RemoteAddr,
+		arg.UserAgent,
+		arg.CreatedAt,
+		arg.LastSeenAt,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const getSessionByID = `-- name: GetSessionByID :one
+SELECT id, user_id, organization_id, token_hash, remote_addr, user_agent, created_at, last_seen_at, expires_at, revoked_at
+FROM sessions
+WHERE organization_id = $1 AND id = $2
+`
+
+type GetSessionByIDParams struct {
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	ID             string `db:"id" json:"id"`
+}
+
+func (q *Queries) GetSessionByID(ctx context.Context, arg GetSessionByIDParams) (Session, error) {
+	row := q.db.QueryRowContext(ctx, getSessionByID, arg.OrganizationID, arg.ID)
+	var i Session
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.OrganizationID,
+		&i.TokenHash,
+		&i.RemoteAddr,
+		&i.UserAgent,
+		&i.CreatedAt,
+		&i.LastSeenAt,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const getSessionByIDForUpdate = `-- name: GetSessionByIDForUpdate :one
+SELECT id, user_id, organization_id, token_hash, remote_addr, user_agent, created_at, last_seen_at, expires_at, revoked_at
+FROM sessions
+WHERE organization_id = $1 AND id = $2
+FOR UPDATE
+`
+
+type GetSessionByIDForUpdateParams struct {
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	ID             string `db:"id" json:"id"`
+}
+
+func (q *Queries) GetSessionByIDForUpdate(ctx context.Context, arg GetSessionByIDForUpdateParams) (Session, error) {
+	row := q.db.QueryRowContext(ctx, getSessionByIDForUpdate, arg.OrganizationID, arg.ID)
+	var i Session
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.OrganizationID,
+		&i.TokenHash,
+		&i.RemoteAddr,
+		&i.UserAgent,
+		&i.CreatedAt,
+		&i.LastSeenAt,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const getSessionByTokenHash = `-- name: GetSessionByTokenHash :one
+SELECT id, user_id, organization_id, token_hash, remote_addr, user_agent, created_at, last_seen_at, expires_at, revoked_at
+FROM sessions
+WHERE token_hash = $1
+`
+
+func (q *Queries) GetSessionByTokenHash(ctx context.Context, tokenHash string) (Session, error) {
+	row := q.db.QueryRowContext(ctx, getSessionByTokenHash, tokenHash)
+	var i Session
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.OrganizationID,
+		&i.TokenHash,
+		&i.RemoteAddr,
+		&i.UserAgent,
+		&i.CreatedAt,
+		&i.LastSeenAt,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const listActiveSessionsByUserID = `-- name: ListActiveSessionsByUserID :many
+SELECT id, user_id, organization_id, token_hash, remote_addr, user_agent, created_at, last_seen_at, expires_at, revoked_at
+FROM sessions
+WHERE organization_id = $1
+  AND user_id = $2
+  AND revoked_at IS NULL
+  AND expires_at > $3
+ORDER BY created_at DESC, id DESC
+`
+
+type ListActiveSessionsByUserIDParams struct {
+	OrganizationID string    `db:"organization_id" json:"organization_id"`
+	UserID         string    `db:"user_id" json:"user_id"`
+	Now            time.Time `db:"now" json:"now"`
+}
+
+func (q *Queries) ListActiveSessionsByUserID(ctx context.Context, arg ListActiveSessionsByUserIDParams) ([]Session, error) {
+	rows, err := q.db.QueryContext(ctx, listActiveSessionsByUserID, arg.OrganizationID, arg.UserID, arg.Now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Session{}
+	for rows.Next() {
+		var i Session
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.OrganizationID,
+			&i.TokenHash,
+			&i.RemoteAddr,
+			&i.UserAgent,
+			&i.CreatedAt,
+			&i.LastSeenAt,
+			&i.ExpiresAt,
+			&i.RevokedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const revokeSession = `-- name: RevokeSession :exec
+UPDATE sessions SET revoked_at = $1
+WHERE organization_id = $2 AND id = $3 AND revoked_at IS NULL
+`
+
+type RevokeSessionParams struct {
+	RevokedAt      sql.NullTime `db:"revoked_at" json:"revoked_at"`
+	OrganizationID string       `db:"organization_id" json:"organization_id"`
+	ID             string       `db:"id" json:"id"`
+}
+
+func (q *Queries) RevokeSession(ctx context.Context, arg RevokeSessionParams) error {
+	_, err := q.db.ExecContext(ctx, revokeSession, arg.RevokedAt, arg.OrganizationID, arg.ID)
+	return err
+}
+
+const revokeUserSessions = `-- name: RevokeUserSessions :exec
+UPDATE sessions SET revoked_at = $1
+WHERE organization_id = $2
+  AND user_id = $3
+  AND id <> $4
+  AND revoked_at IS NULL
+`
+
+type RevokeUserSessionsParams struct {
+	RevokedAt      sql.NullTime `db:"revoked_at" json:"revoked_at"`
+	OrganizationID string       `db:"organization_id" json:"organization_id"`
+	UserID         string       `db:"user_id" json:"user_id"`
+	ExceptID       string       `db:"except_id" json:"except_id"`
+}
+
+// except_id のセッション（操作中のセッションなど）は失効させない
+func (q *Queries) RevokeUserSessions(ctx context.Context, arg RevokeUserSessionsParams) error {
+	_, err := q.db.ExecContext(ctx, revokeUserSessions,
+		arg.RevokedAt,
+		arg.OrganizationID,
+		arg.UserID,
+		arg.ExceptID,
+	)
+	return err
+}
+
+const touchSessionLastSeen = `-- name: TouchSessionLastSeen :exec
+UPDATE sessions SET last_seen_at = $1
+WHERE id = $2
+  AND last_seen_at < $1::timestamp - INTERVAL '1 minute'
+`
+
+type TouchSessionLastSeenParams struct {
+	SeenAt time.Time `db:"seen_at" json:"seen_at"`
+	ID     string    `db:"id" json:"id"`
+}
+
+// 書き込みを減らすため、前回の記録から1分以上経っている場合のみ更新する
+func (q *Queries) TouchSessionLastSeen(ctx context.Context, arg TouchSessionLastSeenParams) error {
+	_, err := q.db.ExecContext(ctx, touchSessionLastSeen, arg.SeenAt, arg.ID)
+	return err
+}
@@ -0,0 +1,291 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: user_imports.sql
+
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const countUserImportRows = `-- name: CountUserImportRows :one
+SELECT COUNT(*) FROM user_import_rows
+WHERE import_id = $1
+  AND ($2::varchar IS NULL OR status = $2)
+`
+
+type CountUserImportRowsParams struct {
+	ImportID string         `db:"import_id" json:"import_id"`
+	Status   sql.NullString `db:"status" json:"status"`
+}
+
+func (q *Queries) CountUserImportRows(ctx context.Context, arg CountUserImportRowsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countUserImportRows, arg.ImportID, arg.Status)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countUserImportRowsByStatus = `-- name: CountUserImportRowsByStatus :many
+SELECT status, COUNT(*) AS count
+FROM user_import_rows
+WHERE import_id = $1
+GROUP BY status
+`
+
+type CountUserImportRowsByStatusRow struct {
+	Status string `db:"status" json:"status"`
+	Count  int64  `db:"count" json:"count"`
+}
+
+func (q *Queries) CountUserImportRowsByStatus(ctx context.Context, importID string) ([]CountUserImportRowsByStatusRow, error) {
+	rows, err := q.db.QueryContext(ctx, countUserImportRowsByStatus, importID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CountUserImportRowsByStatusRow{}
+	for rows.Next() {
+		var i CountUserImportRowsByStatusRow
+		if err := rows.Scan(&i.Status, &i.Count); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createUserImport = `-- name: CreateUserImport :exec
+INSERT INTO user_imports (id, organization_id, format, status, source, total_rows, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+`
+
+type CreateUserImportParams struct {
+	ID             string    `db:"id" json:"id"`
+	OrganizationID string    `db:"organization_id" json:"organization_id"`
+	Format         string    `db:"format" json:"format"`
+	Status         string    `db:"status" json:"status"`
+	Source         []byte    `db:"source" json:"source"`
+	TotalRows      int32     `db:"total_rows" json:"total_rows"`
+	CreatedAt      time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt      time.Time `db:"updated_at" json:"updated_at"`
+}
+
+func (q *Queries) CreateUserImport(ctx context.Context, arg CreateUserImportParams) error {
+	_, err := q.db.ExecContext(ctx, createUserImport,
+		arg.ID,
+		arg.OrganizationID,
+		arg.Format,
+		arg.Status,
+		arg.Source,
+		arg.TotalRows,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	return err
+}
+
+const createUserImportRow = `-- name: CreateUserImportRow :exec
+INSERT INTO user_import_rows (import_id, line, status, name, email, user_id, reason, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+`
+
+type CreateUserImportRowParams struct {
+	ImportID  string         `db:"import_id" json:"import_id"`
+	Line      int32          `db:"line" json:"line"`
+	Status    string         `db:"status" json:"status"`
+	Name      string         `db:"name" json:"name"`
+	Email     string         `db:"email" json:"email"`
+	UserID    sql.NullString `db:"user_id" json:"user_id"`
+	Reason    sql.NullString `db:"reason" json:"reason"`
+	CreatedAt time.Time      `db:"created_at" json:"created_at"`
+}
+
+func (q *Queries) CreateUserImportRow(ctx context.Context, arg CreateUserImportRowParams) error {
+	_, err := q.db.ExecContext(ctx, createUserImportRow,
+		arg.ImportID,
+		arg.Line,
+		arg.Status,
+		arg.Name,
+		arg.Email,
+		arg.UserID,
+		arg.Reason,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const getUserImportByID = `-- name: GetUserImportByID :one
+SELECT id, organization_id, format, status, source, total_rows, last_error, created_at, updated_at, completed_at
+FROM user_imports
+WHERE organization_id = $1 AND id = $2
+`
+
+type GetUserImportByIDParams struct {
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	ID             string `db:"id" json:"id"`
+}
+
+func (q *Queries) GetUserImportByID(ctx context.Context, arg GetUserImportByIDParams) (UserImport, error) {
+	row := q.db.QueryRowContext(ctx, getUserImportByID, arg.OrganizationID, arg.ID)
+	var i UserImport
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Format,
+		&i.Status,
+		&i.Source,
+		&i.TotalRows,
+		&i.LastError,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CompletedAt,
+	)
+	return i, err
+}
+
+const getUserImportByIDForUpdate = `-- name: GetUserImportByIDForUpdate :one
+SELECT id, organization_id, format, status, source, total_rows, last_error, created_at, updated_at, completed_at
+FROM user_imports
+WHERE organization_id = $1 AND id = $2
+FOR UPDATE
+`
+
+type GetUserImportByIDForUpdateParams struct {
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	ID             string `db:"id" json:"id"`
+}
+
+func (q *Queries) GetUserImportByIDForUpdate(ctx context.Context, arg GetUserImportByIDForUpdateParams) (UserImport, error) {
+	row := q.db.QueryRowContext(ctx, getUserImportByIDForUpdate, arg.OrganizationID, arg.ID)
+	var i UserImport
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Format,
+		&i.Status,
+		&i.Source,
+		&i.TotalRows,
+		&i.LastError,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CompletedAt,
+	)
+	return i, err
+}
+
+const listUserImportRowLines = `-- name: ListUserImportRowLines :many
+SELECT line FROM user_import_rows WHERE import_id = $1
+`
+
+func (q *Queries) ListUserImportRowLines(ctx context.Context, importID string) ([]int32, error) {
+	rows, err := q.db.QueryContext(ctx, listUserImportRowLines, importID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int32{}
+	for rows.Next() {
+		var line int32
+		if err := rows.Scan(&line); err != nil {
+			return nil, err
+		}
+		items = append(items, line)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserImportRows = `-- name: ListUserImportRows :many
+SELECT import_id, line, status, name, email, user_id, reason, created_at
+FROM user_import_rows
+WHERE import_id = $1
+  AND ($2::varchar IS NULL OR status = $2)
+ORDER BY line ASC
+LIMIT $4 OFFSET $3
+`
+
+type ListUserImportRowsParams struct {
+	ImportID string         `db:"import_id" json:"import_id"`
+	Status   sql.NullString `db:"status" json:"status"`
+	Offset   int32          `db:"offset" json:"offset"`
+	Limit    int32          `db:"limit" json:"limit"`
+}
+
+func (q *Queries) ListUserImportRows(ctx context.Context, arg ListUserImportRowsParams) ([]UserImportRow, error) {
+	rows, err := q.db.QueryContext(ctx, listUserImportRows,
+		arg.ImportID,
+		arg.Status,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []UserImportRow{}
+	for rows.Next() {
+		var i UserImportRow
+		if err := rows.Scan(
+			&i.ImportID,
+			&i.Line,
+			&i.Status,
+			&i.Name,
+			&i.Email,
+			&i.UserID,
+			&i.Reason,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateUserImportStatus = `-- name: UpdateUserImportStatus :exec
+UPDATE user_imports
+SET status = $1, last_error = $2, updated_at = $3, completed_at = $4
+WHERE organization_id = $5 AND id = $6
+`
+
+type UpdateUserImportStatusParams struct {
+	Status         string         `db:"status" json:"status"`
+	LastError      sql.NullString `db:"last_error" json:"last_error"`
+	UpdatedAt      time.Time      `db:"updated_at" json:"updated_at"`
+	CompletedAt    sql.NullTime   `db:"completed_at" json:"completed_at"`
+	OrganizationID string         `db:"organization_id" json:"organization_id"`
+	ID             string         `db:"id" json:"id"`
+}
+
+func (q *Queries) UpdateUserImportStatus(ctx context.Context, arg UpdateUserImportStatusParams) error {
+	_, err := q.db.ExecContext(ctx, updateUserImportStatus,
+		arg.Status,
+		arg.LastError,
+		arg.UpdatedAt,
+		arg.CompletedAt,
+		arg.OrganizationID,
+		arg.ID,
+	)
+	return err
+}
@@ -7,58 +7,173 @@ package dao
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
 	"time"
+
+	"github.com/lib/pq"
 )
 
 const countUserLogsByUserID = `-- name: CountUserLogsByUserID :one
-SELECT COUNT(*) FROM user_logs WHERE user_id = $1
+SELECT COUNT(*) FROM user_logs
+WHERE organization_id = $1
+  AND user_id = $2
+  AND ($3::varchar IS NULL OR action = $3)
 `
 
-func (q *Queries) CountUserLogsByUserID(ctx context.Context, userID string) (int64, error) {
-	row := q.db.QueryRowContext(ctx, countUserLogsByUserID, userID)
+type CountUserLogsByUserIDParams struct {
+	OrganizationID string         `db:"organization_id" json:"organization_id"`
+	UserID         string         `db:"user_id" json:"user_id"`
+	Action         sql.NullString `db:"action" json:"action"`
+}
+
+func (q *Queries) CountUserLogsByUserID(ctx context.Context, arg CountUserLogsByUserIDParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countUserLogsByUserID, arg.OrganizationID, arg.UserID, arg.Action)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
+const countUserLogsByUserIDs = `-- name: CountUserLogsByUserIDs :many
+SELECT user_id, COUNT(*)::INTEGER AS log_count
+FROM user_logs
+WHERE organization_id = $1
+  AND user_id = ANY($2::VARCHAR[])
+  AND ($3::varchar IS NULL OR action = $3)
+GROUP BY user_id
+`
+
+type CountUserLogsByUserIDsParams struct {
+	OrganizationID string         `db:"organization_id" json:"organization_id"`
+	UserIds        []string       `db:"user_ids" json:"user_ids"`
+	Action         sql.NullString `db:"action" json:"action"`
+}
+
+type CountUserLogsByUserIDsRow struct {
+	UserID   string `db:"user_id" json:"user_id"`
+	LogCount int32  `db:"log_count" json:"log_count"`
+}
+
+// ユーザーごとのログの件数（ログのないユーザーは含まれない）
+func (q *Queries) CountUserLogsByUserIDs(ctx context.Context, arg CountUserLogsByUserIDsParams) ([]CountUserLogsByUserIDsRow, error) {
+	rows, err := q.db.QueryContext(ctx, countUserLogsByUserIDs, arg.OrganizationID, pq.Array(arg.UserIds), arg.Action)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CountUserLogsByUserIDsRow{}
+	for rows.Next() {
+		var i CountUserLogsByUserIDsRow
+		if err := rows.Scan(&i.UserID, &i.LogCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const createUserLog = `-- name: CreateUserLog :exec
-INSERT INTO user_logs (id, user_id, action, created_at)
-VALUES ($1, $2, $3, $4)
+INSERT INTO user_logs (id, user_id, organization_id, action, changes, actor, request_id, created_at, seq, prev_hash, hash)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 `
 
 type CreateUserLogParams struct {
-	ID        string    `db:"id" json:"id"`
-	UserID    string    `db:"user_id" json:"user_id"`
-	Action    string    `db:"action" json:"action"`
-	CreatedAt time.Time `db:"created_at" json:"created_at"`
+	ID             string          `db:"id" json:"id"`
+	UserID         string          `db:"user_id" json:"user_id"`
+	OrganizationID string          `db:"organization_id" json:"organization_id"`
+	Action         string          `db:"action" json:"action"`
+	Changes        json.RawMessage `db:"changes" json:"changes"`
+	Actor          string          `db:"actor" json:"actor"`
+	RequestID      string          `db:"request_id" json:"request_id"`
+	CreatedAt      time.Time       `db:"created_at" json:"created_at"`
+	Seq            int64           `db:"seq" json:"seq"`
+	PrevHash       string          `db:"prev_hash" json:"prev_hash"`
+	Hash           string          `db:"hash" json:"hash"`
 }
 
 func (q *Queries) CreateUserLog(ctx context.Context, arg CreateUserLogParams) error {
 	_, err := q.db.ExecContext(ctx, createUserLog,
 		arg.ID,
 		arg.UserID,
+		arg.OrganizationID,
 		arg.Action,
+		arg.Changes,
+		arg.Actor,
+		arg.RequestID,
 		arg.CreatedAt,
+		arg.Seq,
+		arg.PrevHash,
+		arg.Hash,
 	)
 	return err
 }
 
+const getUserLogChainHead = `-- name: GetUserLogChainHead :one
+SELECT seq, hash, signature FROM user_log_chain WHERE organization_id = $1
+`
+
+type GetUserLogChainHeadRow struct {
+	Seq       int64  `db:"seq" json:"seq"`
+	Hash      string `db:"hash" json:"hash"`
+	Signature string `db:"signature" json:"signature"`
+}
+
+func (q *Queries) GetUserLogChainHead(ctx context.Context, organizationID string) (GetUserLogChainHeadRow, error) {
+	row := q.db.QueryRowContext(ctx, getUserLogChainHead, organizationID)
+	var i GetUserLogChainHeadRow
+	err := row.Scan(&i.Seq, &i.Hash, &i.Signature)
+	return i, err
+}
+
+const getUserLogChainHeadForUpdate = `-- name: GetUserLogChainHeadForUpdate :one
+SELECT seq, hash, signature FROM user_log_chain WHERE organization_id = $1 FOR UPDATE
+`
+
+type GetUserLogChainHeadForUpdateRow struct {
+	Seq       int64  `db:"seq" json:"seq"`
+	Hash      string `db:"hash" json:"hash"`
+	Signature string `db:"signature" json:"signature"`
+}
+
+func (q *Queries) GetUserLogChainHeadForUpdate(ctx context.Context, organizationID string) (GetUserLogChainHeadForUpdateRow, error) {
+	row := q.db.QueryRowContext(ctx, getUserLogChainHeadForUpdate, organizationID)
+	var i GetUserLogChainHeadForUpdateRow
+	err := row.Scan(&i.Seq, &i.Hash, &i.Signature)
+	return i, err
+}
+
 const getUserLogsByUserID = `-- name: GetUserLogsByUserID :many
-SELECT id, user_id, action, created_at
+SELECT id, user_id, organization_id, action, changes, actor, request_id, created_at, seq, prev_hash, hash
 FROM user_logs
-WHERE user_id = $1
-ORDER BY created_at DESC
-LIMIT $2 OFFSET $3
+WHERE organization_id = $1
+  AND user_id = $2
+  AND ($3::varchar IS NULL OR action = $3)
+ORDER BY created_at DESC, id DESC
+LIMIT $5 OFFSET $4
 `
 
 type GetUserLogsByUserIDParams struct {
-	UserID string `db:"user_id" json:"user_id"`
-	Limit  int32  `db:"limit" json:"limit"`
-	Offset int32  `db:"offset" json:"offset"`
+	OrganizationID string         `db:"organization_id" json:"organization_id"`
+	UserID         string         `db:"user_id" json:"user_id"`
+	Action         sql.NullString `db:"action" json:"action"`
+	Offset         int32          `db:"offset" json:"offset"`
+	Limit          int32          `db:"limit" json:"limit"`
 }
 
 func (q *Queries) GetUserLogsByUserID(ctx context.Context, arg GetUserLogsByUserIDParams) ([]UserLog, error) {
-	rows, err := q.db.QueryContext(ctx, getUserLogsByUserID, arg.UserID, arg.Limit, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, getUserLogsByUserID,
+		arg.OrganizationID,
+		arg.UserID,
+		arg.Action,
+		arg.Offset,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -69,8 +184,15 @@ func (q *Queries) GetUserLogsByUserID(ctx context.Context, arg GetUserLogsByUser
 		if err := rows.Scan(
 			&i.ID,
 			&i.UserID,
+			&i.OrganizationID,
 			&i.Action,
+			&i.Changes,
+			&i.Actor,
+			&i.RequestID,
 			&i.CreatedAt,
+			&i.Seq,
+			&i.PrevHash,
+			&i.Hash,
 		); err != nil {
 			return nil, err
 		}
@@ -84,3 +206,197 @@ func (q *Queries) GetUserLogsByUserID(ctx context.Context, arg GetUserLogsByUser
 	}
 	return items, nil
 }
+
+const initUserLogChainHead = `-- name: InitUserLogChainHead :exec
+INSERT INTO user_log_chain (organization_id, seq, hash) VALUES ($1, 0, '')
+ON CONFLICT (organization_id) DO NOTHING
+`
+
+func (q *Queries) InitUserLogChainHead(ctx context.Context, organizationID string) error {
+	_, err := q.db.ExecContext(ctx, initUserLogChainHead, organizationID)
+	return err
+}
+
+const listUserLogChain = `-- name: ListUserLogChain :many
+SELECT id, user_id, organization_id, action, changes, actor, request_id, created_at, seq, prev_hash, hash
+FROM user_logs
+WHERE organization_id = $1 AND seq > $2 AND seq <= $3
+ORDER BY seq
+LIMIT $4
+`
+
+type ListUserLogChainParams struct {
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	AfterSeq       int64  `db:"after_seq" json:"after_seq"`
+	UntilSeq       int64  `db:"until_seq" json:"until_seq"`
+	Limit          int32  `db:"limit" json:"limit"`
+}
+
+func (q *Queries) ListUserLogChain(ctx context.Context, arg ListUserLogChainParams) ([]UserLog, error) {
+	rows, err := q.db.QueryContext(ctx, listUserLogChain,
+		arg.OrganizationID,
+		arg.AfterSeq,
+		arg.UntilSeq,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []UserLog{}
+	for rows.Next() {
+		var i UserLog
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.OrganizationID,
+			&i.Action,
+			&i.Changes,
+			&i.Actor,
+			&i.RequestID,
+			&i.CreatedAt,
+			&i.Seq,
+			&i.PrevHash,
+			&i.Hash,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserLogsByUserIDs = `-- name: ListUserLogsByUserIDs :many
+SELECT id, user_id, organization_id, action, changes, actor, request_id, created_at, seq, prev_hash, hash
+FROM (
+  SELECT id, user_id, organization_id, action, changes, actor, request_id, created_at, seq, prev_hash, hash,
+    ROW_NUMBER() OVER (PARTITION BY user_id ORDER BY created_at DESC, id DESC) AS position
+  FROM user_logs
+  WHERE organization_id = $1
+    AND user_id = ANY($2::VARCHAR[])
+    AND ($3::varchar IS NULL OR action = $3)
+) AS ranked_user_logs
+WHERE position > $4::INTEGER AND position <= $4::INTEGER + $5::INTEGER
+ORDER BY user_id, created_at DESC, id DESC
+`
+
+type ListUserLogsByUserIDsParams struct {
+	OrganizationID string         `db:"organization_id" json:"organization_id"`
+	UserIds        []string       `db:"user_ids" json:"user_ids"`
+	Action         sql.NullString `db:"action" json:"action"`
+	Offset         int32          `db:"offset" json:"offset"`
+	Limit          int32          `db:"limit" json:"limit"`
+}
+
+// ユーザーごとにログを新しい順に並べ、それぞれの offset 件目から limit 件を取得（複数のユーザーのログを1回で読む）
+func (q *Queries) ListUserLogsByUserIDs(ctx context.Context, arg ListUserLogsByUserIDsParams) ([]UserLog, error) {
+	rows, err := q.db.QueryContext(ctx, listUserLogsByUserIDs,
+		arg.OrganizationID,
+		pq.Array(arg.UserIds),
+		arg.Action,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []UserLog{}
+	for rows.Next() {
+		var i UserLog
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.OrganizationID,
+			&i.Action,
+			&i.Changes,
+			&i.Actor,
+			&i.RequestID,
+			&i.CreatedAt,
+			&i.Seq,
+			&i.PrevHash,
+			&i.Hash,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const summarizeUserLogsByUserIDs = `-- name: SummarizeUserLogsByUserIDs :many
+SELECT user_id, COUNT(*)::INTEGER AS log_count, MAX(created_at)::TIMESTAMP AS last_activity_at
+FROM user_logs
+WHERE organization_id = $1 AND user_id = ANY($2::VARCHAR[])
+GROUP BY user_id
+`
+
+type SummarizeUserLogsByUserIDsParams struct {
+	OrganizationID string   `db:"organization_id" json:"organization_id"`
+	UserIds        []string `db:"user_ids" json:"user_ids"`
+}
+
+type SummarizeUserLogsByUserIDsRow struct {
+	UserID         string    `db:"user_id" json:"user_id"`
+	LogCount       int32     `db:"log_count" json:"log_count"`
+	LastActivityAt time.Time `db:"last_activity_at" json:"last_activity_at"`
+}
+
+// ユーザーごとのログの件数と最新の日時（ログのないユーザーは含まれない）
+func (q *Queries) SummarizeUserLogsByUserIDs(ctx context.Context, arg SummarizeUserLogsByUserIDsParams) ([]SummarizeUserLogsByUserIDsRow, error) {
+	rows, err := q.db.QueryContext(ctx, summarizeUserLogsByUserIDs, arg.OrganizationID, pq.Array(arg.UserIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SummarizeUserLogsByUserIDsRow{}
+	for rows.Next() {
+		var i SummarizeUserLogsByUserIDsRow
+		if err := rows.Scan(&i.UserID, &i.LogCount, &i.LastActivityAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateUserLogChainHead = `-- name: UpdateUserLogChainHead :exec
+UPDATE user_log_chain SET seq = $2, hash = $3, signature = $4, updated_at = $5 WHERE organization_id = $1
+`
+
+type UpdateUserLogChainHeadParams struct {
+	OrganizationID string    `db:"organization_id" json:"organization_id"`
+	Seq            int64     `db:"seq" json:"seq"`
+	Hash           string    `db:"hash" json:"hash"`
+	Signature      string    `db:"signature" json:"signature"`
+	UpdatedAt      time.Time `db:"updated_at" json:"updated_at"`
+}
+
+func (q *Queries) UpdateUserLogChainHead(ctx context.Context, arg UpdateUserLogChainHeadParams) error {
+	_, err := q.db.ExecContext(ctx, updateUserLogChainHead,
+		arg.OrganizationID,
+		arg.Seq,
+		arg.Hash,
+		arg.Signature,
+		arg.UpdatedAt,
+	)
+	return err
+}
@@ -0,0 +1,92 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: user_tokens.sql
+
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const createUserToken = `-- name: CreateUserToken :exec
+INSERT INTO user_tokens (id, user_id, organization_id, purpose, token_hash, email, expires_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+`
+
+type CreateUserTokenParams struct {
+	ID             string    `db:"id" json:"id"`
+	UserID         string    `db:"user_id" json:"user_id"`
+	OrganizationID string    `db:"organization_id" json:"organization_id"`
+	Purpose        string    `db:"purpose" json:"purpose"`
+	TokenHash      string    `db:"token_hash" json:"token_hash"`
+	Email          string    `db:"email" json:"email"`
+	ExpiresAt      time.Time `db:"expires_at" json:"expires_at"`
+	CreatedAt      time.Time `db:"created_at" json:"created_at"`
+}
+
+func (q *Queries) CreateUserToken(ctx context.Context, arg CreateUserTokenParams) error {
+	_, err := q.db.ExecContext(ctx, createUserToken,
+		arg.ID,
+		arg.UserID,
+		arg.OrganizationID,
+		arg.Purpose,
+		arg.TokenHash,
+		arg.Email,
+		arg.ExpiresAt,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const getUserTokenByHashForUpdate = `-- name: GetUserTokenByHashForUpdate :one
+SELECT id, user_id, organization_id, purpose, token_hash, email, expires_at, used_at, created_at
+FROM user_tokens
+WHERE token_hash = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetUserTokenByHashForUpdate(ctx context.Context, tokenHash string) (UserToken, error) {
+	row := q.db.QueryRowContext(ctx, getUserTokenByHashForUpdate, tokenHash)
+	var i UserToken
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.OrganizationID,
+		&i.Purpose,
+		&i.TokenHash,
+		&i.Email,
+		&i.ExpiresAt,
+		&i.UsedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const invalidateUserTokens = `-- name: InvalidateUserTokens :exec
+UPDATE user_tokens SET used_at = $1
+WHERE organization_id = $2
+  AND user_id = $3
+  AND purpose = $4
+  AND used_at IS NULL
+`
+
+type InvalidateUserTokensParams struct {
+	UsedAt         sql.NullTime `db:"used_at" json:"used_at"`
+	OrganizationID string       `db:"organization_id" json:"organization_id"`
+	UserID         string       `db:"user_id" json:"user_id"`
+	Purpose        string       `db:"purpose" json:"purpose"`
+}
+
+// 未使用のトークンを使用済みにする（新しいトークンの発行時やパスワードの再設定後に古いリンクを無効にする）
+func (q *Queries) InvalidateUserTokens(ctx context.Context, arg InvalidateUserTokensParams) error {
+	_, err := q.db.ExecContext(ctx, invalidateUserTokens,
+		arg.UsedAt,
+		arg.OrganizationID,
+		arg.UserID,
+		arg.Purpose,
+	)
+	return err
+}
@@ -7,36 +7,39 @@ package dao
 
 import (
 	"context"
+	"database/sql"
 	"time"
 )
 
 const countUsers = `-- name: CountUsers :one
-SELECT COUNT(*) FROM users
+SELECT COUNT(*) FROM users WHERE organization_id = $1
 `
 
-func (q *Queries) CountUsers(ctx context.Context) (int64, error) {
-	row := q.db.QueryRowContext(ctx, countUsers)
+func (q *Queries) CountUsers(ctx context.Context, organizationID string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countUsers, organizationID)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
 const createUser = `-- name: CreateUser :exec
-INSERT INTO users (id, name, email, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5)
+INSERT INTO users (id, organization_id, name, email, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6)
 `
 
 type CreateUserParams struct {
-	ID        string    `db:"id" json:"id"`
-	Name      string    `db:"name" json:"name"`
-	Email     string    `db:"email" json:"email"`
-	CreatedAt time.Time `db:"created_at" json:"created_at"`
-	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+	ID             string    `db:"id" json:"id"`
+	OrganizationID string    `db:"organization_id" json:"organization_id"`
+	Name           string    `db:"name" json:"name"`
+	Email          string    `db:"email" json:"email"`
+	CreatedAt      time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt      time.Time `db:"updated_at" json:"updated_at"`
 }
 
 func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) error {
 	_, err := q.db.ExecContext(ctx, createUser,
 		arg.ID,
+		arg.OrganizationID,
 		arg.Name,
 		arg.Email,
 		arg.CreatedAt,
@@ -46,27 +49,41 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) error {
 }
 
 const deleteUser = `-- name: DeleteUser :exec
-DELETE FROM users WHERE id = $1
+DELETE FROM users WHERE organization_id = $1 AND id = $2
 `
 
-func (q *Queries) DeleteUser(ctx context.Context, id string) error {
-	_, err := q.db.ExecContext(ctx, deleteUser, id)
+type DeleteUserParams struct {
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	ID             string `db:"id" json:"id"`
+}
+
+func (q *Queries) DeleteUser(ctx context.Context, arg DeleteUserParams) error {
+	_, err := q.db.ExecContext(ctx, deleteUser, arg.OrganizationID, arg.ID)
 	return err
 }
 
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, name, email, created_at, updated_at
+SELECT id, organization_id, name, email, email_verified_at, email_invalidated_at, password_hash, created_at, updated_at
 FROM users
-WHERE email = $1
+WHERE organization_id = $1 AND lower(email) = lower($2)
 `
 
-func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error) {
-	row := q.db.QueryRowContext(ctx, getUserByEmail, email)
+type GetUserByEmailParams struct {
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	Email          string `db:"email" json:"email"`
+}
+
+func (q *Queries) GetUserByEmail(ctx context.Context, arg GetUserByEmailParams) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUserByEmail, arg.OrganizationID, arg.Email)
 	var i User
 	err := row.Scan(
 		&i.ID,
+		&i.OrganizationID,
 		&i.Name,
 		&i.Email,
+		&i.EmailVerifiedAt,
+		&i.EmailInvalidatedAt,
+		&i.PasswordHash,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -74,19 +91,28 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error
 }
 
 const getUserByEmailForUpdate = `-- name: GetUserByEmailForUpdate :one
-SELECT id, name, email, created_at, updated_at
+SELECT id, organization_id, name, email, email_verified_at, email_invalidated_at, password_hash, created_at, updated_at
 FROM users
-WHERE email = $1
+WHERE organization_id = $1 AND lower(email) = lower($2)
 FOR UPDATE
 `
 
-func (q *Queries) GetUserByEmailForUpdate(ctx context.Context, email string) (User, error) {
-	row := q.db.QueryRowContext(ctx, getUserByEmailForUpdate, email)
+type GetUserByEmailForUpdateParams struct {
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	Email          string `db:"email" json:"email"`
+}
+
+func (q *Queries) GetUserByEmailForUpdate(ctx context.Context, arg GetUserByEmailForUpdateParams) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUserByEmailForUpdate, arg.OrganizationID, arg.Email)
 	var i User
 	err := row.Scan(
 		&i.ID,
+		&i.OrganizationID,
 		&i.Name,
 		&i.Email,
+		&i.EmailVerifiedAt,
+		&i.EmailInvalidatedAt,
+		&i.PasswordHash,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -94,18 +120,27 @@ func (q *Queries) GetUserByEmailForUpdate(ctx context.Context, email string) (Us
 }
 
 const getUserByID = `-- name: GetUserByID :one
-SELECT id, name, email, created_at, updated_at
+SELECT id, organization_id, name, email, email_verified_at, email_invalidated_at, password_hash, created_at, updated_at
 FROM users
-WHERE id = $1
+WHERE organization_id = $1 AND id = $2
 `
 
-func (q *Queries) GetUserByID(ctx context.Context, id string) (User, error) {
-	row := q.db.QueryRowContext(ctx, getUserByID, id)
+type GetUserByIDParams struct {
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	ID             string `db:"id" json:"id"`
+}
+
+func (q *Queries) GetUserByID(ctx context.Context, arg GetUserByIDParams) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUserByID, arg.OrganizationID, arg.ID)
 	var i User
 	err := row.Scan(
 		&i.ID,
+		&i.OrganizationID,
 		&i.Name,
 		&i.Email,
+		&i.EmailVerifiedAt,
+		&i.EmailInvalidatedAt,
+		&i.PasswordHash,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -113,39 +148,128 @@ func (q *Queries) GetUserByID(ctx context.Context, id string) (User, error) {
 }
 
 const getUserByIDForUpdate = `-- name: GetUserByIDForUpdate :one
-SELECT id, name, email, created_at, updated_at
+SELECT id, organization_id, name, email, email_verified_at, email_invalidated_at, password_hash, created_at, updated_at
 FROM users
-WHERE id = $1
+WHERE organization_id = $1 AND id = $2
 FOR UPDATE
 `
 
-func (q *Queries) GetUserByIDForUpdate(ctx context.Context, id string) (User, error) {
-	row := q.db.QueryRowContext(ctx, getUserByIDForUpdate, id)
+type GetUserByIDForUpdateParams struct {
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	ID             string `db:"id" json:"id"`
+}
+
+func (q *Queries) GetUserByIDForUpdate(ctx context.Context, arg GetUserByIDForUpdateParams) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUserByIDForUpdate, arg.OrganizationID, arg.ID)
 	var i User
 	err := row.Scan(
 		&i.ID,
+		&i.OrganizationID,
 		&i.Name,
 		&i.Email,
+		&i.EmailVerifiedAt,
+		&i.EmailInvalidatedAt,
+		&i.PasswordHash,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
 	return i, err
 }
 
+const listUserOrganizationIDsByEmail = `-- name: ListUserOrganizationIDsByEmail :many
+SELECT organization_id
+FROM users
+WHERE lower(email) = lower($1)
+ORDER BY organization_id
+`
+
+// すべての組織からメールアドレスが一致するユーザーの組織を探す（組織を区別しない外部からの通知の処理用）
+func (q *Queries) ListUserOrganizationIDsByEmail(ctx context.Context, email string) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listUserOrganizationIDsByEmail, email)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var organization_id string
+		if err := rows.Scan(&organization_id); err != nil {
+			return nil, err
+		}
+		items = append(items, organization_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listUsers = `-- name: ListUsers :many
-SELECT id, name, email, created_at, updated_at
+SELECT id, organization_id, name, email, email_verified_at, email_invalidated_at, password_hash, created_at, updated_at
 FROM users
+WHERE organization_id = $1
 ORDER BY created_at DESC
-LIMIT $1 OFFSET $2
+LIMIT $3 OFFSET $2
 `
 
 type ListUsersParams struct {
-	Limit  int32 `db:"limit" json:"limit"`
-	Offset int32 `db:"offset" json:"offset"`
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	Offset         int32  `db:"offset" json:"offset"`
+	Limit          int32  `db:"limit" json:"limit"`
 }
 
 func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error) {
-	rows, err := q.db.QueryContext(ctx, listUsers, arg.Limit, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, listUsers, arg.OrganizationID, arg.Offset, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []User{}
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.Name,
+			&i.Email,
+			&i.EmailVerifiedAt,
+			&i.EmailInvalidatedAt,
+			&i.PasswordHash,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUsersForStream = `-- name: ListUsersForStream :many
+SELECT id, organization_id, name, email, email_verified_at, email_invalidated_at, password_hash, created_at, updated_at
+FROM users
+WHERE organization_id = $1
+ORDER BY created_at DESC, id DESC
+LIMIT $2
+`
+
+type ListUsersForStreamParams struct {
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	Limit          int32  `db:"limit" json:"limit"`
+}
+
+// 一覧と同じ順序（作成日時の降順、同じ日時はIDの降順）で先頭から取得する（キーセットページネーションの最初のページ）
+func (q *Queries) ListUsersForStream(ctx context.Context, arg ListUsersForStreamParams) ([]User, error) {
+	rows, err := q.db.QueryContext(ctx, listUsersForStream, arg.OrganizationID, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
@@ -155,8 +279,67 @@ func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]User, e
 		var i User
 		if err := rows.Scan(
 			&i.ID,
+			&i.OrganizationID,
 			&i.Name,
 			&i.Email,
+			&i.EmailVerifiedAt,
+			&i.EmailInvalidatedAt,
+			&i.PasswordHash,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUsersForStreamAfter = `-- name: ListUsersForStreamAfter :many
+SELECT id, organization_id, name, email, email_verified_at, email_invalidated_at, password_hash, created_at, updated_at
+FROM users
+WHERE organization_id = $1
+  AND (created_at, id) < ($2::timestamp, $3::varchar)
+ORDER BY created_at DESC, id DESC
+LIMIT $4
+`
+
+type ListUsersForStreamAfterParams struct {
+	OrganizationID string    `db:"organization_id" json:"organization_id"`
+	AfterCreatedAt time.Time `db:"after_created_at" json:"after_created_at"`
+	AfterID        string    `db:"after_id" json:"after_id"`
+	Limit          int32     `db:"limit" json:"limit"`
+}
+
+// ListUsersForStream の続きとして、指定したユーザーより後のユーザーを取得する
+func (q *Queries) ListUsersForStreamAfter(ctx context.Context, arg ListUsersForStreamAfterParams) ([]User, error) {
+	rows, err := q.db.QueryContext(ctx, listUsersForStreamAfter,
+		arg.OrganizationID,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []User{}
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.Name,
+			&i.Email,
+			&i.EmailVerifiedAt,
+			&i.EmailInvalidatedAt,
+			&i.PasswordHash,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 		); err != nil {
@@ -176,14 +359,15 @@ func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]User, e
 const updateUser = `-- name: UpdateUser :exec
 UPDATE users
 SET name = $1, email = $2, updated_at = $3
-WHERE id = $4
+WHERE organization_id = $4 AND id = $5
 `
 
 type UpdateUserParams struct {
-	Name      string    `db:"name" json:"name"`
-	Email     string    `db:"email" json:"email"`
-	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
-	ID        string    `db:"id" json:"id"`
+	Name           string    `db:"name" json:"name"`
+	Email          string    `db:"email" json:"email"`
+	UpdatedAt      time.Time `db:"updated_at" json:"updated_at"`
+	OrganizationID string    `db:"organization_id" json:"organization_id"`
+	ID             string    `db:"id" json:"id"`
 }
 
 func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) error {
@@ -191,35 +375,52 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) error {
 		arg.Name,
 		arg.Email,
 		arg.UpdatedAt,
+		arg.OrganizationID,
 		arg.ID,
 	)
 	return err
 }
 
-const upsertUser = `-- name: UpsertUser :exec
-INSERT INTO users (id, name, email, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5)
+const upsertUser = `-- name: UpsertUser :execrows
+INSERT INTO users (id, organization_id, name, email, email_verified_at, email_invalidated_at, password_hash, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 ON CONFLICT (id) DO UPDATE SET
     name = EXCLUDED.name,
     email = EXCLUDED.email,
+    email_verified_at = EXCLUDED.email_verified_at,
+    email_invalidated_at = EXCLUDED.email_invalidated_at,
+    password_hash = EXCLUDED.password_hash,
     updated_at = EXCLUDED.updated_at
+WHERE users.organization_id = EXCLUDED.organization_id
 `
 
 type UpsertUserParams struct {
-	ID        string    `db:"id" json:"id"`
-	Name      string    `db:"name" json:"name"`
-	Email     string    `db:"email" json:"email"`
-	CreatedAt time.Time `db:"created_at" json:"created_at"`
-	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+	ID                 string       `db:"id" json:"id"`
+	OrganizationID     string       `db:"organization_id" json:"organization_id"`
+	Name               string       `db:"name" json:"name"`
+	Email              string       `db:"email" json:"email"`
+	EmailVerifiedAt    sql.NullTime `db:"email_verified_at" json:"email_verified_at"`
+	EmailInvalidatedAt sql.NullTime `db:"email_invalidated_at" json:"email_invalidated_at"`
+	PasswordHash       string       `db:"password_hash" json:"password_hash"`
+	CreatedAt          time.Time    `db:"created_at" json:"created_at"`
+	UpdatedAt          time.Time    `db:"updated_at" json:"updated_at"`
 }
 
-func (q *Queries) UpsertUser(ctx context.Context, arg UpsertUserParams) error {
-	_, err := q.db.ExecContext(ctx, upsertUser,
+// 別の組織の同じIDのユーザーは上書きしない（影響行数が0になる）
+func (q *Queries) UpsertUser(ctx context.Context, arg UpsertUserParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, upsertUser,
 		arg.ID,
+		arg.OrganizationID,
 		arg.Name,
 		arg.Email,
+		arg.EmailVerifiedAt,
+		arg.EmailInvalidatedAt,
+		arg.PasswordHash,
 		arg.CreatedAt,
 		arg.UpdatedAt,
 	)
-	return err
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
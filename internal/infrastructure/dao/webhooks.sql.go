@@ -0,0 +1,410 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.28.0
+// source: webhooks.sql
+
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const countWebhookDeliveries = `-- name: CountWebhookDeliveries :one
+SELECT COUNT(*) FROM webhook_deliveries
+WHERE organization_id = $1 AND subscription_id = $2
+`
+
+type CountWebhookDeliveriesParams struct {
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	SubscriptionID string `db:"subscription_id" json:"subscription_id"`
+}
+
+func (q *Queries) CountWebhookDeliveries(ctx context.Context, arg CountWebhookDeliveriesParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countWebhookDeliveries, arg.OrganizationID, arg.SubscriptionID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countWebhookSubscriptions = `-- name: CountWebhookSubscriptions :one
+SELECT COUNT(*) FROM webhook_subscriptions
+WHERE organization_id = $1
+`
+
+func (q *Queries) CountWebhookSubscriptions(ctx context.Context, organizationID string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countWebhookSubscriptions, organizationID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createWebhookDelivery = `-- name: CreateWebhookDelivery :exec
+INSERT INTO webhook_deliveries (id, organization_id, subscription_id, event_id, event_type, attempt, status_code, error, duration_ms, delivered_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+`
+
+type CreateWebhookDeliveryParams struct {
+	ID             string    `db:"id" json:"id"`
+	OrganizationID string    `db:"organization_id" json:"organization_id"`
+	SubscriptionID string    `db:"subscription_id" json:"subscription_id"`
+	EventID        string    `db:"event_id" json:"event_id"`
+	EventType      string    `db:"event_type" json:"event_type"`
+	Attempt        int32     `db:"attempt" json:"attempt"`
+	StatusCode     int32     `db:"status_code" json:"status_code"`
+	Error          string    `db:"error" json:"error"`
+	DurationMs     int32     `db:"duration_ms" json:"duration_ms"`
+	DeliveredAt    time.Time `db:"delivered_at" json:"delivered_at"`
+}
+
+func (q *Queries) CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) error {
+	_, err := q.db.ExecContext(ctx, createWebhookDelivery,
+		arg.ID,
+		arg.OrganizationID,
+		arg.SubscriptionID,
+		arg.EventID,
+		arg.EventType,
+		arg.Attempt,
+		arg.StatusCode,
+		arg.Error,
+		arg.DurationMs,
+		arg.DeliveredAt,
+	)
+	return err
+}
+
+const createWebhookSubscription = `-- name: CreateWebhookSubscription :exec
+INSERT INTO webhook_subscriptions (id, organization_id, url, secret, event_types, created_by, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+`
+
+type CreateWebhookSubscriptionParams struct {
+	ID             string    `db:"id" json:"id"`
+	OrganizationID string    `db:"organization_id" json:"organization_id"`
+	Url            string    `db:"url" json:"url"`
+	Secret         string    `db:"secret" json:"secret"`
+	EventTypes     []string  `db:"event_types" json:"event_types"`
+	CreatedBy      string    `db:"created_by" json:"created_by"`
+	CreatedAt      time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt      time.Time `db:"updated_at" json:"updated_at"`
+}
+
+func (q *Queries) CreateWebhookSubscription(ctx context.Context, arg CreateWebhookSubscriptionParams) error {
+	_, err := q.db.ExecContext(ctx, createWebhookSubscription,
+		arg.ID,
+		arg.OrganizationID,
+		arg.Url,
+		arg.Secret,
+		pq.Array(arg.EventTypes),
+		arg.CreatedBy,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	return err
+}
+
+const deleteWebhookDeliveries = `-- name: DeleteWebhookDeliveries :exec
+DELETE FROM webhook_deliveries
+WHERE organization_id = $1 AND subscription_id = $2
+`
+
+type DeleteWebhookDeliveriesParams struct {
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	SubscriptionID string `db:"subscription_id" json:"subscription_id"`
+}
+
+func (q *Queries) DeleteWebhookDeliveries(ctx context.Context, arg DeleteWebhookDeliveriesParams) error {
+	_, err := q.db.ExecContext(ctx, deleteWebhookDeliveries, arg.OrganizationID, arg.SubscriptionID)
+	return err
+}
+
+const deleteWebhookSubscription = `-- name: DeleteWebhookSubscription :exec
+DELETE FROM webhook_subscriptions
+WHERE organization_id = $1 AND id = $2
+`
+
+type DeleteWebhookSubscriptionParams struct {
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	ID             string `db:"id" json:"id"`
+}
+
+func (q *Queries) DeleteWebhookSubscription(ctx context.Context, arg DeleteWebhookSubscriptionParams) error {
+	_, err := q.db.ExecContext(ctx, deleteWebhookSubscription, arg.OrganizationID, arg.ID)
+	return err
+}
+
+const getWebhookEventDeliveryState = `-- name: GetWebhookEventDeliveryState :one
+SELECT COUNT(*)::integer AS attempts, COALESCE(BOOL_OR(error = ''), false)::boolean AS delivered
+FROM webhook_deliveries
+WHERE subscription_id = $1 AND event_id = $2
+`
+
+type GetWebhookEventDeliveryStateParams struct {
+	SubscriptionID string `db:"subscription_id" json:"subscription_id"`
+	EventID        string `db:"event_id" json:"event_id"`
+}
+
+type GetWebhookEventDeliveryStateRow struct {
+	Attempts  int32 `db:"attempts" json:"attempts"`
+	Delivered bool  `db:"delivered" json:"delivered"`
+}
+
+// イベントの配信を試みた回数と、成功した配信があるかどうか
+func (q *Queries) GetWebhookEventDeliveryState(ctx context.Context, arg GetWebhookEventDeliveryStateParams) (GetWebhookEventDeliveryStateRow, error) {
+	row := q.db.QueryRowContext(ctx, getWebhookEventDeliveryState, arg.SubscriptionID, arg.EventID)
+	var i GetWebhookEventDeliveryStateRow
+	err := row.Scan(&i.Attempts, &i.Delivered)
+	return i, err
+}
+
+const getWebhookSubscriptionByID = `-- name: GetWebhookSubscriptionByID :one
+SELECT id, organization_id, url, secret, event_types, consecutive_failures, disabled_at, disabled_reason, created_by, created_at, updated_at
+FROM webhook_subscriptions
+WHERE organization_id = $1 AND id = $2
+`
+
+type GetWebhookSubscriptionByIDParams struct {
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	ID             string `db:"id" json:"id"`
+}
+
+func (q *Queries) GetWebhookSubscriptionByID(ctx context.Context, arg GetWebhookSubscriptionByIDParams) (WebhookSubscription, error) {
+	row := q.db.QueryRowContext(ctx, getWebhookSubscriptionByID, arg.OrganizationID, arg.ID)
+	var i WebhookSubscription
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Url,
+		&i.Secret,
+		pq.Array(&i.EventTypes),
+		&i.ConsecutiveFailures,
+		&i.DisabledAt,
+		&i.DisabledReason,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getWebhookSubscriptionByIDForUpdate = `-- name: GetWebhookSubscriptionByIDForUpdate :one
+SELECT id, organization_id, url, secret, event_types, consecutive_failures, disabled_at, disabled_reason, created_by, created_at, updated_at
+FROM webhook_subscriptions
+WHERE organization_id = $1 AND id = $2
+FOR UPDATE
+`
+
+type GetWebhookSubscriptionByIDForUpdateParams struct {
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	ID             string `db:"id" json:"id"`
+}
+
+func (q *Queries) GetWebhookSubscriptionByIDForUpdate(ctx context.Context, arg GetWebhookSubscriptionByIDForUpdateParams) (WebhookSubscription, error) {
+	row := q.db.QueryRowContext(ctx, getWebhookSubscriptionByIDForUpdate, arg.OrganizationID, arg.ID)
+	var i WebhookSubscription
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Url,
+		&i.Secret,
+		pq.Array(&i.EventTypes),
+		&i.ConsecutiveFailures,
+		&i.DisabledAt,
+		&i.DisabledReason,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listActiveWebhookSubscriptionsByEventType = `-- name: ListActiveWebhookSubscriptionsByEventType :many
+SELECT id, organization_id, url, secret, event_types, consecutive_failures, disabled_at, disabled_reason, created_by, created_at, updated_at
+FROM webhook_subscriptions
+WHERE organization_id = $1
+  AND disabled_at IS NULL
+  AND $2::text = ANY(event_types)
+ORDER BY created_at, id
+`
+
+type ListActiveWebhookSubscriptionsByEventTypeParams struct {
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	EventType      string `db:"event_type" json:"event_type"`
+}
+
+func (q *Queries) ListActiveWebhookSubscriptionsByEventType(ctx context.Context, arg ListActiveWebhookSubscriptionsByEventTypeParams) ([]WebhookSubscription, error) {
+	rows, err := q.db.QueryContext(ctx, listActiveWebhookSubscriptionsByEventType, arg.OrganizationID, arg.EventType)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []WebhookSubscription{}
+	for rows.Next() {
+		var i WebhookSubscription
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.Url,
+			&i.Secret,
+			pq.Array(&i.EventTypes),
+			&i.ConsecutiveFailures,
+			&i.DisabledAt,
+			&i.DisabledReason,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhookDeliveries = `-- name: ListWebhookDeliveries :many
+SELECT id, organization_id, subscription_id, event_id, event_type, attempt, status_code, error, duration_ms, delivered_at
+FROM webhook_deliveries
+WHERE organization_id = $1 AND subscription_id = $2
+ORDER BY delivered_at DESC, id DESC
+LIMIT $4 OFFSET $3
+`
+
+type ListWebhookDeliveriesParams struct {
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	SubscriptionID string `db:"subscription_id" json:"subscription_id"`
+	Offset         int32  `db:"offset" json:"offset"`
+	Limit          int32  `db:"limit" json:"limit"`
+}
+
+func (q *Queries) ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]WebhookDelivery, error) {
+	rows, err := q.db.QueryContext(ctx, listWebhookDeliveries,
+		arg.OrganizationID,
+		arg.SubscriptionID,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []WebhookDelivery{}
+	for rows.Next() {
+		var i WebhookDelivery
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.SubscriptionID,
+			&i.EventID,
+			&i.EventType,
+			&i.Attempt,
+			&i.StatusCode,
+			&i.Error,
+			&i.DurationMs,
+			&i.DeliveredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhookSubscriptions = `-- name: ListWebhookSubscriptions :many
+SELECT id, organization_id, url, secret, event_types, consecutive_failures, disabled_at, disabled_reason, created_by, created_at, updated_at
+FROM webhook_subscriptions
+WHERE organization_id = $1
+ORDER BY created_at DESC, id DESC
+LIMIT $3 OFFSET $2
+`
+
+type ListWebhookSubscriptionsParams struct {
+	OrganizationID string `db:"organization_id" json:"organization_id"`
+	Offset         int32  `db:"offset" json:"offset"`
+	Limit          int32  `db:"limit" json:"limit"`
+}
+
+func (q *Queries) ListWebhookSubscriptions(ctx context.Context, arg ListWebhookSubscriptionsParams) ([]WebhookSubscription, error) {
+	rows, err := q.db.QueryContext(ctx, listWebhookSubscriptions, arg.OrganizationID, arg.Offset, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []WebhookSubscription{}
+	for rows.Next() {
+		var i WebhookSubscription
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.Url,
+			&i.Secret,
+			pq.Array(&i.EventTypes),
+			&i.ConsecutiveFailures,
+			&i.DisabledAt,
+			&i.DisabledReason,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateWebhookSubscription = `-- name: UpdateWebhookSubscription :exec
+UPDATE webhook_subscriptions
+SET url = $1,
+    event_types = $2,
+    consecutive_failures = $3,
+    disabled_at = $4,
+    disabled_reason = $5,
+    updated_at = $6
+WHERE organization_id = $7 AND id = $8
+`
+
+type UpdateWebhookSubscriptionParams struct {
+	Url                 string       `db:"url" json:"url"`
+	EventTypes          []string     `db:"event_types" json:"event_types"`
+	ConsecutiveFailures int32        `db:"consecutive_failures" json:"consecutive_failures"`
+	DisabledAt          sql.NullTime `db:"disabled_at" json:"disabled_at"`
+	DisabledReason      string       `db:"disabled_reason" json:"disabled_reason"`
+	UpdatedAt           time.Time    `db:"updated_at" json:"updated_at"`
+	OrganizationID      string       `db:"organization_id" json:"organization_id"`
+	ID                  string       `db:"id" json:"id"`
+}
+
+func (q *Queries) UpdateWebhookSubscription(ctx context.Context, arg UpdateWebhookSubscriptionParams) error {
+	_, err := q.db.ExecContext(ctx, updateWebhookSubscription,
+		arg.Url,
+		pq.Array(arg.EventTypes),
+		arg.ConsecutiveFailures,
+		arg.DisabledAt,
+		arg.DisabledReason,
+		arg.UpdatedAt,
+		arg.OrganizationID,
+		arg.ID,
+	)
+	return err
+}
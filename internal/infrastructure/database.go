@@ -3,12 +3,17 @@ package infrastructure
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
-	_ "github.com/lib/pq"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/lib/pq"
 )
 
+// uniqueViolationCode PostgreSQLの一意制約違反のエラーコード
+const uniqueViolationCode = "23505"
+
 // DBTX は *sql.DB と *sql.Tx の共通インターフェース
 type DBTX interface {
 	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
@@ -27,14 +32,32 @@ type Config struct {
 	SSLMode  string
 }
 
-// NewDB データベース接続を作成
-func NewDB(cfg Config) (*sql.DB, error) {
-	dsn := fmt.Sprintf(
+// DSN lib/pq の接続文字列（LISTEN 用の接続にも使う）
+func (c Config) DSN() string {
+	return fmt.Sprintf(
 		"host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
-		cfg.Host, cfg.Port, cfg.User, cfg.Password, cfg.DBName, cfg.SSLMode,
+		c.Host, c.Port, c.User, c.Password, c.DBName, c.SSLMode,
 	)
+}
 
-	db, err := sql.Open("postgres", dsn)
+// NewDB データベース接続を作成し、接続を確認する
+func NewDB(cfg Config) (*sql.DB, error) {
+	db, err := OpenDB(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// 接続確認
+	if err := db.Ping(); err != nil {
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	return db, nil
+}
+
+// OpenDB データベース接続を作成（接続は確認しないため、起動時に停止しているリードレプリカにも使える）
+func OpenDB(cfg Config) (*sql.DB, error) {
+	db, err := sql.Open("postgres", cfg.DSN())
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
@@ -44,32 +67,35 @@ func NewDB(cfg Config) (*sql.DB, error) {
 	db.SetMaxIdleConns(5)
 	db.SetConnMaxLifetime(5 * time.Minute)
 
-	// 接続確認
-	if err := db.Ping(); err != nil {
-		return nil, fmt.Errorf("failed to ping database: %w", err)
-	}
-
 	return db, nil
 }
 
 // TransactionManager トランザクション管理
 type TransactionManager struct {
-	db *sql.DB
+	db     *sql.DB
+	events *EventBus
 }
 
 // NewTransactionManager TransactionManagerのコンストラクタ
 func NewTransactionManager(db *sql.DB) *TransactionManager {
-	return &TransactionManager{db: db}
+	return &TransactionManager{db: db, events: NewEventBus()}
+}
+
+// Subscribe コミット後に配信されるドメインイベントの購読者を登録（種類を省略した場合はすべてのイベントを購読する）
+func (tm *TransactionManager) Subscribe(handler EventHandler, eventTypes ...string) {
+	tm.events.Subscribe(handler, eventTypes...)
 }
 
 // RunInTransaction トランザクション内で処理を実行
+// 処理中に保存されたドメインイベントを集め、コミットに成功した場合のみ購読者へ配信する
 func (tm *TransactionManager) RunInTransaction(ctx context.Context, fn func(ctx context.Context, tx DBTX) error) error {
 	tx, err := tm.db.BeginTx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
 
-	if err := fn(ctx, tx); err != nil {
+	txCtx, collector := domain.WithEventCollector(ctx)
+	if err := fn(txCtx, tx); err != nil {
 		if rbErr := tx.Rollback(); rbErr != nil {
 			return fmt.Errorf("failed to rollback: %v (original error: %w)", rbErr, err)
 		}
@@ -80,5 +106,15 @@ func (tm *TransactionManager) RunInTransaction(ctx context.Context, fn func(ctx
 		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
+	tm.events.Publish(ctx, collector.Events())
 	return nil
 }
+
+// IsUniqueViolation err が指定した制約（一意インデックス）の一意制約違反かどうか判定
+func IsUniqueViolation(err error, constraint string) bool {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return false
+	}
+	return pqErr.Code == uniqueViolationCode && pqErr.Constraint == constraint
+}
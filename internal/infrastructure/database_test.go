@@ -0,0 +1,164 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+)
+
+// fakeTxDB トランザクションの開始・コミット・ロールバックだけを記録するデータベース
+type fakeTxDB struct {
+	// commitErr コミット時に返すエラー
+	commitErr error
+	committed int
+	rollbacks int
+}
+
+func newFakeTxDB(t *testing.T) (*fakeTxDB, *sql.DB) {
+	t.Helper()
+	fake := &fakeTxDB{}
+	db := sql.OpenDB(fake)
+	t.Cleanup(func() { _ = db.Close() })
+	return fake, db
+}
+
+func (f *fakeTxDB) Connect(context.Context) (driver.Conn, error) { return fakeTxConn{f}, nil }
+func (f *fakeTxDB) Driver() driver.Driver                        { return nil }
+
+type fakeTxConn struct{ db *fakeTxDB }
+
+func (c fakeTxConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepare is not supported")
+}
+func (c fakeTxConn) Close() error              { return nil }
+func (c fakeTxConn) Begin() (driver.Tx, error) { return fakeTxConnTx(c), nil }
+
+type fakeTxConnTx struct{ db *fakeTxDB }
+
+func (t fakeTxConnTx) Commit() error {
+	if t.db.commitErr != nil {
+		return t.db.commitErr
+	}
+	t.db.committed++
+	return nil
+}
+
+func (t fakeTxConnTx) Rollback() error {
+	t.db.rollbacks++
+	return nil
+}
+
+// testUserCreated テスト用のドメインイベント
+func testUserCreated() domain.DomainEvent {
+	user, _ := domain.NewUser("John", "john@example.com")
+	return user.PullEvents()[0]
+}
+
+func TestTransactionManager_RunInTransaction_PublishesAfterCommit(t *testing.T) {
+	fake, db := newFakeTxDB(t)
+	tm := NewTransactionManager(db)
+
+	var (
+		published        []domain.DomainEvent
+		committedAtEvent int
+	)
+	tm.Subscribe(func(_ context.Context, event domain.DomainEvent) {
+		published = append(published, event)
+		committedAtEvent = fake.committed
+	})
+
+	event := testUserCreated()
+	err := tm.RunInTransaction(context.Background(), func(ctx context.Context, _ DBTX) error {
+		domain.CollectEvents(ctx, event)
+		// コミット前には配信しない
+		if len(published) != 0 {
+			t.Error("expected no events to be published before commit")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(published) != 1 || published[0] != event {
+		t.Fatalf("expected the collected event to be published, got %v", published)
+	}
+	if committedAtEvent != 1 {
+		t.Error("expected the event to be published after commit")
+	}
+}
+
+func TestTransactionManager_RunInTransaction_DoesNotPublishOnFailure(t *testing.T) {
+	tests := []struct {
+		name          string
+		fnErr         error
+		commitErr     error
+		wantRollbacks int
+	}{
+		{name: "function fails", fnErr: errors.New("validation failed"), wantRollbacks: 1},
+		{name: "commit fails", commitErr: errors.New("serialization failure")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake, db := newFakeTxDB(t)
+			fake.commitErr = tt.commitErr
+			tm := NewTransactionManager(db)
+
+			published := 0
+			tm.Subscribe(func(context.Context, domain.DomainEvent) { published++ })
+
+			err := tm.RunInTransaction(context.Background(), func(ctx context.Context, _ DBTX) error {
+				domain.CollectEvents(ctx, testUserCreated())
+				return tt.fnErr
+			})
+
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if tt.fnErr != nil && !errors.Is(err, tt.fnErr) {
+				t.Errorf("expected %v, got %v", tt.fnErr, err)
+			}
+			if tt.commitErr != nil && !errors.Is(err, tt.commitErr) {
+				t.Errorf("expected %v, got %v", tt.commitErr, err)
+			}
+			if published != 0 {
+				t.Errorf("expected no events to be published, got %d", published)
+			}
+			if fake.rollbacks != tt.wantRollbacks {
+				t.Errorf("expected %d rollbacks, got %d", tt.wantRollbacks, fake.rollbacks)
+			}
+		})
+	}
+}
+
+func TestEventBus_Publish(t *testing.T) {
+	bus := NewEventBus()
+	var received []string
+	bus.Subscribe(func(context.Context, domain.DomainEvent) { panic("handler failed") })
+	bus.Subscribe(func(_ context.Context, event domain.DomainEvent) {
+		received = append(received, "all:"+event.EventType())
+	})
+	bus.Subscribe(func(_ context.Context, event domain.DomainEvent) {
+		received = append(received, "deleted:"+event.EventType())
+	}, domain.EventTypeUserDeleted)
+
+	deleted := domain.UserDeleted{UserID: "01ARZ3NDEKTSV4RRFFQ69G5FAV"}
+	bus.Publish(context.Background(), []domain.DomainEvent{testUserCreated(), deleted})
+
+	// 購読者のパニックはほかの購読者への配信を止めず、種類を指定した購読者には該当するイベントのみを配信する
+	want := []string{"all:user.created", "all:user.deleted", "deleted:user.deleted"}
+	if len(received) != len(want) {
+		t.Fatalf("expected %v, got %v", want, received)
+	}
+	for i := range want {
+		if received[i] != want[i] {
+			t.Errorf("expected %v, got %v", want, received)
+			break
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/lib/pq"
+)
+
+// usersColumnsQuery users テーブルの列（テーブルがない場合は0行）
+// スキーマを適用する前のデータベースで実行するため、sqlc のクエリ（適用後のスキーマが前提）は使わない
+const usersColumnsQuery = `SELECT column_name FROM information_schema.columns WHERE table_schema = current_schema() AND table_name = 'users'`
+
+// duplicateUserEmailsQuery 組織ごとに大文字小文字だけが異なるメールアドレス（%s は組織IDの列）
+const duplicateUserEmailsQuery = `SELECT %s, lower(email), array_agg(id ORDER BY created_at, id), array_agg(email ORDER BY created_at, id)
+FROM users
+GROUP BY 1, 2
+HAVING count(*) > 1
+ORDER BY 1, 2`
+
+// DuplicateUserEmail 大文字小文字だけが異なるメールアドレスを持つ同じ組織のユーザー
+type DuplicateUserEmail struct {
+	// OrganizationID 組織のID（organization_id 列がまだない場合は、スキーマの適用で設定される既定の組織）
+	OrganizationID string
+	// Email 小文字にしたメールアドレス
+	Email string
+	// UserIDs 重複しているユーザーのID（作成日時の昇順）
+	UserIDs []string
+	// Emails 重複しているユーザーの保存されているメールアドレス（UserIDs と同じ順）
+	Emails []string
+}
+
+// FindDuplicateUserEmails 組織ごとの (organization_id, lower(email)) 一意インデックスを作成できなくなる重複を探す
+// スキーマの適用前に実行し、重複がある場合は適用する前に解消する（users テーブルがない場合は空）
+func FindDuplicateUserEmails(ctx context.Context, db DBTX) ([]DuplicateUserEmail, error) {
+	rows, err := db.QueryContext(ctx, usersColumnsQuery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users columns: %w", err)
+	}
+	columns := map[string]bool{}
+	for rows.Next() {
+		var column string
+		if err := rows.Scan(&column); err != nil {
+			_ = rows.Close()
+			return nil, fmt.Errorf("failed to scan users column: %w", err)
+		}
+		columns[column] = true
+	}
+	if err := rows.Close(); err != nil {
+		return nil, fmt.Errorf("failed to list users columns: %w", err)
+	}
+	if len(columns) == 0 {
+		return nil, nil
+	}
+
+	// 組織の導入前のデータベースでは、スキーマの適用ですべてのユーザーが既定の組織に入る
+	organizationColumn := "organization_id"
+	if !columns["organization_id"] {
+		organizationColumn = pq.QuoteLiteral(domain.DefaultOrganizationID) + "::varchar"
+	}
+
+	rows, err = db.QueryContext(ctx, fmt.Sprintf(duplicateUserEmailsQuery, organizationColumn))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find duplicate user emails: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var duplicates []DuplicateUserEmail
+	for rows.Next() {
+		var d DuplicateUserEmail
+		if err := rows.Scan(&d.OrganizationID, &d.Email, pq.Array(&d.UserIDs), pq.Array(&d.Emails)); err != nil {
+			return nil, fmt.Errorf("failed to scan duplicate user email: %w", err)
+		}
+		duplicates = append(duplicates, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to find duplicate user emails: %w", err)
+	}
+	return duplicates, nil
+}
@@ -0,0 +1,119 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+)
+
+// fakeUsersTableDB スキーマ適用前の users テーブルを再現するメモリ上のデータベース
+type fakeUsersTableDB struct {
+	// columns users テーブルの列（テーブルがない場合は空）
+	columns []string
+	// duplicates 重複の検索結果
+	duplicates [][]driver.Value
+	// duplicateQuery 実行された重複の検索クエリ
+	duplicateQuery string
+}
+
+func (f *fakeUsersTableDB) Connect(context.Context) (driver.Conn, error) {
+	return fakeUsersTableConn{f}, nil
+}
+func (f *fakeUsersTableDB) Driver() driver.Driver { return nil }
+
+type fakeUsersTableConn struct{ db *fakeUsersTableDB }
+
+func (c fakeUsersTableConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepare is not supported")
+}
+func (c fakeUsersTableConn) Close() error { return nil }
+func (c fakeUsersTableConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("begin is not supported")
+}
+
+func (c fakeUsersTableConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	if query == usersColumnsQuery {
+		rows := &fakeValueRows{columns: 1}
+		for _, column := range c.db.columns {
+			rows.values = append(rows.values, []driver.Value{column})
+		}
+		return rows, nil
+	}
+	c.db.duplicateQuery = query
+	return &fakeValueRows{columns: 4, values: c.db.duplicates}, nil
+}
+
+// fakeValueRows 固定の値を返す driver.Rows
+type fakeValueRows struct {
+	columns int
+	values  [][]driver.Value
+}
+
+func (r *fakeValueRows) Columns() []string { return make([]string, r.columns) }
+func (r *fakeValueRows) Close() error      { return nil }
+func (r *fakeValueRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+	return nil
+}
+
+func TestFindDuplicateUserEmails(t *testing.T) {
+	tests := []struct {
+		name    string
+		columns []string
+		// wantOrganization 重複を組織ごとに判定する列（空の場合はクエリを実行しない）
+		wantOrganization string
+	}{
+		{name: "no users table"},
+		{name: "users with organizations", columns: []string{"id", "organization_id", "email", "created_at"}, wantOrganization: "organization_id"},
+		{name: "users before organizations", columns: []string{"id", "email", "created_at"}, wantOrganization: "'" + domain.DefaultOrganizationID + "'::varchar"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeUsersTableDB{
+				columns: tt.columns,
+				duplicates: [][]driver.Value{{
+					domain.DefaultOrganizationID, "alice@example.com",
+					[]byte("{01ARZ3NDEKTSV4RRFFQ69G5FA1,01ARZ3NDEKTSV4RRFFQ69G5FA2}"),
+					[]byte("{Alice@example.com,alice@example.com}"),
+				}},
+			}
+			db := sql.OpenDB(fake)
+			t.Cleanup(func() { _ = db.Close() })
+
+			got, err := FindDuplicateUserEmails(context.Background(), db)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantOrganization == "" {
+				if got != nil || fake.duplicateQuery != "" {
+					t.Errorf("expected no check without a users table, got %+v", got)
+				}
+				return
+			}
+			if !strings.HasPrefix(fake.duplicateQuery, "SELECT "+tt.wantOrganization+", lower(email)") {
+				t.Errorf("expected duplicates grouped by %s, got query %q", tt.wantOrganization, fake.duplicateQuery)
+			}
+			want := []DuplicateUserEmail{{
+				OrganizationID: domain.DefaultOrganizationID,
+				Email:          "alice@example.com",
+				UserIDs:        []string{"01ARZ3NDEKTSV4RRFFQ69G5FA1", "01ARZ3NDEKTSV4RRFFQ69G5FA2"},
+				Emails:         []string{"Alice@example.com", "alice@example.com"},
+			}}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("expected %+v, got %+v", want, got)
+			}
+		})
+	}
+}
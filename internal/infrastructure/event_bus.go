@@ -0,0 +1,68 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// EventHandler プロセス内でドメインイベントを受け取る購読者
+// コミット後に同期的に呼び出されるため、時間のかかる処理や失敗してはならない処理はアウトボックス（ワーカー）で行う
+type EventHandler func(ctx context.Context, event domain.DomainEvent)
+
+// EventBus プロセス内の購読者へドメインイベントを配信する
+type EventBus struct {
+	mu       sync.RWMutex
+	handlers map[string][]EventHandler
+}
+
+// NewEventBus EventBusのコンストラクタ
+func NewEventBus() *EventBus {
+	return &EventBus{
+		handlers: make(map[string][]EventHandler),
+	}
+}
+
+// Subscribe 指定した種類のドメインイベントの購読者を登録（種類を省略した場合はすべてのイベントを購読する）
+func (b *EventBus) Subscribe(handler EventHandler, eventTypes ...string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(eventTypes) == 0 {
+		eventTypes = []string{""}
+	}
+	for _, eventType := range eventTypes {
+		b.handlers[eventType] = append(b.handlers[eventType], handler)
+	}
+}
+
+// Publish ドメインイベントを発生順に購読者へ配信する
+// 購読者のパニックはログに記録し、ほかの購読者への配信を続ける
+func (b *EventBus) Publish(ctx context.Context, events []domain.DomainEvent) {
+	for _, event := range events {
+		b.mu.RLock()
+		handlers := append(append([]EventHandler{}, b.handlers[""]...), b.handlers[event.EventType()]...)
+		b.mu.RUnlock()
+
+		for _, handler := range handlers {
+			b.dispatch(ctx, handler, event)
+		}
+	}
+}
+
+// dispatch 1つの購読者にドメインイベントを配信する
+func (b *EventBus) dispatch(ctx context.Context, handler EventHandler, event domain.DomainEvent) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.FromContext(ctx).Error("domain event handler panicked",
+				slog.String("event_type", event.EventType()),
+				slog.String("aggregate_id", event.AggregateID()),
+				slog.String("panic", fmt.Sprint(r)),
+			)
+		}
+	}()
+	handler(ctx, event)
+}
@@ -0,0 +1,173 @@
+package infrastructure
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+	"github.com/oklog/ulid/v2"
+)
+
+// ErrIdempotencyLeaseLost 処理中のキーのリースが切れ、ほかのリクエストに引き継がれた
+var ErrIdempotencyLeaseLost = errors.New("idempotency key lease lost")
+
+// IdempotencyRecord Idempotency-Keyに紐づくリクエストとレスポンスの記録
+type IdempotencyRecord struct {
+	Key         string
+	Fingerprint string
+	// StatusCode 記録済みレスポンスのステータスコード（処理中の場合は0）
+	StatusCode int
+	Header     map[string]string
+	Body       []byte
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+	// LeaseID 処理中のキーを保持しているリクエストのID（Extend・Complete・Release に渡す）
+	LeaseID string
+	// LockedUntil 処理中のキーのリースの期限（過ぎると同じリクエストの再試行が引き継ぐ）
+	LockedUntil time.Time
+}
+
+// Completed レスポンスが記録済みかどうか
+func (r *IdempotencyRecord) Completed() bool {
+	return r.StatusCode != 0
+}
+
+// IdempotencyStore Idempotency-KeyをPostgreSQLに保存するストア
+type IdempotencyStore struct {
+	queries *dao.Queries
+}
+
+// NewIdempotencyStore IdempotencyStoreのコンストラクタ
+func NewIdempotencyStore(db *sql.DB) *IdempotencyStore {
+	return &IdempotencyStore{queries: dao.New(db)}
+}
+
+// Acquire キーを処理中として登録し、lease の間ロックする
+// 登録できた場合はリースを持つ記録と true、有効な既存キーがある場合はその記録と false を返す
+// 期限切れの既存キーと、同じリクエストでリースが切れた処理中のキー（処理中にサーバーが停止した）は上書きされる
+func (s *IdempotencyStore) Acquire(ctx context.Context, key, fingerprint string, ttl, lease time.Duration) (*IdempotencyRecord, bool, error) {
+	now := time.Now()
+	record := &IdempotencyRecord{
+		Key:         key,
+		Fingerprint: fingerprint,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(ttl),
+		LeaseID:     ulid.MustNew(ulid.Timestamp(now), rand.Reader).String(),
+		LockedUntil: now.Add(lease),
+	}
+	affected, err := s.queries.AcquireIdempotencyKey(ctx, dao.AcquireIdempotencyKeyParams{
+		IdempotencyKey: key,
+		Fingerprint:    fingerprint,
+		CreatedAt:      record.CreatedAt,
+		ExpiresAt:      record.ExpiresAt,
+		LeaseID:        sql.NullString{String: record.LeaseID, Valid: true},
+		LockedUntil:    sql.NullTime{Time: record.LockedUntil, Valid: true},
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to acquire idempotency key: %w", err)
+	}
+	if affected > 0 {
+		return record, true, nil
+	}
+
+	row, err := s.queries.GetIdempotencyKey(ctx, key)
+	if err == sql.ErrNoRows {
+		// 取得までの間に削除された場合は呼び出し側で再試行できるよう競合として扱う
+		return nil, false, fmt.Errorf("idempotency key disappeared while acquiring: %s", key)
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get idempotency key: %w", err)
+	}
+
+	existing, err := toIdempotencyRecord(row)
+	if err != nil {
+		return nil, false, err
+	}
+	return existing, false, nil
+}
+
+// Extend 処理中のキーのリースを延長する（引き継がれていた場合は ErrIdempotencyLeaseLost）
+func (s *IdempotencyStore) Extend(ctx context.Context, key, leaseID string, lease time.Duration) error {
+	affected, err := s.queries.ExtendIdempotencyKeyLease(ctx, dao.ExtendIdempotencyKeyLeaseParams{
+		IdempotencyKey: key,
+		LeaseID:        sql.NullString{String: leaseID, Valid: true},
+		LockedUntil:    sql.NullTime{Time: time.Now().Add(lease), Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to extend idempotency key lease: %w", err)
+	}
+	if affected == 0 {
+		return ErrIdempotencyLeaseLost
+	}
+	return nil
+}
+
+// Complete 処理結果のレスポンスを記録する（引き継がれていた場合は ErrIdempotencyLeaseLost）
+func (s *IdempotencyStore) Complete(ctx context.Context, key, leaseID string, statusCode int, header map[string]string, body []byte) error {
+	headerJSON, err := json.Marshal(header)
+	if err != nil {
+		return fmt.Errorf("failed to marshal response headers: %w", err)
+	}
+	affected, err := s.queries.CompleteIdempotencyKey(ctx, dao.CompleteIdempotencyKeyParams{
+		IdempotencyKey:  key,
+		LeaseID:         sql.NullString{String: leaseID, Valid: true},
+		StatusCode:      sql.NullInt32{Int32: int32(statusCode), Valid: true},
+		ResponseHeaders: headerJSON,
+		ResponseBody:    body,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to complete idempotency key: %w", err)
+	}
+	if affected == 0 {
+		return ErrIdempotencyLeaseLost
+	}
+	return nil
+}
+
+// Release 処理中のキーを削除し、同じキーでの再試行を可能にする（引き継がれていた場合は何もしない）
+func (s *IdempotencyStore) Release(ctx context.Context, key, leaseID string) error {
+	err := s.queries.DeleteIdempotencyKey(ctx, dao.DeleteIdempotencyKeyParams{
+		IdempotencyKey: key,
+		LeaseID:        sql.NullString{String: leaseID, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+	return nil
+}
+
+// DeleteExpired 期限切れのキーを削除し、削除件数を返す
+func (s *IdempotencyStore) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	deleted, err := s.queries.DeleteExpiredIdempotencyKeys(ctx, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired idempotency keys: %w", err)
+	}
+	return deleted, nil
+}
+
+// toIdempotencyRecord dao.IdempotencyKeyをIdempotencyRecordに変換
+func toIdempotencyRecord(k dao.IdempotencyKey) (*IdempotencyRecord, error) {
+	record := &IdempotencyRecord{
+		Key:         k.IdempotencyKey,
+		Fingerprint: k.Fingerprint,
+		Body:        k.ResponseBody,
+		CreatedAt:   k.CreatedAt,
+		ExpiresAt:   k.ExpiresAt,
+		LeaseID:     k.LeaseID.String,
+		LockedUntil: k.LockedUntil.Time,
+	}
+	if k.StatusCode.Valid {
+		record.StatusCode = int(k.StatusCode.Int32)
+	}
+	if len(k.ResponseHeaders) > 0 {
+		if err := json.Unmarshal(k.ResponseHeaders, &record.Header); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal response headers: %w", err)
+		}
+	}
+	return record, nil
+}
@@ -0,0 +1,83 @@
+package infrastructure
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLRUCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	cache := NewLRUCache[int](2, time.Minute)
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+	// a を使うことで、最も長く使われていない項目を b にする
+	if _, ok := cache.Get("a"); !ok {
+		t.Fatal("expected a to be cached")
+	}
+	cache.Set("c", 3)
+
+	if _, ok := cache.Get("b"); ok {
+		t.Error("expected b to be evicted")
+	}
+	if v, ok := cache.Get("a"); !ok || v != 1 {
+		t.Errorf("expected a=1, got %d (ok=%v)", v, ok)
+	}
+	if v, ok := cache.Get("c"); !ok || v != 3 {
+		t.Errorf("expected c=3, got %d (ok=%v)", v, ok)
+	}
+	if cache.Len() != 2 {
+		t.Errorf("expected 2 items, got %d", cache.Len())
+	}
+}
+
+func TestLRUCache_Expires(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	cache := NewLRUCache[string](10, time.Minute)
+	cache.now = func() time.Time { return now }
+
+	cache.Set("a", "value")
+	now = now.Add(59 * time.Second)
+	if _, ok := cache.Get("a"); !ok {
+		t.Fatal("expected a to be cached before the ttl")
+	}
+
+	now = now.Add(time.Second)
+	if _, ok := cache.Get("a"); ok {
+		t.Error("expected a to expire after the ttl")
+	}
+	if cache.Len() != 0 {
+		t.Errorf("expected expired item to be removed, got %d items", cache.Len())
+	}
+}
+
+func TestLRUCache_SetRefreshesValueAndExpiry(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	cache := NewLRUCache[string](10, time.Minute)
+	cache.now = func() time.Time { return now }
+
+	cache.Set("a", "old")
+	now = now.Add(30 * time.Second)
+	cache.Set("a", "new")
+	now = now.Add(45 * time.Second)
+
+	if v, ok := cache.Get("a"); !ok || v != "new" {
+		t.Errorf("expected a=new, got %q (ok=%v)", v, ok)
+	}
+	if cache.Len() != 1 {
+		t.Errorf("expected 1 item, got %d", cache.Len())
+	}
+}
+
+func TestLRUCache_Delete(t *testing.T) {
+	cache := NewLRUCache[int](10, time.Minute)
+	cache.Set("a", 1)
+	cache.Delete("a")
+	// 存在しない項目の削除は何もしない
+	cache.Delete("missing")
+
+	if _, ok := cache.Get("a"); ok {
+		t.Error("expected a to be deleted")
+	}
+	if cache.Len() != 0 {
+		t.Errorf("expected 0 items, got %d", cache.Len())
+	}
+}
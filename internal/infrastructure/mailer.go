@@ -0,0 +1,95 @@
+package infrastructure
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"strconv"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// MailerConfig メール送信の設定
+type MailerConfig struct {
+	// Host SMTPサーバーのホスト（空の場合はメールを送信せずログに出力する）
+	Host     string
+	Port     int
+	Username string
+	Password string
+	// From 送信元のメールアドレス
+	From string
+}
+
+// SMTPMailer SMTPでメールを送信する
+type SMTPMailer struct {
+	config MailerConfig
+}
+
+// NewSMTPMailer SMTPMailerのコンストラクタ
+func NewSMTPMailer(config MailerConfig) *SMTPMailer {
+	return &SMTPMailer{config: config}
+}
+
+// Send メールを送信する
+func (m *SMTPMailer) Send(ctx context.Context, message domain.EmailMessage) error {
+	body, err := buildMailBody(m.config.From, message)
+	if err != nil {
+		return err
+	}
+
+	var auth smtp.Auth
+	if m.config.Username != "" {
+		auth = smtp.PlainAuth("", m.config.Username, m.config.Password, m.config.Host)
+	}
+	addr := net.JoinHostPort(m.config.Host, strconv.Itoa(m.config.Port))
+	if err := smtp.SendMail(addr, auth, m.config.From, []string{message.To}, body); err != nil {
+		return fmt.Errorf("failed to send mail: %w", err)
+	}
+	return nil
+}
+
+// LogMailer メールを送信せずにログへ出力する（ローカル開発用）
+type LogMailer struct{}
+
+// NewLogMailer LogMailerのコンストラクタ
+func NewLogMailer() *LogMailer {
+	return &LogMailer{}
+}
+
+// Send メールの内容をログに出力する
+func (m *LogMailer) Send(ctx context.Context, message domain.EmailMessage) error {
+	logger.FromContext(ctx).Info("sending email (log only)",
+		slog.String("to", message.To),
+		slog.String("subject", message.Subject),
+		slog.String("body", message.Body),
+	)
+	return nil
+}
+
+// buildMailBody ヘッダーと quoted-printable でエンコードした本文からメールを組み立てる
+func buildMailBody(from string, message domain.EmailMessage) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", message.To)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("UTF-8", message.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+
+	w := quotedprintable.NewWriter(&buf)
+	if _, err := w.Write([]byte(message.Body)); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,238 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// QueryDB 読み取りに使う接続のインターフェース（*sql.DB と ReplicaRouter が実装する）
+type QueryDB interface {
+	DBTX
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
+}
+
+// replicationLagQuery レプリカが適用していないWALがある場合に、最後に適用したトランザクションからの経過秒数を返す
+const replicationLagQuery = `SELECT CASE
+    WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+    ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+END`
+
+// ReplicaConfig リードレプリカへの振り分けの設定
+type ReplicaConfig struct {
+	// HealthCheckInterval レプリカの死活・遅延を確認する間隔
+	HealthCheckInterval time.Duration
+	// MaxLag これより遅延しているレプリカは使わない（0の場合は遅延を確認しない）
+	MaxLag time.Duration
+}
+
+// replica リードレプリカの接続と状態
+type replica struct {
+	name    string
+	db      *sql.DB
+	healthy atomic.Bool
+}
+
+// ReplicaRouter 読み取りのクエリを正常なリードレプリカに振り分ける（QueryDB を実装）
+// 正常なレプリカがない場合と、コンテキストでプライマリが指定された場合（WithPrimary）はプライマリで実行する
+type ReplicaRouter struct {
+	primary  *sql.DB
+	replicas []*replica
+	config   ReplicaConfig
+	logger   *slog.Logger
+	next     atomic.Uint64
+	stopCh   chan struct{}
+	stopOnce sync.Once
+}
+
+// NewReplicaRouter ReplicaRouterのコンストラクタ
+// レプリカは最初の確認が終わるまで使わないため、Start を呼び出してから利用する
+func NewReplicaRouter(primary *sql.DB, replicas map[string]*sql.DB, config ReplicaConfig, logger *slog.Logger) *ReplicaRouter {
+	router := &ReplicaRouter{
+		primary: primary,
+		config:  config,
+		logger:  logger,
+		stopCh:  make(chan struct{}),
+	}
+	for name, db := range replicas {
+		router.replicas = append(router.replicas, &replica{name: name, db: db})
+	}
+	return router
+}
+
+// Start レプリカの死活・遅延を確認し、以降は一定間隔で確認し続ける
+func (r *ReplicaRouter) Start(ctx context.Context) {
+	r.checkReplicas(ctx)
+	if len(r.replicas) == 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(r.config.HealthCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				r.checkReplicas(ctx)
+			case <-r.stopCh:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Close 確認を停止し、レプリカの接続を閉じる（プライマリは閉じない）
+func (r *ReplicaRouter) Close() error {
+	r.stopOnce.Do(func() {
+		close(r.stopCh)
+	})
+	var errs []error
+	for _, rep := range r.replicas {
+		errs = append(errs, rep.db.Close())
+	}
+	return errors.Join(errs...)
+}
+
+// checkReplicas すべてのレプリカの状態を確認し、変化した場合はログに記録する
+func (r *ReplicaRouter) checkReplicas(ctx context.Context) {
+	for _, rep := range r.replicas {
+		err := r.checkReplica(ctx, rep)
+		healthy := err == nil
+		if rep.healthy.Swap(healthy) == healthy {
+			continue
+		}
+		if healthy {
+			r.logger.Info("read replica is healthy", slog.String("replica", rep.name))
+		} else {
+			r.logger.Warn("read replica is unhealthy, falling back",
+				slog.String("replica", rep.name),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+}
+
+// checkReplica レプリカに接続でき、遅延が上限以内かどうか確認する
+func (r *ReplicaRouter) checkReplica(ctx context.Context, rep *replica) error {
+	ctx, cancel := context.WithTimeout(ctx, r.config.HealthCheckInterval)
+	defer cancel()
+
+	if err := rep.db.PingContext(ctx); err != nil {
+		return err
+	}
+	if r.config.MaxLag <= 0 {
+		return nil
+	}
+
+	var lagSeconds float64
+	if err := rep.db.QueryRowContext(ctx, replicationLagQuery).Scan(&lagSeconds); err != nil {
+		return fmt.Errorf("failed to check replication lag: %w", err)
+	}
+	if lag := time.Duration(lagSeconds * float64(time.Second)); lag > r.config.MaxLag {
+		return fmt.Errorf("replication lag %s exceeds %s", lag.Round(time.Millisecond), r.config.MaxLag)
+	}
+	return nil
+}
+
+// pick クエリを実行する接続を選ぶ（正常なレプリカを順番に使い、ない場合はプライマリ）
+func (r *ReplicaRouter) pick(ctx context.Context) (*sql.DB, *replica) {
+	if len(r.replicas) == 0 || PrimaryRequested(ctx) {
+		return r.primary, nil
+	}
+	start := r.next.Add(1)
+	for i := range r.replicas {
+		rep := r.replicas[(start+uint64(i))%uint64(len(r.replicas))]
+		if rep.healthy.Load() {
+			return rep.db, rep
+		}
+	}
+	return r.primary, nil
+}
+
+// fallback レプリカへの接続に失敗した場合にレプリカを異常とし、プライマリで再実行するかどうか判定する
+// SQLのエラー（PostgreSQLが返したエラー）とコンテキストのキャンセルは再実行しない
+func (r *ReplicaRouter) fallback(ctx context.Context, rep *replica, err error) bool {
+	if rep == nil || err == nil || ctx.Err() != nil {
+		return false
+	}
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return false
+	}
+	if rep.healthy.Swap(false) {
+		r.logger.Warn("read replica query failed, falling back to primary",
+			slog.String("replica", rep.name),
+			slog.String("error", err.Error()),
+		)
+	}
+	return true
+}
+
+// ExecContext DBTXインターフェースを実装
+func (r *ReplicaRouter) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	db, rep := r.pick(ctx)
+	result, err := db.ExecContext(ctx, query, args...)
+	if r.fallback(ctx, rep, err) {
+		return r.primary.ExecContext(ctx, query, args...)
+	}
+	return result, err
+}
+
+// PrepareContext DBTXインターフェースを実装
+func (r *ReplicaRouter) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	db, rep := r.pick(ctx)
+	stmt, err := db.PrepareContext(ctx, query)
+	if r.fallback(ctx, rep, err) {
+		return r.primary.PrepareContext(ctx, query)
+	}
+	return stmt, err
+}
+
+// QueryContext DBTXインターフェースを実装
+func (r *ReplicaRouter) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	db, rep := r.pick(ctx)
+	rows, err := db.QueryContext(ctx, query, args...)
+	if r.fallback(ctx, rep, err) {
+		return r.primary.QueryContext(ctx, query, args...)
+	}
+	return rows, err
+}
+
+// QueryRowContext DBTXインターフェースを実装
+// エラーは Scan まで分からないため、失敗してもプライマリでは再実行しない（次の確認までにレプリカが異常となる）
+func (r *ReplicaRouter) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	db, _ := r.pick(ctx)
+	return db.QueryRowContext(ctx, query, args...)
+}
+
+// BeginTx QueryDBインターフェースを実装
+func (r *ReplicaRouter) BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error) {
+	db, rep := r.pick(ctx)
+	tx, err := db.BeginTx(ctx, opts)
+	if r.fallback(ctx, rep, err) {
+		return r.primary.BeginTx(ctx, opts)
+	}
+	return tx, err
+}
+
+type primaryContextKey struct{}
+
+// WithPrimary コンテキストの読み取りをプライマリで実行するよう指定する（直前の書き込みを読むため）
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryContextKey{}, true)
+}
+
+// PrimaryRequested コンテキストでプライマリが指定されているかどうか
+func PrimaryRequested(ctx context.Context) bool {
+	primary, _ := ctx.Value(primaryContextKey{}).(bool)
+	return primary
+}
@@ -0,0 +1,303 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// fakeReplicaDB 接続の可否・レプリケーションの遅延・クエリのエラーを切り替えられるメモリ上のデータベース
+type fakeReplicaDB struct {
+	name string
+
+	mu sync.Mutex
+	// connectErr 接続時に返すエラー（停止しているデータベース）
+	connectErr error
+	// queryErr 通常のクエリで返すエラー
+	queryErr error
+	// lagSeconds レプリケーションの遅延（秒）
+	lagSeconds float64
+	// queries 実行されたクエリ（遅延の確認を除く）
+	queries int
+	// lagChecks 遅延を確認した回数
+	lagChecks int
+}
+
+func newFakeReplicaDB(t *testing.T, name string) (*fakeReplicaDB, *sql.DB) {
+	t.Helper()
+	fake := &fakeReplicaDB{name: name}
+	db := sql.OpenDB(fake)
+	// 停止・復旧を切り替えたときに、残っている接続を使い回さないようにする
+	db.SetMaxIdleConns(0)
+	t.Cleanup(func() { _ = db.Close() })
+	return fake, db
+}
+
+func (f *fakeReplicaDB) set(fn func(f *fakeReplicaDB)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	fn(f)
+}
+
+func (f *fakeReplicaDB) queryCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.queries
+}
+
+func (f *fakeReplicaDB) Connect(context.Context) (driver.Conn, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.connectErr != nil {
+		return nil, f.connectErr
+	}
+	return fakeReplicaConn{f}, nil
+}
+func (f *fakeReplicaDB) Driver() driver.Driver { return nil }
+
+type fakeReplicaConn struct{ db *fakeReplicaDB }
+
+func (c fakeReplicaConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepare is not supported")
+}
+func (c fakeReplicaConn) Close() error { return nil }
+func (c fakeReplicaConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+func (c fakeReplicaConn) Ping(context.Context) error {
+	return nil
+}
+
+func (c fakeReplicaConn) BeginTx(context.Context, driver.TxOptions) (driver.Tx, error) {
+	f := c.db
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.queryErr != nil {
+		return nil, f.queryErr
+	}
+	f.queries++
+	return fakeReplicaTx{}, nil
+}
+
+func (c fakeReplicaConn) ExecContext(context.Context, string, []driver.NamedValue) (driver.Result, error) {
+	f := c.db
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.queryErr != nil {
+		return nil, f.queryErr
+	}
+	f.queries++
+	return driver.RowsAffected(1), nil
+}
+
+func (c fakeReplicaConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	f := c.db
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if query == replicationLagQuery {
+		f.lagChecks++
+		return &fakeReplicaRows{values: []driver.Value{f.lagSeconds}}, nil
+	}
+	if f.queryErr != nil {
+		return nil, f.queryErr
+	}
+	f.queries++
+	// どのデータベースで実行されたか分かるよう、名前を返す
+	return &fakeReplicaRows{values: []driver.Value{f.name}}, nil
+}
+
+type fakeReplicaTx struct{}
+
+func (fakeReplicaTx) Commit() error   { return nil }
+func (fakeReplicaTx) Rollback() error { return nil }
+
+// fakeReplicaRows 1行1列の結果
+type fakeReplicaRows struct {
+	values []driver.Value
+	done   bool
+}
+
+func (r *fakeReplicaRows) Columns() []string { return []string{"value"} }
+func (r *fakeReplicaRows) Close() error      { return nil }
+func (r *fakeReplicaRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	copy(dest, r.values)
+	r.done = true
+	return nil
+}
+
+// newTestReplicaRouter プライマリと指定した名前のレプリカで ReplicaRouter を作成する（Start は呼び出さない）
+func newTestReplicaRouter(t *testing.T, config ReplicaConfig, names ...string) (*ReplicaRouter, *fakeReplicaDB, map[string]*fakeReplicaDB) {
+	t.Helper()
+	primary, primaryDB := newFakeReplicaDB(t, "primary")
+	fakes := map[string]*fakeReplicaDB{}
+	replicas := map[string]*sql.DB{}
+	for _, name := range names {
+		fakes[name], replicas[name] = newFakeReplicaDB(t, name)
+	}
+	if config.HealthCheckInterval == 0 {
+		config.HealthCheckInterval = time.Second
+	}
+	router := NewReplicaRouter(primaryDB, replicas, config, slog.New(slog.DiscardHandler))
+	return router, primary, fakes
+}
+
+// queryTarget クエリを実行したデータベースの名前
+func queryTarget(t *testing.T, ctx context.Context, router *ReplicaRouter) string {
+	t.Helper()
+	rows, err := router.QueryContext(ctx, "SELECT 1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer rows.Close()
+	var name string
+	if !rows.Next() {
+		t.Fatal("expected a row")
+	}
+	if err := rows.Scan(&name); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return name
+}
+
+func TestReplicaRouter_UsesPrimaryUntilChecked(t *testing.T) {
+	router, _, _ := newTestReplicaRouter(t, ReplicaConfig{}, "replica1")
+
+	// 最初の確認が終わるまではレプリカを使わない
+	if got := queryTarget(t, context.Background(), router); got != "primary" {
+		t.Errorf("expected primary before the first health check, got %s", got)
+	}
+}
+
+func TestReplicaRouter_RoutesToHealthyReplicas(t *testing.T) {
+	router, primary, replicas := newTestReplicaRouter(t, ReplicaConfig{}, "replica1", "replica2")
+	router.checkReplicas(context.Background())
+
+	seen := map[string]int{}
+	for i := 0; i < 4; i++ {
+		seen[queryTarget(t, context.Background(), router)]++
+	}
+
+	// 正常なレプリカを順番に使う
+	if seen["replica1"] != 2 || seen["replica2"] != 2 {
+		t.Errorf("expected queries to be spread over replicas, got %v", seen)
+	}
+	if primary.queryCount() != 0 {
+		t.Errorf("expected no queries on primary, got %d", primary.queryCount())
+	}
+	if replicas["replica1"].lagChecks != 0 {
+		t.Error("expected replication lag not to be checked when MaxLag is 0")
+	}
+}
+
+func TestReplicaRouter_UsesPrimaryWhenRequested(t *testing.T) {
+	router, _, _ := newTestReplicaRouter(t, ReplicaConfig{}, "replica1")
+	router.checkReplicas(context.Background())
+
+	if got := queryTarget(t, WithPrimary(context.Background()), router); got != "primary" {
+		t.Errorf("expected primary for WithPrimary context, got %s", got)
+	}
+}
+
+func TestReplicaRouter_ExcludesUnhealthyReplicas(t *testing.T) {
+	router, _, replicas := newTestReplicaRouter(t, ReplicaConfig{}, "replica1", "replica2")
+	replicas["replica1"].set(func(f *fakeReplicaDB) { f.connectErr = errors.New("connection refused") })
+	router.checkReplicas(context.Background())
+
+	for i := 0; i < 3; i++ {
+		if got := queryTarget(t, context.Background(), router); got != "replica2" {
+			t.Errorf("expected unreachable replica to be skipped, got %s", got)
+		}
+	}
+
+	// 次の確認で復旧したレプリカを再び使う
+	replicas["replica1"].set(func(f *fakeReplicaDB) { f.connectErr = nil })
+	router.checkReplicas(context.Background())
+	seen := map[string]int{}
+	for i := 0; i < 2; i++ {
+		seen[queryTarget(t, context.Background(), router)]++
+	}
+	if seen["replica1"] != 1 {
+		t.Errorf("expected recovered replica to be used again, got %v", seen)
+	}
+}
+
+func TestReplicaRouter_ExcludesLaggingReplicas(t *testing.T) {
+	router, _, replicas := newTestReplicaRouter(t, ReplicaConfig{MaxLag: 5 * time.Second}, "replica1", "replica2")
+	replicas["replica1"].set(func(f *fakeReplicaDB) { f.lagSeconds = 10 })
+	replicas["replica2"].set(func(f *fakeReplicaDB) { f.lagSeconds = 1 })
+	router.checkReplicas(context.Background())
+
+	for i := 0; i < 3; i++ {
+		if got := queryTarget(t, context.Background(), router); got != "replica2" {
+			t.Errorf("expected lagging replica to be skipped, got %s", got)
+		}
+	}
+}
+
+func TestReplicaRouter_FallsBackToPrimaryWithoutHealthyReplicas(t *testing.T) {
+	router, _, replicas := newTestReplicaRouter(t, ReplicaConfig{MaxLag: 5 * time.Second}, "replica1", "replica2")
+	replicas["replica1"].set(func(f *fakeReplicaDB) { f.connectErr = errors.New("connection refused") })
+	replicas["replica2"].set(func(f *fakeReplicaDB) { f.lagSeconds = 10 })
+	router.checkReplicas(context.Background())
+
+	if got := queryTarget(t, context.Background(), router); got != "primary" {
+		t.Errorf("expected primary without healthy replicas, got %s", got)
+	}
+}
+
+func TestReplicaRouter_FallsBackToPrimaryOnConnectionError(t *testing.T) {
+	router, primary, replicas := newTestReplicaRouter(t, ReplicaConfig{}, "replica1")
+	router.checkReplicas(context.Background())
+
+	// 確認の後にレプリカが停止した場合、失敗したクエリをプライマリで再実行し、レプリカを異常とする
+	replicas["replica1"].set(func(f *fakeReplicaDB) { f.connectErr = errors.New("connection refused") })
+	if got := queryTarget(t, context.Background(), router); got != "primary" {
+		t.Errorf("expected query to be retried on primary, got %s", got)
+	}
+	if _, err := router.ExecContext(context.Background(), "SELECT 1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tx, err := router.BeginTx(context.Background(), &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_ = tx.Rollback()
+
+	if primary.queryCount() != 3 {
+		t.Errorf("expected 3 queries on primary, got %d", primary.queryCount())
+	}
+	if router.replicas[0].healthy.Load() {
+		t.Error("expected failed replica to be marked unhealthy")
+	}
+}
+
+func TestReplicaRouter_DoesNotRetrySQLErrors(t *testing.T) {
+	router, primary, replicas := newTestReplicaRouter(t, ReplicaConfig{}, "replica1")
+	router.checkReplicas(context.Background())
+
+	// PostgreSQLが返したエラーはプライマリでも同じ結果になるため再実行しない
+	sqlErr := &pq.Error{Code: "42P01", Message: `relation "missing" does not exist`}
+	replicas["replica1"].set(func(f *fakeReplicaDB) { f.queryErr = sqlErr })
+	_, err := router.QueryContext(context.Background(), "SELECT * FROM missing")
+
+	if !errors.Is(err, sqlErr) {
+		t.Errorf("expected SQL error, got %v", err)
+	}
+	if primary.queryCount() != 0 {
+		t.Errorf("expected no retry on primary, got %d queries", primary.queryCount())
+	}
+	if !router.replicas[0].healthy.Load() {
+		t.Error("expected replica to stay healthy after a SQL error")
+	}
+}
@@ -0,0 +1,102 @@
+package infrastructure
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+)
+
+// webhookUserAgent 送信するWebhookのUser-Agent
+const webhookUserAgent = "go-react-cqrs-template-webhook/1.0"
+
+// webhookResponseBodyLimit 接続を再利用するために読み捨てる応答のボディの上限
+const webhookResponseBodyLimit = 4 << 10
+
+// WebhookSenderConfig Webhook送信の設定
+type WebhookSenderConfig struct {
+	// Timeout 1回の送信で受信側の応答を待つ時間
+	Timeout time.Duration
+	// AllowPrivateNetworks ループバック・プライベートアドレスへの送信を許可する（ローカル開発・テスト用）
+	// 無効な場合は、利用者が登録したURLから内部のサービスへ到達できないように拒否する
+	AllowPrivateNetworks bool
+}
+
+// HTTPWebhookSender WebhookをHTTPで送信する
+type HTTPWebhookSender struct {
+	client *http.Client
+}
+
+// NewHTTPWebhookSender HTTPWebhookSenderのコンストラクタ
+func NewHTTPWebhookSender(config WebhookSenderConfig) *HTTPWebhookSender {
+	dialer := &net.Dialer{Timeout: config.Timeout}
+	if !config.AllowPrivateNetworks {
+		// 名前解決後の接続先で判定する（DNSの応答を差し替えて内部のアドレスへ向けられないようにする）
+		dialer.Control = rejectPrivateAddress
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &HTTPWebhookSender{
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   config.Timeout,
+			// リダイレクト先には署名を送らない（3xx は失敗として扱う）
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+// Send 署名付きのリクエストを送信し、受信側が返したHTTPステータスを返す（2xx 以外はエラー）
+func (s *HTTPWebhookSender) Send(ctx context.Context, request domain.WebhookRequest) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, request.URL, bytes.NewReader(request.Body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", webhookUserAgent)
+	req.Header.Set(domain.WebhookIDHeader, request.EventID)
+	req.Header.Set(domain.WebhookEventHeader, request.EventType)
+	req.Header.Set(domain.WebhookTimestampHeader, strconv.FormatInt(request.Timestamp.Unix(), 10))
+	req.Header.Set(domain.WebhookSignatureHeader, request.Signature)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to send webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, webhookResponseBodyLimit))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("webhook receiver responded with status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// errWebhookPrivateAddress 接続先がループバック・プライベートアドレスのエラー
+var errWebhookPrivateAddress = errors.New("webhook destination resolves to a private address")
+
+// rejectPrivateAddress 接続先がループバック・プライベート・リンクローカルなどのアドレスの場合に接続を拒否する
+func rejectPrivateAddress(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsUnspecified() || ip.IsMulticast() || ip.IsInterfaceLocalMulticast() {
+		return errWebhookPrivateAddress
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+)
+
+func newTestWebhookRequest(t *testing.T, url string) (domain.WebhookRequest, *domain.WebhookSubscription) {
+	t.Helper()
+	subscription, err := domain.NewWebhookSubscription(url, []string{domain.EventTypeUserCreated}, domain.NewSystemPrincipal("test"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	body := []byte(`{"id":"01ARZ3NDEKTSV4RRFFQ69G5FAV","type":"user.created"}`)
+	return domain.NewWebhookRequest(subscription, "01ARZ3NDEKTSV4RRFFQ69G5FAV", domain.EventTypeUserCreated, body, time.Now()), subscription
+}
+
+func TestHTTPWebhookSender_Send(t *testing.T) {
+	t.Run("delivers a signed request", func(t *testing.T) {
+		var (
+			secret   string
+			received *http.Request
+			body     []byte
+			verified bool
+		)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = r
+			body, _ = io.ReadAll(r.Body)
+			// 受信側と同じ手順で署名を検証する
+			verified = domain.VerifyWebhookSignature(secret, r.Header.Get(domain.WebhookTimestampHeader), r.Header.Get(domain.WebhookSignatureHeader), body, time.Now())
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		request, subscription := newTestWebhookRequest(t, server.URL+"/hooks")
+		secret = subscription.Secret
+		sender := NewHTTPWebhookSender(WebhookSenderConfig{Timeout: 5 * time.Second, AllowPrivateNetworks: true})
+
+		status, err := sender.Send(context.Background(), request)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if status != http.StatusNoContent {
+			t.Errorf("expected status %d, got %d", http.StatusNoContent, status)
+		}
+		if received.Method != http.MethodPost || received.URL.Path != "/hooks" {
+			t.Errorf("unexpected request: %s %s", received.Method, received.URL.Path)
+		}
+		if got := received.Header.Get(domain.WebhookIDHeader); got != "01ARZ3NDEKTSV4RRFFQ69G5FAV" {
+			t.Errorf("expected %s to be the event id, got %s", domain.WebhookIDHeader, got)
+		}
+		if got := received.Header.Get(domain.WebhookEventHeader); got != domain.EventTypeUserCreated {
+			t.Errorf("expected %s to be %s, got %s", domain.WebhookEventHeader, domain.EventTypeUserCreated, got)
+		}
+		if string(body) != string(request.Body) {
+			t.Errorf("expected body %s, got %s", request.Body, body)
+		}
+		if !verified {
+			t.Error("expected the receiver to verify the signature")
+		}
+	})
+
+	t.Run("reports a non-2xx status as a failure", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		request, _ := newTestWebhookRequest(t, server.URL)
+		sender := NewHTTPWebhookSender(WebhookSenderConfig{Timeout: 5 * time.Second, AllowPrivateNetworks: true})
+
+		status, err := sender.Send(context.Background(), request)
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+		if status != http.StatusServiceUnavailable {
+			t.Errorf("expected status %d, got %d", http.StatusServiceUnavailable, status)
+		}
+	})
+
+	t.Run("does not follow redirects", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, "/elsewhere", http.StatusFound)
+		}))
+		defer server.Close()
+
+		request, _ := newTestWebhookRequest(t, server.URL)
+		sender := NewHTTPWebhookSender(WebhookSenderConfig{Timeout: 5 * time.Second, AllowPrivateNetworks: true})
+
+		status, err := sender.Send(context.Background(), request)
+		if err == nil || status != http.StatusFound {
+			t.Errorf("expected the redirect to fail with status %d, got %d (err=%v)", http.StatusFound, status, err)
+		}
+	})
+
+	t.Run("rejects private addresses", func(t *testing.T) {
+		called := false
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+		}))
+		defer server.Close()
+
+		request, _ := newTestWebhookRequest(t, server.URL)
+		sender := NewHTTPWebhookSender(WebhookSenderConfig{Timeout: 5 * time.Second})
+
+		status, err := sender.Send(context.Background(), request)
+		if !errors.Is(err, errWebhookPrivateAddress) {
+			t.Errorf("expected errWebhookPrivateAddress, got %v", err)
+		}
+		if status != 0 || called {
+			t.Errorf("expected no request to reach the receiver, got status %d (called=%v)", status, called)
+		}
+	})
+}
@@ -222,6 +222,32 @@ func Conflict(message string, userMessage string) *AppError {
 	)
 }
 
+// UnprocessableEntity は処理できないリクエストのエラーを作成します
+func UnprocessableEntity(message string, userMessage string) *AppError {
+	if userMessage == "" {
+		userMessage = "リクエストを処理できません"
+	}
+	return New(
+		message,
+		userMessage,
+		http.StatusUnprocessableEntity,
+		LevelInfo,
+	)
+}
+
+// TooManyRequests は試行回数の上限を超えたエラーを作成します
+func TooManyRequests(message string, userMessage string) *AppError {
+	if userMessage == "" {
+		userMessage = "リクエストが多すぎます。しばらく時間をおいてから再度お試しください"
+	}
+	return New(
+		message,
+		userMessage,
+		http.StatusTooManyRequests,
+		LevelWarning,
+	)
+}
+
 // captureStack はスタックトレースをキャプチャします
 func captureStack(skip int) []string {
 	const maxDepth = 32
@@ -0,0 +1,112 @@
+package queryservice
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+)
+
+// APIKeyQueryService APIキーの読み取り操作を担当
+type APIKeyQueryService struct {
+	queries *dao.Queries
+}
+
+// NewAPIKeyQueryService APIKeyQueryServiceのコンストラクタ
+func NewAPIKeyQueryService(db infrastructure.QueryDB) *APIKeyQueryService {
+	return &APIKeyQueryService{queries: dao.New(db)}
+}
+
+// FindByID IDでAPIキーを検索
+func (q *APIKeyQueryService) FindByID(ctx context.Context, id string) (*domain.APIKey, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	apiKey, err := q.queries.GetAPIKeyByID(ctx, dao.GetAPIKeyByIDParams{OrganizationID: organizationID, ID: id})
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainAPIKey(apiKey), nil
+}
+
+// FindByPrefix プレフィックスでAPIキーを検索
+// 認証時にテナントを決めるために使うため、テナントでは絞り込まない（APIキーが APIKey.OrganizationID に紐づく）
+func (q *APIKeyQueryService) FindByPrefix(ctx context.Context, prefix string) (*domain.APIKey, error) {
+	apiKey, err := q.queries.GetAPIKeyByPrefix(ctx, prefix)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainAPIKey(apiKey), nil
+}
+
+// FindAll APIキーを新しい順に取得（ページネーション対応）
+func (q *APIKeyQueryService) FindAll(ctx context.Context, includeRevoked bool, limit, offset int) ([]*domain.APIKey, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	apiKeys, err := q.queries.ListAPIKeys(ctx, dao.ListAPIKeysParams{
+		OrganizationID: organizationID,
+		IncludeRevoked: includeRevoked,
+		Limit:          int32(limit),
+		Offset:         int32(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.APIKey, len(apiKeys))
+	for i, k := range apiKeys {
+		result[i] = toDomainAPIKey(k)
+	}
+	return result, nil
+}
+
+// Count APIキーの件数を取得
+func (q *APIKeyQueryService) Count(ctx context.Context, includeRevoked bool) (int, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return 0, err
+	}
+	count, err := q.queries.CountAPIKeys(ctx, dao.CountAPIKeysParams{
+		OrganizationID: organizationID,
+		IncludeRevoked: includeRevoked,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// toDomainAPIKey dao.ApiKeyをdomain.APIKeyに変換
+func toDomainAPIKey(k dao.ApiKey) *domain.APIKey {
+	apiKey := &domain.APIKey{
+		ID:             k.ID,
+		OrganizationID: k.OrganizationID,
+		Name:           k.Name,
+		Prefix:         k.Prefix,
+		SecretHash:     k.SecretHash,
+		Scopes:         k.Scopes,
+		CreatedBy:      k.CreatedBy,
+		CreatedAt:      k.CreatedAt,
+	}
+	if k.ExpiresAt.Valid {
+		apiKey.ExpiresAt = &k.ExpiresAt.Time
+	}
+	if k.LastUsedAt.Valid {
+		apiKey.LastUsedAt = &k.LastUsedAt.Time
+	}
+	if k.RevokedAt.Valid {
+		apiKey.RevokedAt = &k.RevokedAt.Time
+	}
+	return apiKey
+}
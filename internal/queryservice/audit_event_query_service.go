@@ -0,0 +1,100 @@
+package queryservice
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+)
+
+// AuditEventQueryService 監査イベントの読み取り操作を担当
+type AuditEventQueryService struct {
+	queries *dao.Queries
+}
+
+// NewAuditEventQueryService AuditEventQueryServiceのコンストラクタ
+func NewAuditEventQueryService(db infrastructure.QueryDB) *AuditEventQueryService {
+	return &AuditEventQueryService{queries: dao.New(db)}
+}
+
+// FindAll 条件に一致する監査イベントを新しい順に取得（ページネーション対応）
+func (q *AuditEventQueryService) FindAll(ctx context.Context, filter domain.AuditEventFilter, limit, offset int) ([]*domain.AuditEvent, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	events, err := q.queries.ListAuditEvents(ctx, dao.ListAuditEventsParams{
+		OrganizationID: organizationID,
+		AggregateType:  toNullString(string(filter.AggregateType)),
+		AggregateID:    toNullString(filter.AggregateID),
+		Action:         toNullString(filter.Action),
+		Actor:          toNullString(filter.Actor),
+		Since:          toNullTime(filter.Since),
+		Until:          toNullTime(filter.Until),
+		Limit:          int32(limit),
+		Offset:         int32(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.AuditEvent, len(events))
+	for i, e := range events {
+		event, err := toDomainAuditEvent(e)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = event
+	}
+	return result, nil
+}
+
+// Count 条件に一致する監査イベントの件数を取得
+func (q *AuditEventQueryService) Count(ctx context.Context, filter domain.AuditEventFilter) (int, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return 0, err
+	}
+	count, err := q.queries.CountAuditEvents(ctx, dao.CountAuditEventsParams{
+		OrganizationID: organizationID,
+		AggregateType:  toNullString(string(filter.AggregateType)),
+		AggregateID:    toNullString(filter.AggregateID),
+		Action:         toNullString(filter.Action),
+		Actor:          toNullString(filter.Actor),
+		Since:          toNullTime(filter.Since),
+		Until:          toNullTime(filter.Until),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// toNullTime ゼロ値の場合はNULLとして扱う
+func toNullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
+// toDomainAuditEvent dao.AuditEventをdomain.AuditEventに変換
+func toDomainAuditEvent(e dao.AuditEvent) (*domain.AuditEvent, error) {
+	var metadata domain.RequestMetadata
+	if err := json.Unmarshal(e.Metadata, &metadata); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal audit event metadata: %w", err)
+	}
+	return &domain.AuditEvent{
+		ID:            e.ID,
+		AggregateType: domain.AuditAggregateType(e.AggregateType),
+		AggregateID:   e.AggregateID,
+		Action:        e.Action,
+		Actor:         e.Actor,
+		RequestID:     e.RequestID,
+		Metadata:      metadata,
+		Payload:       e.Payload,
+		CreatedAt:     e.CreatedAt,
+	}, nil
+}
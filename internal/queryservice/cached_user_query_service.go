@@ -0,0 +1,227 @@
+package queryservice
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+// UserCache ユーザーのキャッシュのインターフェース（プロセス間で共有するキャッシュに差し替えられるようにする）
+// キャッシュのエラーは読み取りを失敗させず、データベースから読む
+type UserCache interface {
+	Get(ctx context.Context, key string) (*domain.User, bool, error)
+	Set(ctx context.Context, key string, user *domain.User) error
+	Delete(ctx context.Context, key string) error
+}
+
+// LRUUserCache プロセス内のLRUキャッシュによるUserCache
+type LRUUserCache struct {
+	cache *infrastructure.LRUCache[domain.User]
+}
+
+// NewLRUUserCache LRUUserCacheのコンストラクタ
+func NewLRUUserCache(capacity int, ttl time.Duration) *LRUUserCache {
+	return &LRUUserCache{cache: infrastructure.NewLRUCache[domain.User](capacity, ttl)}
+}
+
+// Get UserCacheインターフェースを実装（呼び出し元が変更しても影響しないよう、コピーを返す）
+func (c *LRUUserCache) Get(_ context.Context, key string) (*domain.User, bool, error) {
+	user, ok := c.cache.Get(key)
+	if !ok {
+		return nil, false, nil
+	}
+	return &user, true, nil
+}
+
+// Set UserCacheインターフェースを実装（コピーを保存する）
+func (c *LRUUserCache) Set(_ context.Context, key string, user *domain.User) error {
+	c.cache.Set(key, *user)
+	return nil
+}
+
+// Delete UserCacheインターフェースを実装
+func (c *LRUUserCache) Delete(_ context.Context, key string) error {
+	c.cache.Delete(key)
+	return nil
+}
+
+// UserCacheStats ユーザーのキャッシュの利用状況
+type UserCacheStats struct {
+	Hits   uint64 `json:"hits"`
+	Misses uint64 `json:"misses"`
+	// Bypasses プライマリからの読み取りが指定されたため、キャッシュを使わなかった回数
+	Bypasses uint64 `json:"bypasses"`
+	// Errors キャッシュの操作に失敗した回数
+	Errors uint64 `json:"errors"`
+}
+
+// userCacheChangeBatchSize キャッシュの削除のために1回に読む変更通知の件数
+const userCacheChangeBatchSize = 100
+
+// userCacheSettleRetry 確定していない変更通知が残っている場合に読み直すまでの間隔
+// より小さいIDの変更を保存したトランザクションの終了は通知されないため、通知を待たずに読み直す
+const userCacheSettleRetry = 200 * time.Millisecond
+
+// UserCacheChangeFeed すべての組織の変更通知の読み取り（ほかのプロセスで変更されたユーザーをキャッシュから削除するために使う）
+type UserCacheChangeFeed interface {
+	FindAfterInAllOrganizations(ctx context.Context, afterID int64, limit int) ([]*domain.Change, error)
+	LatestIDInAllOrganizations(ctx context.Context) (int64, error)
+}
+
+// CachedUserQueryService IDでのユーザーの検索をキャッシュする UserQueryRepository のデコレーター
+// キャッシュはコミット後のユーザーのイベント（Invalidate）と、ほかのプロセスのコマンドを含む変更通知（FollowChanges）で削除する
+// 直前の書き込みを読むためにプライマリが指定されたリクエスト（infrastructure.WithPrimary）はキャッシュを読まない
+// パスワードのハッシュはキャッシュに保存しないため、FindByID が返すユーザーの PasswordHash は常に空になる
+type CachedUserQueryService struct {
+	usecase.UserQueryRepository
+	cache    UserCache
+	hits     atomic.Uint64
+	misses   atomic.Uint64
+	bypasses atomic.Uint64
+	errors   atomic.Uint64
+}
+
+// NewCachedUserQueryService CachedUserQueryServiceのコンストラクタ
+func NewCachedUserQueryService(next usecase.UserQueryRepository, cache UserCache) *CachedUserQueryService {
+	return &CachedUserQueryService{UserQueryRepository: next, cache: cache}
+}
+
+// FindByID IDでユーザーを検索（キャッシュにない場合はデータベースから読んでキャッシュする）
+func (q *CachedUserQueryService) FindByID(ctx context.Context, id string) (*domain.User, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	key := userCacheKey(organizationID, id)
+
+	if infrastructure.PrimaryRequested(ctx) {
+		q.bypasses.Add(1)
+	} else {
+		user, ok, err := q.cache.Get(ctx, key)
+		if err != nil {
+			q.recordError(ctx, "get", err)
+		} else if ok {
+			q.hits.Add(1)
+			return user, nil
+		}
+		q.misses.Add(1)
+	}
+
+	user, err := q.UserQueryRepository.FindByID(ctx, id)
+	if err != nil || user == nil {
+		// 存在しないユーザーはキャッシュしない（作成直後に見つからないままにならないように）
+		return user, err
+	}
+	user.PasswordHash = ""
+	if err := q.cache.Set(ctx, key, user); err != nil {
+		q.recordError(ctx, "set", err)
+	}
+	return user, nil
+}
+
+// Invalidate コミットされたユーザーのイベントのユーザーをキャッシュから削除する（TransactionManager.Subscribe で登録する）
+func (q *CachedUserQueryService) Invalidate(ctx context.Context, event domain.DomainEvent) {
+	if event.AggregateType() != domain.AggregateTypeUser {
+		return
+	}
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		q.recordError(ctx, "delete", err)
+		return
+	}
+	if err := q.cache.Delete(ctx, userCacheKey(organizationID, event.AggregateID())); err != nil {
+		q.recordError(ctx, "delete", err)
+	}
+}
+
+// FollowChanges 変更通知を読み、変更されたユーザーをキャッシュから削除する（wake が閉じられるか ctx が終了するまで実行する）
+// wake は変更通知が保存されると値を受け取るチャンネル（infrastructure.ChangeListener.SubscribeAll）
+// 開始時点より後の変更通知のみを対象とし、読み取りに失敗した場合は次の通知で読み直す
+func (q *CachedUserQueryService) FollowChanges(ctx context.Context, feed UserCacheChangeFeed, wake <-chan struct{}) {
+	cursor, err := feed.LatestIDInAllOrganizations(ctx)
+	started := err == nil
+	if err != nil {
+		q.recordError(ctx, "follow", err)
+	}
+	// pending 確定していない変更通知が残っている（通知がなくても userCacheSettleRetry 後に読み直す）
+	pending := false
+	for {
+		var retry <-chan time.Time
+		if pending {
+			retry = time.After(userCacheSettleRetry)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case _, ok := <-wake:
+			if !ok {
+				return
+			}
+		case <-retry:
+		}
+		if !started {
+			// 開始位置を読めなかった場合は、変更通知をすべて読み直さないよう現在の末尾から始める
+			if cursor, err = feed.LatestIDInAllOrganizations(ctx); err != nil {
+				q.recordError(ctx, "follow", err)
+				continue
+			}
+			started = true
+			continue
+		}
+		cursor, pending = q.invalidateChanges(ctx, feed, cursor)
+	}
+}
+
+// invalidateChanges cursor より後の確定した変更通知のユーザーをキャッシュから削除し、最後に読んだ変更通知のIDを返す
+// 確定していない変更通知の手前で止めた場合は pending が真
+func (q *CachedUserQueryService) invalidateChanges(ctx context.Context, feed UserCacheChangeFeed, cursor int64) (int64, bool) {
+	for {
+		changes, err := feed.FindAfterInAllOrganizations(ctx, cursor, userCacheChangeBatchSize)
+		if err != nil {
+			q.recordError(ctx, "follow", err)
+			return cursor, false
+		}
+		settled, pending := domain.SettledChanges(changes)
+		for _, change := range settled {
+			cursor = change.ID
+			if change.AggregateType != domain.AggregateTypeUser {
+				continue
+			}
+			if err := q.cache.Delete(ctx, userCacheKey(change.OrganizationID, change.AggregateID)); err != nil {
+				q.recordError(ctx, "delete", err)
+			}
+		}
+		if pending || len(changes) < userCacheChangeBatchSize {
+			return cursor, pending
+		}
+	}
+}
+
+// Stats キャッシュの利用状況を取得
+func (q *CachedUserQueryService) Stats() UserCacheStats {
+	return UserCacheStats{
+		Hits:     q.hits.Load(),
+		Misses:   q.misses.Load(),
+		Bypasses: q.bypasses.Load(),
+		Errors:   q.errors.Load(),
+	}
+}
+
+func (q *CachedUserQueryService) recordError(ctx context.Context, operation string, err error) {
+	q.errors.Add(1)
+	logger.FromContext(ctx).Warn("user cache operation failed",
+		slog.String("operation", operation),
+		slog.String("error", err.Error()),
+	)
+}
+
+// userCacheKey テナントごとのユーザーのキャッシュのキー
+func userCacheKey(organizationID, userID string) string {
+	return "user:" + organizationID + ":" + userID
+}
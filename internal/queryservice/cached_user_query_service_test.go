@@ -0,0 +1,388 @@
+package queryservice
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/usecase"
+)
+
+const (
+	testOrganizationID = "01ARZ3NDEKTSV4RRFFQ69G5FO1"
+	testUserID         = "01ARZ3NDEKTSV4RRFFQ69G5FAV"
+)
+
+// fakeUserQuery データベースの代わりに、呼び出し回数を数えてユーザーを返す
+type fakeUserQuery struct {
+	usecase.UserQueryRepository
+	users map[string]domain.User
+	calls int
+}
+
+func (q *fakeUserQuery) FindByID(_ context.Context, id string) (*domain.User, error) {
+	q.calls++
+	user, ok := q.users[id]
+	if !ok {
+		return nil, nil
+	}
+	return &user, nil
+}
+
+// fakeChangeFeed 変更通知の代わり（FollowChanges のゴルーチンから読まれる）
+type fakeChangeFeed struct {
+	mu      sync.Mutex
+	changes []*domain.Change
+	// latestErrs LatestIDInAllOrganizations が順に返すエラー
+	latestErrs []error
+}
+
+func (f *fakeChangeFeed) append(change *domain.Change) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.changes = append(f.changes, change)
+}
+
+// settle 変更通知を確定させる（より小さいIDの変更を保存したトランザクションが終了した）
+func (f *fakeChangeFeed) settle(id int64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for i, change := range f.changes {
+		if change.ID == id {
+			settled := *change
+			settled.Pending = false
+			f.changes[i] = &settled
+		}
+	}
+}
+
+func (f *fakeChangeFeed) FindAfterInAllOrganizations(_ context.Context, afterID int64, limit int) ([]*domain.Change, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var result []*domain.Change
+	for _, change := range f.changes {
+		if change.ID > afterID && len(result) < limit {
+			result = append(result, change)
+		}
+	}
+	return result, nil
+}
+
+func (f *fakeChangeFeed) LatestIDInAllOrganizations(_ context.Context) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(f.latestErrs) > 0 {
+		err := f.latestErrs[0]
+		f.latestErrs = f.latestErrs[1:]
+		return 0, err
+	}
+	// 最初の確定していない変更通知の手前までの最新のID
+	var latestID int64
+	for _, change := range f.changes {
+		if change.Pending {
+			break
+		}
+		latestID = change.ID
+	}
+	return latestID, nil
+}
+
+func newTestCachedUserQuery() (*CachedUserQueryService, *fakeUserQuery) {
+	next := &fakeUserQuery{users: map[string]domain.User{
+		testUserID: {ID: testUserID, OrganizationID: testOrganizationID, Name: "John", Email: "john@example.com", PasswordHash: "$argon2id$hash"},
+	}}
+	return NewCachedUserQueryService(next, NewLRUUserCache(10, time.Minute)), next
+}
+
+func TestCachedUserQueryService_FindByID(t *testing.T) {
+	q, next := newTestCachedUserQuery()
+	ctx := domain.WithTenant(context.Background(), testOrganizationID)
+
+	for i := 0; i < 2; i++ {
+		user, err := q.FindByID(ctx, testUserID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if user == nil || user.Name != "John" {
+			t.Fatalf("unexpected user %+v", user)
+		}
+		// パスワードのハッシュはキャッシュ・呼び出し元のどちらにも渡さない
+		if user.PasswordHash != "" {
+			t.Errorf("expected password hash to be stripped, got %q", user.PasswordHash)
+		}
+		// 返したユーザーを変更してもキャッシュに影響しない
+		user.Name = "changed"
+	}
+
+	if next.calls != 1 {
+		t.Errorf("expected 1 database read, got %d", next.calls)
+	}
+	if stats := q.Stats(); stats.Hits != 1 || stats.Misses != 1 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+}
+
+func TestCachedUserQueryService_FindByID_SeparatesTenants(t *testing.T) {
+	q, next := newTestCachedUserQuery()
+
+	if _, err := q.FindByID(domain.WithTenant(context.Background(), testOrganizationID), testUserID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := q.FindByID(domain.WithTenant(context.Background(), "01ARZ3NDEKTSV4RRFFQ69G5FO2"), testUserID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if next.calls != 2 {
+		t.Errorf("expected each tenant to read the database, got %d reads", next.calls)
+	}
+}
+
+func TestCachedUserQueryService_FindByID_DoesNotCacheMissingUser(t *testing.T) {
+	q, next := newTestCachedUserQuery()
+	ctx := domain.WithTenant(context.Background(), testOrganizationID)
+
+	for i := 0; i < 2; i++ {
+		user, err := q.FindByID(ctx, "01ARZ3NDEKTSV4RRFFQ69G5FAW")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if user != nil {
+			t.Fatalf("expected nil user, got %+v", user)
+		}
+	}
+
+	if next.calls != 2 {
+		t.Errorf("expected 2 database reads, got %d", next.calls)
+	}
+}
+
+func TestCachedUserQueryService_FindByID_BypassesCacheForPrimary(t *testing.T) {
+	q, next := newTestCachedUserQuery()
+	ctx := domain.WithTenant(context.Background(), testOrganizationID)
+	if _, err := q.FindByID(ctx, testUserID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// 直前の書き込みを読むリクエストはキャッシュにあってもデータベースから読む
+	next.users[testUserID] = domain.User{ID: testUserID, OrganizationID: testOrganizationID, Name: "Jane", PasswordHash: "$argon2id$hash"}
+	user, err := q.FindByID(infrastructure.WithPrimary(ctx), testUserID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if user.Name != "Jane" || user.PasswordHash != "" {
+		t.Errorf("unexpected user %+v", user)
+	}
+	if next.calls != 2 {
+		t.Errorf("expected 2 database reads, got %d", next.calls)
+	}
+
+	// プライマリから読んだ最新の値でキャッシュを更新する
+	cached, err := q.FindByID(ctx, testUserID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cached.Name != "Jane" || next.calls != 2 {
+		t.Errorf("expected refreshed cache entry, got %+v after %d reads", cached, next.calls)
+	}
+	if stats := q.Stats(); stats.Bypasses != 1 || stats.Hits != 1 || stats.Misses != 1 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+}
+
+func TestCachedUserQueryService_Invalidate(t *testing.T) {
+	q, next := newTestCachedUserQuery()
+	ctx := domain.WithTenant(context.Background(), testOrganizationID)
+	if _, err := q.FindByID(ctx, testUserID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	q.Invalidate(ctx, domain.UserDeleted{UserID: testUserID})
+	if _, err := q.FindByID(ctx, testUserID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if next.calls != 2 {
+		t.Errorf("expected the invalidated user to be read again, got %d reads", next.calls)
+	}
+}
+
+func TestCachedUserQueryService_FollowChanges(t *testing.T) {
+	tests := []struct {
+		name      string
+		change    *domain.Change
+		wantReads int
+	}{
+		{
+			name:      "user changed in another process",
+			change:    &domain.Change{ID: 2, OrganizationID: testOrganizationID, AggregateType: domain.AggregateTypeUser, AggregateID: testUserID},
+			wantReads: 2,
+		},
+		{
+			name:      "same user id in another organization",
+			change:    &domain.Change{ID: 2, OrganizationID: "01ARZ3NDEKTSV4RRFFQ69G5FO2", AggregateType: domain.AggregateTypeUser, AggregateID: testUserID},
+			wantReads: 1,
+		},
+		{
+			name:      "other aggregate",
+			change:    &domain.Change{ID: 2, OrganizationID: testOrganizationID, AggregateType: domain.AggregateType("webhook"), AggregateID: testUserID},
+			wantReads: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, next := newTestCachedUserQuery()
+			ctx := domain.WithTenant(context.Background(), testOrganizationID)
+			// 開始前の変更通知は読まない
+			feed := &fakeChangeFeed{changes: []*domain.Change{
+				{ID: 1, OrganizationID: testOrganizationID, AggregateType: domain.AggregateTypeUser, AggregateID: testUserID},
+			}}
+			wake := make(chan struct{})
+			done := make(chan struct{})
+			go func() {
+				q.FollowChanges(context.Background(), feed, wake)
+				close(done)
+			}()
+
+			if _, err := q.FindByID(ctx, testUserID); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			// 最初の通知を受け取った時点で開始位置は読み終わっている
+			wake <- struct{}{}
+			feed.append(tt.change)
+			// 通知を送った後、チャンネルを閉じて終了を待つことで削除の完了を待つ
+			wake <- struct{}{}
+			close(wake)
+			<-done
+
+			if _, err := q.FindByID(ctx, testUserID); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if next.calls != tt.wantReads {
+				t.Errorf("expected %d database reads, got %d", tt.wantReads, next.calls)
+			}
+		})
+	}
+}
+
+func TestCachedUserQueryService_FollowChanges_ReadsInBatches(t *testing.T) {
+	q, _ := newTestCachedUserQuery()
+	ctx := domain.WithTenant(context.Background(), testOrganizationID)
+	feed := &fakeChangeFeed{}
+
+	var userIDs []string
+	for i := 0; i < userCacheChangeBatchSize+1; i++ {
+		userID := "user-" + string(rune('a'+i%26)) + string(rune('a'+i/26))
+		userIDs = append(userIDs, userID)
+		if err := q.cache.Set(ctx, userCacheKey(testOrganizationID, userID), &domain.User{ID: userID}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		feed.changes = append(feed.changes, &domain.Change{ID: int64(i + 1), OrganizationID: testOrganizationID, AggregateType: domain.AggregateTypeUser, AggregateID: userID})
+	}
+
+	// 1回の通知でバッチの件数を超える変更通知をすべて読む
+	if cursor, _ := q.invalidateChanges(ctx, feed, 0); cursor != int64(len(userIDs)) {
+		t.Errorf("expected cursor %d, got %d", len(userIDs), cursor)
+	}
+	for _, userID := range userIDs {
+		if _, ok, _ := q.cache.Get(ctx, userCacheKey(testOrganizationID, userID)); ok {
+			t.Errorf("expected %s to be invalidated", userID)
+		}
+	}
+}
+
+func TestCachedUserQueryService_FollowChanges_WaitsForPendingChanges(t *testing.T) {
+	q, _ := newTestCachedUserQuery()
+	ctx := domain.WithTenant(context.Background(), testOrganizationID)
+	userIDs := []string{"user-a", "user-b"}
+	for _, userID := range userIDs {
+		if err := q.cache.Set(ctx, userCacheKey(testOrganizationID, userID), &domain.User{ID: userID}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	// ID 1 を保存したトランザクションより先に、ID 2 を保存したトランザクションがコミットした
+	feed := &fakeChangeFeed{changes: []*domain.Change{
+		{ID: 1, OrganizationID: testOrganizationID, AggregateType: domain.AggregateTypeUser, AggregateID: "user-a", Pending: true},
+		{ID: 2, OrganizationID: testOrganizationID, AggregateType: domain.AggregateTypeUser, AggregateID: "user-b", Pending: true},
+	}}
+
+	// 確定していない変更通知から先は読み進めない
+	cursor, pending := q.invalidateChanges(ctx, feed, 0)
+	if cursor != 0 || !pending {
+		t.Errorf("expected cursor 0 with pending changes, got %d (pending %v)", cursor, pending)
+	}
+	for _, userID := range userIDs {
+		if _, ok, _ := q.cache.Get(ctx, userCacheKey(testOrganizationID, userID)); !ok {
+			t.Errorf("expected %s to be kept until the change is settled", userID)
+		}
+	}
+
+	followCtx, cancel := context.WithCancel(context.Background())
+	wake := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		q.FollowChanges(followCtx, feed, wake)
+		close(done)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+	wake <- struct{}{}
+	wake <- struct{}{}
+
+	// 確定した後は、通知がなくても読み直して削除する
+	feed.settle(1)
+	feed.settle(2)
+	deadline := time.Now().Add(2 * time.Second)
+	for _, userID := range userIDs {
+		for {
+			_, ok, _ := q.cache.Get(ctx, userCacheKey(testOrganizationID, userID))
+			if !ok {
+				break
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("expected %s to be invalidated after the change was settled", userID)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+}
+
+func TestCachedUserQueryService_FollowChanges_RetriesStartPosition(t *testing.T) {
+	q, next := newTestCachedUserQuery()
+	ctx := domain.WithTenant(context.Background(), testOrganizationID)
+	feed := &fakeChangeFeed{
+		changes:    []*domain.Change{{ID: 1, OrganizationID: testOrganizationID, AggregateType: domain.AggregateTypeUser, AggregateID: testUserID}},
+		latestErrs: []error{errors.New("connection refused"), errors.New("connection refused")},
+	}
+	if _, err := q.FindByID(ctx, testUserID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wake := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		q.FollowChanges(context.Background(), feed, wake)
+		close(done)
+	}()
+	// 開始位置を読めない間は、過去の変更通知をすべて読み直さない
+	wake <- struct{}{}
+	wake <- struct{}{}
+	close(wake)
+	<-done
+
+	if _, err := q.FindByID(ctx, testUserID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if next.calls != 1 {
+		t.Errorf("expected cached user to be kept, got %d reads", next.calls)
+	}
+	if stats := q.Stats(); stats.Errors != 2 {
+		t.Errorf("expected 2 errors, got %+v", stats)
+	}
+}
@@ -0,0 +1,90 @@
+package queryservice
+
+import (
+	"context"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+)
+
+// ChangeQueryService 変更通知の読み取り操作を担当
+// NOTIFY を受けてすぐに読むため、レプリカではなくプライマリの接続を渡す
+type ChangeQueryService struct {
+	queries *dao.Queries
+}
+
+// NewChangeQueryService ChangeQueryServiceのコンストラクタ
+func NewChangeQueryService(db infrastructure.QueryDB) *ChangeQueryService {
+	return &ChangeQueryService{queries: dao.New(db)}
+}
+
+// FindAfter 指定したIDより後の変更通知を古い順に取得（確定していない変更通知は Pending が真）
+func (q *ChangeQueryService) FindAfter(ctx context.Context, afterID int64, limit int) ([]*domain.Change, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := q.queries.ListChangesAfter(ctx, dao.ListChangesAfterParams{
+		OrganizationID: organizationID,
+		AfterID:        afterID,
+		Limit:          int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	changes := make([]*domain.Change, len(rows))
+	for i, row := range rows {
+		changes[i] = toDomainChange(row)
+	}
+	return changes, nil
+}
+
+// FindAfterInAllOrganizations すべての組織の、指定したIDより後の変更通知を古い順に取得（キャッシュの削除に使う）
+func (q *ChangeQueryService) FindAfterInAllOrganizations(ctx context.Context, afterID int64, limit int) ([]*domain.Change, error) {
+	rows, err := q.queries.ListChangesAfterInAllOrganizations(ctx, dao.ListChangesAfterInAllOrganizationsParams{
+		AfterID: afterID,
+		Limit:   int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	changes := make([]*domain.Change, len(rows))
+	for i, row := range rows {
+		changes[i] = toDomainChange(dao.ListChangesAfterRow(row))
+	}
+	return changes, nil
+}
+
+// LatestID 組織の最新の確定した変更通知のID（変更通知がない場合は0）
+func (q *ChangeQueryService) LatestID(ctx context.Context) (int64, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return q.queries.GetLatestChangeID(ctx, organizationID)
+}
+
+// LatestIDInAllOrganizations すべての組織の最新の確定した変更通知のID（変更通知がない場合は0）
+func (q *ChangeQueryService) LatestIDInAllOrganizations(ctx context.Context) (int64, error) {
+	return q.queries.GetLatestChangeIDInAllOrganizations(ctx)
+}
+
+// OldestID 保持している最も古い変更通知のID（変更通知がない場合は0）
+// IDはテナントをまたいだ連番のため、すべてのテナントで最も古いものを返す
+func (q *ChangeQueryService) OldestID(ctx context.Context) (int64, error) {
+	return q.queries.GetOldestChangeID(ctx)
+}
+
+// toDomainChange dao.ListChangesAfterRowをdomain.Changeに変換
+func toDomainChange(row dao.ListChangesAfterRow) *domain.Change {
+	return &domain.Change{
+		ID:             row.ID,
+		OrganizationID: row.OrganizationID,
+		AggregateType:  domain.AggregateType(row.AggregateType),
+		AggregateID:    row.AggregateID,
+		Type:           domain.ChangeType(row.ChangeType),
+		OccurredAt:     row.OccurredAt,
+		Pending:        !row.Settled,
+	}
+}
@@ -0,0 +1,97 @@
+package queryservice
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+)
+
+// InboundEventQueryService 受信したWebhookのイベントの読み取り操作を担当
+type InboundEventQueryService struct {
+	queries *dao.Queries
+}
+
+// NewInboundEventQueryService InboundEventQueryServiceのコンストラクタ
+func NewInboundEventQueryService(db infrastructure.QueryDB) *InboundEventQueryService {
+	return &InboundEventQueryService{queries: dao.New(db)}
+}
+
+// FindByID IDで受信イベントを検索
+func (q *InboundEventQueryService) FindByID(ctx context.Context, id string) (*domain.InboundEvent, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	event, err := q.queries.GetInboundEventByID(ctx, dao.GetInboundEventByIDParams{OrganizationID: organizationID, ID: id})
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainInboundEvent(event), nil
+}
+
+// FindAll 条件に一致する受信イベントを新しい順に取得（ページネーション対応）
+func (q *InboundEventQueryService) FindAll(ctx context.Context, filter domain.InboundEventFilter, limit, offset int) ([]*domain.InboundEvent, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	events, err := q.queries.ListInboundEvents(ctx, dao.ListInboundEventsParams{
+		OrganizationID: organizationID,
+		Provider:       toNullString(filter.Provider),
+		Status:         toNullString(string(filter.Status)),
+		Limit:          int32(limit),
+		Offset:         int32(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.InboundEvent, len(events))
+	for i, e := range events {
+		result[i] = toDomainInboundEvent(e)
+	}
+	return result, nil
+}
+
+// Count 条件に一致する受信イベントの件数を取得
+func (q *InboundEventQueryService) Count(ctx context.Context, filter domain.InboundEventFilter) (int, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return 0, err
+	}
+	count, err := q.queries.CountInboundEvents(ctx, dao.CountInboundEventsParams{
+		OrganizationID: organizationID,
+		Provider:       toNullString(filter.Provider),
+		Status:         toNullString(string(filter.Status)),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// toDomainInboundEvent dao.InboundEventをdomain.InboundEventに変換
+func toDomainInboundEvent(e dao.InboundEvent) *domain.InboundEvent {
+	event := &domain.InboundEvent{
+		ID:              e.ID,
+		OrganizationID:  e.OrganizationID,
+		Provider:        e.Provider,
+		ProviderEventID: e.ProviderEventID,
+		EventType:       e.EventType,
+		Subject:         e.Subject,
+		Payload:         e.Payload,
+		Status:          domain.InboundEventStatus(e.Status),
+		LastError:       e.LastError,
+		ReceivedAt:      e.ReceivedAt,
+	}
+	if e.ProcessedAt.Valid {
+		event.ProcessedAt = &e.ProcessedAt.Time
+	}
+	return event
+}
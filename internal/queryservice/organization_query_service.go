@@ -0,0 +1,117 @@
+package queryservice
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+)
+
+// OrganizationQueryService 組織とメンバーシップの読み取り操作を担当
+type OrganizationQueryService struct {
+	queries *dao.Queries
+}
+
+// NewOrganizationQueryService OrganizationQueryServiceのコンストラクタ
+func NewOrganizationQueryService(db infrastructure.QueryDB) *OrganizationQueryService {
+	return &OrganizationQueryService{queries: dao.New(db)}
+}
+
+// FindByID IDで組織を検索
+func (q *OrganizationQueryService) FindByID(ctx context.Context, id string) (*domain.Organization, error) {
+	organization, err := q.queries.GetOrganizationByID(ctx, id)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainOrganization(organization), nil
+}
+
+// FindByMember ユーザーがメンバーである組織を名前順に取得
+func (q *OrganizationQueryService) FindByMember(ctx context.Context, userID string) ([]*domain.Organization, error) {
+	organizations, err := q.queries.ListOrganizationsByMember(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*domain.Organization, len(organizations))
+	for i, o := range organizations {
+		result[i] = toDomainOrganization(o)
+	}
+	return result, nil
+}
+
+// FindMembership 指定した組織のメンバーシップを検索
+// リクエストのテナントを決める前（認証時）に使うため、組織IDを明示的に受け取る
+func (q *OrganizationQueryService) FindMembership(ctx context.Context, organizationID, userID string) (*domain.Membership, error) {
+	membership, err := q.queries.GetMembership(ctx, dao.GetMembershipParams{
+		OrganizationID: organizationID,
+		UserID:         userID,
+	})
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainMembership(membership), nil
+}
+
+// FindMemberships コンテキストのテナントのメンバーシップを参加順に取得（ページネーション対応）
+func (q *OrganizationQueryService) FindMemberships(ctx context.Context, limit, offset int) ([]*domain.Membership, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	memberships, err := q.queries.ListMemberships(ctx, dao.ListMembershipsParams{
+		OrganizationID: organizationID,
+		Limit:          int32(limit),
+		Offset:         int32(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*domain.Membership, len(memberships))
+	for i, m := range memberships {
+		result[i] = toDomainMembership(m)
+	}
+	return result, nil
+}
+
+// CountMemberships コンテキストのテナントのメンバー数を取得
+func (q *OrganizationQueryService) CountMemberships(ctx context.Context) (int, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return 0, err
+	}
+	count, err := q.queries.CountMemberships(ctx, organizationID)
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// toDomainOrganization dao.Organizationをdomain.Organizationに変換
+func toDomainOrganization(o dao.Organization) *domain.Organization {
+	return &domain.Organization{
+		ID:        o.ID,
+		Name:      o.Name,
+		Slug:      o.Slug,
+		CreatedAt: o.CreatedAt,
+		UpdatedAt: o.UpdatedAt,
+	}
+}
+
+// toDomainMembership dao.OrganizationMembershipをdomain.Membershipに変換
+func toDomainMembership(m dao.OrganizationMembership) *domain.Membership {
+	return &domain.Membership{
+		OrganizationID: m.OrganizationID,
+		UserID:         m.UserID,
+		Roles:          domain.ParseRoles(m.Roles),
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+	}
+}
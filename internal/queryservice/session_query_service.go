@@ -0,0 +1,90 @@
+package queryservice
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+)
+
+// SessionQueryService セッションの読み取り操作を担当
+type SessionQueryService struct {
+	queries *dao.Queries
+}
+
+// NewSessionQueryService SessionQueryServiceのコンストラクタ
+func NewSessionQueryService(db infrastructure.QueryDB) *SessionQueryService {
+	return &SessionQueryService{queries: dao.New(db)}
+}
+
+// FindByID IDでセッションを検索
+func (q *SessionQueryService) FindByID(ctx context.Context, id string) (*domain.Session, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	session, err := q.queries.GetSessionByID(ctx, dao.GetSessionByIDParams{OrganizationID: organizationID, ID: id})
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainSession(session), nil
+}
+
+// FindByTokenHash トークンのハッシュでセッションを検索
+// 認証時にテナントを決めるために使うため、テナントでは絞り込まない（セッションが Session.OrganizationID に紐づく）
+func (q *SessionQueryService) FindByTokenHash(ctx context.Context, tokenHash string) (*domain.Session, error) {
+	session, err := q.queries.GetSessionByTokenHash(ctx, tokenHash)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainSession(session), nil
+}
+
+// FindActiveByUserID ユーザーの有効なセッションを新しい順に取得
+func (q *SessionQueryService) FindActiveByUserID(ctx context.Context, userID string, now time.Time) ([]*domain.Session, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := q.queries.ListActiveSessionsByUserID(ctx, dao.ListActiveSessionsByUserIDParams{
+		OrganizationID: organizationID,
+		UserID:         userID,
+		Now:            now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*domain.Session, len(sessions))
+	for i, s := range sessions {
+		result[i] = toDomainSession(s)
+	}
+	return result, nil
+}
+
+// toDomainSession dao.Sessionをdomain.Sessionに変換
+func toDomainSession(s dao.Session) *domain.Session {
+	session := &domain.Session{
+		ID:             s.ID,
+		UserID:         s.UserID,
+		OrganizationID: s.OrganizationID,
+		TokenHash:      s.TokenHash,
+		RemoteAddr:     s.RemoteAddr,
+		UserAgent:      s.UserAgent,
+		CreatedAt:      s.CreatedAt,
+		LastSeenAt:     s.LastSeenAt,
+		ExpiresAt:      s.ExpiresAt,
+	}
+	if s.RevokedAt.Valid {
+		session.RevokedAt = &s.RevokedAt.Time
+	}
+	return session
+}
@@ -0,0 +1,126 @@
+package queryservice
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+)
+
+// UserImportQueryService ユーザーインポートの読み取り操作を担当
+type UserImportQueryService struct {
+	queries *dao.Queries
+}
+
+// NewUserImportQueryService UserImportQueryServiceのコンストラクタ
+func NewUserImportQueryService(db infrastructure.QueryDB) *UserImportQueryService {
+	return &UserImportQueryService{queries: dao.New(db)}
+}
+
+// FindByID IDでインポートを検索
+func (q *UserImportQueryService) FindByID(ctx context.Context, id string) (*domain.UserImport, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userImport, err := q.queries.GetUserImportByID(ctx, dao.GetUserImportByIDParams{OrganizationID: organizationID, ID: id})
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainUserImport(userImport), nil
+}
+
+// Summarize インポート行の処理結果を集計
+func (q *UserImportQueryService) Summarize(ctx context.Context, importID string) (*domain.UserImportSummary, error) {
+	counts, err := q.queries.CountUserImportRowsByStatus(ctx, importID)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &domain.UserImportSummary{}
+	for _, c := range counts {
+		switch domain.UserImportRowStatus(c.Status) {
+		case domain.UserImportRowStatusCreated:
+			summary.Created = int(c.Count)
+		case domain.UserImportRowStatusSkippedDuplicate:
+			summary.SkippedDuplicate = int(c.Count)
+		case domain.UserImportRowStatusInvalid:
+			summary.Invalid = int(c.Count)
+		}
+	}
+	return summary, nil
+}
+
+// FindRows インポート行の処理結果を取得（ページネーション・状態での絞り込み対応）
+func (q *UserImportQueryService) FindRows(ctx context.Context, importID string, status domain.UserImportRowStatus, limit, offset int) ([]*domain.UserImportRow, error) {
+	rows, err := q.queries.ListUserImportRows(ctx, dao.ListUserImportRowsParams{
+		ImportID: importID,
+		Status:   toNullString(string(status)),
+		Limit:    int32(limit),
+		Offset:   int32(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.UserImportRow, len(rows))
+	for i, r := range rows {
+		result[i] = toDomainUserImportRow(r)
+	}
+	return result, nil
+}
+
+// CountRows インポート行の件数を取得
+func (q *UserImportQueryService) CountRows(ctx context.Context, importID string, status domain.UserImportRowStatus) (int, error) {
+	count, err := q.queries.CountUserImportRows(ctx, dao.CountUserImportRowsParams{
+		ImportID: importID,
+		Status:   toNullString(string(status)),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// toDomainUserImport dao.UserImportをdomain.UserImportに変換（読み取り用途のため元ファイルは含めない）
+func toDomainUserImport(i dao.UserImport) *domain.UserImport {
+	userImport := &domain.UserImport{
+		ID:        i.ID,
+		Format:    domain.UserImportFormat(i.Format),
+		Status:    domain.UserImportStatus(i.Status),
+		TotalRows: int(i.TotalRows),
+		CreatedAt: i.CreatedAt,
+		UpdatedAt: i.UpdatedAt,
+	}
+	if i.LastError.Valid {
+		userImport.LastError = i.LastError.String
+	}
+	if i.CompletedAt.Valid {
+		userImport.CompletedAt = &i.CompletedAt.Time
+	}
+	return userImport
+}
+
+// toDomainUserImportRow dao.UserImportRowをdomain.UserImportRowに変換
+func toDomainUserImportRow(r dao.UserImportRow) *domain.UserImportRow {
+	return &domain.UserImportRow{
+		ImportID:  r.ImportID,
+		Line:      int(r.Line),
+		Status:    domain.UserImportRowStatus(r.Status),
+		Name:      r.Name,
+		Email:     r.Email,
+		UserID:    r.UserID.String,
+		Reason:    r.Reason.String,
+		CreatedAt: r.CreatedAt,
+	}
+}
+
+// toNullString 空文字列をNULLとして扱うsql.NullStringに変換
+func toNullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
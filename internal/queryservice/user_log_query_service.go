@@ -0,0 +1,138 @@
+package queryservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+)
+
+// UserLogQueryService ユーザーログの読み取り操作を担当
+type UserLogQueryService struct {
+	queries *dao.Queries
+}
+
+// NewUserLogQueryService UserLogQueryServiceのコンストラクタ
+func NewUserLogQueryService(db infrastructure.QueryDB) *UserLogQueryService {
+	return &UserLogQueryService{queries: dao.New(db)}
+}
+
+// FindByUserID ユーザーIDでログを新しい順に取得（ページネーション・アクションでの絞り込み対応）
+// users テーブルは参照しないため、削除済みユーザーのログも取得できる
+func (q *UserLogQueryService) FindByUserID(ctx context.Context, userID string, action domain.UserLogAction, limit, offset int) ([]*domain.UserLog, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	logs, err := q.queries.GetUserLogsByUserID(ctx, dao.GetUserLogsByUserIDParams{
+		OrganizationID: organizationID,
+		UserID:         userID,
+		Action:         toNullString(string(action)),
+		Limit:          int32(limit),
+		Offset:         int32(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.UserLog, len(logs))
+	for i, l := range logs {
+		userLog, err := toDomainUserLog(l)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = userLog
+	}
+	return result, nil
+}
+
+// CountByUserID ユーザーIDでログの件数を取得
+func (q *UserLogQueryService) CountByUserID(ctx context.Context, userID string, action domain.UserLogAction) (int, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return 0, err
+	}
+	count, err := q.queries.CountUserLogsByUserID(ctx, dao.CountUserLogsByUserIDParams{
+		OrganizationID: organizationID,
+		UserID:         userID,
+		Action:         toNullString(string(action)),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// FindByUserIDs 複数のユーザーのログを1回のクエリで取得し、ユーザーIDごとにまとめる（ページネーションはユーザーごとに適用）
+// ログのないユーザーは結果に含まれない
+func (q *UserLogQueryService) FindByUserIDs(ctx context.Context, userIDs []string, action domain.UserLogAction, limit, offset int) (map[string][]*domain.UserLog, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	logs, err := q.queries.ListUserLogsByUserIDs(ctx, dao.ListUserLogsByUserIDsParams{
+		OrganizationID: organizationID,
+		UserIds:        userIDs,
+		Action:         toNullString(string(action)),
+		Offset:         int32(offset),
+		Limit:          int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string][]*domain.UserLog, len(userIDs))
+	for _, l := range logs {
+		userLog, err := toDomainUserLog(l)
+		if err != nil {
+			return nil, err
+		}
+		result[l.UserID] = append(result[l.UserID], userLog)
+	}
+	return result, nil
+}
+
+// CountByUserIDs 複数のユーザーのログの件数を1回のクエリで取得（ログのないユーザーは結果に含まれない）
+func (q *UserLogQueryService) CountByUserIDs(ctx context.Context, userIDs []string, action domain.UserLogAction) (map[string]int, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	counts, err := q.queries.CountUserLogsByUserIDs(ctx, dao.CountUserLogsByUserIDsParams{
+		OrganizationID: organizationID,
+		UserIds:        userIDs,
+		Action:         toNullString(string(action)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]int, len(counts))
+	for _, c := range counts {
+		result[c.UserID] = int(c.LogCount)
+	}
+	return result, nil
+}
+
+// toDomainUserLog dao.UserLogをdomain.UserLogに変換
+func toDomainUserLog(l dao.UserLog) (*domain.UserLog, error) {
+	changes := domain.UserChanges{}
+	if err := json.Unmarshal(l.Changes, &changes); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal user log changes: %w", err)
+	}
+	return &domain.UserLog{
+		ID:        l.ID,
+		UserID:    l.UserID,
+		Action:    domain.UserLogAction(l.Action),
+		Changes:   changes,
+		Actor:     l.Actor,
+		RequestID: l.RequestID,
+		CreatedAt: l.CreatedAt,
+		Seq:       l.Seq,
+		PrevHash:  l.PrevHash,
+		Hash:      l.Hash,
+	}, nil
+}
@@ -3,24 +3,31 @@ package queryservice
 import (
 	"context"
 	"database/sql"
+	"fmt"
 
 	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
 	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
 )
 
 // UserQueryService ユーザー読み取り操作を担当
+// すべての読み取りはコンテキストのテナント（domain.WithTenant）のユーザーに限定される
 type UserQueryService struct {
 	queries *dao.Queries
 }
 
 // NewUserQueryService UserQueryServiceのコンストラクタ
-func NewUserQueryService(db *sql.DB) *UserQueryService {
+func NewUserQueryService(db infrastructure.QueryDB) *UserQueryService {
 	return &UserQueryService{queries: dao.New(db)}
 }
 
 // FindByID IDでユーザーを検索
 func (q *UserQueryService) FindByID(ctx context.Context, id string) (*domain.User, error) {
-	user, err := q.queries.GetUserByID(ctx, id)
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user, err := q.queries.GetUserByID(ctx, dao.GetUserByIDParams{OrganizationID: organizationID, ID: id})
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -32,9 +39,14 @@ func (q *UserQueryService) FindByID(ctx context.Context, id string) (*domain.Use
 
 // FindAll すべてのユーザーを取得（ページネーション対応）
 func (q *UserQueryService) FindAll(ctx context.Context, limit, offset int) ([]*domain.User, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
 	users, err := q.queries.ListUsers(ctx, dao.ListUsersParams{
-		Limit:  int32(limit),
-		Offset: int32(offset),
+		OrganizationID: organizationID,
+		Limit:          int32(limit),
+		Offset:         int32(offset),
 	})
 	if err != nil {
 		return nil, err
@@ -44,16 +56,70 @@ func (q *UserQueryService) FindAll(ctx context.Context, limit, offset int) ([]*d
 
 // Count ユーザーの総数を取得
 func (q *UserQueryService) Count(ctx context.Context) (int, error) {
-	count, err := q.queries.CountUsers(ctx)
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return 0, err
+	}
+	count, err := q.queries.CountUsers(ctx, organizationID)
 	if err != nil {
 		return 0, err
 	}
 	return int(count), nil
 }
 
+// userStreamPageSize StreamAll で1回のクエリで読み出す件数
+const userStreamPageSize = 500
+
+// StreamAll すべてのユーザーを一覧と同じ順序で fn に渡す
+// 最後に読んだユーザーの続きから（キーセットページネーション）少しずつ読み出すため、件数が多くても全件をメモリに載せない
+// ページごとに別のクエリで読むため長いトランザクションを保持しないが、読み出し中の追加・削除は反映される場合がある
+func (q *UserQueryService) StreamAll(ctx context.Context, fn func(*domain.User) error) error {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return err
+	}
+
+	var last *dao.User
+	for {
+		users, err := q.listUsersPage(ctx, organizationID, last)
+		if err != nil {
+			return fmt.Errorf("failed to list users: %w", err)
+		}
+		for _, u := range users {
+			if err := fn(toDomainUser(u)); err != nil {
+				return err
+			}
+		}
+		if len(users) < userStreamPageSize {
+			return nil
+		}
+		last = &users[len(users)-1]
+	}
+}
+
+// listUsersPage last より後の1ページ分のユーザーを取得（last が nil の場合は先頭のページ）
+func (q *UserQueryService) listUsersPage(ctx context.Context, organizationID string, last *dao.User) ([]dao.User, error) {
+	if last == nil {
+		return q.queries.ListUsersForStream(ctx, dao.ListUsersForStreamParams{
+			OrganizationID: organizationID,
+			Limit:          userStreamPageSize,
+		})
+	}
+	return q.queries.ListUsersForStreamAfter(ctx, dao.ListUsersForStreamAfterParams{
+		OrganizationID: organizationID,
+		AfterCreatedAt: last.CreatedAt,
+		AfterID:        last.ID,
+		Limit:          userStreamPageSize,
+	})
+}
+
 // FindByEmail メールアドレスでユーザーを検索
 func (q *UserQueryService) FindByEmail(ctx context.Context, email string) (*domain.User, error) {
-	user, err := q.queries.GetUserByEmail(ctx, email)
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user, err := q.queries.GetUserByEmail(ctx, dao.GetUserByEmailParams{OrganizationID: organizationID, Email: email})
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -65,13 +131,22 @@ func (q *UserQueryService) FindByEmail(ctx context.Context, email string) (*doma
 
 // toDomainUser dao.Userをdomain.Userに変換
 func toDomainUser(u dao.User) *domain.User {
-	return &domain.User{
-		ID:        u.ID,
-		Name:      u.Name,
-		Email:     u.Email,
-		CreatedAt: u.CreatedAt,
-		UpdatedAt: u.UpdatedAt,
+	user := &domain.User{
+		ID:             u.ID,
+		OrganizationID: u.OrganizationID,
+		Name:           u.Name,
+		Email:          u.Email,
+		PasswordHash:   u.PasswordHash,
+		CreatedAt:      u.CreatedAt,
+		UpdatedAt:      u.UpdatedAt,
+	}
+	if u.EmailVerifiedAt.Valid {
+		user.EmailVerifiedAt = &u.EmailVerifiedAt.Time
+	}
+	if u.EmailInvalidatedAt.Valid {
+		user.EmailInvalidatedAt = &u.EmailInvalidatedAt.Time
 	}
+	return user
 }
 
 // toDomainUsers []dao.Userを[]*domain.Userに変換
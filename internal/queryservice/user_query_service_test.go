@@ -0,0 +1,171 @@
+package queryservice
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+)
+
+// fakeUserTable キーセットページネーションのクエリだけを実装したメモリ上の users テーブル
+type fakeUserTable struct {
+	// rows 作成日時の降順、同じ日時はIDの降順に並べたユーザー
+	rows [][]driver.Value
+	// pages 実行されたクエリ名
+	pages []string
+}
+
+func newFakeUserTable(t *testing.T, count int) (*fakeUserTable, *sql.DB) {
+	t.Helper()
+	table := &fakeUserTable{}
+	createdAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	for i := range count {
+		// 3件ずつ同じ作成日時にして、日時が同じユーザーをIDで区切れることを確認する
+		table.rows = append(table.rows, []driver.Value{
+			fmt.Sprintf("user-%05d", i), testOrganizationID, fmt.Sprintf("User %d", i), fmt.Sprintf("user%d@example.com", i),
+			nil, nil, "", createdAt.Add(time.Duration(i/3) * time.Second), createdAt,
+		})
+	}
+	slices.SortFunc(table.rows, func(a, b []driver.Value) int {
+		if c := b[7].(time.Time).Compare(a[7].(time.Time)); c != 0 {
+			return c
+		}
+		return strings.Compare(b[0].(string), a[0].(string))
+	})
+	db := sql.OpenDB(table)
+	t.Cleanup(func() { _ = db.Close() })
+	return table, db
+}
+
+func (f *fakeUserTable) Connect(context.Context) (driver.Conn, error) { return fakeUserConn{f}, nil }
+func (f *fakeUserTable) Driver() driver.Driver                        { return nil }
+
+type fakeUserConn struct{ table *fakeUserTable }
+
+func (c fakeUserConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepare is not supported")
+}
+func (c fakeUserConn) Close() error { return nil }
+func (c fakeUserConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("begin is not supported")
+}
+
+func (c fakeUserConn) QueryContext(_ context.Context, query string, named []driver.NamedValue) (driver.Rows, error) {
+	name := strings.Fields(query)[2]
+	c.table.pages = append(c.table.pages, name)
+
+	var (
+		limit int
+		after func(row []driver.Value) bool
+	)
+	switch name {
+	case "ListUsersForStream":
+		limit = int(named[1].Value.(int64))
+		after = func([]driver.Value) bool { return true }
+	case "ListUsersForStreamAfter":
+		afterCreatedAt, afterID := named[1].Value.(time.Time), named[2].Value.(string)
+		limit = int(named[3].Value.(int64))
+		// (created_at, id) < (afterCreatedAt, afterID)
+		after = func(row []driver.Value) bool {
+			createdAt := row[7].(time.Time)
+			return createdAt.Before(afterCreatedAt) || (createdAt.Equal(afterCreatedAt) && row[0].(string) < afterID)
+		}
+	default:
+		return nil, fmt.Errorf("unexpected query: %s", name)
+	}
+
+	rows := &fakeUserRows{}
+	for _, row := range c.table.rows {
+		if row[1] == named[0].Value && after(row) && len(rows.values) < limit {
+			rows.values = append(rows.values, row)
+		}
+	}
+	return rows, nil
+}
+
+type fakeUserRows struct {
+	values [][]driver.Value
+	next   int
+}
+
+func (r *fakeUserRows) Columns() []string { return make([]string, 9) }
+func (r *fakeUserRows) Close() error      { return nil }
+func (r *fakeUserRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.values) {
+		return io.EOF
+	}
+	copy(dest, r.values[r.next])
+	r.next++
+	return nil
+}
+
+func TestUserQueryService_StreamAll(t *testing.T) {
+	tests := []struct {
+		name      string
+		count     int
+		wantPages int
+	}{
+		{name: "empty", count: 0, wantPages: 1},
+		{name: "single page", count: 10, wantPages: 1},
+		{name: "exactly one page", count: userStreamPageSize, wantPages: 2},
+		{name: "multiple pages", count: 2*userStreamPageSize + 1, wantPages: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table, db := newFakeUserTable(t, tt.count)
+			q := NewUserQueryService(db)
+			ctx := domain.WithTenant(context.Background(), testOrganizationID)
+
+			var got []string
+			err := q.StreamAll(ctx, func(user *domain.User) error {
+				got = append(got, user.ID)
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			// 一覧と同じ順序で、重複も欠落もなく渡す
+			if len(got) != tt.count {
+				t.Fatalf("expected %d users, got %d", tt.count, len(got))
+			}
+			for i, row := range table.rows {
+				if got[i] != row[0] {
+					t.Fatalf("user %d: expected %s, got %s", i, row[0], got[i])
+				}
+			}
+			if len(table.pages) != tt.wantPages {
+				t.Errorf("expected %d queries, got %v", tt.wantPages, table.pages)
+			}
+		})
+	}
+}
+
+func TestUserQueryService_StreamAll_StopsOnError(t *testing.T) {
+	table, db := newFakeUserTable(t, 2*userStreamPageSize)
+	q := NewUserQueryService(db)
+	ctx := domain.WithTenant(context.Background(), testOrganizationID)
+	wantErr := errors.New("client disconnected")
+
+	streamed := 0
+	err := q.StreamAll(ctx, func(*domain.User) error {
+		streamed++
+		return wantErr
+	})
+
+	if !errors.Is(err, wantErr) {
+		t.Errorf("expected %v, got %v", wantErr, err)
+	}
+	if streamed != 1 || len(table.pages) != 1 {
+		t.Errorf("expected streaming to stop after the first user, got %d users and %d queries", streamed, len(table.pages))
+	}
+}
@@ -0,0 +1,122 @@
+package queryservice
+
+import (
+	"context"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+)
+
+// UserSummaryQueryService ユーザー一覧（ユーザーログの件数・最新の日時を含む）の読み取り操作を担当
+// 読み取りモデルを使う場合は投影が更新する user_summaries を、使わない場合は users と user_logs を直接読む
+type UserSummaryQueryService struct {
+	queries      *dao.Queries
+	useReadModel bool
+}
+
+// NewUserSummaryQueryService UserSummaryQueryServiceのコンストラクタ
+// useReadModel を true にすると投影（ワーカーが更新する）から読むため、書き込みの反映が遅れることがある
+func NewUserSummaryQueryService(db infrastructure.QueryDB, useReadModel bool) *UserSummaryQueryService {
+	return &UserSummaryQueryService{queries: dao.New(db), useReadModel: useReadModel}
+}
+
+// FindAll ユーザー一覧を作成日時の新しい順に取得（ページネーション対応）
+func (q *UserSummaryQueryService) FindAll(ctx context.Context, limit, offset int) ([]*domain.UserSummary, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if q.useReadModel {
+		rows, err := q.queries.ListUserSummaries(ctx, dao.ListUserSummariesParams{
+			OrganizationID: organizationID,
+			Limit:          int32(limit),
+			Offset:         int32(offset),
+		})
+		if err != nil {
+			return nil, err
+		}
+		summaries := make([]*domain.UserSummary, len(rows))
+		for i, row := range rows {
+			summaries[i] = toDomainUserSummary(row)
+		}
+		return summaries, nil
+	}
+
+	users, err := q.queries.ListUsers(ctx, dao.ListUsersParams{
+		OrganizationID: organizationID,
+		Limit:          int32(limit),
+		Offset:         int32(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+	userIDs := make([]string, len(users))
+	for i, u := range users {
+		userIDs[i] = u.ID
+	}
+	logSummaries, err := q.queries.SummarizeUserLogsByUserIDs(ctx, dao.SummarizeUserLogsByUserIDsParams{
+		OrganizationID: organizationID,
+		UserIds:        userIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	logSummaryByUserID := make(map[string]dao.SummarizeUserLogsByUserIDsRow, len(logSummaries))
+	for _, s := range logSummaries {
+		logSummaryByUserID[s.UserID] = s
+	}
+
+	summaries := make([]*domain.UserSummary, len(users))
+	for i, u := range users {
+		summaries[i] = &domain.UserSummary{User: toDomainUser(u)}
+		if s, ok := logSummaryByUserID[u.ID]; ok {
+			summaries[i].LogCount = int(s.LogCount)
+			summaries[i].LastActivityAt = &s.LastActivityAt
+		}
+	}
+	return summaries, nil
+}
+
+// Count ユーザーの総数を取得
+func (q *UserSummaryQueryService) Count(ctx context.Context) (int, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	if q.useReadModel {
+		count, err = q.queries.CountUserSummaries(ctx, organizationID)
+	} else {
+		count, err = q.queries.CountUsers(ctx, organizationID)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// toDomainUserSummary dao.UserSummaryをdomain.UserSummaryに変換
+func toDomainUserSummary(s dao.UserSummary) *domain.UserSummary {
+	summary := &domain.UserSummary{
+		User: &domain.User{
+			ID:             s.ID,
+			OrganizationID: s.OrganizationID,
+			Name:           s.Name,
+			Email:          s.Email,
+			CreatedAt:      s.CreatedAt,
+			UpdatedAt:      s.UpdatedAt,
+		},
+		LogCount: int(s.LogCount),
+	}
+	if s.EmailVerifiedAt.Valid {
+		summary.User.EmailVerifiedAt = &s.EmailVerifiedAt.Time
+	}
+	if s.EmailInvalidatedAt.Valid {
+		summary.User.EmailInvalidatedAt = &s.EmailInvalidatedAt.Time
+	}
+	if s.LastActivityAt.Valid {
+		summary.LastActivityAt = &s.LastActivityAt.Time
+	}
+	return summary
+}
@@ -0,0 +1,142 @@
+package queryservice
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure/dao"
+)
+
+// WebhookQueryService Webhookと配信の記録の読み取り操作を担当
+type WebhookQueryService struct {
+	queries *dao.Queries
+}
+
+// NewWebhookQueryService WebhookQueryServiceのコンストラクタ
+func NewWebhookQueryService(db infrastructure.QueryDB) *WebhookQueryService {
+	return &WebhookQueryService{queries: dao.New(db)}
+}
+
+// FindByID IDでWebhookを検索
+func (q *WebhookQueryService) FindByID(ctx context.Context, id string) (*domain.WebhookSubscription, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	subscription, err := q.queries.GetWebhookSubscriptionByID(ctx, dao.GetWebhookSubscriptionByIDParams{OrganizationID: organizationID, ID: id})
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainWebhookSubscription(subscription), nil
+}
+
+// FindAll Webhookを新しい順に取得（ページネーション対応）
+func (q *WebhookQueryService) FindAll(ctx context.Context, limit, offset int) ([]*domain.WebhookSubscription, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	subscriptions, err := q.queries.ListWebhookSubscriptions(ctx, dao.ListWebhookSubscriptionsParams{
+		OrganizationID: organizationID,
+		Limit:          int32(limit),
+		Offset:         int32(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.WebhookSubscription, len(subscriptions))
+	for i, s := range subscriptions {
+		result[i] = toDomainWebhookSubscription(s)
+	}
+	return result, nil
+}
+
+// Count Webhookの件数を取得
+func (q *WebhookQueryService) Count(ctx context.Context) (int, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return 0, err
+	}
+	count, err := q.queries.CountWebhookSubscriptions(ctx, organizationID)
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// FindDeliveries Webhookの配信の記録を新しい順に取得（ページネーション対応）
+func (q *WebhookQueryService) FindDeliveries(ctx context.Context, subscriptionID string, limit, offset int) ([]*domain.WebhookDelivery, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	deliveries, err := q.queries.ListWebhookDeliveries(ctx, dao.ListWebhookDeliveriesParams{
+		OrganizationID: organizationID,
+		SubscriptionID: subscriptionID,
+		Limit:          int32(limit),
+		Offset:         int32(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.WebhookDelivery, len(deliveries))
+	for i, d := range deliveries {
+		result[i] = &domain.WebhookDelivery{
+			ID:             d.ID,
+			SubscriptionID: d.SubscriptionID,
+			OrganizationID: d.OrganizationID,
+			EventID:        d.EventID,
+			EventType:      d.EventType,
+			Attempt:        int(d.Attempt),
+			StatusCode:     int(d.StatusCode),
+			Error:          d.Error,
+			Duration:       time.Duration(d.DurationMs) * time.Millisecond,
+			DeliveredAt:    d.DeliveredAt,
+		}
+	}
+	return result, nil
+}
+
+// CountDeliveries Webhookの配信の記録の件数を取得
+func (q *WebhookQueryService) CountDeliveries(ctx context.Context, subscriptionID string) (int, error) {
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return 0, err
+	}
+	count, err := q.queries.CountWebhookDeliveries(ctx, dao.CountWebhookDeliveriesParams{
+		OrganizationID: organizationID,
+		SubscriptionID: subscriptionID,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// toDomainWebhookSubscription dao.WebhookSubscriptionをdomain.WebhookSubscriptionに変換
+func toDomainWebhookSubscription(s dao.WebhookSubscription) *domain.WebhookSubscription {
+	subscription := &domain.WebhookSubscription{
+		ID:                  s.ID,
+		OrganizationID:      s.OrganizationID,
+		URL:                 s.Url,
+		Secret:              s.Secret,
+		EventTypes:          s.EventTypes,
+		ConsecutiveFailures: int(s.ConsecutiveFailures),
+		DisabledReason:      s.DisabledReason,
+		CreatedBy:           s.CreatedBy,
+		CreatedAt:           s.CreatedAt,
+		UpdatedAt:           s.UpdatedAt,
+	}
+	if s.DisabledAt.Valid {
+		subscription.DisabledAt = &s.DisabledAt.Time
+	}
+	return subscription
+}
@@ -0,0 +1,27 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// recordAuditEvent 集約に対する操作を監査イベントとして記録する（RunInTransaction 内で使用）
+// 操作の主体・リクエストID・リクエストの付帯情報はコンテキストから取得する
+// payload は集約固有の内容で、JSONオブジェクトに変換できる値（不要な場合は nil）
+func recordAuditEvent(ctx context.Context, tx infrastructure.DBTX, aggregateType domain.AuditAggregateType, aggregateID, action string, payload any) error {
+	event, err := domain.NewAuditEvent(aggregateType, aggregateID, action, payload)
+	if err != nil {
+		return err
+	}
+	event.AttributeTo(domain.PrincipalFromContext(ctx), logger.GetRequestID(ctx), domain.RequestMetadataFromContext(ctx))
+	return command.SaveAuditEvent(ctx, tx, event)
+}
+
+// userAuditPayload ユーザーの監査イベントに記録する内容
+func userAuditPayload(user *domain.User) map[string]any {
+	return map[string]any{"name": user.Name, "email": user.Email}
+}
@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// AuthenticateAPIKeyUsecase APIキー認証ユースケース
+type AuthenticateAPIKeyUsecase struct {
+	apiKeyQuery APIKeyQueryRepository
+	txManager   TransactionManager
+}
+
+// NewAuthenticateAPIKeyUsecase AuthenticateAPIKeyUsecaseのコンストラクタ
+func NewAuthenticateAPIKeyUsecase(
+	apiKeyQuery APIKeyQueryRepository,
+	txManager TransactionManager,
+) *AuthenticateAPIKeyUsecase {
+	return &AuthenticateAPIKeyUsecase{
+		apiKeyQuery: apiKeyQuery,
+		txManager:   txManager,
+	}
+}
+
+// Execute トークンに対応する有効なAPIキーを返す
+// 形式不正・不一致・失効済み・期限切れの場合は nil を返す（理由は区別しない）
+func (u *AuthenticateAPIKeyUsecase) Execute(ctx context.Context, token string) (*domain.APIKey, error) {
+	log := logger.FromContext(ctx)
+
+	prefix, ok := domain.ParseAPIKeyToken(token)
+	if !ok {
+		return nil, nil
+	}
+
+	apiKey, err := u.apiKeyQuery.FindByPrefix(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if apiKey == nil || !apiKey.VerifyToken(token) || !apiKey.Active(now) {
+		log.Info("api key authentication failed", slog.String("prefix", prefix))
+		return nil, nil
+	}
+
+	// 最終利用日時の更新に失敗しても認証自体は成功とする
+	err = u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		return command.TouchAPIKeyLastUsed(ctx, tx, apiKey.ID, now)
+	})
+	if err != nil {
+		log.Warn("failed to update api key last used", slog.String("api_key_id", apiKey.ID), slog.String("error", err.Error()))
+	}
+	return apiKey, nil
+}
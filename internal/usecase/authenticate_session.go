@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// AuthenticateSessionUsecase セッション認証ユースケース
+type AuthenticateSessionUsecase struct {
+	sessionQuery      SessionQueryRepository
+	userQuery         UserQueryRepository
+	organizationQuery OrganizationQueryRepository
+	txManager         TransactionManager
+}
+
+// NewAuthenticateSessionUsecase AuthenticateSessionUsecaseのコンストラクタ
+func NewAuthenticateSessionUsecase(
+	sessionQuery SessionQueryRepository,
+	userQuery UserQueryRepository,
+	organizationQuery OrganizationQueryRepository,
+	txManager TransactionManager,
+) *AuthenticateSessionUsecase {
+	return &AuthenticateSessionUsecase{
+		sessionQuery:      sessionQuery,
+		userQuery:         userQuery,
+		organizationQuery: organizationQuery,
+		txManager:         txManager,
+	}
+}
+
+// Execute トークンに対応する有効なセッションと、ログインした組織でのユーザーのメンバーシップを返す
+// 不一致・失効済み・期限切れ・ユーザー削除済みの場合は nil を返す（理由は区別しない）
+// メンバーシップがない場合（組織内のロールを持たないユーザー）は、メンバーシップのみ nil を返す
+func (u *AuthenticateSessionUsecase) Execute(ctx context.Context, token string) (*domain.Session, *domain.Membership, error) {
+	log := logger.FromContext(ctx)
+
+	session, err := u.sessionQuery.FindByTokenHash(ctx, domain.HashSessionToken(token))
+	if err != nil {
+		return nil, nil, err
+	}
+	now := time.Now()
+	if session == nil || !session.Active(now) {
+		return nil, nil, nil
+	}
+
+	// セッションはログインした組織に紐づく
+	ctx = domain.WithTenant(ctx, session.OrganizationID)
+	user, err := u.userQuery.FindByID(ctx, session.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if user == nil {
+		log.Info("session user not found", slog.String("session_id", session.ID))
+		return nil, nil, nil
+	}
+	membership, err := u.organizationQuery.FindMembership(ctx, session.OrganizationID, user.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 最終利用日時の更新に失敗しても認証自体は成功とする
+	err = u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		return command.TouchSessionLastSeen(ctx, tx, session.ID, now)
+	})
+	if err != nil {
+		log.Warn("failed to update session last seen", slog.String("session_id", session.ID), slog.String("error", err.Error()))
+	}
+	return session, membership, nil
+}
@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// ChangeUserPasswordUsecase パスワード変更ユースケース
+type ChangeUserPasswordUsecase struct {
+	txManager     TransactionManager
+	eventSourcing command.UserEventSourcing
+}
+
+// NewChangeUserPasswordUsecase ChangeUserPasswordUsecaseのコンストラクタ
+func NewChangeUserPasswordUsecase(txManager TransactionManager, eventSourcing command.UserEventSourcing) *ChangeUserPasswordUsecase {
+	return &ChangeUserPasswordUsecase{
+		txManager:     txManager,
+		eventSourcing: eventSourcing,
+	}
+}
+
+// Execute ユーザーのパスワードを変更し、操作中のセッション以外のセッションを失効させる
+// 本人が変更する場合は現在のパスワードが必要（未設定の場合を除く）。users:update 権限があれば他のユーザーのパスワードを再設定できる
+func (u *ChangeUserPasswordUsecase) Execute(ctx context.Context, id, currentPassword, newPassword string) error {
+	log := logger.FromContext(ctx)
+	log.Info("changing user password", slog.String("user_id", id))
+
+	// 権限の確認（本人は権限がなくても可）
+	principal := domain.PrincipalFromContext(ctx)
+	if err := domain.AuthorizeSelfOr(principal, id, domain.PermissionUsersUpdate); err != nil {
+		return err
+	}
+
+	return u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		// 行ロック付きでユーザーを取得
+		user, err := command.FindByIDForUpdate(ctx, tx, u.eventSourcing, id)
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return domain.ErrUserNotFound(id)
+		}
+
+		// 本人の場合は現在のパスワードを確認（セッションを奪われた場合に乗っ取られないようにする）
+		exceptSessionID := ""
+		if principal.IsUser(id) {
+			if user.HasPassword() && !user.VerifyPassword(currentPassword) {
+				return domain.ErrCurrentPasswordIncorrect()
+			}
+			exceptSessionID = principal.SessionID
+		}
+
+		if err := user.SetPassword(newPassword); err != nil {
+			return err
+		}
+		if err := command.Save(ctx, tx, u.eventSourcing, user); err != nil {
+			return err
+		}
+
+		// 古いパスワードで作られたセッションを失効させる
+		if err := command.RevokeUserSessions(ctx, tx, user.ID, exceptSessionID, time.Now()); err != nil {
+			return err
+		}
+
+		// 監査イベントを記録（パスワードは記録しない）
+		return recordAuditEvent(ctx, tx, domain.AuditAggregateTypeUser, user.ID, "password_changed", nil)
+	})
+}
@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// CreateAPIKeyUsecase APIキー作成ユースケース
+type CreateAPIKeyUsecase struct {
+	txManager TransactionManager
+}
+
+// NewCreateAPIKeyUsecase CreateAPIKeyUsecaseのコンストラクタ
+func NewCreateAPIKeyUsecase(txManager TransactionManager) *CreateAPIKeyUsecase {
+	return &CreateAPIKeyUsecase{
+		txManager: txManager,
+	}
+}
+
+// Execute APIキーを作成し、APIキーと平文のトークンを返す
+// トークンは保存しないため、ここで返したものを一度だけ利用者に表示する
+func (u *CreateAPIKeyUsecase) Execute(ctx context.Context, name string, scopes []string, expiresAt *time.Time) (*domain.APIKey, string, error) {
+	log := logger.FromContext(ctx)
+	log.Info("creating api key", slog.String("name", name))
+
+	// 権限の確認
+	principal := domain.PrincipalFromContext(ctx)
+	if err := domain.Authorize(principal, domain.PermissionAPIKeysManage); err != nil {
+		return nil, "", err
+	}
+
+	apiKey, token, err := domain.NewAPIKey(name, scopes, expiresAt, principal)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// 作成者が持たない権限をAPIキーに付与させない（ワイルドカードはリソースのすべての権限が必要）
+	for _, scope := range apiKey.Scopes {
+		for _, permission := range domain.ScopePermissions(scope) {
+			if err := domain.Authorize(principal, permission); err != nil {
+				return nil, "", err
+			}
+		}
+	}
+
+	err = u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		if err := command.SaveAPIKey(ctx, tx, apiKey); err != nil {
+			return err
+		}
+
+		// 監査イベントを記録（シークレットは記録しない）
+		return recordAuditEvent(ctx, tx, domain.AuditAggregateTypeAPIKey, apiKey.ID, "created", apiKeyAuditPayload(apiKey))
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return apiKey, token, nil
+}
+
+// apiKeyAuditPayload APIキーの監査イベントに記録する内容
+func apiKeyAuditPayload(apiKey *domain.APIKey) map[string]any {
+	payload := map[string]any{
+		"name":   apiKey.Name,
+		"prefix": apiKey.DisplayPrefix(),
+		"scopes": apiKey.Scopes,
+	}
+	if apiKey.ExpiresAt != nil {
+		payload["expiresAt"] = apiKey.ExpiresAt
+	}
+	return payload
+}
@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// CreateOrganizationUsecase 組織作成ユースケース
+type CreateOrganizationUsecase struct {
+	txManager TransactionManager
+}
+
+// NewCreateOrganizationUsecase CreateOrganizationUsecaseのコンストラクタ
+func NewCreateOrganizationUsecase(txManager TransactionManager) *CreateOrganizationUsecase {
+	return &CreateOrganizationUsecase{
+		txManager: txManager,
+	}
+}
+
+// Execute 組織を作成し、作成したユーザーを管理者として組織に加える
+// 組織の作成者が管理者となるため、ユーザーとして認証されている必要がある
+func (u *CreateOrganizationUsecase) Execute(ctx context.Context, name, slug string) (*domain.Organization, error) {
+	log := logger.FromContext(ctx)
+	log.Info("creating organization", slog.String("slug", slug))
+
+	principal := domain.PrincipalFromContext(ctx)
+	if principal.Type != domain.PrincipalTypeUser {
+		return nil, domain.ErrLoginRequired()
+	}
+
+	organization, err := domain.NewOrganization(name, slug)
+	if err != nil {
+		return nil, err
+	}
+	membership := domain.NewMembership(organization.ID, principal.ID, []domain.Role{domain.RoleAdmin})
+
+	err = u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		if err := command.SaveOrganization(ctx, tx, organization); err != nil {
+			return err
+		}
+
+		// メンバーシップと監査イベントは作成した組織に記録する
+		ctx = domain.WithTenant(ctx, organization.ID)
+		if err := command.SaveMembership(ctx, tx, membership); err != nil {
+			return err
+		}
+		return recordAuditEvent(ctx, tx, domain.AuditAggregateTypeOrganization, organization.ID, "created", organizationAuditPayload(organization))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return organization, nil
+}
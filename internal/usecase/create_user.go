@@ -12,53 +12,88 @@ import (
 
 // CreateUserUsecase ユーザー作成ユースケース
 type CreateUserUsecase struct {
-	userQuery UserQueryRepository
-	txManager TransactionManager
+	userQuery          UserQueryRepository
+	txManager          TransactionManager
+	eventSourcing      command.UserEventSourcing
+	userLogHashKey     domain.UserLogHashKey
+	emailNormalization domain.EmailNormalization
 }
 
 // NewCreateUserUsecase CreateUserUsecaseのコンストラクタ
 func NewCreateUserUsecase(
 	userQuery UserQueryRepository,
 	txManager TransactionManager,
+	eventSourcing command.UserEventSourcing,
+	userLogHashKey domain.UserLogHashKey,
+	emailNormalization domain.EmailNormalization,
 ) *CreateUserUsecase {
 	return &CreateUserUsecase{
-		userQuery: userQuery,
-		txManager: txManager,
+		userQuery:          userQuery,
+		txManager:          txManager,
+		eventSourcing:      eventSourcing,
+		userLogHashKey:     userLogHashKey,
+		emailNormalization: emailNormalization,
 	}
 }
 
-// Execute ユーザーを作成
-func (u *CreateUserUsecase) Execute(ctx context.Context, name, email string) error {
+// Execute ユーザーを作成し、作成したユーザーを返す（password が空文字列の場合はパスワードを設定しない）
+func (u *CreateUserUsecase) Execute(ctx context.Context, name, email, password string) (*domain.User, error) {
 	log := logger.FromContext(ctx)
 	log.Info("creating user", slog.String("email", email))
 
-	return u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
-		// メールアドレスの重複チェック（ロック付き）
-		existingUser, err := command.FindByEmailForUpdate(ctx, tx, email)
+	// 権限の確認
+	if err := domain.Authorize(domain.PrincipalFromContext(ctx), domain.PermissionUsersCreate); err != nil {
+		return nil, err
+	}
+
+	var created *domain.User
+	err := u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		// ドメインモデルの作成（メールアドレスの正規化を含む）
+		user, err := domain.NewUser(name, u.emailNormalization.Normalize(email))
 		if err != nil {
 			return err
 		}
-		if existingUser != nil {
-			return domain.ErrEmailAlreadyExists(email)
+		if password != "" {
+			if err := user.SetPassword(password); err != nil {
+				return err
+			}
 		}
 
-		// ドメインモデルの作成
-		user, err := domain.NewUser(name, email)
+		// メールアドレスの重複チェック（ロック付き、大文字小文字を区別しない）
+		existingUser, err := command.FindByEmailForUpdate(ctx, tx, u.eventSourcing, user.Email)
 		if err != nil {
 			return err
 		}
+		if existingUser != nil {
+			return domain.ErrEmailAlreadyExists(user.Email)
+		}
 
 		// 永続化
-		if err := command.Save(ctx, tx, user); err != nil {
+		if err := command.Save(ctx, tx, u.eventSourcing, user); err != nil {
 			return err
 		}
 
 		// ユーザー作成ログを保存
-		userLog := domain.NewUserLog(user.ID, domain.UserLogActionCreated)
-		if err := command.SaveUserLog(ctx, tx, userLog); err != nil {
+		userLog := attributeUserLog(ctx, domain.NewUserLog(user.ID, domain.UserLogActionCreated))
+		if err := command.SaveUserLog(ctx, tx, u.userLogHashKey, userLog); err != nil {
+			return err
+		}
+
+		// 監査イベントを記録
+		if err := recordAuditEvent(ctx, tx, domain.AuditAggregateTypeUser, user.ID, string(domain.UserLogActionCreated), userAuditPayload(user)); err != nil {
+			return err
+		}
+
+		// メールアドレスの確認メールを送信する
+		if err := enqueueUserTokenEmail(ctx, tx, domain.UserTokenPurposeEmailVerification, user.ID); err != nil {
 			return err
 		}
 
+		created = user
 		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
 }
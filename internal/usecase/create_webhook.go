@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// CreateWebhookUsecase Webhook作成ユースケース
+type CreateWebhookUsecase struct {
+	txManager TransactionManager
+}
+
+// NewCreateWebhookUsecase CreateWebhookUsecaseのコンストラクタ
+func NewCreateWebhookUsecase(txManager TransactionManager) *CreateWebhookUsecase {
+	return &CreateWebhookUsecase{
+		txManager: txManager,
+	}
+}
+
+// Execute Webhookを作成する
+// 署名シークレットを含めて返すため、呼び出し側は作成時にだけ利用者に表示する
+func (u *CreateWebhookUsecase) Execute(ctx context.Context, url string, eventTypes []string) (*domain.WebhookSubscription, error) {
+	log := logger.FromContext(ctx)
+	log.Info("creating webhook", slog.String("url", url))
+
+	// 権限の確認
+	if err := domain.Authorize(domain.PrincipalFromContext(ctx), domain.PermissionWebhooksManage); err != nil {
+		return nil, err
+	}
+
+	subscription, err := domain.NewWebhookSubscription(url, eventTypes, domain.PrincipalFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		if err := command.SaveWebhookSubscription(ctx, tx, subscription); err != nil {
+			return err
+		}
+
+		// 監査イベントを記録（シークレットは記録しない）
+		return recordAuditEvent(ctx, tx, domain.AuditAggregateTypeWebhook, subscription.ID, "created", webhookAuditPayload(subscription))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+// webhookAuditPayload Webhookの監査イベントに記録する内容
+func webhookAuditPayload(subscription *domain.WebhookSubscription) map[string]any {
+	return map[string]any{
+		"url":        subscription.URL,
+		"eventTypes": subscription.EventTypes,
+		"active":     subscription.Active(),
+	}
+}
@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"log/slog"
+	"time"
 
 	"github.com/example/go-react-cqrs-template/internal/command"
 	"github.com/example/go-react-cqrs-template/internal/domain"
@@ -12,18 +13,24 @@ import (
 
 // DeleteUserUsecase ユーザー削除ユースケース
 type DeleteUserUsecase struct {
-	userQuery UserQueryRepository
-	txManager TransactionManager
+	userQuery      UserQueryRepository
+	txManager      TransactionManager
+	eventSourcing  command.UserEventSourcing
+	userLogHashKey domain.UserLogHashKey
 }
 
 // NewDeleteUserUsecase DeleteUserUsecaseのコンストラクタ
 func NewDeleteUserUsecase(
 	userQuery UserQueryRepository,
 	txManager TransactionManager,
+	eventSourcing command.UserEventSourcing,
+	userLogHashKey domain.UserLogHashKey,
 ) *DeleteUserUsecase {
 	return &DeleteUserUsecase{
-		userQuery: userQuery,
-		txManager: txManager,
+		userQuery:      userQuery,
+		txManager:      txManager,
+		eventSourcing:  eventSourcing,
+		userLogHashKey: userLogHashKey,
 	}
 }
 
@@ -32,9 +39,14 @@ func (u *DeleteUserUsecase) Execute(ctx context.Context, id string) error {
 	log := logger.FromContext(ctx)
 	log.Info("deleting user", slog.String("user_id", id))
 
+	// 権限の確認
+	if err := domain.Authorize(domain.PrincipalFromContext(ctx), domain.PermissionUsersDelete); err != nil {
+		return err
+	}
+
 	return u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
 		// 行ロック付きで存在確認
-		user, err := command.FindByIDForUpdate(ctx, tx, id)
+		user, err := command.FindByIDForUpdate(ctx, tx, u.eventSourcing, id)
 		if err != nil {
 			return err
 		}
@@ -43,12 +55,28 @@ func (u *DeleteUserUsecase) Execute(ctx context.Context, id string) error {
 		}
 
 		// ユーザー削除ログを保存
-		userLog := domain.NewUserLog(id, domain.UserLogActionDeleted)
-		if err := command.SaveUserLog(ctx, tx, userLog); err != nil {
+		userLog := attributeUserLog(ctx, domain.NewUserLog(id, domain.UserLogActionDeleted))
+		if err := command.SaveUserLog(ctx, tx, u.userLogHashKey, userLog); err != nil {
+			return err
+		}
+
+		// 監査イベントを記録（削除後も内容を追えるよう削除前の値を残す）
+		if err := recordAuditEvent(ctx, tx, domain.AuditAggregateTypeUser, id, string(domain.UserLogActionDeleted), userAuditPayload(user)); err != nil {
+			return err
+		}
+
+		// ログイン中のセッションを失効させる
+		if err := command.RevokeUserSessions(ctx, tx, id, "", time.Now()); err != nil {
+			return err
+		}
+
+		// 組織でのロールを取り消す
+		if err := command.DeleteMembership(ctx, tx, id); err != nil {
 			return err
 		}
 
-		// 削除
-		return command.Delete(ctx, tx, id)
+		// 削除（UserDeleted イベントはコミット後に配信される）
+		user.Delete()
+		return command.Delete(ctx, tx, u.eventSourcing, user)
 	})
 }
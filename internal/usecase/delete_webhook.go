@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// DeleteWebhookUsecase Webhook削除ユースケース
+type DeleteWebhookUsecase struct {
+	txManager TransactionManager
+}
+
+// NewDeleteWebhookUsecase DeleteWebhookUsecaseのコンストラクタ
+func NewDeleteWebhookUsecase(txManager TransactionManager) *DeleteWebhookUsecase {
+	return &DeleteWebhookUsecase{
+		txManager: txManager,
+	}
+}
+
+// Execute Webhookと配信の記録を削除する（登録済みの配信のジョブは実行時に読み飛ばす）
+func (u *DeleteWebhookUsecase) Execute(ctx context.Context, id string) error {
+	log := logger.FromContext(ctx)
+	log.Info("deleting webhook", slog.String("webhook_id", id))
+
+	// 権限の確認
+	if err := domain.Authorize(domain.PrincipalFromContext(ctx), domain.PermissionWebhooksManage); err != nil {
+		return err
+	}
+
+	return u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		// 行ロック付きで存在確認
+		subscription, err := command.FindWebhookSubscriptionByIDForUpdate(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if subscription == nil {
+			return domain.ErrWebhookNotFound(id)
+		}
+
+		if err := command.DeleteWebhookSubscription(ctx, tx, subscription.ID); err != nil {
+			return err
+		}
+
+		// 監査イベントを記録
+		return recordAuditEvent(ctx, tx, domain.AuditAggregateTypeWebhook, subscription.ID, "deleted", webhookAuditPayload(subscription))
+	})
+}
@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// webhookEventBody 送信するWebhookのボディ
+type webhookEventBody struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	OccurredAt time.Time       `json:"occurredAt"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// DeliverWebhookUsecase Webhook配信ユースケース（ワーカーから実行する）
+type DeliverWebhookUsecase struct {
+	txManager TransactionManager
+	sender    WebhookSender
+}
+
+// NewDeliverWebhookUsecase DeliverWebhookUsecaseのコンストラクタ
+func NewDeliverWebhookUsecase(txManager TransactionManager, sender WebhookSender) *DeliverWebhookUsecase {
+	return &DeliverWebhookUsecase{
+		txManager: txManager,
+		sender:    sender,
+	}
+}
+
+// Execute イベントをWebhookへ送信し、配信の記録を残す
+// 失敗した場合はエラーを返し、ジョブのバックオフで再送させる
+// 失敗が続いてWebhookを無効にした場合・Webhookが削除・無効にされている場合・配信済みの場合は再送しない
+func (u *DeliverWebhookUsecase) Execute(ctx context.Context, payload DeliverWebhookPayload) error {
+	log := logger.FromContext(ctx).With(
+		slog.String("webhook_id", payload.SubscriptionID),
+		slog.String("event_id", payload.EventID),
+	)
+
+	// 権限の確認
+	if err := domain.Authorize(domain.PrincipalFromContext(ctx), domain.PermissionWebhooksManage); err != nil {
+		return err
+	}
+
+	var (
+		subscription *domain.WebhookSubscription
+		attempt      int
+	)
+	err := u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		var err error
+		subscription, err = command.FindWebhookSubscriptionByIDForUpdate(ctx, tx, payload.SubscriptionID)
+		if err != nil || subscription == nil || !subscription.Active() {
+			subscription = nil
+			return err
+		}
+		attempts, delivered, err := command.GetWebhookEventDeliveryState(ctx, tx, subscription.ID, payload.EventID)
+		if err != nil || delivered {
+			subscription = nil
+			return err
+		}
+		attempt = attempts + 1
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if subscription == nil {
+		log.Info("webhook delivery skipped")
+		return nil
+	}
+
+	body, err := json.Marshal(webhookEventBody{
+		ID:         payload.EventID,
+		Type:       payload.EventType,
+		OccurredAt: payload.OccurredAt,
+		Data:       payload.Data,
+	})
+	if err != nil {
+		return err
+	}
+
+	// 送信中は行ロックを保持しない（受信側の応答を待つ間に設定の更新を妨げない）
+	startTime := time.Now()
+	statusCode, sendErr := u.sender.Send(ctx, domain.NewWebhookRequest(subscription, payload.EventID, payload.EventType, body, startTime))
+	delivery := domain.NewWebhookDelivery(subscription, payload.EventID, payload.EventType, attempt, statusCode, sendErr, time.Since(startTime))
+
+	var disabled bool
+	err = u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		// 送信中に削除された場合は記録しない
+		current, err := command.FindWebhookSubscriptionByIDForUpdate(ctx, tx, subscription.ID)
+		if err != nil || current == nil {
+			return err
+		}
+		if err := command.SaveWebhookDelivery(ctx, tx, delivery); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if delivery.Succeeded() {
+			current.RecordDeliverySuccess(now)
+		} else {
+			disabled = current.RecordDeliveryFailure(delivery.Error, now)
+		}
+		if err := command.UpdateWebhookSubscription(ctx, tx, current); err != nil {
+			return err
+		}
+		if !disabled {
+			return nil
+		}
+
+		// 監査イベントを記録
+		auditPayload := webhookAuditPayload(current)
+		auditPayload["reason"] = current.DisabledReason
+		return recordAuditEvent(ctx, tx, domain.AuditAggregateTypeWebhook, current.ID, "disabled", auditPayload)
+	})
+	if err != nil {
+		return err
+	}
+
+	if sendErr == nil {
+		log.Info("webhook delivered", slog.Int("attempt", attempt), slog.Int("status", statusCode))
+		return nil
+	}
+	if disabled {
+		log.Warn("webhook disabled after sustained failures",
+			slog.Int("attempt", attempt),
+			slog.String("error", sendErr.Error()),
+		)
+		return nil
+	}
+	return fmt.Errorf("failed to deliver webhook (attempt %d): %w", attempt, sendErr)
+}
@@ -0,0 +1,259 @@
+package usecase
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+)
+
+const (
+	testWebhookOrganizationID = "01ARZ3NDEKTSV4RRFFQ69G5FO1"
+	testWebhookID             = "01ARZ3NDEKTSV4RRFFQ69G5FW1"
+	testWebhookEventID        = "01ARZ3NDEKTSV4RRFFQ69G5FE1"
+)
+
+// fakeWebhookDelivery 記録された配信
+type fakeWebhookDelivery struct {
+	attempt    int64
+	statusCode int64
+	err        string
+}
+
+// fakeWebhookStore 1つのWebhookと配信の記録を保持し、fakeDB のクエリとして提供する
+type fakeWebhookStore struct {
+	mu                  sync.Mutex
+	deleted             bool
+	consecutiveFailures int64
+	disabledAt          any
+	disabledReason      string
+	deliveries          []fakeWebhookDelivery
+}
+
+func (s *fakeWebhookStore) register(db *fakeDB) {
+	db.handle("GetWebhookSubscriptionByIDForUpdate", func([]driver.Value) ([][]driver.Value, error) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if s.deleted {
+			return nil, nil
+		}
+		now := time.Now()
+		return [][]driver.Value{{
+			testWebhookID, testWebhookOrganizationID, "https://example.com/webhooks", "whsec_test", []byte("{user.created}"),
+			s.consecutiveFailures, s.disabledAt, s.disabledReason, "user:01ARZ3NDEKTSV4RRFFQ69G5FAV", now, now,
+		}}, nil
+	})
+	db.handle("GetWebhookEventDeliveryState", func([]driver.Value) ([][]driver.Value, error) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delivered := false
+		for _, d := range s.deliveries {
+			delivered = delivered || d.err == ""
+		}
+		return [][]driver.Value{{int64(len(s.deliveries)), delivered}}, nil
+	})
+	db.handle("CreateWebhookDelivery", func(args []driver.Value) ([][]driver.Value, error) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.deliveries = append(s.deliveries, fakeWebhookDelivery{attempt: args[5].(int64), statusCode: args[6].(int64), err: args[7].(string)})
+		return nil, nil
+	})
+	db.handle("UpdateWebhookSubscription", func(args []driver.Value) ([][]driver.Value, error) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.consecutiveFailures = args[2].(int64)
+		s.disabledAt = args[3]
+		s.disabledReason = args[4].(string)
+		return nil, nil
+	})
+}
+
+func (s *fakeWebhookStore) snapshot() fakeWebhookStore {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return fakeWebhookStore{
+		consecutiveFailures: s.consecutiveFailures,
+		disabledAt:          s.disabledAt,
+		disabledReason:      s.disabledReason,
+		deliveries:          append([]fakeWebhookDelivery(nil), s.deliveries...),
+	}
+}
+
+// fakeWebhookSender 順に応答を返す WebhookSender（応答を使い切った後は最後の応答を繰り返す）
+type fakeWebhookSender struct {
+	mu        sync.Mutex
+	responses []fakeWebhookResponse
+	requests  []domain.WebhookRequest
+}
+
+type fakeWebhookResponse struct {
+	statusCode int
+	err        error
+}
+
+func (s *fakeWebhookSender) Send(_ context.Context, request domain.WebhookRequest) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = append(s.requests, request)
+	response := s.responses[min(len(s.requests), len(s.responses))-1]
+	return response.statusCode, response.err
+}
+
+func (s *fakeWebhookSender) sent() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.requests)
+}
+
+var errWebhookUnavailable = errors.New("webhook endpoint returned status 503")
+
+// newDeliverWebhookTest 配信ジョブと同じ主体・テナントのコンテキストと、テスト用のユースケースを作成する
+func newDeliverWebhookTest(t *testing.T, store *fakeWebhookStore, sender *fakeWebhookSender) (context.Context, *DeliverWebhookUsecase, *fakeDB) {
+	t.Helper()
+	db, txManager := newFakeDB(t)
+	store.register(db)
+	ctx := domain.WithPrincipal(context.Background(), domain.NewSystemPrincipal(DeliverWebhookJobType).WithOrganization(testWebhookOrganizationID))
+	ctx = domain.WithTenant(ctx, testWebhookOrganizationID)
+	return ctx, NewDeliverWebhookUsecase(txManager, sender), db
+}
+
+func testDeliverWebhookPayload() DeliverWebhookPayload {
+	return DeliverWebhookPayload{
+		SubscriptionID: testWebhookID,
+		EventID:        testWebhookEventID,
+		EventType:      "user.created",
+		OccurredAt:     time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		Data:           []byte(`{"userId":"01ARZ3NDEKTSV4RRFFQ69G5FAV"}`),
+	}
+}
+
+func TestDeliverWebhook_RetriesUntilDelivered(t *testing.T) {
+	store := &fakeWebhookStore{}
+	sender := &fakeWebhookSender{responses: []fakeWebhookResponse{
+		{statusCode: 503, err: errWebhookUnavailable},
+		{statusCode: 0, err: errors.New("connection refused")},
+		{statusCode: 204},
+	}}
+	ctx, uc, _ := newDeliverWebhookTest(t, store, sender)
+	payload := testDeliverWebhookPayload()
+
+	// 失敗した配信はエラーを返し、ジョブのバックオフで再送させる（試行回数は配信の記録から数える）
+	for attempt := 1; attempt <= 2; attempt++ {
+		err := uc.Execute(ctx, payload)
+		if err == nil || !strings.Contains(err.Error(), fmt.Sprintf("(attempt %d)", attempt)) {
+			t.Fatalf("attempt %d: expected a retryable error, got %v", attempt, err)
+		}
+		if got := store.snapshot().consecutiveFailures; got != int64(attempt) {
+			t.Errorf("attempt %d: expected %d consecutive failures, got %d", attempt, attempt, got)
+		}
+	}
+	if err := uc.Execute(ctx, payload); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	state := store.snapshot()
+	want := []fakeWebhookDelivery{
+		{attempt: 1, statusCode: 503, err: errWebhookUnavailable.Error()},
+		{attempt: 2, statusCode: 0, err: "connection refused"},
+		{attempt: 3, statusCode: 204},
+	}
+	if len(state.deliveries) != len(want) {
+		t.Fatalf("expected %d deliveries, got %+v", len(want), state.deliveries)
+	}
+	for i := range want {
+		if state.deliveries[i] != want[i] {
+			t.Errorf("delivery %d: expected %+v, got %+v", i, want[i], state.deliveries[i])
+		}
+	}
+	// 成功すると連続した失敗の回数はリセットされる
+	if state.consecutiveFailures != 0 || state.disabledAt != nil {
+		t.Errorf("expected an active webhook without failures, got %d failures (disabled at %v)", state.consecutiveFailures, state.disabledAt)
+	}
+
+	// 再送でも同じイベントIDとボディを送る
+	for i, request := range sender.requests {
+		if request.EventID != testWebhookEventID || string(request.Body) != string(sender.requests[0].Body) {
+			t.Errorf("request %d: expected the same event %s and body, got %s %s", i, testWebhookEventID, request.EventID, request.Body)
+		}
+	}
+
+	// 配信済みのイベントのジョブ（アウトボックスからの再配信などで重複したもの）は送信しない
+	if err := uc.Execute(ctx, payload); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sender.sent() != 3 {
+		t.Errorf("expected delivered event not to be sent again, got %d requests", sender.sent())
+	}
+}
+
+func TestDeliverWebhook_DisablesAfterSustainedFailures(t *testing.T) {
+	store := &fakeWebhookStore{consecutiveFailures: domain.WebhookMaxConsecutiveFailures - 1}
+	sender := &fakeWebhookSender{responses: []fakeWebhookResponse{{statusCode: 503, err: errWebhookUnavailable}}}
+	ctx, uc, db := newDeliverWebhookTest(t, store, sender)
+	payload := testDeliverWebhookPayload()
+
+	// 失敗が上限に達するとWebhookを無効にし、ジョブを再送させない
+	if err := uc.Execute(ctx, payload); err != nil {
+		t.Fatalf("expected no retry after disabling the webhook, got %v", err)
+	}
+	state := store.snapshot()
+	if state.disabledAt == nil {
+		t.Fatal("expected webhook to be disabled")
+	}
+	if state.disabledReason != errWebhookUnavailable.Error() {
+		t.Errorf("expected disabled reason %q, got %q", errWebhookUnavailable.Error(), state.disabledReason)
+	}
+	if state.consecutiveFailures != domain.WebhookMaxConsecutiveFailures {
+		t.Errorf("expected %d consecutive failures, got %d", domain.WebhookMaxConsecutiveFailures, state.consecutiveFailures)
+	}
+	if len(state.deliveries) != 1 || state.deliveries[0].err != errWebhookUnavailable.Error() {
+		t.Errorf("expected the failed delivery to be recorded, got %+v", state.deliveries)
+	}
+	if got := db.count("CreateAuditEvent"); got != 1 {
+		t.Errorf("expected disabling to be audited once, got %d", got)
+	}
+
+	// 無効にしたWebhookへの残りの配信ジョブは送信しない
+	if err := uc.Execute(ctx, payload); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sender.sent() != 1 {
+		t.Errorf("expected disabled webhook not to be sent, got %d requests", sender.sent())
+	}
+}
+
+func TestDeliverWebhook_FailureBelowLimitKeepsWebhookActive(t *testing.T) {
+	store := &fakeWebhookStore{consecutiveFailures: domain.WebhookMaxConsecutiveFailures - 2}
+	sender := &fakeWebhookSender{responses: []fakeWebhookResponse{{statusCode: 503, err: errWebhookUnavailable}}}
+	ctx, uc, db := newDeliverWebhookTest(t, store, sender)
+
+	if err := uc.Execute(ctx, testDeliverWebhookPayload()); !errors.Is(err, errWebhookUnavailable) {
+		t.Fatalf("expected the send error to be returned for a retry, got %v", err)
+	}
+	state := store.snapshot()
+	if state.disabledAt != nil || state.consecutiveFailures != domain.WebhookMaxConsecutiveFailures-1 {
+		t.Errorf("expected an active webhook with %d failures, got %d failures (disabled at %v)", domain.WebhookMaxConsecutiveFailures-1, state.consecutiveFailures, state.disabledAt)
+	}
+	if got := db.count("CreateAuditEvent"); got != 0 {
+		t.Errorf("expected no audit event, got %d", got)
+	}
+}
+
+func TestDeliverWebhook_SkipsDeletedWebhook(t *testing.T) {
+	store := &fakeWebhookStore{deleted: true}
+	sender := &fakeWebhookSender{responses: []fakeWebhookResponse{{statusCode: 204}}}
+	ctx, uc, db := newDeliverWebhookTest(t, store, sender)
+
+	if err := uc.Execute(ctx, testDeliverWebhookPayload()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sender.sent() != 0 || db.count("CreateWebhookDelivery") != 0 {
+		t.Errorf("expected deleted webhook to be skipped, got %d requests and %d deliveries", sender.sent(), db.count("CreateWebhookDelivery"))
+	}
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// DeliverWebhookJobType Webhook配信ジョブの種類
+const DeliverWebhookJobType = "deliver_webhook"
+
+// deliverWebhookJobMaxAttempts Webhook配信ジョブの最大試行回数
+// ジョブのバックオフ（5秒から倍々で最大5分）で再送し、最初の配信から10分ほど受信側の復旧を待つ
+const deliverWebhookJobMaxAttempts = 8
+
+// DeliverWebhookPayload Webhook配信ジョブのペイロード
+// 配信するイベントの内容をジョブに保存し、再送でも同じボディを送る
+type DeliverWebhookPayload struct {
+	SubscriptionID string          `json:"subscription_id"`
+	EventID        string          `json:"event_id"`
+	EventType      string          `json:"event_type"`
+	OccurredAt     time.Time       `json:"occurred_at"`
+	Data           json.RawMessage `json:"data"`
+}
+
+// EnqueueWebhookDeliveriesUsecase Webhook配信ジョブ登録ユースケース（アウトボックスの購読者として実行する）
+type EnqueueWebhookDeliveriesUsecase struct {
+	txManager TransactionManager
+}
+
+// NewEnqueueWebhookDeliveriesUsecase EnqueueWebhookDeliveriesUsecaseのコンストラクタ
+func NewEnqueueWebhookDeliveriesUsecase(txManager TransactionManager) *EnqueueWebhookDeliveriesUsecase {
+	return &EnqueueWebhookDeliveriesUsecase{
+		txManager: txManager,
+	}
+}
+
+// Execute イベントを購読している有効なWebhookごとに配信ジョブを登録する
+// アウトボックスから同じイベントが再配信されるとジョブが重複するが、配信済みのイベントは配信ジョブが読み飛ばす
+func (u *EnqueueWebhookDeliveriesUsecase) Execute(ctx context.Context, event *domain.OutboxEvent) error {
+	log := logger.FromContext(ctx)
+
+	// 権限の確認
+	if err := domain.Authorize(domain.PrincipalFromContext(ctx), domain.PermissionWebhooksManage); err != nil {
+		return err
+	}
+
+	return u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		subscriptions, err := command.FindActiveWebhookSubscriptionsByEventType(ctx, tx, event.EventType)
+		if err != nil {
+			return err
+		}
+
+		for _, subscription := range subscriptions {
+			payload, err := json.Marshal(DeliverWebhookPayload{
+				SubscriptionID: subscription.ID,
+				EventID:        event.ID,
+				EventType:      event.EventType,
+				OccurredAt:     event.OccurredAt,
+				Data:           event.Payload,
+			})
+			if err != nil {
+				return err
+			}
+			job := domain.NewJob(DeliverWebhookJobType, payload, deliverWebhookJobMaxAttempts)
+			if err := command.EnqueueJob(ctx, tx, job); err != nil {
+				return err
+			}
+		}
+
+		if len(subscriptions) > 0 {
+			log.Info("webhook deliveries enqueued",
+				slog.String("event_id", event.ID),
+				slog.String("event_type", event.EventType),
+				slog.Int("count", len(subscriptions)),
+			)
+		}
+		return nil
+	})
+}
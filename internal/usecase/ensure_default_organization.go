@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+// EnsureDefaultOrganizationUsecase 既定の組織の作成ユースケース
+type EnsureDefaultOrganizationUsecase struct {
+	txManager TransactionManager
+}
+
+// NewEnsureDefaultOrganizationUsecase EnsureDefaultOrganizationUsecaseのコンストラクタ
+func NewEnsureDefaultOrganizationUsecase(txManager TransactionManager) *EnsureDefaultOrganizationUsecase {
+	return &EnsureDefaultOrganizationUsecase{
+		txManager: txManager,
+	}
+}
+
+// Execute 既定の組織が存在しない場合に作成する（サーバーの起動時に呼び出す）
+func (u *EnsureDefaultOrganizationUsecase) Execute(ctx context.Context) error {
+	return u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		return command.EnsureOrganization(ctx, tx, domain.NewDefaultOrganization())
+	})
+}
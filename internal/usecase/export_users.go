@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// ExportUsersUsecase ユーザーエクスポートユースケース
+type ExportUsersUsecase struct {
+	userQuery UserQueryRepository
+}
+
+// NewExportUsersUsecase ExportUsersUsecaseのコンストラクタ
+func NewExportUsersUsecase(userQuery UserQueryRepository) *ExportUsersUsecase {
+	return &ExportUsersUsecase{
+		userQuery: userQuery,
+	}
+}
+
+// Execute すべてのユーザーを一覧と同じ順序で1件ずつ fn に渡す
+func (u *ExportUsersUsecase) Execute(ctx context.Context, fn func(*domain.User) error) error {
+	log := logger.FromContext(ctx)
+	log.Info("exporting users")
+
+	// 権限の確認
+	if err := domain.Authorize(domain.PrincipalFromContext(ctx), domain.PermissionUsersRead); err != nil {
+		return err
+	}
+
+	count := 0
+	err := u.userQuery.StreamAll(ctx, func(user *domain.User) error {
+		count++
+		return fn(user)
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("users exported", slog.Int("count", count))
+	return nil
+}
@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// FindAPIKeyUsecase APIキー取得ユースケース
+type FindAPIKeyUsecase struct {
+	apiKeyQuery APIKeyQueryRepository
+}
+
+// NewFindAPIKeyUsecase FindAPIKeyUsecaseのコンストラクタ
+func NewFindAPIKeyUsecase(apiKeyQuery APIKeyQueryRepository) *FindAPIKeyUsecase {
+	return &FindAPIKeyUsecase{
+		apiKeyQuery: apiKeyQuery,
+	}
+}
+
+// Execute APIキーを取得
+func (u *FindAPIKeyUsecase) Execute(ctx context.Context, id string) (*domain.APIKey, error) {
+	log := logger.FromContext(ctx)
+	log.Info("finding api key", slog.String("api_key_id", id))
+
+	// 権限の確認
+	if err := domain.Authorize(domain.PrincipalFromContext(ctx), domain.PermissionAPIKeysManage); err != nil {
+		return nil, err
+	}
+
+	apiKey, err := u.apiKeyQuery.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if apiKey == nil {
+		return nil, domain.ErrAPIKeyNotFound(id)
+	}
+	return apiKey, nil
+}
@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// FindCurrentOrganizationUsecase 現在の組織取得ユースケース
+type FindCurrentOrganizationUsecase struct {
+	organizationQuery OrganizationQueryRepository
+}
+
+// NewFindCurrentOrganizationUsecase FindCurrentOrganizationUsecaseのコンストラクタ
+func NewFindCurrentOrganizationUsecase(organizationQuery OrganizationQueryRepository) *FindCurrentOrganizationUsecase {
+	return &FindCurrentOrganizationUsecase{
+		organizationQuery: organizationQuery,
+	}
+}
+
+// Execute リクエストのテナントの組織を取得
+func (u *FindCurrentOrganizationUsecase) Execute(ctx context.Context) (*domain.Organization, error) {
+	log := logger.FromContext(ctx)
+	log.Info("finding current organization")
+
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	organization, err := u.organizationQuery.FindByID(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	if organization == nil {
+		return nil, domain.ErrOrganizationNotFound(organizationID)
+	}
+	return organization, nil
+}
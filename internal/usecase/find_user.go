@@ -25,6 +25,11 @@ func (u *FindUserUsecase) Execute(ctx context.Context, id string) (*domain.User,
 	log := logger.FromContext(ctx)
 	log.Info("finding user", slog.String("user_id", id))
 
+	// 権限の確認（本人は権限がなくても可）
+	if err := domain.AuthorizeSelfOr(domain.PrincipalFromContext(ctx), id, domain.PermissionUsersRead); err != nil {
+		return nil, err
+	}
+
 	user, err := u.userQuery.FindByID(ctx, id)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// FindUserImportUsecase ユーザーインポート取得ユースケース
+type FindUserImportUsecase struct {
+	importQuery UserImportQueryRepository
+}
+
+// NewFindUserImportUsecase FindUserImportUsecaseのコンストラクタ
+func NewFindUserImportUsecase(importQuery UserImportQueryRepository) *FindUserImportUsecase {
+	return &FindUserImportUsecase{
+		importQuery: importQuery,
+	}
+}
+
+// Execute インポートと行ごとの処理結果の集計を取得
+func (u *FindUserImportUsecase) Execute(ctx context.Context, id string) (*domain.UserImport, *domain.UserImportSummary, error) {
+	log := logger.FromContext(ctx)
+	log.Info("finding user import", slog.String("import_id", id))
+
+	// 権限の確認
+	if err := domain.Authorize(domain.PrincipalFromContext(ctx), domain.PermissionUsersImport); err != nil {
+		return nil, nil, err
+	}
+
+	userImport, err := u.importQuery.FindByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if userImport == nil {
+		return nil, nil, domain.ErrUserImportNotFound(id)
+	}
+
+	summary, err := u.importQuery.Summarize(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return userImport, summary, nil
+}
@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// FindWebhookUsecase Webhook取得ユースケース
+type FindWebhookUsecase struct {
+	webhookQuery WebhookQueryRepository
+}
+
+// NewFindWebhookUsecase FindWebhookUsecaseのコンストラクタ
+func NewFindWebhookUsecase(webhookQuery WebhookQueryRepository) *FindWebhookUsecase {
+	return &FindWebhookUsecase{
+		webhookQuery: webhookQuery,
+	}
+}
+
+// Execute Webhookを取得
+func (u *FindWebhookUsecase) Execute(ctx context.Context, id string) (*domain.WebhookSubscription, error) {
+	log := logger.FromContext(ctx)
+	log.Info("finding webhook", slog.String("webhook_id", id))
+
+	// 権限の確認
+	if err := domain.Authorize(domain.PrincipalFromContext(ctx), domain.PermissionWebhooksManage); err != nil {
+		return nil, err
+	}
+
+	subscription, err := u.webhookQuery.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if subscription == nil {
+		return nil, domain.ErrWebhookNotFound(id)
+	}
+	return subscription, nil
+}
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// importUsersSyncMaxRows リクエスト内で同期的に処理する最大行数（超える場合はワーカーで処理する）
+const importUsersSyncMaxRows = 100
+
+// importUsersJobMaxAttempts インポート処理ジョブの最大試行回数
+const importUsersJobMaxAttempts = 5
+
+// ImportUsersUsecase ユーザー一括インポートユースケース
+type ImportUsersUsecase struct {
+	txManager TransactionManager
+	processor *ProcessUserImportUsecase
+}
+
+// NewImportUsersUsecase ImportUsersUsecaseのコンストラクタ
+func NewImportUsersUsecase(
+	txManager TransactionManager,
+	processor *ProcessUserImportUsecase,
+) *ImportUsersUsecase {
+	return &ImportUsersUsecase{
+		txManager: txManager,
+		processor: processor,
+	}
+}
+
+// Execute インポートを受け付け、作成したインポートのIDを返す
+// 行数が少ない場合はその場で処理し、多い場合はワーカーのジョブとして登録する
+func (u *ImportUsersUsecase) Execute(ctx context.Context, format domain.UserImportFormat, source []byte) (string, error) {
+	log := logger.FromContext(ctx)
+	log.Info("importing users", slog.String("format", string(format)), slog.Int("size", len(source)))
+
+	// 権限の確認
+	if err := domain.Authorize(domain.PrincipalFromContext(ctx), domain.PermissionUsersImport); err != nil {
+		return "", err
+	}
+
+	// ドメインモデルの作成（ファイル形式の検証）
+	userImport, _, err := domain.NewUserImport(format, source)
+	if err != nil {
+		return "", err
+	}
+	async := userImport.TotalRows > importUsersSyncMaxRows
+
+	err = u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		if err := command.CreateUserImport(ctx, tx, userImport); err != nil {
+			return err
+		}
+		auditPayload := map[string]any{"format": userImport.Format, "totalRows": userImport.TotalRows}
+		if err := recordAuditEvent(ctx, tx, domain.AuditAggregateTypeUserImport, userImport.ID, "created", auditPayload); err != nil {
+			return err
+		}
+		if !async {
+			return nil
+		}
+
+		// インポートと同じトランザクションでジョブを登録する
+		payload, err := json.Marshal(ProcessUserImportPayload{ImportID: userImport.ID})
+		if err != nil {
+			return err
+		}
+		job := domain.NewJob(ProcessUserImportJobType, payload, importUsersJobMaxAttempts)
+		return command.EnqueueJob(ctx, tx, job)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	log.Info("user import accepted",
+		slog.String("import_id", userImport.ID),
+		slog.Int("total_rows", userImport.TotalRows),
+		slog.Bool("async", async),
+	)
+
+	if !async {
+		if err := u.processor.Execute(ctx, userImport.ID); err != nil {
+			return "", err
+		}
+	}
+	return userImport.ID, nil
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// NewInvalidateBouncedEmail ハードバウンスしたメールアドレスを、そのアドレスを使うすべての組織のユーザーで配信できないと記録する InboundEventProcessor を作成
+// メール配信サービスは組織を区別しないため、すべての組織から探す
+func NewInvalidateBouncedEmail(eventSourcing command.UserEventSourcing) InboundEventProcessor {
+	return func(ctx context.Context, tx infrastructure.DBTX, event *domain.InboundEvent) error {
+		return invalidateBouncedEmail(ctx, tx, eventSourcing, event)
+	}
+}
+
+// invalidateBouncedEmail メールアドレスが一致するすべての組織のユーザーで、メールアドレスを配信できないと記録する
+func invalidateBouncedEmail(ctx context.Context, tx infrastructure.DBTX, eventSourcing command.UserEventSourcing, event *domain.InboundEvent) error {
+	log := logger.FromContext(ctx)
+	if event.Subject == "" {
+		log.Warn("bounced email address is missing", slog.String("inbound_event_id", event.ID))
+		return nil
+	}
+
+	organizationIDs, err := command.FindOrganizationIDsByUserEmail(ctx, tx, event.Subject)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, organizationID := range organizationIDs {
+		// 以降はユーザーの組織をテナントとする
+		ctx := domain.WithTenant(ctx, organizationID)
+		ctx = domain.WithPrincipal(ctx, domain.PrincipalFromContext(ctx).WithOrganization(organizationID))
+
+		user, err := command.FindByEmailForUpdate(ctx, tx, eventSourcing, event.Subject)
+		if err != nil {
+			return err
+		}
+		if user == nil || user.EmailInvalid() {
+			continue
+		}
+		user.InvalidateEmail(event.EventType, now)
+		if err := command.Save(ctx, tx, eventSourcing, user); err != nil {
+			return err
+		}
+		err = recordAuditEvent(ctx, tx, domain.AuditAggregateTypeUser, user.ID, "email_invalidated", map[string]any{
+			"email":          user.Email,
+			"reason":         event.EventType,
+			"provider":       event.Provider,
+			"inboundEventId": event.ID,
+		})
+		if err != nil {
+			return err
+		}
+		log.Info("user email invalidated", slog.String("user_id", user.ID), slog.String("organization_id", organizationID))
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// ListAPIKeysUsecase APIキー一覧取得ユースケース
+type ListAPIKeysUsecase struct {
+	apiKeyQuery APIKeyQueryRepository
+}
+
+// NewListAPIKeysUsecase ListAPIKeysUsecaseのコンストラクタ
+func NewListAPIKeysUsecase(apiKeyQuery APIKeyQueryRepository) *ListAPIKeysUsecase {
+	return &ListAPIKeysUsecase{
+		apiKeyQuery: apiKeyQuery,
+	}
+}
+
+// Execute APIキーを新しい順に取得し、総件数とともに返す（includeRevoked が false の場合は失効済みを除く）
+func (u *ListAPIKeysUsecase) Execute(ctx context.Context, includeRevoked bool, limit, offset int) ([]*domain.APIKey, int, error) {
+	log := logger.FromContext(ctx)
+	log.Info("listing api keys",
+		slog.Bool("include_revoked", includeRevoked),
+		slog.Int("limit", limit),
+		slog.Int("offset", offset),
+	)
+
+	// 権限の確認
+	if err := domain.Authorize(domain.PrincipalFromContext(ctx), domain.PermissionAPIKeysManage); err != nil {
+		return nil, 0, err
+	}
+
+	total, err := u.apiKeyQuery.Count(ctx, includeRevoked)
+	if err != nil {
+		return nil, 0, err
+	}
+	if total == 0 {
+		return []*domain.APIKey{}, 0, nil
+	}
+
+	apiKeys, err := u.apiKeyQuery.FindAll(ctx, includeRevoked, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	return apiKeys, total, nil
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// ListAuditEventsUsecase 監査イベント一覧取得ユースケース
+type ListAuditEventsUsecase struct {
+	auditEventQuery AuditEventQueryRepository
+}
+
+// NewListAuditEventsUsecase ListAuditEventsUsecaseのコンストラクタ
+func NewListAuditEventsUsecase(auditEventQuery AuditEventQueryRepository) *ListAuditEventsUsecase {
+	return &ListAuditEventsUsecase{
+		auditEventQuery: auditEventQuery,
+	}
+}
+
+// Execute 条件に一致する監査イベントを新しい順に取得し、総件数とともに返す
+func (u *ListAuditEventsUsecase) Execute(ctx context.Context, filter domain.AuditEventFilter, limit, offset int) ([]*domain.AuditEvent, int, error) {
+	log := logger.FromContext(ctx)
+	log.Info("listing audit events",
+		slog.String("aggregate_type", string(filter.AggregateType)),
+		slog.String("aggregate_id", filter.AggregateID),
+		slog.String("action", filter.Action),
+		slog.String("actor", filter.Actor),
+		slog.Int("limit", limit),
+		slog.Int("offset", offset),
+	)
+
+	// 権限の確認
+	if err := domain.Authorize(domain.PrincipalFromContext(ctx), domain.PermissionAuditRead); err != nil {
+		return nil, 0, err
+	}
+
+	if !filter.Since.IsZero() && !filter.Until.IsZero() && !filter.Since.Before(filter.Until) {
+		return nil, 0, domain.ErrAuditEventPeriodInvalid()
+	}
+
+	total, err := u.auditEventQuery.Count(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+	if total == 0 {
+		return []*domain.AuditEvent{}, 0, nil
+	}
+
+	events, err := u.auditEventQuery.FindAll(ctx, filter, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	return events, total, nil
+}
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// ListInboundEventsUsecase 受信イベント一覧取得ユースケース
+type ListInboundEventsUsecase struct {
+	inboundEventQuery InboundEventQueryRepository
+}
+
+// NewListInboundEventsUsecase ListInboundEventsUsecaseのコンストラクタ
+func NewListInboundEventsUsecase(inboundEventQuery InboundEventQueryRepository) *ListInboundEventsUsecase {
+	return &ListInboundEventsUsecase{
+		inboundEventQuery: inboundEventQuery,
+	}
+}
+
+// Execute 条件に一致する受信イベントを新しい順に取得し、総件数とともに返す
+func (u *ListInboundEventsUsecase) Execute(ctx context.Context, filter domain.InboundEventFilter, limit, offset int) ([]*domain.InboundEvent, int, error) {
+	log := logger.FromContext(ctx)
+	log.Info("listing inbound events",
+		slog.String("provider", filter.Provider),
+		slog.String("status", string(filter.Status)),
+		slog.Int("limit", limit),
+		slog.Int("offset", offset),
+	)
+
+	// 権限の確認
+	if err := domain.Authorize(domain.PrincipalFromContext(ctx), domain.PermissionInboundEventsManage); err != nil {
+		return nil, 0, err
+	}
+
+	total, err := u.inboundEventQuery.Count(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+	if total == 0 {
+		return []*domain.InboundEvent{}, 0, nil
+	}
+
+	events, err := u.inboundEventQuery.FindAll(ctx, filter, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	return events, total, nil
+}
@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// ListMembersUsecase 組織のメンバー一覧取得ユースケース
+type ListMembersUsecase struct {
+	organizationQuery OrganizationQueryRepository
+}
+
+// NewListMembersUsecase ListMembersUsecaseのコンストラクタ
+func NewListMembersUsecase(organizationQuery OrganizationQueryRepository) *ListMembersUsecase {
+	return &ListMembersUsecase{
+		organizationQuery: organizationQuery,
+	}
+}
+
+// Execute リクエストのテナントのメンバーを参加順に取得し、総件数とともに返す
+func (u *ListMembersUsecase) Execute(ctx context.Context, limit, offset int) ([]*domain.Membership, int, error) {
+	log := logger.FromContext(ctx)
+	log.Info("listing members", slog.Int("limit", limit), slog.Int("offset", offset))
+
+	// 権限の確認
+	if err := domain.Authorize(domain.PrincipalFromContext(ctx), domain.PermissionMembersManage); err != nil {
+		return nil, 0, err
+	}
+
+	total, err := u.organizationQuery.CountMemberships(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	if total == 0 {
+		return []*domain.Membership{}, 0, nil
+	}
+
+	memberships, err := u.organizationQuery.FindMemberships(ctx, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	return memberships, total, nil
+}
@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// ListOrganizationsUsecase 所属組織一覧取得ユースケース
+type ListOrganizationsUsecase struct {
+	organizationQuery OrganizationQueryRepository
+}
+
+// NewListOrganizationsUsecase ListOrganizationsUsecaseのコンストラクタ
+func NewListOrganizationsUsecase(organizationQuery OrganizationQueryRepository) *ListOrganizationsUsecase {
+	return &ListOrganizationsUsecase{
+		organizationQuery: organizationQuery,
+	}
+}
+
+// Execute 認証されたユーザーがメンバーである組織を名前順に取得
+func (u *ListOrganizationsUsecase) Execute(ctx context.Context) ([]*domain.Organization, error) {
+	log := logger.FromContext(ctx)
+	log.Info("listing organizations")
+
+	principal := domain.PrincipalFromContext(ctx)
+	if principal.Type != domain.PrincipalTypeUser {
+		return nil, domain.ErrLoginRequired()
+	}
+	return u.organizationQuery.FindByMember(ctx, principal.ID)
+}
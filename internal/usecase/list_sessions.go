@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// ListSessionsUsecase セッション一覧取得ユースケース
+type ListSessionsUsecase struct {
+	sessionQuery SessionQueryRepository
+}
+
+// NewListSessionsUsecase ListSessionsUsecaseのコンストラクタ
+func NewListSessionsUsecase(sessionQuery SessionQueryRepository) *ListSessionsUsecase {
+	return &ListSessionsUsecase{
+		sessionQuery: sessionQuery,
+	}
+}
+
+// Execute ログイン中のユーザーの有効なセッションを新しい順に取得
+func (u *ListSessionsUsecase) Execute(ctx context.Context) ([]*domain.Session, error) {
+	log := logger.FromContext(ctx)
+	log.Info("listing sessions")
+
+	principal := domain.PrincipalFromContext(ctx)
+	if principal.Type != domain.PrincipalTypeUser {
+		return nil, domain.ErrLoginRequired()
+	}
+	return u.sessionQuery.FindActiveByUserID(ctx, principal.ID, time.Now())
+}
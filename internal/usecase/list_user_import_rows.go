@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// ListUserImportRowsUsecase ユーザーインポートの行ごとの結果取得ユースケース
+type ListUserImportRowsUsecase struct {
+	importQuery UserImportQueryRepository
+}
+
+// NewListUserImportRowsUsecase ListUserImportRowsUsecaseのコンストラクタ
+func NewListUserImportRowsUsecase(importQuery UserImportQueryRepository) *ListUserImportRowsUsecase {
+	return &ListUserImportRowsUsecase{
+		importQuery: importQuery,
+	}
+}
+
+// Execute 行ごとの処理結果を取得（status が空の場合は全件）
+func (u *ListUserImportRowsUsecase) Execute(ctx context.Context, importID string, status domain.UserImportRowStatus, limit, offset int) ([]*domain.UserImportRow, int, error) {
+	log := logger.FromContext(ctx)
+	log.Info("listing user import rows",
+		slog.String("import_id", importID),
+		slog.String("status", string(status)),
+		slog.Int("limit", limit),
+		slog.Int("offset", offset),
+	)
+
+	// 権限の確認
+	if err := domain.Authorize(domain.PrincipalFromContext(ctx), domain.PermissionUsersImport); err != nil {
+		return nil, 0, err
+	}
+
+	userImport, err := u.importQuery.FindByID(ctx, importID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if userImport == nil {
+		return nil, 0, domain.ErrUserImportNotFound(importID)
+	}
+
+	rows, err := u.importQuery.FindRows(ctx, importID, status, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := u.importQuery.CountRows(ctx, importID, status)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return rows, total, nil
+}
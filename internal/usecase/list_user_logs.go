@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// ListUserLogsUsecase ユーザーの操作履歴取得ユースケース
+type ListUserLogsUsecase struct {
+	userLogQuery UserLogQueryRepository
+	userQuery    UserQueryRepository
+}
+
+// NewListUserLogsUsecase ListUserLogsUsecaseのコンストラクタ
+func NewListUserLogsUsecase(userLogQuery UserLogQueryRepository, userQuery UserQueryRepository) *ListUserLogsUsecase {
+	return &ListUserLogsUsecase{
+		userLogQuery: userLogQuery,
+		userQuery:    userQuery,
+	}
+}
+
+// Execute ユーザーの操作履歴を新しい順に取得（action が空の場合は全件）
+// 削除済みユーザーでもログが残っていれば取得できる
+func (u *ListUserLogsUsecase) Execute(ctx context.Context, userID string, action domain.UserLogAction, limit, offset int) ([]*domain.UserLog, int, error) {
+	log := logger.FromContext(ctx)
+	log.Info("listing user logs",
+		slog.String("user_id", userID),
+		slog.String("action", string(action)),
+		slog.Int("limit", limit),
+		slog.Int("offset", offset),
+	)
+
+	// 権限の確認（本人は権限がなくても可）
+	if err := domain.AuthorizeSelfOr(domain.PrincipalFromContext(ctx), userID, domain.PermissionUsersRead); err != nil {
+		return nil, 0, err
+	}
+
+	total, err := u.userLogQuery.CountByUserID(ctx, userID, action)
+	if err != nil {
+		return nil, 0, err
+	}
+	if total == 0 {
+		// 絞り込みで0件なのか、ユーザー自体が存在しないのかを区別する
+		exists, err := u.userExists(ctx, userID, action)
+		if err != nil {
+			return nil, 0, err
+		}
+		if !exists {
+			return nil, 0, domain.ErrUserNotFound(userID)
+		}
+		return []*domain.UserLog{}, 0, nil
+	}
+
+	logs, err := u.userLogQuery.FindByUserID(ctx, userID, action, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	return logs, total, nil
+}
+
+// userExists ユーザーが現存するか、過去に存在した（ログが残っている）かどうか
+func (u *ListUserLogsUsecase) userExists(ctx context.Context, userID string, action domain.UserLogAction) (bool, error) {
+	if action != "" {
+		total, err := u.userLogQuery.CountByUserID(ctx, userID, "")
+		if err != nil {
+			return false, err
+		}
+		if total > 0 {
+			return true, nil
+		}
+	}
+
+	user, err := u.userQuery.FindByID(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return user != nil, nil
+}
@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// ListUserLogsByUserIDsUsecase 複数のユーザーの操作履歴をまとめて取得するユースケース
+// ユーザーの一覧とそれぞれのログを1回のリクエストで返す場合に、ユーザーごとにクエリを発行しないために使う
+type ListUserLogsByUserIDsUsecase struct {
+	userLogQuery UserLogQueryRepository
+}
+
+// NewListUserLogsByUserIDsUsecase ListUserLogsByUserIDsUsecaseのコンストラクタ
+func NewListUserLogsByUserIDsUsecase(userLogQuery UserLogQueryRepository) *ListUserLogsByUserIDsUsecase {
+	return &ListUserLogsByUserIDsUsecase{
+		userLogQuery: userLogQuery,
+	}
+}
+
+// Execute ユーザーごとの操作履歴（新しい順）と件数を取得（action が空の場合は全件、ページネーションはユーザーごとに適用）
+// ログのないユーザーは結果に含まれない。ユーザーの存在は確認しない（呼び出し元が取得したユーザーのIDを渡す）
+func (u *ListUserLogsByUserIDsUsecase) Execute(ctx context.Context, userIDs []string, action domain.UserLogAction, limit, offset int) (map[string][]*domain.UserLog, map[string]int, error) {
+	log := logger.FromContext(ctx)
+	log.Info("listing user logs by user ids",
+		slog.Int("user_count", len(userIDs)),
+		slog.String("action", string(action)),
+		slog.Int("limit", limit),
+		slog.Int("offset", offset),
+	)
+
+	// 権限の確認（本人のログは権限がなくても可）
+	principal := domain.PrincipalFromContext(ctx)
+	for _, userID := range userIDs {
+		if err := domain.AuthorizeSelfOr(principal, userID, domain.PermissionUsersRead); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if len(userIDs) == 0 {
+		return map[string][]*domain.UserLog{}, map[string]int{}, nil
+	}
+
+	totals, err := u.userLogQuery.CountByUserIDs(ctx, userIDs, action)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(totals) == 0 {
+		return map[string][]*domain.UserLog{}, totals, nil
+	}
+
+	logs, err := u.userLogQuery.FindByUserIDs(ctx, userIDs, action, limit, offset)
+	if err != nil {
+		return nil, nil, err
+	}
+	return logs, totals, nil
+}
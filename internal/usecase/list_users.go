@@ -10,27 +10,32 @@ import (
 
 // ListUsersUsecase ユーザー一覧取得ユースケース
 type ListUsersUsecase struct {
-	userQuery UserQueryRepository
+	userSummaryQuery UserSummaryQueryRepository
 }
 
 // NewListUsersUsecase ListUsersUsecaseのコンストラクタ
-func NewListUsersUsecase(userQuery UserQueryRepository) *ListUsersUsecase {
+func NewListUsersUsecase(userSummaryQuery UserSummaryQueryRepository) *ListUsersUsecase {
 	return &ListUsersUsecase{
-		userQuery: userQuery,
+		userSummaryQuery: userSummaryQuery,
 	}
 }
 
-// Execute ユーザー一覧を取得
-func (u *ListUsersUsecase) Execute(ctx context.Context, limit, offset int) ([]*domain.User, int, error) {
+// Execute ユーザー一覧（ユーザーログの件数・最新の日時を含む）を取得
+func (u *ListUsersUsecase) Execute(ctx context.Context, limit, offset int) ([]*domain.UserSummary, int, error) {
 	log := logger.FromContext(ctx)
 	log.Info("listing users", slog.Int("limit", limit), slog.Int("offset", offset))
 
-	users, err := u.userQuery.FindAll(ctx, limit, offset)
+	// 権限の確認
+	if err := domain.Authorize(domain.PrincipalFromContext(ctx), domain.PermissionUsersRead); err != nil {
+		return nil, 0, err
+	}
+
+	users, err := u.userSummaryQuery.FindAll(ctx, limit, offset)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	total, err := u.userQuery.Count(ctx)
+	total, err := u.userSummaryQuery.Count(ctx)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// ListWebhookDeliveriesUsecase Webhookの配信の記録取得ユースケース
+type ListWebhookDeliveriesUsecase struct {
+	webhookQuery WebhookQueryRepository
+}
+
+// NewListWebhookDeliveriesUsecase ListWebhookDeliveriesUsecaseのコンストラクタ
+func NewListWebhookDeliveriesUsecase(webhookQuery WebhookQueryRepository) *ListWebhookDeliveriesUsecase {
+	return &ListWebhookDeliveriesUsecase{
+		webhookQuery: webhookQuery,
+	}
+}
+
+// Execute Webhookの配信の記録を新しい順に取得し、総件数とともに返す
+func (u *ListWebhookDeliveriesUsecase) Execute(ctx context.Context, subscriptionID string, limit, offset int) ([]*domain.WebhookDelivery, int, error) {
+	log := logger.FromContext(ctx)
+	log.Info("listing webhook deliveries",
+		slog.String("webhook_id", subscriptionID),
+		slog.Int("limit", limit),
+		slog.Int("offset", offset),
+	)
+
+	// 権限の確認
+	if err := domain.Authorize(domain.PrincipalFromContext(ctx), domain.PermissionWebhooksManage); err != nil {
+		return nil, 0, err
+	}
+
+	subscription, err := u.webhookQuery.FindByID(ctx, subscriptionID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if subscription == nil {
+		return nil, 0, domain.ErrWebhookNotFound(subscriptionID)
+	}
+
+	total, err := u.webhookQuery.CountDeliveries(ctx, subscriptionID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if total == 0 {
+		return []*domain.WebhookDelivery{}, 0, nil
+	}
+
+	deliveries, err := u.webhookQuery.FindDeliveries(ctx, subscriptionID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	return deliveries, total, nil
+}
@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// ListWebhooksUsecase Webhook一覧取得ユースケース
+type ListWebhooksUsecase struct {
+	webhookQuery WebhookQueryRepository
+}
+
+// NewListWebhooksUsecase ListWebhooksUsecaseのコンストラクタ
+func NewListWebhooksUsecase(webhookQuery WebhookQueryRepository) *ListWebhooksUsecase {
+	return &ListWebhooksUsecase{
+		webhookQuery: webhookQuery,
+	}
+}
+
+// Execute Webhookを新しい順に取得し、総件数とともに返す
+func (u *ListWebhooksUsecase) Execute(ctx context.Context, limit, offset int) ([]*domain.WebhookSubscription, int, error) {
+	log := logger.FromContext(ctx)
+	log.Info("listing webhooks",
+		slog.Int("limit", limit),
+		slog.Int("offset", offset),
+	)
+
+	// 権限の確認
+	if err := domain.Authorize(domain.PrincipalFromContext(ctx), domain.PermissionWebhooksManage); err != nil {
+		return nil, 0, err
+	}
+
+	total, err := u.webhookQuery.Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	if total == 0 {
+		return []*domain.WebhookSubscription{}, 0, nil
+	}
+
+	subscriptions, err := u.webhookQuery.FindAll(ctx, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	return subscriptions, total, nil
+}
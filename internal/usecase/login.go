@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// LoginUsecase ログインユースケース
+type LoginUsecase struct {
+	txManager     TransactionManager
+	eventSourcing command.UserEventSourcing
+	lockout       domain.LoginLockoutPolicy
+	sessionTTL    time.Duration
+}
+
+// NewLoginUsecase LoginUsecaseのコンストラクタ
+func NewLoginUsecase(
+	txManager TransactionManager,
+	eventSourcing command.UserEventSourcing,
+	lockout domain.LoginLockoutPolicy,
+	sessionTTL time.Duration,
+) *LoginUsecase {
+	return &LoginUsecase{
+		txManager:     txManager,
+		eventSourcing: eventSourcing,
+		lockout:       lockout,
+		sessionTTL:    sessionTTL,
+	}
+}
+
+// Execute メールアドレスとパスワードを検証してセッションを作成し、セッションと平文のトークンを返す
+// 同じメールアドレスで失敗が続いた場合は、成功するパスワードであっても一定時間ログインを拒否する
+// 認証情報と失敗回数はトランザクション内でプライマリから読み、失敗回数はすべてのサーバーで共有する
+func (u *LoginUsecase) Execute(ctx context.Context, email, password string) (*domain.Session, string, error) {
+	log := logger.FromContext(ctx)
+	log.Info("logging in", slog.String("email", email))
+
+	// メールアドレスは組織ごとに一意のため、リクエストのテナントのユーザーとしてログインする
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var (
+		session  *domain.Session
+		token    string
+		loginErr error
+	)
+	err = u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		now := time.Now()
+		// 同じメールアドレスへの同時のログインは、失敗回数の行のロックで順に処理する
+		failures, err := command.FindLoginFailuresForUpdate(ctx, tx, email)
+		if err != nil {
+			return err
+		}
+		if retryAfter, locked := failures.RetryAfter(u.lockout, now); locked {
+			log.Warn("login locked out", slog.String("email", email), slog.Duration("retry_after", retryAfter))
+			loginErr = domain.ErrTooManyLoginAttempts(retryAfter)
+			return nil
+		}
+
+		user, err := command.FindByEmailForUpdate(ctx, tx, u.eventSourcing, email)
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			// 登録済みかどうかを応答時間から推測されないよう、存在しない場合もハッシュ計算を行う
+			domain.VerifyDummyPassword(password)
+		}
+		if user == nil || !user.VerifyPassword(password) {
+			// 失敗の記録をコミットするため、エラーはトランザクションの外で返す
+			failures.Record(u.lockout, now)
+			if err := command.SaveLoginFailures(ctx, tx, failures); err != nil {
+				return err
+			}
+			log.Info("login failed", slog.String("email", email))
+			loginErr = domain.ErrInvalidCredentials()
+			return nil
+		}
+		// 失敗がない場合も、ロックのために作成した行を削除する
+		if err := command.DeleteLoginFailures(ctx, tx, email); err != nil {
+			return err
+		}
+
+		session, token, err = domain.NewSession(user.ID, domain.RequestMetadataFromContext(ctx), u.sessionTTL)
+		if err != nil {
+			return err
+		}
+		if err := command.SaveSession(ctx, tx, session); err != nil {
+			return err
+		}
+
+		// ログインしたユーザーを操作の主体として監査イベントを記録（トークンは記録しない）
+		ctx = domain.WithPrincipal(ctx, domain.NewUserPrincipal(user.ID).WithOrganization(organizationID))
+		return recordAuditEvent(ctx, tx, domain.AuditAggregateTypeSession, session.ID, "login", sessionAuditPayload(session))
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	if loginErr != nil {
+		return nil, "", loginErr
+	}
+	return session, token, nil
+}
+
+// sessionAuditPayload セッションの監査イベントに記録する内容
+func sessionAuditPayload(session *domain.Session) map[string]any {
+	return map[string]any{
+		"userId":    session.UserID,
+		"expiresAt": session.ExpiresAt,
+	}
+}
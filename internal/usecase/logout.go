@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// LogoutUsecase ログアウトユースケース
+type LogoutUsecase struct {
+	txManager TransactionManager
+}
+
+// NewLogoutUsecase LogoutUsecaseのコンストラクタ
+func NewLogoutUsecase(txManager TransactionManager) *LogoutUsecase {
+	return &LogoutUsecase{
+		txManager: txManager,
+	}
+}
+
+// Execute リクエストを認証したセッションを失効させる（セッションで認証されていない場合は何もしない）
+func (u *LogoutUsecase) Execute(ctx context.Context) error {
+	sessionID := domain.PrincipalFromContext(ctx).SessionID
+	if sessionID == "" {
+		return nil
+	}
+
+	log := logger.FromContext(ctx)
+	log.Info("logging out", slog.String("session_id", sessionID))
+
+	return u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		// 行ロック付きで存在確認
+		session, err := command.FindSessionByIDForUpdate(ctx, tx, sessionID)
+		if err != nil {
+			return err
+		}
+		if session == nil {
+			return nil
+		}
+		return revokeSession(ctx, tx, session, "logout")
+	})
+}
+
+// revokeSession セッションを失効させ、監査イベントを記録する（失効済みの場合は何もしない。RunInTransaction 内で使用）
+func revokeSession(ctx context.Context, tx infrastructure.DBTX, session *domain.Session, action string) error {
+	if session.RevokedAt != nil {
+		return nil
+	}
+
+	session.Revoke(time.Now())
+	if err := command.RevokeSession(ctx, tx, session.ID, *session.RevokedAt); err != nil {
+		return err
+	}
+
+	// 監査イベントを記録
+	return recordAuditEvent(ctx, tx, domain.AuditAggregateTypeSession, session.ID, action, sessionAuditPayload(session))
+}
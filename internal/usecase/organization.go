@@ -0,0 +1,13 @@
+package usecase
+
+import "github.com/example/go-react-cqrs-template/internal/domain"
+
+// organizationAuditPayload 組織の監査イベントに記録する内容
+func organizationAuditPayload(organization *domain.Organization) map[string]any {
+	return map[string]any{"name": organization.Name, "slug": organization.Slug}
+}
+
+// membershipAuditPayload メンバーシップの監査イベントに記録する内容
+func membershipAuditPayload(membership *domain.Membership) map[string]any {
+	return map[string]any{"userId": membership.UserID, "roles": domain.RoleNames(membership.Roles)}
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// InboundEventProcessor 受信イベントの種類ごとの処理（RunInTransaction 内で実行される）
+// 再処理で同じイベントを何度処理しても結果が変わらないようにする
+type InboundEventProcessor func(ctx context.Context, tx infrastructure.DBTX, event *domain.InboundEvent) error
+
+// ProcessInboundEventUsecase 受信イベント処理ユースケース（ワーカーから実行する）
+type ProcessInboundEventUsecase struct {
+	txManager TransactionManager
+	// processors イベントの種類ごとの処理（登録されていない種類のイベントは対象外として記録する）
+	processors map[string]InboundEventProcessor
+}
+
+// NewProcessInboundEventUsecase ProcessInboundEventUsecaseのコンストラクタ
+func NewProcessInboundEventUsecase(txManager TransactionManager, processors map[string]InboundEventProcessor) *ProcessInboundEventUsecase {
+	return &ProcessInboundEventUsecase{
+		txManager:  txManager,
+		processors: processors,
+	}
+}
+
+// Execute 受信イベントの種類に応じた処理を実行し、処理の状態を記録する
+// 処理に失敗した場合は失敗を記録してエラーを返し、ジョブのバックオフで再試行させる
+// 処理済み・対象外のイベント（再処理を指示されていないもの）は何もしない
+func (u *ProcessInboundEventUsecase) Execute(ctx context.Context, payload ProcessInboundEventPayload) error {
+	log := logger.FromContext(ctx).With(slog.String("inbound_event_id", payload.InboundEventID))
+
+	// 権限の確認
+	if err := domain.Authorize(domain.PrincipalFromContext(ctx), domain.PermissionInboundEventsManage); err != nil {
+		return err
+	}
+
+	var event *domain.InboundEvent
+	processErr := u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		var err error
+		event, err = command.FindInboundEventByIDForUpdate(ctx, tx, payload.InboundEventID)
+		if err != nil || event == nil || !event.Processable() {
+			event = nil
+			return err
+		}
+
+		processor, ok := u.processors[event.EventType]
+		if !ok {
+			event.MarkIgnored(time.Now())
+			return command.UpdateInboundEventStatus(ctx, tx, event)
+		}
+		if err := processor(ctx, tx, event); err != nil {
+			return err
+		}
+		event.MarkProcessed(time.Now())
+		return command.UpdateInboundEventStatus(ctx, tx, event)
+	})
+	if processErr == nil {
+		if event == nil {
+			log.Info("inbound event processing skipped")
+		} else {
+			log.Info("inbound event processed", slog.String("event_type", event.EventType), slog.String("status", string(event.Status)))
+		}
+		return nil
+	}
+
+	// 処理の変更はロールバックされているため、失敗だけを記録する
+	recordErr := u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		failed, err := command.FindInboundEventByIDForUpdate(ctx, tx, payload.InboundEventID)
+		if err != nil || failed == nil {
+			return err
+		}
+		failed.MarkFailed(processErr.Error())
+		return command.UpdateInboundEventStatus(ctx, tx, failed)
+	})
+	if recordErr != nil {
+		log.Warn("failed to record inbound event failure", slog.String("error", recordErr.Error()))
+	}
+	return processErr
+}
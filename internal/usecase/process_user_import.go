@@ -0,0 +1,190 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// ProcessUserImportJobType インポート処理ジョブの種類
+const ProcessUserImportJobType = "process_user_import"
+
+// ProcessUserImportPayload インポート処理ジョブのペイロード
+type ProcessUserImportPayload struct {
+	ImportID string `json:"import_id"`
+}
+
+// ProcessUserImportUsecase ユーザーインポート処理ユースケース
+type ProcessUserImportUsecase struct {
+	txManager          TransactionManager
+	eventSourcing      command.UserEventSourcing
+	userLogHashKey     domain.UserLogHashKey
+	emailNormalization domain.EmailNormalization
+}
+
+// NewProcessUserImportUsecase ProcessUserImportUsecaseのコンストラクタ
+func NewProcessUserImportUsecase(
+	txManager TransactionManager,
+	eventSourcing command.UserEventSourcing,
+	userLogHashKey domain.UserLogHashKey,
+	emailNormalization domain.EmailNormalization,
+) *ProcessUserImportUsecase {
+	return &ProcessUserImportUsecase{
+		txManager:          txManager,
+		eventSourcing:      eventSourcing,
+		userLogHashKey:     userLogHashKey,
+		emailNormalization: emailNormalization,
+	}
+}
+
+// Execute インポートの各行からユーザーを作成し、行ごとの結果を記録する
+// 行ごとに別トランザクションで処理するため、途中で失敗しても再実行時は未処理の行から再開する
+func (u *ProcessUserImportUsecase) Execute(ctx context.Context, importID string) error {
+	log := logger.FromContext(ctx)
+	log.Info("processing user import", slog.String("import_id", importID))
+
+	// 権限の確認
+	if err := domain.Authorize(domain.PrincipalFromContext(ctx), domain.PermissionUsersImport); err != nil {
+		return err
+	}
+
+	var (
+		userImport *domain.UserImport
+		processed  map[int]bool
+	)
+	err := u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		var err error
+		userImport, err = command.FindUserImportByIDForUpdate(ctx, tx, importID)
+		if err != nil {
+			return err
+		}
+		if userImport == nil {
+			return domain.ErrUserImportNotFound(importID)
+		}
+		if userImport.Finished() {
+			return nil
+		}
+
+		processed, err = command.FindProcessedUserImportLines(ctx, tx, importID)
+		if err != nil {
+			return err
+		}
+
+		userImport.Start()
+		return command.UpdateUserImportStatus(ctx, tx, userImport)
+	})
+	if err != nil {
+		return err
+	}
+	if userImport.Finished() {
+		log.Info("user import already finished", slog.String("import_id", importID))
+		return nil
+	}
+
+	records, err := domain.ParseUserImportRecords(userImport.Format, userImport.Source)
+	if err != nil {
+		// 受付時に検証済みのため通常は発生しない。再試行しても結果は変わらないため失敗として確定する
+		return u.fail(ctx, userImport, err)
+	}
+
+	for _, record := range records {
+		if processed[record.Line] {
+			continue
+		}
+		err := u.processRecord(ctx, importID, record)
+		var conflictErr *domain.ConflictError
+		if errors.As(err, &conflictErr) {
+			// 重複チェックの後に同じメールアドレスのユーザーが作成された場合
+			row := domain.NewUserImportRow(importID, record, domain.UserImportRowStatusSkippedDuplicate, "", conflictErr.UserMessage)
+			err = u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+				return command.SaveUserImportRow(ctx, tx, row)
+			})
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		userImport.Complete()
+		if err := command.UpdateUserImportStatus(ctx, tx, userImport); err != nil {
+			return err
+		}
+		payload := map[string]any{"totalRows": userImport.TotalRows}
+		if err := recordAuditEvent(ctx, tx, domain.AuditAggregateTypeUserImport, userImport.ID, string(userImport.Status), payload); err != nil {
+			return err
+		}
+		log.Info("user import completed", slog.String("import_id", importID), slog.Int("total_rows", userImport.TotalRows))
+		return nil
+	})
+}
+
+// processRecord 1行分のユーザーを作成し、結果を記録する
+func (u *ProcessUserImportUsecase) processRecord(ctx context.Context, importID string, record domain.UserImportRecord) error {
+	return u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		if record.ParseError != "" {
+			row := domain.NewUserImportRow(importID, record, domain.UserImportRowStatusInvalid, "", record.ParseError)
+			return command.SaveUserImportRow(ctx, tx, row)
+		}
+
+		// ドメインモデルの作成（入力値の検証）
+		user, err := domain.NewUser(record.Name, u.emailNormalization.Normalize(record.Email))
+		if err != nil {
+			var validationErr *domain.ValidationError
+			if !errors.As(err, &validationErr) {
+				return err
+			}
+			row := domain.NewUserImportRow(importID, record, domain.UserImportRowStatusInvalid, "", validationErr.UserMessage)
+			return command.SaveUserImportRow(ctx, tx, row)
+		}
+
+		// メールアドレスの重複チェック（ロック付き）
+		existingUser, err := command.FindByEmailForUpdate(ctx, tx, u.eventSourcing, user.Email)
+		if err != nil {
+			return err
+		}
+		if existingUser != nil {
+			reason := domain.ErrEmailAlreadyExists(user.Email).UserMessage
+			row := domain.NewUserImportRow(importID, record, domain.UserImportRowStatusSkippedDuplicate, existingUser.ID, reason)
+			return command.SaveUserImportRow(ctx, tx, row)
+		}
+
+		// 永続化
+		if err := command.Save(ctx, tx, u.eventSourcing, user); err != nil {
+			return err
+		}
+
+		// ユーザー作成ログを保存
+		userLog := attributeUserLog(ctx, domain.NewUserLog(user.ID, domain.UserLogActionCreated))
+		if err := command.SaveUserLog(ctx, tx, u.userLogHashKey, userLog); err != nil {
+			return err
+		}
+		if err := recordAuditEvent(ctx, tx, domain.AuditAggregateTypeUser, user.ID, string(domain.UserLogActionCreated), userAuditPayload(user)); err != nil {
+			return err
+		}
+
+		row := domain.NewUserImportRow(importID, record, domain.UserImportRowStatusCreated, user.ID, "")
+		return command.SaveUserImportRow(ctx, tx, row)
+	})
+}
+
+// fail インポートを失敗として記録する
+func (u *ProcessUserImportUsecase) fail(ctx context.Context, userImport *domain.UserImport, cause error) error {
+	logger.FromContext(ctx).Warn("user import failed",
+		slog.String("import_id", userImport.ID),
+		slog.String("error", cause.Error()),
+	)
+	return u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		userImport.Fail(cause.Error())
+		if err := command.UpdateUserImportStatus(ctx, tx, userImport); err != nil {
+			return err
+		}
+		payload := map[string]any{"error": cause.Error()}
+		return recordAuditEvent(ctx, tx, domain.AuditAggregateTypeUserImport, userImport.ID, string(userImport.Status), payload)
+	})
+}
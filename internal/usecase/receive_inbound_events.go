@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// ProcessInboundEventJobType 受信イベント処理ジョブの種類
+const ProcessInboundEventJobType = "process_inbound_event"
+
+// processInboundEventJobMaxAttempts 受信イベント処理ジョブの最大試行回数
+const processInboundEventJobMaxAttempts = 5
+
+// ProcessInboundEventPayload 受信イベント処理ジョブのペイロード
+type ProcessInboundEventPayload struct {
+	InboundEventID string `json:"inbound_event_id"`
+}
+
+// ReceiveInboundEventsUsecase Webhookで受信したイベントの保存ユースケース
+type ReceiveInboundEventsUsecase struct {
+	txManager TransactionManager
+}
+
+// NewReceiveInboundEventsUsecase ReceiveInboundEventsUsecaseのコンストラクタ
+func NewReceiveInboundEventsUsecase(txManager TransactionManager) *ReceiveInboundEventsUsecase {
+	return &ReceiveInboundEventsUsecase{
+		txManager: txManager,
+	}
+}
+
+// Execute 署名を検証したイベントをコンテキストのテナントに保存し、処理ジョブを登録する（新しく保存したイベントの件数を返す）
+// プロバイダーが再送した受信済みのイベントは保存もジョブの登録もしない
+// 署名の検証が認証となるため、権限は確認しない
+func (u *ReceiveInboundEventsUsecase) Execute(ctx context.Context, events []*domain.InboundEvent) (int, error) {
+	log := logger.FromContext(ctx)
+
+	received := 0
+	err := u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		received = 0
+		for _, event := range events {
+			saved, err := command.SaveInboundEvent(ctx, tx, event)
+			if err != nil {
+				return err
+			}
+			if !saved {
+				log.Info("duplicate inbound event ignored",
+					slog.String("provider", event.Provider),
+					slog.String("provider_event_id", event.ProviderEventID),
+				)
+				continue
+			}
+			if err := enqueueProcessInboundEvent(ctx, tx, event.ID); err != nil {
+				return err
+			}
+			received++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	log.Info("inbound events received",
+		slog.Int("received", received),
+		slog.Int("duplicates", len(events)-received),
+	)
+	return received, nil
+}
+
+// enqueueProcessInboundEvent 受信イベントの処理ジョブを登録する（RunInTransaction 内で使用）
+func enqueueProcessInboundEvent(ctx context.Context, tx infrastructure.DBTX, inboundEventID string) error {
+	payload, err := json.Marshal(ProcessInboundEventPayload{InboundEventID: inboundEventID})
+	if err != nil {
+		return err
+	}
+	return command.EnqueueJob(ctx, tx, domain.NewJob(ProcessInboundEventJobType, payload, processInboundEventJobMaxAttempts))
+}
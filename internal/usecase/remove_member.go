@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// RemoveMemberUsecase 組織のメンバー削除ユースケース
+type RemoveMemberUsecase struct {
+	txManager TransactionManager
+}
+
+// NewRemoveMemberUsecase RemoveMemberUsecaseのコンストラクタ
+func NewRemoveMemberUsecase(txManager TransactionManager) *RemoveMemberUsecase {
+	return &RemoveMemberUsecase{
+		txManager: txManager,
+	}
+}
+
+// Execute リクエストのテナントからメンバーを外す（組織でのロールがなくなる。ユーザー自体は削除しない）
+func (u *RemoveMemberUsecase) Execute(ctx context.Context, userID string) error {
+	log := logger.FromContext(ctx)
+	log.Info("removing member", slog.String("user_id", userID))
+
+	// 権限の確認
+	if err := domain.Authorize(domain.PrincipalFromContext(ctx), domain.PermissionMembersManage); err != nil {
+		return err
+	}
+
+	return u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		// 行ロック付きで存在確認
+		membership, err := command.FindMembershipForUpdate(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+		if membership == nil {
+			return domain.ErrMembershipNotFound(userID)
+		}
+
+		if err := command.DeleteMembership(ctx, tx, userID); err != nil {
+			return err
+		}
+
+		// 監査イベントを記録（削除前のロールを残す）
+		return recordAuditEvent(ctx, tx, domain.AuditAggregateTypeOrganization, membership.OrganizationID, "member_removed", membershipAuditPayload(membership))
+	})
+}
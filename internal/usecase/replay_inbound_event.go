@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// ReplayInboundEventUsecase 受信イベント再処理ユースケース
+type ReplayInboundEventUsecase struct {
+	txManager TransactionManager
+}
+
+// NewReplayInboundEventUsecase ReplayInboundEventUsecaseのコンストラクタ
+func NewReplayInboundEventUsecase(txManager TransactionManager) *ReplayInboundEventUsecase {
+	return &ReplayInboundEventUsecase{
+		txManager: txManager,
+	}
+}
+
+// Execute 保存した受信イベントを処理待ちに戻し、処理ジョブを登録する（処理済みのイベントも再処理する）
+func (u *ReplayInboundEventUsecase) Execute(ctx context.Context, id string) (*domain.InboundEvent, error) {
+	log := logger.FromContext(ctx)
+	log.Info("replaying inbound event", slog.String("inbound_event_id", id))
+
+	// 権限の確認
+	if err := domain.Authorize(domain.PrincipalFromContext(ctx), domain.PermissionInboundEventsManage); err != nil {
+		return nil, err
+	}
+
+	var event *domain.InboundEvent
+	err := u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		var err error
+		event, err = command.FindInboundEventByIDForUpdate(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if event == nil {
+			return domain.ErrInboundEventNotFound(id)
+		}
+
+		previousStatus := event.Status
+		event.Replay()
+		if err := command.UpdateInboundEventStatus(ctx, tx, event); err != nil {
+			return err
+		}
+		if err := enqueueProcessInboundEvent(ctx, tx, event.ID); err != nil {
+			return err
+		}
+		return recordAuditEvent(ctx, tx, domain.AuditAggregateTypeInboundEvent, event.ID, "replayed", map[string]any{
+			"provider":        event.Provider,
+			"providerEventId": event.ProviderEventID,
+			"eventType":       event.EventType,
+			"previousStatus":  string(previousStatus),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return event, nil
+}
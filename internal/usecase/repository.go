@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/example/go-react-cqrs-template/internal/domain"
 	"github.com/example/go-react-cqrs-template/internal/infrastructure"
@@ -18,4 +19,99 @@ type UserQueryRepository interface {
 	FindByEmail(ctx context.Context, email string) (*domain.User, error)
 	FindAll(ctx context.Context, limit, offset int) ([]*domain.User, error)
 	Count(ctx context.Context) (int, error)
+	StreamAll(ctx context.Context, fn func(*domain.User) error) error
+}
+
+// UserSummaryQueryRepository ユーザー一覧（ユーザーログの集計を含む）の読み取り操作のインターフェース
+type UserSummaryQueryRepository interface {
+	FindAll(ctx context.Context, limit, offset int) ([]*domain.UserSummary, error)
+	Count(ctx context.Context) (int, error)
+}
+
+// UserImportQueryRepository ユーザーインポートの読み取り操作のインターフェース
+type UserImportQueryRepository interface {
+	FindByID(ctx context.Context, id string) (*domain.UserImport, error)
+	Summarize(ctx context.Context, importID string) (*domain.UserImportSummary, error)
+	FindRows(ctx context.Context, importID string, status domain.UserImportRowStatus, limit, offset int) ([]*domain.UserImportRow, error)
+	CountRows(ctx context.Context, importID string, status domain.UserImportRowStatus) (int, error)
+}
+
+// UserLogQueryRepository ユーザーログの読み取り操作のインターフェース
+type UserLogQueryRepository interface {
+	FindByUserID(ctx context.Context, userID string, action domain.UserLogAction, limit, offset int) ([]*domain.UserLog, error)
+	CountByUserID(ctx context.Context, userID string, action domain.UserLogAction) (int, error)
+	FindByUserIDs(ctx context.Context, userIDs []string, action domain.UserLogAction, limit, offset int) (map[string][]*domain.UserLog, error)
+	CountByUserIDs(ctx context.Context, userIDs []string, action domain.UserLogAction) (map[string]int, error)
+}
+
+// AuditEventQueryRepository 監査イベントの読み取り操作のインターフェース
+type AuditEventQueryRepository interface {
+	FindAll(ctx context.Context, filter domain.AuditEventFilter, limit, offset int) ([]*domain.AuditEvent, error)
+	Count(ctx context.Context, filter domain.AuditEventFilter) (int, error)
+}
+
+// APIKeyQueryRepository APIキーの読み取り操作のインターフェース
+type APIKeyQueryRepository interface {
+	FindByID(ctx context.Context, id string) (*domain.APIKey, error)
+	FindByPrefix(ctx context.Context, prefix string) (*domain.APIKey, error)
+	FindAll(ctx context.Context, includeRevoked bool, limit, offset int) ([]*domain.APIKey, error)
+	Count(ctx context.Context, includeRevoked bool) (int, error)
+}
+
+// WebhookQueryRepository Webhookと配信の記録の読み取り操作のインターフェース
+type WebhookQueryRepository interface {
+	FindByID(ctx context.Context, id string) (*domain.WebhookSubscription, error)
+	FindAll(ctx context.Context, limit, offset int) ([]*domain.WebhookSubscription, error)
+	Count(ctx context.Context) (int, error)
+	FindDeliveries(ctx context.Context, subscriptionID string, limit, offset int) ([]*domain.WebhookDelivery, error)
+	CountDeliveries(ctx context.Context, subscriptionID string) (int, error)
+}
+
+// InboundEventQueryRepository 受信したWebhookのイベントの読み取り操作のインターフェース
+type InboundEventQueryRepository interface {
+	FindByID(ctx context.Context, id string) (*domain.InboundEvent, error)
+	FindAll(ctx context.Context, filter domain.InboundEventFilter, limit, offset int) ([]*domain.InboundEvent, error)
+	Count(ctx context.Context, filter domain.InboundEventFilter) (int, error)
+}
+
+// SessionQueryRepository セッションの読み取り操作のインターフェース
+type SessionQueryRepository interface {
+	FindByID(ctx context.Context, id string) (*domain.Session, error)
+	FindByTokenHash(ctx context.Context, tokenHash string) (*domain.Session, error)
+	FindActiveByUserID(ctx context.Context, userID string, now time.Time) ([]*domain.Session, error)
+}
+
+// OrganizationQueryRepository 組織とメンバーシップの読み取り操作のインターフェース
+type OrganizationQueryRepository interface {
+	FindByID(ctx context.Context, id string) (*domain.Organization, error)
+	FindByMember(ctx context.Context, userID string) ([]*domain.Organization, error)
+	FindMembership(ctx context.Context, organizationID, userID string) (*domain.Membership, error)
+	FindMemberships(ctx context.Context, limit, offset int) ([]*domain.Membership, error)
+	CountMemberships(ctx context.Context) (int, error)
+}
+
+// ChangeQueryRepository 変更通知の読み取り操作のインターフェース
+type ChangeQueryRepository interface {
+	FindAfter(ctx context.Context, afterID int64, limit int) ([]*domain.Change, error)
+	LatestID(ctx context.Context) (int64, error)
+	OldestID(ctx context.Context) (int64, error)
+}
+
+// ChangeNotifier 変更の通知を購読するインターフェース（infrastructure.ChangeListener が実装する）
+type ChangeNotifier interface {
+	// Subscribe 組織に変更があると値を受け取るチャンネルと、購読を解除する関数を返す
+	// チャンネルは通知の受け取りを停止すると閉じられる
+	Subscribe(organizationID string) (<-chan struct{}, func())
+}
+
+// Mailer メール送信のインターフェース
+type Mailer interface {
+	Send(ctx context.Context, message domain.EmailMessage) error
+}
+
+// WebhookSender Webhookのリクエスト送信のインターフェース
+type WebhookSender interface {
+	// Send リクエストを送信し、受信側が返したHTTPステータスを返す（応答がなかった場合は0とエラー）
+	// 2xx 以外のステータスもエラーとして返す
+	Send(ctx context.Context, request domain.WebhookRequest) (int, error)
 }
@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// RequestEmailVerificationUsecase メール確認メール再送ユースケース
+type RequestEmailVerificationUsecase struct {
+	userQuery UserQueryRepository
+	txManager TransactionManager
+}
+
+// NewRequestEmailVerificationUsecase RequestEmailVerificationUsecaseのコンストラクタ
+func NewRequestEmailVerificationUsecase(
+	userQuery UserQueryRepository,
+	txManager TransactionManager,
+) *RequestEmailVerificationUsecase {
+	return &RequestEmailVerificationUsecase{
+		userQuery: userQuery,
+		txManager: txManager,
+	}
+}
+
+// Execute メール確認メールの送信ジョブを登録する（確認済みの場合は何もしない）
+func (u *RequestEmailVerificationUsecase) Execute(ctx context.Context, userID string) error {
+	log := logger.FromContext(ctx)
+	log.Info("requesting email verification", slog.String("user_id", userID))
+
+	// 権限の確認（本人は権限がなくても可）
+	if err := domain.AuthorizeSelfOr(domain.PrincipalFromContext(ctx), userID, domain.PermissionUsersUpdate); err != nil {
+		return err
+	}
+
+	user, err := u.userQuery.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return domain.ErrUserNotFound(userID)
+	}
+	if user.EmailVerified() {
+		return nil
+	}
+
+	return u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		return enqueueUserTokenEmail(ctx, tx, domain.UserTokenPurposeEmailVerification, user.ID)
+	})
+}
@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// RequestPasswordResetUsecase パスワード再設定メール送信ユースケース
+type RequestPasswordResetUsecase struct {
+	userQuery UserQueryRepository
+	txManager TransactionManager
+}
+
+// NewRequestPasswordResetUsecase RequestPasswordResetUsecaseのコンストラクタ
+func NewRequestPasswordResetUsecase(
+	userQuery UserQueryRepository,
+	txManager TransactionManager,
+) *RequestPasswordResetUsecase {
+	return &RequestPasswordResetUsecase{
+		userQuery: userQuery,
+		txManager: txManager,
+	}
+}
+
+// Execute パスワード再設定メールの送信ジョブを登録する
+// 登録済みかどうかを推測されないよう、存在しないメールアドレスでもエラーを返さない
+func (u *RequestPasswordResetUsecase) Execute(ctx context.Context, email string) error {
+	log := logger.FromContext(ctx)
+	log.Info("requesting password reset", slog.String("email", email))
+
+	user, err := u.userQuery.FindByEmail(ctx, email)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		log.Info("password reset requested for unknown email", slog.String("email", email))
+		return nil
+	}
+
+	return u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		return enqueueUserTokenEmail(ctx, tx, domain.UserTokenPurposePasswordReset, user.ID)
+	})
+}
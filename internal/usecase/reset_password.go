@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// ResetPasswordUsecase パスワード再設定ユースケース
+type ResetPasswordUsecase struct {
+	txManager     TransactionManager
+	eventSourcing command.UserEventSourcing
+}
+
+// NewResetPasswordUsecase ResetPasswordUsecaseのコンストラクタ
+func NewResetPasswordUsecase(
+	txManager TransactionManager,
+	eventSourcing command.UserEventSourcing,
+) *ResetPasswordUsecase {
+	return &ResetPasswordUsecase{
+		txManager:     txManager,
+		eventSourcing: eventSourcing,
+	}
+}
+
+// Execute メールで送付したトークンを検証し、新しいパスワードを設定する
+// すべてのセッションを失効させ、ログイン失敗によるロックも解除する
+// メールを受け取れたことで本人のアドレスと確認できるため、未確認のメールアドレスは確認済みにする
+func (u *ResetPasswordUsecase) Execute(ctx context.Context, token, newPassword string) error {
+	log := logger.FromContext(ctx)
+	log.Info("resetting password")
+
+	return u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		now := time.Now()
+		user, err := consumeUserToken(ctx, tx, u.eventSourcing, token, domain.UserTokenPurposePasswordReset, now)
+		if err != nil {
+			return err
+		}
+
+		// 以降はトークンの持ち主の組織をテナントとする
+		ctx = domain.WithTenant(ctx, user.OrganizationID)
+		if err := user.SetPassword(newPassword); err != nil {
+			return err
+		}
+		user.VerifyEmail(now)
+		if err := command.Save(ctx, tx, u.eventSourcing, user); err != nil {
+			return err
+		}
+		if err := command.RevokeUserSessions(ctx, tx, user.ID, "", now); err != nil {
+			return err
+		}
+		if err := command.DeleteLoginFailures(ctx, tx, user.Email); err != nil {
+			return err
+		}
+
+		// トークンの持ち主を操作の主体として監査イベントを記録（パスワードは記録しない）
+		ctx = domain.WithPrincipal(ctx, domain.NewUserPrincipal(user.ID).WithOrganization(user.OrganizationID))
+		return recordAuditEvent(ctx, tx, domain.AuditAggregateTypeUser, user.ID, "password_reset", nil)
+	})
+}
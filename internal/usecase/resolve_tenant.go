@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// ResolveTenantUsecase リクエストのテナント決定ユースケース
+type ResolveTenantUsecase struct {
+	organizationQuery OrganizationQueryRepository
+}
+
+// NewResolveTenantUsecase ResolveTenantUsecaseのコンストラクタ
+func NewResolveTenantUsecase(organizationQuery OrganizationQueryRepository) *ResolveTenantUsecase {
+	return &ResolveTenantUsecase{
+		organizationQuery: organizationQuery,
+	}
+}
+
+// Execute 操作の主体と指定された組織（空の場合は指定なし）からリクエストのテナントを決め、
+// テナントに紐づけた主体を返す（返す主体の OrganizationID がテナントとなる）
+//
+//   - 指定がない場合、または認証情報が紐づく組織（紐づかない場合は既定の組織）と同じ場合はその組織
+//   - 認証されていない呼び出しは任意の組織を指定できる（ログイン・パスワード再設定など）が、
+//     AUTH_ANONYMOUS_ROLES のロールは既定の組織でのみ付与し、その他の組織では権限を持たない
+//   - ユーザーはメンバーである組織を指定でき、その組織でのロールが付与される
+//   - APIキーなど、その他の主体は紐づく組織以外を指定できない
+func (u *ResolveTenantUsecase) Execute(ctx context.Context, organizationID string) (domain.Principal, error) {
+	principal := domain.PrincipalFromContext(ctx)
+	bound := principal.OrganizationID
+	if bound == "" {
+		bound = domain.DefaultOrganizationID
+	}
+	if organizationID == "" || organizationID == bound {
+		return principal.WithOrganization(bound), nil
+	}
+
+	log := logger.FromContext(ctx)
+	switch principal.Type {
+	case domain.PrincipalTypeAnonymous:
+		organization, err := u.organizationQuery.FindByID(ctx, organizationID)
+		if err != nil {
+			return domain.Principal{}, err
+		}
+		if organization == nil {
+			return domain.Principal{}, domain.ErrOrganizationNotFound(organizationID)
+		}
+		// 匿名のロールを他の組織に持ち込ませない（テナントの分離を迂回させない）
+		return principal.WithOrganization(organizationID).WithRoles(), nil
+	case domain.PrincipalTypeUser:
+		membership, err := u.organizationQuery.FindMembership(ctx, organizationID, principal.ID)
+		if err != nil {
+			return domain.Principal{}, err
+		}
+		if membership == nil {
+			log.Info("tenant access denied", slog.String("principal", principal.String()), slog.String("organization_id", organizationID))
+			return domain.Principal{}, domain.ErrNotOrganizationMember(principal, organizationID)
+		}
+		// 認証情報の組織で付与されたロールは引き継がない
+		return principal.WithOrganization(organizationID).WithRoles(membership.Roles...), nil
+	default:
+		log.Info("tenant access denied", slog.String("principal", principal.String()), slog.String("organization_id", organizationID))
+		return domain.Principal{}, domain.ErrNotOrganizationMember(principal, organizationID)
+	}
+}
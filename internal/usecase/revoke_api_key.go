@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// RevokeAPIKeyUsecase APIキー失効ユースケース
+type RevokeAPIKeyUsecase struct {
+	txManager TransactionManager
+}
+
+// NewRevokeAPIKeyUsecase RevokeAPIKeyUsecaseのコンストラクタ
+func NewRevokeAPIKeyUsecase(txManager TransactionManager) *RevokeAPIKeyUsecase {
+	return &RevokeAPIKeyUsecase{
+		txManager: txManager,
+	}
+}
+
+// Execute APIキーを失効させる（失効済みの場合は何もしない）
+func (u *RevokeAPIKeyUsecase) Execute(ctx context.Context, id string) error {
+	log := logger.FromContext(ctx)
+	log.Info("revoking api key", slog.String("api_key_id", id))
+
+	// 権限の確認
+	if err := domain.Authorize(domain.PrincipalFromContext(ctx), domain.PermissionAPIKeysManage); err != nil {
+		return err
+	}
+
+	return u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		// 行ロック付きで存在確認
+		apiKey, err := command.FindAPIKeyByIDForUpdate(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if apiKey == nil {
+			return domain.ErrAPIKeyNotFound(id)
+		}
+		if apiKey.RevokedAt != nil {
+			return nil
+		}
+
+		apiKey.Revoke(time.Now())
+		if err := command.RevokeAPIKey(ctx, tx, apiKey.ID, *apiKey.RevokedAt); err != nil {
+			return err
+		}
+
+		// 監査イベントを記録
+		return recordAuditEvent(ctx, tx, domain.AuditAggregateTypeAPIKey, apiKey.ID, "revoked", apiKeyAuditPayload(apiKey))
+	})
+}
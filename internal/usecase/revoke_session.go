@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// RevokeSessionUsecase セッション失効ユースケース
+type RevokeSessionUsecase struct {
+	txManager TransactionManager
+}
+
+// NewRevokeSessionUsecase RevokeSessionUsecaseのコンストラクタ
+func NewRevokeSessionUsecase(txManager TransactionManager) *RevokeSessionUsecase {
+	return &RevokeSessionUsecase{
+		txManager: txManager,
+	}
+}
+
+// Execute セッションを失効させる（失効済みの場合は何もしない）
+// 本人のセッションのほか、users:update 権限があれば他のユーザーのセッションも失効できる
+func (u *RevokeSessionUsecase) Execute(ctx context.Context, id string) error {
+	log := logger.FromContext(ctx)
+	log.Info("revoking session", slog.String("session_id", id))
+
+	return u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		// 行ロック付きで存在確認
+		session, err := command.FindSessionByIDForUpdate(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if session == nil {
+			return domain.ErrSessionNotFound(id)
+		}
+
+		// 権限の確認（他人のセッションの存在は明かさない）
+		if err := domain.AuthorizeSelfOr(domain.PrincipalFromContext(ctx), session.UserID, domain.PermissionUsersUpdate); err != nil {
+			return domain.ErrSessionNotFound(id)
+		}
+
+		return revokeSession(ctx, tx, session, "revoked")
+	})
+}
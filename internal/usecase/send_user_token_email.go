@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// SendUserTokenEmailUsecase トークン付きメール送信ユースケース（ワーカーから実行する）
+type SendUserTokenEmailUsecase struct {
+	txManager     TransactionManager
+	eventSourcing command.UserEventSourcing
+	mailer        Mailer
+	// baseURL メールに記載するリンクのベースURL（フロントエンドのURL）
+	baseURL string
+}
+
+// NewSendUserTokenEmailUsecase SendUserTokenEmailUsecaseのコンストラクタ
+func NewSendUserTokenEmailUsecase(
+	txManager TransactionManager,
+	eventSourcing command.UserEventSourcing,
+	mailer Mailer,
+	baseURL string,
+) *SendUserTokenEmailUsecase {
+	return &SendUserTokenEmailUsecase{
+		txManager:     txManager,
+		eventSourcing: eventSourcing,
+		mailer:        mailer,
+		baseURL:       baseURL,
+	}
+}
+
+// Execute トークンを発行してメールで送信する
+// 以前に発行した同じ用途のトークンは無効にするため、再試行で送り直した場合も最後のメールのリンクだけが有効になる
+func (u *SendUserTokenEmailUsecase) Execute(ctx context.Context, userID string, purpose domain.UserTokenPurpose) error {
+	log := logger.FromContext(ctx)
+	log.Info("sending user token email", slog.String("user_id", userID), slog.String("purpose", string(purpose)))
+
+	// 権限の確認
+	if err := domain.Authorize(domain.PrincipalFromContext(ctx), domain.PermissionUsersUpdate); err != nil {
+		return err
+	}
+
+	var (
+		user  *domain.User
+		token string
+	)
+	err := u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		var err error
+		user, err = command.FindByIDForUpdate(ctx, tx, u.eventSourcing, userID)
+		if err != nil {
+			return err
+		}
+		// ジョブの登録後に削除・確認済みになった場合と、配信できないと判定されたアドレスには送信しない
+		if user == nil || user.EmailInvalid() || (purpose == domain.UserTokenPurposeEmailVerification && user.EmailVerified()) {
+			user = nil
+			return nil
+		}
+
+		if err := command.InvalidateUserTokens(ctx, tx, user.ID, purpose, time.Now()); err != nil {
+			return err
+		}
+		var userToken *domain.UserToken
+		userToken, token, err = domain.NewUserToken(user, purpose)
+		if err != nil {
+			return err
+		}
+		return command.SaveUserToken(ctx, tx, userToken)
+	})
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		log.Info("user token email skipped", slog.String("user_id", userID), slog.String("purpose", string(purpose)))
+		return nil
+	}
+
+	return u.mailer.Send(ctx, userTokenEmail(purpose, user, u.baseURL, token))
+}
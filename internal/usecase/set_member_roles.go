@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// SetMemberRolesUsecase 組織のメンバー追加・ロール変更ユースケース
+type SetMemberRolesUsecase struct {
+	txManager TransactionManager
+}
+
+// NewSetMemberRolesUsecase SetMemberRolesUsecaseのコンストラクタ
+func NewSetMemberRolesUsecase(txManager TransactionManager) *SetMemberRolesUsecase {
+	return &SetMemberRolesUsecase{
+		txManager: txManager,
+	}
+}
+
+// Execute リクエストのテナントにユーザーをメンバーとして加え、ロールを置き換える（メンバーの場合はロールのみ変更）
+// userID は外部のIDプロバイダーで認証されるユーザー（JWT の sub）も指定できるため、ユーザーの存在は確認しない
+func (u *SetMemberRolesUsecase) Execute(ctx context.Context, userID string, roles []domain.Role) (*domain.Membership, error) {
+	log := logger.FromContext(ctx)
+	log.Info("setting member roles", slog.String("user_id", userID), slog.Any("roles", domain.RoleNames(roles)))
+
+	// 権限の確認
+	if err := domain.Authorize(domain.PrincipalFromContext(ctx), domain.PermissionMembersManage); err != nil {
+		return nil, err
+	}
+
+	var membership *domain.Membership
+	err := u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		organizationID, err := domain.RequireTenant(ctx)
+		if err != nil {
+			return err
+		}
+
+		// 行ロック付きで既存のメンバーシップを取得
+		membership, err = command.FindMembershipForUpdate(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+		action := "member_updated"
+		if membership == nil {
+			membership = domain.NewMembership(organizationID, userID, roles)
+			action = "member_added"
+		} else {
+			membership.SetRoles(roles)
+		}
+
+		if err := command.SaveMembership(ctx, tx, membership); err != nil {
+			return err
+		}
+
+		// 監査イベントを記録
+		return recordAuditEvent(ctx, tx, domain.AuditAggregateTypeOrganization, organizationID, action, membershipAuditPayload(membership))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return membership, nil
+}
@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// changeStreamBatchSize 1回に読む変更通知の件数
+const changeStreamBatchSize = 100
+
+// ChangeStream 組織の変更通知の購読
+// Wake が値を受け取るたびに、Next が空を返すまで読み進める
+// 確定していない変更通知が残っている間（Pending）は、通知がなくても少し待って読み直す
+type ChangeStream struct {
+	// Resync 再開位置より後の変更通知が保持期間を過ぎて削除されている（クライアントは表示中のデータを読み直す必要がある）
+	Resync bool
+	// Wake 組織に変更があると値を受け取る（サーバーの停止時に閉じられる）
+	Wake <-chan struct{}
+
+	changeQuery ChangeQueryRepository
+	cursor      int64
+	pending     bool
+	unsubscribe func()
+}
+
+// Next 前回読んだ変更通知より後の確定した変更通知を古い順に取得（ない場合は空）
+// 確定していない変更通知とその後の変更通知は返さず、再開位置もその手前で止める
+func (s *ChangeStream) Next(ctx context.Context) ([]*domain.Change, error) {
+	changes, err := s.changeQuery.FindAfter(ctx, s.cursor, changeStreamBatchSize)
+	if err != nil {
+		return nil, err
+	}
+	changes, s.pending = domain.SettledChanges(changes)
+	if len(changes) > 0 {
+		s.cursor = changes[len(changes)-1].ID
+	}
+	return changes, nil
+}
+
+// Pending 最後の Next で、確定していない変更通知を読まずに残した
+func (s *ChangeStream) Pending() bool {
+	return s.pending
+}
+
+// Cursor 最後に読んだ（または再開位置の）変更通知のID
+func (s *ChangeStream) Cursor() int64 {
+	return s.cursor
+}
+
+// Close 購読を解除する
+func (s *ChangeStream) Close() {
+	s.unsubscribe()
+}
+
+// StreamChangesUsecase 変更通知の購読ユースケース
+type StreamChangesUsecase struct {
+	changeQuery ChangeQueryRepository
+	notifier    ChangeNotifier
+}
+
+// NewStreamChangesUsecase StreamChangesUsecaseのコンストラクタ
+func NewStreamChangesUsecase(changeQuery ChangeQueryRepository, notifier ChangeNotifier) *StreamChangesUsecase {
+	return &StreamChangesUsecase{
+		changeQuery: changeQuery,
+		notifier:    notifier,
+	}
+}
+
+// Execute コンテキストのテナントの変更通知を購読する（不要になったら Close を呼び出す）
+// lastEventID を指定した場合はその後の変更通知から、省略した場合は購読を始めた後の変更通知から読む
+func (u *StreamChangesUsecase) Execute(ctx context.Context, lastEventID *int64) (*ChangeStream, error) {
+	log := logger.FromContext(ctx)
+
+	// 権限の確認
+	if err := domain.Authorize(domain.PrincipalFromContext(ctx), domain.PermissionUsersRead); err != nil {
+		return nil, err
+	}
+
+	organizationID, err := domain.RequireTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 再開位置を決める前に購読し、その間に保存された変更通知を取りこぼさないようにする
+	wake, unsubscribe := u.notifier.Subscribe(organizationID)
+	stream := &ChangeStream{Wake: wake, changeQuery: u.changeQuery, unsubscribe: unsubscribe}
+
+	if lastEventID != nil {
+		oldestID, err := u.changeQuery.OldestID(ctx)
+		if err != nil {
+			stream.Close()
+			return nil, err
+		}
+		// 保持している変更通知がすべて再開位置より後の場合は、間の変更通知が削除されている可能性がある
+		stream.Resync = (oldestID == 0 && *lastEventID > 0) || *lastEventID < oldestID-1
+		stream.cursor = *lastEventID
+	}
+	if lastEventID == nil || stream.Resync {
+		latestID, err := u.changeQuery.LatestID(ctx)
+		if err != nil {
+			stream.Close()
+			return nil, err
+		}
+		stream.cursor = latestID
+	}
+
+	log.Info("streaming changes", slog.Int64("cursor", stream.cursor), slog.Bool("resync", stream.Resync))
+	return stream, nil
+}
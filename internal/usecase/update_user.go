@@ -12,18 +12,27 @@ import (
 
 // UpdateUserUsecase ユーザー更新ユースケース
 type UpdateUserUsecase struct {
-	userQuery UserQueryRepository
-	txManager TransactionManager
+	userQuery          UserQueryRepository
+	txManager          TransactionManager
+	eventSourcing      command.UserEventSourcing
+	userLogHashKey     domain.UserLogHashKey
+	emailNormalization domain.EmailNormalization
 }
 
 // NewUpdateUserUsecase UpdateUserUsecaseのコンストラクタ
 func NewUpdateUserUsecase(
 	userQuery UserQueryRepository,
 	txManager TransactionManager,
+	eventSourcing command.UserEventSourcing,
+	userLogHashKey domain.UserLogHashKey,
+	emailNormalization domain.EmailNormalization,
 ) *UpdateUserUsecase {
 	return &UpdateUserUsecase{
-		userQuery: userQuery,
-		txManager: txManager,
+		userQuery:          userQuery,
+		txManager:          txManager,
+		eventSourcing:      eventSourcing,
+		userLogHashKey:     userLogHashKey,
+		emailNormalization: emailNormalization,
 	}
 }
 
@@ -32,9 +41,14 @@ func (u *UpdateUserUsecase) Execute(ctx context.Context, id, name, email string)
 	log := logger.FromContext(ctx)
 	log.Info("updating user", slog.String("user_id", id))
 
+	// 権限の確認（本人は権限がなくても可）
+	if err := domain.AuthorizeSelfOr(domain.PrincipalFromContext(ctx), id, domain.PermissionUsersUpdate); err != nil {
+		return err
+	}
+
 	return u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
 		// 行ロック付きでユーザーを取得
-		user, err := command.FindByIDForUpdate(ctx, tx, id)
+		user, err := command.FindByIDForUpdate(ctx, tx, u.eventSourcing, id)
 		if err != nil {
 			return err
 		}
@@ -42,9 +56,13 @@ func (u *UpdateUserUsecase) Execute(ctx context.Context, id, name, email string)
 			return domain.ErrUserNotFound(id)
 		}
 
-		// メールアドレスが変更される場合、重複チェック（ロック付き）
-		if email != "" && email != user.Email {
-			existingUser, err := command.FindByEmailForUpdate(ctx, tx, email)
+		// メールアドレスが変更される場合、重複チェック（ロック付き、大文字小文字を区別しない）
+		if email != "" {
+			email = u.emailNormalization.Normalize(email)
+		}
+		emailChanged := email != "" && !domain.SameEmail(email, user.Email)
+		if emailChanged {
+			existingUser, err := command.FindByEmailForUpdate(ctx, tx, u.eventSourcing, email)
 			if err != nil {
 				return err
 			}
@@ -54,11 +72,34 @@ func (u *UpdateUserUsecase) Execute(ctx context.Context, id, name, email string)
 		}
 
 		// ドメインモデルの更新
+		before := *user
 		if err := user.Update(name, email); err != nil {
 			return err
 		}
 
 		// 永続化
-		return command.Save(ctx, tx, user)
+		if err := command.Save(ctx, tx, u.eventSourcing, user); err != nil {
+			return err
+		}
+
+		// 新しいメールアドレスの確認メールを送信する（変更前の確認は Update で取り消される）
+		if emailChanged {
+			if err := enqueueUserTokenEmail(ctx, tx, domain.UserTokenPurposeEmailVerification, user.ID); err != nil {
+				return err
+			}
+		}
+
+		// 変更された項目がある場合はユーザー更新ログを保存
+		changes := domain.DiffUsers(&before, user)
+		if len(changes) == 0 {
+			return nil
+		}
+		userLog := attributeUserLog(ctx, domain.NewUserUpdatedLog(user.ID, changes))
+		if err := command.SaveUserLog(ctx, tx, u.userLogHashKey, userLog); err != nil {
+			return err
+		}
+
+		// 監査イベントを記録
+		return recordAuditEvent(ctx, tx, domain.AuditAggregateTypeUser, user.ID, string(domain.UserLogActionUpdated), map[string]any{"changes": changes})
 	})
 }
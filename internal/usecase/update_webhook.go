@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// UpdateWebhookUsecase Webhook更新ユースケース
+type UpdateWebhookUsecase struct {
+	txManager TransactionManager
+}
+
+// NewUpdateWebhookUsecase UpdateWebhookUsecaseのコンストラクタ
+func NewUpdateWebhookUsecase(txManager TransactionManager) *UpdateWebhookUsecase {
+	return &UpdateWebhookUsecase{
+		txManager: txManager,
+	}
+}
+
+// Execute WebhookのURL・イベントの種類・有効かどうかを更新する（nil の項目は変更しない）
+// 自動で無効にされたWebhookは active に true を指定して有効に戻す
+func (u *UpdateWebhookUsecase) Execute(ctx context.Context, id string, url *string, eventTypes *[]string, active *bool) (*domain.WebhookSubscription, error) {
+	log := logger.FromContext(ctx)
+	log.Info("updating webhook", slog.String("webhook_id", id))
+
+	// 権限の確認
+	if err := domain.Authorize(domain.PrincipalFromContext(ctx), domain.PermissionWebhooksManage); err != nil {
+		return nil, err
+	}
+
+	var subscription *domain.WebhookSubscription
+	err := u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		// 行ロック付きで存在確認
+		var err error
+		subscription, err = command.FindWebhookSubscriptionByIDForUpdate(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if subscription == nil {
+			return domain.ErrWebhookNotFound(id)
+		}
+
+		var newURL string
+		if url != nil {
+			// 空文字列は「変更しない」と区別できないため、不正なURLとして扱う
+			if *url == "" {
+				return domain.ErrWebhookURLInvalid()
+			}
+			newURL = *url
+		}
+		var newEventTypes []string
+		if eventTypes != nil {
+			newEventTypes = append([]string{}, *eventTypes...)
+		}
+		if err := subscription.Update(newURL, newEventTypes, active, time.Now()); err != nil {
+			return err
+		}
+		if err := command.UpdateWebhookSubscription(ctx, tx, subscription); err != nil {
+			return err
+		}
+
+		// 監査イベントを記録
+		return recordAuditEvent(ctx, tx, domain.AuditAggregateTypeWebhook, subscription.ID, "updated", webhookAuditPayload(subscription))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
@@ -0,0 +1,13 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// attributeUserLog コンテキストの操作主体とリクエストIDをユーザーログに記録する
+func attributeUserLog(ctx context.Context, userLog *domain.UserLog) *domain.UserLog {
+	return userLog.AttributeTo(domain.PrincipalFromContext(ctx), logger.GetRequestID(ctx))
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+const (
+	// SendEmailVerificationJobType メール確認メール送信ジョブの種類
+	SendEmailVerificationJobType = "send_email_verification"
+	// SendPasswordResetJobType パスワード再設定メール送信ジョブの種類
+	SendPasswordResetJobType = "send_password_reset"
+)
+
+// userTokenEmailJobMaxAttempts メール送信ジョブの最大試行回数
+const userTokenEmailJobMaxAttempts = 5
+
+// SendUserTokenEmailPayload メール送信ジョブのペイロード
+// トークンはワーカーが送信時に発行するため、平文のトークンはジョブに保存しない
+type SendUserTokenEmailPayload struct {
+	UserID string `json:"user_id"`
+}
+
+// enqueueUserTokenEmail トークン付きメールの送信ジョブを登録する（RunInTransaction 内で使用）
+func enqueueUserTokenEmail(ctx context.Context, tx infrastructure.DBTX, purpose domain.UserTokenPurpose, userID string) error {
+	payload, err := json.Marshal(SendUserTokenEmailPayload{UserID: userID})
+	if err != nil {
+		return err
+	}
+	job := domain.NewJob(userTokenEmailJobType(purpose), payload, userTokenEmailJobMaxAttempts)
+	return command.EnqueueJob(ctx, tx, job)
+}
+
+// userTokenEmailJobType 用途に対応するジョブの種類
+func userTokenEmailJobType(purpose domain.UserTokenPurpose) string {
+	if purpose == domain.UserTokenPurposePasswordReset {
+		return SendPasswordResetJobType
+	}
+	return SendEmailVerificationJobType
+}
+
+// userTokenEmail トークンのリンクを記載したメールを作成する
+func userTokenEmail(purpose domain.UserTokenPurpose, user *domain.User, baseURL, token string) domain.EmailMessage {
+	baseURL = strings.TrimRight(baseURL, "/")
+	if purpose == domain.UserTokenPurposePasswordReset {
+		link := baseURL + "/reset-password?token=" + url.QueryEscape(token)
+		return domain.EmailMessage{
+			To:      user.Email,
+			Subject: "パスワードの再設定",
+			Body: fmt.Sprintf("%s 様\n\n"+
+				"パスワードの再設定を受け付けました。以下のリンクから新しいパスワードを設定してください。\n\n%s\n\n"+
+				"このリンクの有効期限は%d分です。お心当たりのない場合は、このメールを破棄してください。\n",
+				user.Name, link, int(domain.PasswordResetTokenTTL.Minutes())),
+		}
+	}
+
+	link := baseURL + "/verify-email?token=" + url.QueryEscape(token)
+	return domain.EmailMessage{
+		To:      user.Email,
+		Subject: "メールアドレスの確認",
+		Body: fmt.Sprintf("%s 様\n\n"+
+			"以下のリンクからメールアドレスの確認を完了してください。\n\n%s\n\n"+
+			"このリンクの有効期限は%d時間です。お心当たりのない場合は、このメールを破棄してください。\n",
+			user.Name, link, int(domain.EmailVerificationTokenTTL.Hours())),
+	}
+}
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// VerifyEmailUsecase メールアドレス確認ユースケース
+type VerifyEmailUsecase struct {
+	txManager     TransactionManager
+	eventSourcing command.UserEventSourcing
+}
+
+// NewVerifyEmailUsecase VerifyEmailUsecaseのコンストラクタ
+func NewVerifyEmailUsecase(txManager TransactionManager, eventSourcing command.UserEventSourcing) *VerifyEmailUsecase {
+	return &VerifyEmailUsecase{
+		txManager:     txManager,
+		eventSourcing: eventSourcing,
+	}
+}
+
+// Execute メールで送付したトークンを検証し、メールアドレスを確認済みにする
+// トークン自体が本人確認となるため、ログインしていなくても実行できる
+func (u *VerifyEmailUsecase) Execute(ctx context.Context, token string) error {
+	log := logger.FromContext(ctx)
+	log.Info("verifying email")
+
+	return u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		now := time.Now()
+		user, err := consumeUserToken(ctx, tx, u.eventSourcing, token, domain.UserTokenPurposeEmailVerification, now)
+		if err != nil {
+			return err
+		}
+
+		// 以降はトークンの持ち主の組織をテナントとする
+		ctx = domain.WithTenant(ctx, user.OrganizationID)
+		user.VerifyEmail(now)
+		if err := command.Save(ctx, tx, u.eventSourcing, user); err != nil {
+			return err
+		}
+
+		// トークンの持ち主を操作の主体として監査イベントを記録
+		ctx = domain.WithPrincipal(ctx, domain.NewUserPrincipal(user.ID).WithOrganization(user.OrganizationID))
+		return recordAuditEvent(ctx, tx, domain.AuditAggregateTypeUser, user.ID, "email_verified", map[string]any{"email": user.Email})
+	})
+}
+
+// consumeUserToken トークンを検証して使用済みにし、トークンの持ち主を返す（RunInTransaction 内で使用）
+// 存在しない・使用済み・期限切れ・発行後にメールアドレスが変更されたトークンはすべて ErrUserTokenInvalid とする
+// トークンはリクエストのテナントに関わらず、トークンを発行した組織（User.OrganizationID）のユーザーを対象とする
+func consumeUserToken(ctx context.Context, tx infrastructure.DBTX, eventSourcing command.UserEventSourcing, token string, purpose domain.UserTokenPurpose, now time.Time) (*domain.User, error) {
+	userToken, err := command.FindUserTokenByHashForUpdate(ctx, tx, domain.HashUserToken(token))
+	if err != nil {
+		return nil, err
+	}
+	if userToken == nil || !userToken.Usable(purpose, now) {
+		return nil, domain.ErrUserTokenInvalid()
+	}
+	ctx = domain.WithTenant(ctx, userToken.OrganizationID)
+
+	user, err := command.FindByIDForUpdate(ctx, tx, eventSourcing, userToken.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil || !userToken.IssuedFor(user) {
+		return nil, domain.ErrUserTokenInvalid()
+	}
+
+	// 使用したトークンと同じ用途の未使用トークンをまとめて無効にする
+	if err := command.InvalidateUserTokens(ctx, tx, user.ID, purpose, now); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+	"github.com/example/go-react-cqrs-template/internal/pkg/logger"
+)
+
+// VerifyUserLogChainUsecase ユーザーログのハッシュチェーン検証ユースケース
+type VerifyUserLogChainUsecase struct {
+	txManager      TransactionManager
+	userLogHashKey domain.UserLogHashKey
+}
+
+// NewVerifyUserLogChainUsecase VerifyUserLogChainUsecaseのコンストラクタ
+func NewVerifyUserLogChainUsecase(txManager TransactionManager, userLogHashKey domain.UserLogHashKey) *VerifyUserLogChainUsecase {
+	return &VerifyUserLogChainUsecase{
+		txManager:      txManager,
+		userLogHashKey: userLogHashKey,
+	}
+}
+
+// Execute ユーザーログが改ざん・削除されていないかハッシュチェーンをたどって検証する
+func (u *VerifyUserLogChainUsecase) Execute(ctx context.Context) (*domain.UserLogChainVerification, error) {
+	log := logger.FromContext(ctx)
+	log.Info("verifying user log chain")
+
+	// 権限の確認
+	if err := domain.Authorize(domain.PrincipalFromContext(ctx), domain.PermissionAuditRead); err != nil {
+		return nil, err
+	}
+
+	var result *domain.UserLogChainVerification
+	err := u.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		var err error
+		result, err = command.VerifyUserLogChain(ctx, tx, u.userLogHashKey)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !result.Valid() {
+		log.Warn("user log chain is broken",
+			slog.Int64("seq", result.Break.Seq),
+			slog.String("log_id", result.Break.LogID),
+			slog.String("reason", string(result.Break.Reason)),
+		)
+	}
+	log.Info("user log chain verified",
+		slog.Int("checked", result.CheckedCount),
+		slog.Int64("head_seq", result.Head.Seq),
+		slog.Bool("valid", result.Valid()),
+	)
+	return result, nil
+}
@@ -12,14 +12,17 @@ type Config struct {
 	MaxConcurrency int
 	// ShutdownTimeout グレースフルシャットダウンのタイムアウト
 	ShutdownTimeout time.Duration
+	// LoginFailureRetention ログイン失敗の記録を保持する時間（サーバーのロックの時間と同じにする。0の場合は削除しない）
+	LoginFailureRetention time.Duration
 }
 
 // DefaultConfig デフォルト設定を返す
 func DefaultConfig() Config {
 	return Config{
-		PollInterval:    5 * time.Second,
-		BatchSize:       10,
-		MaxConcurrency:  5,
-		ShutdownTimeout: 30 * time.Second,
+		PollInterval:          5 * time.Second,
+		BatchSize:             10,
+		MaxConcurrency:        5,
+		ShutdownTimeout:       30 * time.Second,
+		LoginFailureRetention: 15 * time.Minute,
 	}
 }
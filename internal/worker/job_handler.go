@@ -19,15 +19,18 @@ func (f JobHandlerFunc) Handle(ctx context.Context, payload json.RawMessage) err
 	return f(ctx, payload)
 }
 
-// Registry ジョブハンドラーの登録と取得
+// Registry ジョブハンドラー・アウトボックスのイベントの購読者・投影の登録と取得
 type Registry struct {
-	handlers map[string]JobHandler
+	handlers    map[string]JobHandler
+	subscribers map[string][]EventSubscriber
+	projections []Projection
 }
 
 // NewRegistry Registryのコンストラクタ
 func NewRegistry() *Registry {
 	return &Registry{
-		handlers: make(map[string]JobHandler),
+		handlers:    make(map[string]JobHandler),
+		subscribers: make(map[string][]EventSubscriber),
 	}
 }
 
@@ -0,0 +1,31 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+// deleteStaleLoginFailures ロックの時間が経過したログイン失敗の記録を削除する
+// ログインは最後の失敗からロックの時間が経過した記録を数え直すため、削除しても結果は変わらない
+func (w *Worker) deleteStaleLoginFailures(ctx context.Context) {
+	if w.config.LoginFailureRetention <= 0 {
+		return
+	}
+	err := w.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		deleted, err := command.DeleteStaleLoginFailures(ctx, tx, time.Now().Add(-w.config.LoginFailureRetention))
+		if err != nil {
+			return err
+		}
+		if deleted > 0 {
+			w.logger.Info("deleted stale login failures", slog.Int64("deleted", deleted))
+		}
+		return nil
+	})
+	if err != nil && ctx.Err() == nil {
+		w.logger.Error("failed to delete stale login failures", slog.String("error", err.Error()))
+	}
+}
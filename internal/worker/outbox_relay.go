@@ -0,0 +1,112 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+// EventSubscriber アウトボックスのドメインイベントの永続的な購読者のインターフェース
+// 配信に失敗したイベントは次のポーリングで再配信され、同じイベントが複数回届くことがあるため冪等に実装する
+type EventSubscriber interface {
+	Handle(ctx context.Context, event *domain.OutboxEvent) error
+}
+
+// EventSubscriberFunc 関数型のEventSubscriber
+type EventSubscriberFunc func(ctx context.Context, event *domain.OutboxEvent) error
+
+// Handle EventSubscriberインターフェースを実装
+func (f EventSubscriberFunc) Handle(ctx context.Context, event *domain.OutboxEvent) error {
+	return f(ctx, event)
+}
+
+// Subscribe 指定した種類のドメインイベントの購読者を登録（種類を省略した場合はすべてのイベントを購読する）
+func (r *Registry) Subscribe(subscriber EventSubscriber, eventTypes ...string) {
+	if len(eventTypes) == 0 {
+		eventTypes = []string{""}
+	}
+	for _, eventType := range eventTypes {
+		r.subscribers[eventType] = append(r.subscribers[eventType], subscriber)
+	}
+}
+
+// SubscribeFunc 関数型の購読者を登録
+func (r *Registry) SubscribeFunc(fn func(ctx context.Context, event *domain.OutboxEvent) error, eventTypes ...string) {
+	r.Subscribe(EventSubscriberFunc(fn), eventTypes...)
+}
+
+// Subscribers ドメインイベントの種類に対応する購読者を取得
+func (r *Registry) Subscribers(eventType string) []EventSubscriber {
+	subscribers := append([]EventSubscriber{}, r.subscribers[""]...)
+	return append(subscribers, r.subscribers[eventType]...)
+}
+
+// relayOutbox 配信されていないアウトボックスのイベントを記録順に購読者へ配信する
+// 配信に失敗したイベントは失敗を記録して次のポーリングで再配信し、同じ集約の後続のイベントはそれまで配信しない
+func (w *Worker) relayOutbox(ctx context.Context) {
+	err := w.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		events, err := command.FetchPendingOutboxEvents(ctx, tx, w.config.BatchSize)
+		if err != nil {
+			return err
+		}
+
+		blocked := make(map[string]bool)
+		for _, event := range events {
+			aggregateKey := string(event.AggregateType) + ":" + event.AggregateID
+			if blocked[aggregateKey] {
+				continue
+			}
+
+			if err := w.deliverEvent(ctx, event); err != nil {
+				blocked[aggregateKey] = true
+				if err := command.MarkOutboxEventFailed(ctx, tx, event.ID, err.Error()); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := command.MarkOutboxEventPublished(ctx, tx, event.ID, time.Now()); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		if ctx.Err() != nil {
+			return // コンテキストキャンセル時はエラーではない
+		}
+		w.logger.Error("failed to relay outbox events",
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// deliverEvent 1つのイベントをすべての購読者へ配信する
+func (w *Worker) deliverEvent(ctx context.Context, event *domain.OutboxEvent) error {
+	eventLogger := w.logger.With(
+		slog.String("event_id", event.ID),
+		slog.String("event_type", event.EventType),
+		slog.String("aggregate_id", event.AggregateID),
+		slog.String("organization_id", event.OrganizationID),
+	)
+
+	// 購読者の操作はイベントの種類をシステムの主体として記録し、イベントが発生した組織のデータに限定する
+	handlerCtx := domain.WithPrincipal(ctx, domain.NewSystemPrincipal(event.EventType).WithOrganization(event.OrganizationID))
+	handlerCtx = domain.WithTenant(handlerCtx, event.OrganizationID)
+	for _, subscriber := range w.registry.Subscribers(event.EventType) {
+		if err := subscriber.Handle(handlerCtx, event); err != nil {
+			eventLogger.Error("event delivery failed",
+				slog.String("error", err.Error()),
+				slog.Int("attempts", event.Attempts+1),
+			)
+			return err
+		}
+	}
+
+	eventLogger.Debug("event delivered")
+	return nil
+}
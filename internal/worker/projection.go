@@ -0,0 +1,115 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+// Projection 読み取りモデルの投影のインターフェース
+// ワーカーがアウトボックスのイベントを記録順に読み進め、チェックポイントと同じトランザクションで読み取り用のテーブルを更新する
+// 失敗したバッチは次のポーリングで再適用されるため、Apply は冪等に実装する
+type Projection interface {
+	// Name 投影の名前（チェックポイントのキー）
+	Name() string
+	// Apply 1つのイベントを読み取りモデルに反映する（関係のない種類のイベントは無視する）
+	Apply(ctx context.Context, tx infrastructure.DBTX, event *domain.OutboxEvent) error
+	// Reset 読み取りモデルを初期状態に戻す（初回と作り直しの際に、アウトボックスの先頭から読み直す前に呼ばれる）
+	Reset(ctx context.Context, tx infrastructure.DBTX) error
+}
+
+// RegisterProjection 投影を登録
+func (r *Registry) RegisterProjection(projection Projection) {
+	r.projections = append(r.projections, projection)
+}
+
+// Projections 登録されている投影を取得
+func (r *Registry) Projections() []Projection {
+	return r.projections
+}
+
+// RebuildProjection 投影の読み取りモデルを初期状態に戻し、次のポーリングでアウトボックスの先頭から読み直す
+func (w *Worker) RebuildProjection(ctx context.Context, name string) error {
+	for _, projection := range w.registry.Projections() {
+		if projection.Name() != name {
+			continue
+		}
+		return w.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+			if _, _, err := command.LockProjectionCheckpoint(ctx, tx, name); err != nil {
+				return err
+			}
+			if err := projection.Reset(ctx, tx); err != nil {
+				return fmt.Errorf("failed to reset projection %s: %w", name, err)
+			}
+			return command.UpdateProjectionCheckpoint(ctx, tx, name, command.ProjectionCheckpoint{})
+		})
+	}
+	return fmt.Errorf("no projection registered: %s", name)
+}
+
+// runProjections 登録されているすべての投影をアウトボックスの最新のイベントまで進める
+func (w *Worker) runProjections(ctx context.Context) {
+	for _, projection := range w.registry.Projections() {
+		for {
+			applied, err := w.advanceProjection(ctx, projection)
+			if err != nil {
+				if ctx.Err() != nil {
+					return // コンテキストキャンセル時はエラーではない
+				}
+				w.logger.Error("failed to advance projection",
+					slog.String("projection", projection.Name()),
+					slog.String("error", err.Error()),
+				)
+				break
+			}
+			if applied < w.config.BatchSize {
+				break
+			}
+		}
+	}
+}
+
+// advanceProjection 投影にチェックポイントの後のイベントを1バッチ分適用し、適用した件数を返す
+// 適用に失敗した場合はバッチ全体をロールバックし、次のポーリングで同じイベントから再適用する
+func (w *Worker) advanceProjection(ctx context.Context, projection Projection) (int, error) {
+	applied := 0
+	err := w.txManager.RunInTransaction(ctx, func(ctx context.Context, tx infrastructure.DBTX) error {
+		checkpoint, created, err := command.LockProjectionCheckpoint(ctx, tx, projection.Name())
+		if err != nil {
+			return err
+		}
+		if created {
+			w.logger.Info("initializing projection", slog.String("projection", projection.Name()))
+			if err := projection.Reset(ctx, tx); err != nil {
+				return fmt.Errorf("failed to reset projection: %w", err)
+			}
+		}
+
+		events, err := command.ListOutboxEventsAfter(ctx, tx, checkpoint, w.config.BatchSize)
+		if err != nil {
+			return err
+		}
+		if len(events) == 0 {
+			return nil
+		}
+
+		for _, event := range events {
+			// イベントが発生した組織のデータに限定する
+			eventCtx := domain.WithTenant(ctx, event.OrganizationID)
+			if err := projection.Apply(eventCtx, tx, event); err != nil {
+				return fmt.Errorf("failed to apply event %s (%s): %w", event.ID, event.EventType, err)
+			}
+		}
+
+		applied = len(events)
+		return command.UpdateProjectionCheckpoint(ctx, tx, projection.Name(), command.CheckpointOf(events[len(events)-1]))
+	})
+	if err != nil {
+		return 0, err
+	}
+	return applied, nil
+}
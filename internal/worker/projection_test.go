@@ -0,0 +1,459 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+const testOrganizationID = "01ARZ3NDEKTSV4RRFFQ69G5FO1"
+
+// fakeOutboxEvent アウトボックスのイベントと、記録したトランザクション
+type fakeOutboxEvent struct {
+	transactionID int64
+	id            string
+	aggregateType domain.AggregateType
+	aggregateID   string
+}
+
+// fakeProjectionState トランザクションでまとめて反映・破棄するデータ
+type fakeProjectionState struct {
+	// checkpoints 投影の名前ごとのチェックポイント
+	checkpoints map[string]command.ProjectionCheckpoint
+	// summaries 一覧の行があるユーザー（組織ID/ユーザーID）
+	summaries map[string]bool
+}
+
+func (s fakeProjectionState) clone() fakeProjectionState {
+	return fakeProjectionState{checkpoints: maps.Clone(s.checkpoints), summaries: maps.Clone(s.summaries)}
+}
+
+// fakeProjectionDB 投影が使うクエリだけを実装したメモリ上のデータベース
+// トランザクションは開始時の状態を複製し、コミットで反映・ロールバックで破棄する
+type fakeProjectionDB struct {
+	mu     sync.Mutex
+	events []fakeOutboxEvent
+	// oldestRunning 実行中のトランザクションのうち最も古いもののID（pg_snapshot_xmin、これ以降のイベントは読まない）
+	oldestRunning int64
+	// users 存在するユーザー（組織ID/ユーザーID）
+	users     map[string]bool
+	committed fakeProjectionState
+	// executed 実行されたクエリの名前
+	executed []string
+}
+
+func newFakeProjectionDB(t *testing.T) (*fakeProjectionDB, *infrastructure.TransactionManager) {
+	t.Helper()
+	fake := &fakeProjectionDB{
+		oldestRunning: 1000,
+		users:         map[string]bool{},
+		committed:     fakeProjectionState{checkpoints: map[string]command.ProjectionCheckpoint{}, summaries: map[string]bool{}},
+	}
+	db := sql.OpenDB(fake)
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = db.Close() })
+	return fake, infrastructure.NewTransactionManager(db)
+}
+
+func (f *fakeProjectionDB) set(fn func(f *fakeProjectionDB)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	fn(f)
+}
+
+func (f *fakeProjectionDB) checkpoint(name string) command.ProjectionCheckpoint {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.committed.checkpoints[name]
+}
+
+func (f *fakeProjectionDB) count(name string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	n := 0
+	for _, executed := range f.executed {
+		if executed == name {
+			n++
+		}
+	}
+	return n
+}
+
+func (f *fakeProjectionDB) Connect(context.Context) (driver.Conn, error) {
+	return &fakeProjectionConn{db: f}, nil
+}
+func (f *fakeProjectionDB) Driver() driver.Driver { return nil }
+
+type fakeProjectionConn struct {
+	db *fakeProjectionDB
+	// tx トランザクション中の状態（トランザクションの外では nil）
+	tx *fakeProjectionState
+}
+
+func (c *fakeProjectionConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepare is not supported")
+}
+func (c *fakeProjectionConn) Close() error { return nil }
+func (c *fakeProjectionConn) Begin() (driver.Tx, error) {
+	c.db.mu.Lock()
+	defer c.db.mu.Unlock()
+	state := c.db.committed.clone()
+	c.tx = &state
+	return c, nil
+}
+func (c *fakeProjectionConn) Commit() error {
+	c.db.mu.Lock()
+	defer c.db.mu.Unlock()
+	c.db.committed = *c.tx
+	c.tx = nil
+	return nil
+}
+func (c *fakeProjectionConn) Rollback() error {
+	c.tx = nil
+	return nil
+}
+
+func (c *fakeProjectionConn) ExecContext(_ context.Context, query string, named []driver.NamedValue) (driver.Result, error) {
+	f := c.db
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	name := strings.Fields(query)[2]
+	f.executed = append(f.executed, name)
+	args := values(named)
+
+	switch name {
+	case "InitProjectionCheckpoint":
+		if _, ok := c.tx.checkpoints[args[0].(string)]; ok {
+			return driver.RowsAffected(0), nil
+		}
+		c.tx.checkpoints[args[0].(string)] = command.ProjectionCheckpoint{}
+	case "UpdateProjectionCheckpoint":
+		c.tx.checkpoints[args[0].(string)] = command.ProjectionCheckpoint{TransactionID: args[1].(int64), EventID: args[2].(string)}
+	case "RefreshUserSummary":
+		// 引数は (projected_at, organization_id, id)
+		key := fmt.Sprint(args[1], "/", args[2])
+		if !f.users[key] {
+			return driver.RowsAffected(0), nil
+		}
+		c.tx.summaries[key] = true
+	case "DeleteUserSummary":
+		delete(c.tx.summaries, fmt.Sprint(args[0], "/", args[1]))
+	case "ClearUserSummaries":
+		clear(c.tx.summaries)
+	case "SeedUserSummaries":
+		for key := range f.users {
+			c.tx.summaries[key] = true
+		}
+	default:
+		return nil, fmt.Errorf("unexpected exec: %s", name)
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (c *fakeProjectionConn) QueryContext(_ context.Context, query string, named []driver.NamedValue) (driver.Rows, error) {
+	f := c.db
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	name := strings.Fields(query)[2]
+	f.executed = append(f.executed, name)
+	args := values(named)
+
+	rows := &fakeRows{}
+	switch name {
+	case "GetProjectionCheckpointForUpdate":
+		checkpoint := c.tx.checkpoints[args[0].(string)]
+		rows.values = append(rows.values, []driver.Value{checkpoint.TransactionID, checkpoint.EventID})
+	case "ListOutboxEventsAfter":
+		// (transaction_id, id) がチェックポイントより後で、実行中の最も古いトランザクションより前のイベント
+		after := command.ProjectionCheckpoint{TransactionID: args[0].(int64), EventID: args[1].(string)}
+		var events []fakeOutboxEvent
+		for _, event := range f.events {
+			if event.transactionID >= f.oldestRunning {
+				continue
+			}
+			if event.transactionID > after.TransactionID || (event.transactionID == after.TransactionID && event.id > after.EventID) {
+				events = append(events, event)
+			}
+		}
+		slices.SortFunc(events, func(a, b fakeOutboxEvent) int {
+			if a.transactionID != b.transactionID {
+				return int(a.transactionID - b.transactionID)
+			}
+			return strings.Compare(a.id, b.id)
+		})
+		now := time.Now()
+		for _, event := range events[:min(len(events), int(args[2].(int64)))] {
+			rows.values = append(rows.values, []driver.Value{
+				event.id, testOrganizationID, string(event.aggregateType), event.aggregateID, "event", []byte("{}"), now,
+				nil, int64(0), nil, now, event.transactionID,
+			})
+		}
+	default:
+		return nil, fmt.Errorf("unexpected query: %s", name)
+	}
+	return rows, nil
+}
+
+func values(named []driver.NamedValue) []driver.Value {
+	args := make([]driver.Value, len(named))
+	for i, v := range named {
+		args[i] = v.Value
+	}
+	return args
+}
+
+type fakeRows struct {
+	values [][]driver.Value
+	next   int
+}
+
+func (r *fakeRows) Columns() []string {
+	if len(r.values) == 0 {
+		return nil
+	}
+	return make([]string, len(r.values[0]))
+}
+func (r *fakeRows) Close() error { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.values) {
+		return io.EOF
+	}
+	copy(dest, r.values[r.next])
+	r.next++
+	return nil
+}
+
+// recordingProjection 適用したイベントを記録する投影
+type recordingProjection struct {
+	applied []string
+	resets  int
+	// failOn このIDのイベントの適用に失敗する
+	failOn string
+}
+
+func (p *recordingProjection) Name() string { return "recording" }
+
+func (p *recordingProjection) Apply(ctx context.Context, _ infrastructure.DBTX, event *domain.OutboxEvent) error {
+	if event.ID == p.failOn {
+		return errors.New("apply failed")
+	}
+	// イベントの組織がテナントになっている
+	if organizationID, err := domain.RequireTenant(ctx); err != nil || organizationID != event.OrganizationID {
+		return fmt.Errorf("unexpected tenant: %q (%v)", organizationID, err)
+	}
+	p.applied = append(p.applied, event.ID)
+	return nil
+}
+
+func (p *recordingProjection) Reset(context.Context, infrastructure.DBTX) error {
+	p.resets++
+	p.applied = nil
+	return nil
+}
+
+func newTestWorker(txManager *infrastructure.TransactionManager, batchSize int, projections ...Projection) *Worker {
+	registry := NewRegistry()
+	for _, projection := range projections {
+		registry.RegisterProjection(projection)
+	}
+	config := DefaultConfig()
+	config.BatchSize = batchSize
+	return NewWorker(txManager, registry, config, slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+func userEvent(transactionID int64, id string) fakeOutboxEvent {
+	return fakeOutboxEvent{transactionID: transactionID, id: id, aggregateType: domain.AggregateTypeUser, aggregateID: "user-" + id}
+}
+
+func TestAdvanceProjection_AppliesInTransactionOrder(t *testing.T) {
+	db, txManager := newFakeProjectionDB(t)
+	projection := &recordingProjection{}
+	w := newTestWorker(txManager, 10, projection)
+	// IDはトランザクションの順と逆になることがある
+	db.set(func(f *fakeProjectionDB) {
+		f.events = []fakeOutboxEvent{userEvent(101, "01A"), userEvent(100, "01C"), userEvent(100, "01B")}
+	})
+
+	applied, err := w.advanceProjection(context.Background(), projection)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if applied != 3 {
+		t.Errorf("expected 3 applied events, got %d", applied)
+	}
+	// 初回は読み取りモデルを初期状態に戻してから適用する
+	if projection.resets != 1 {
+		t.Errorf("expected the projection to be reset once, got %d", projection.resets)
+	}
+	if want := []string{"01B", "01C", "01A"}; !slices.Equal(projection.applied, want) {
+		t.Errorf("expected events %v, got %v", want, projection.applied)
+	}
+	if got, want := db.checkpoint(projection.Name()), (command.ProjectionCheckpoint{TransactionID: 101, EventID: "01A"}); got != want {
+		t.Errorf("expected checkpoint %+v, got %+v", want, got)
+	}
+
+	// チェックポイントより前のイベントは読み直さず、初期化も繰り返さない
+	applied, err = w.advanceProjection(context.Background(), projection)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if applied != 0 || projection.resets != 1 || len(projection.applied) != 3 {
+		t.Errorf("expected nothing to be applied again, got %d applied, %d resets, events %v", applied, projection.resets, projection.applied)
+	}
+}
+
+func TestAdvanceProjection_DoesNotSkipLateCommits(t *testing.T) {
+	db, txManager := newFakeProjectionDB(t)
+	projection := &recordingProjection{}
+	w := newTestWorker(txManager, 10, projection)
+	// トランザクション 100 はIDの小さいイベント 01A を記録したまま実行中で、後から始まった 101 が先にコミットした
+	db.set(func(f *fakeProjectionDB) {
+		f.events = []fakeOutboxEvent{userEvent(101, "01B")}
+		f.oldestRunning = 100
+	})
+
+	// 実行中のトランザクション以降のイベントはコミットされるまで待つ
+	if _, err := w.advanceProjection(context.Background(), projection); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(projection.applied) != 0 {
+		t.Errorf("expected events after a running transaction to wait, got %v", projection.applied)
+	}
+
+	db.set(func(f *fakeProjectionDB) {
+		f.events = append(f.events, userEvent(100, "01A"))
+		f.oldestRunning = 102
+	})
+	if _, err := w.advanceProjection(context.Background(), projection); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"01A", "01B"}; !slices.Equal(projection.applied, want) {
+		t.Errorf("expected events %v, got %v", want, projection.applied)
+	}
+}
+
+func TestAdvanceProjection_RollsBackFailedBatch(t *testing.T) {
+	db, txManager := newFakeProjectionDB(t)
+	projection := &recordingProjection{failOn: "01B"}
+	w := newTestWorker(txManager, 10, projection)
+	db.set(func(f *fakeProjectionDB) {
+		f.events = []fakeOutboxEvent{userEvent(100, "01A"), userEvent(101, "01B")}
+	})
+
+	if _, err := w.advanceProjection(context.Background(), projection); err == nil {
+		t.Fatal("expected an error")
+	}
+	// チェックポイントは作成前に戻り、次回は初期化からやり直す
+	if got := db.checkpoint(projection.Name()); got != (command.ProjectionCheckpoint{}) {
+		t.Errorf("expected the checkpoint not to move, got %+v", got)
+	}
+
+	projection.failOn = ""
+	if _, err := w.advanceProjection(context.Background(), projection); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"01A", "01B"}; !slices.Equal(projection.applied, want) {
+		t.Errorf("expected events %v, got %v", want, projection.applied)
+	}
+	if projection.resets != 2 {
+		t.Errorf("expected the projection to be reset again after the rollback, got %d", projection.resets)
+	}
+}
+
+func TestRunProjections_ReadsInBatches(t *testing.T) {
+	db, txManager := newFakeProjectionDB(t)
+	projection := &recordingProjection{}
+	w := newTestWorker(txManager, 2, projection)
+	db.set(func(f *fakeProjectionDB) {
+		f.events = []fakeOutboxEvent{userEvent(100, "01A"), userEvent(101, "01B"), userEvent(102, "01C"), userEvent(103, "01D"), userEvent(104, "01E")}
+	})
+
+	// 1回のポーリングで、バッチの件数を超えるイベントも最新まで読み進める
+	w.runProjections(context.Background())
+	if want := []string{"01A", "01B", "01C", "01D", "01E"}; !slices.Equal(projection.applied, want) {
+		t.Errorf("expected events %v, got %v", want, projection.applied)
+	}
+	if got := db.count("UpdateProjectionCheckpoint"); got != 3 {
+		t.Errorf("expected 3 checkpoint updates, got %d", got)
+	}
+	if got, want := db.checkpoint(projection.Name()), (command.ProjectionCheckpoint{TransactionID: 104, EventID: "01E"}); got != want {
+		t.Errorf("expected checkpoint %+v, got %+v", want, got)
+	}
+}
+
+func TestRebuildProjection(t *testing.T) {
+	db, txManager := newFakeProjectionDB(t)
+	projection := &recordingProjection{}
+	w := newTestWorker(txManager, 10, projection)
+	db.set(func(f *fakeProjectionDB) {
+		f.events = []fakeOutboxEvent{userEvent(100, "01A"), userEvent(101, "01B")}
+	})
+	w.runProjections(context.Background())
+
+	if err := w.RebuildProjection(context.Background(), projection.Name()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// 読み取りモデルを初期状態に戻し、次のポーリングで先頭から読み直す
+	if projection.resets != 2 || len(projection.applied) != 0 {
+		t.Errorf("expected the projection to be reset, got %d resets, events %v", projection.resets, projection.applied)
+	}
+	if got := db.checkpoint(projection.Name()); got != (command.ProjectionCheckpoint{}) {
+		t.Errorf("expected the checkpoint to be cleared, got %+v", got)
+	}
+	w.runProjections(context.Background())
+	if want := []string{"01A", "01B"}; !slices.Equal(projection.applied, want) {
+		t.Errorf("expected events %v, got %v", want, projection.applied)
+	}
+
+	if err := w.RebuildProjection(context.Background(), "unknown"); err == nil {
+		t.Error("expected an error for an unknown projection")
+	}
+}
+
+func TestUserSummaryProjection(t *testing.T) {
+	db, txManager := newFakeProjectionDB(t)
+	projection := NewUserSummaryProjection()
+	w := newTestWorker(txManager, 10, projection)
+	existing := testOrganizationID + "/user-existing"
+	db.set(func(f *fakeProjectionDB) {
+		f.users[existing] = true
+		f.users[testOrganizationID+"/user-01A"] = true
+		f.events = []fakeOutboxEvent{
+			userEvent(100, "01A"),
+			// 削除されたユーザーの行は消える
+			userEvent(101, "01B"),
+			// ユーザー以外の集約のイベントは無視する
+			{transactionID: 102, id: "01C", aggregateType: domain.AggregateType("webhook"), aggregateID: "user-existing"},
+		}
+	})
+
+	w.runProjections(context.Background())
+
+	// 初回はイベントのない既存のユーザーも users テーブルから作る
+	db.mu.Lock()
+	summaries := slices.Sorted(maps.Keys(db.committed.summaries))
+	db.mu.Unlock()
+	if want := []string{testOrganizationID + "/user-01A", existing}; !slices.Equal(summaries, want) {
+		t.Errorf("expected summaries %v, got %v", want, summaries)
+	}
+	if got := db.count("RefreshUserSummary"); got != 2 {
+		t.Errorf("expected 2 refreshed users, got %d", got)
+	}
+	if got := db.count("DeleteUserSummary"); got != 1 {
+		t.Errorf("expected 1 deleted summary, got %d", got)
+	}
+}
@@ -0,0 +1,40 @@
+package worker
+
+import (
+	"context"
+
+	"github.com/example/go-react-cqrs-template/internal/command"
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+// UserSummaryProjectionName ユーザー一覧の投影の名前
+const UserSummaryProjectionName = "user_summaries"
+
+// UserSummaryProjection ユーザーのイベントから一覧の読み取りモデル（user_summaries）を更新する投影
+// イベントの内容ではなくユーザーとユーザーログの現在の状態から行を作り直すため、イベントの順序や重複の影響を受けない
+type UserSummaryProjection struct{}
+
+// NewUserSummaryProjection UserSummaryProjectionのコンストラクタ
+func NewUserSummaryProjection() *UserSummaryProjection {
+	return &UserSummaryProjection{}
+}
+
+// Name Projectionインターフェースを実装
+func (p *UserSummaryProjection) Name() string {
+	return UserSummaryProjectionName
+}
+
+// Apply Projectionインターフェースを実装
+func (p *UserSummaryProjection) Apply(ctx context.Context, tx infrastructure.DBTX, event *domain.OutboxEvent) error {
+	if event.AggregateType != domain.AggregateTypeUser {
+		return nil
+	}
+	return command.RefreshUserSummary(ctx, tx, event.AggregateID)
+}
+
+// Reset Projectionインターフェースを実装
+// イベントが記録される前から存在するユーザーも含めるため、users テーブルから作り直す
+func (p *UserSummaryProjection) Reset(ctx context.Context, tx infrastructure.DBTX) error {
+	return command.ResetUserSummaries(ctx, tx)
+}
@@ -58,6 +58,9 @@ func (w *Worker) Run(ctx context.Context) error {
 			return nil
 		case <-ticker.C:
 			w.poll(ctx, sem, &wg)
+			w.relayOutbox(ctx)
+			w.runProjections(ctx)
+			w.deleteStaleLoginFailures(ctx)
 		}
 	}
 }
@@ -107,6 +110,7 @@ func (w *Worker) processJob(ctx context.Context, job *domain.Job) {
 	jobLogger := w.logger.With(
 		slog.String("job_id", job.ID),
 		slog.String("job_type", job.JobType),
+		slog.String("organization_id", job.OrganizationID),
 		slog.Int("attempt", job.Attempts),
 	)
 
@@ -122,7 +126,10 @@ func (w *Worker) processJob(ctx context.Context, job *domain.Job) {
 		return
 	}
 
-	if err := handler.Handle(ctx, job.Payload); err != nil {
+	// ジョブ内の操作はジョブ種別をシステムの主体として記録し、ジョブを登録した組織のデータに限定する
+	handlerCtx := domain.WithPrincipal(ctx, domain.NewSystemPrincipal(job.JobType).WithOrganization(job.OrganizationID))
+	handlerCtx = domain.WithTenant(handlerCtx, job.OrganizationID)
+	if err := handler.Handle(handlerCtx, job.Payload); err != nil {
 		duration := time.Since(startTime)
 		jobLogger.Error("job failed",
 			slog.String("error", err.Error()),
@@ -0,0 +1,116 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/example/go-react-cqrs-template/internal/domain"
+	"github.com/example/go-react-cqrs-template/internal/infrastructure"
+)
+
+// fakeJobDB ジョブの状態の更新を記録するメモリ上のデータベース
+type fakeJobDB struct {
+	mu sync.Mutex
+	// updates 実行されたクエリの名前と引数（実行順）
+	updates []fakeJobUpdate
+}
+
+type fakeJobUpdate struct {
+	name string
+	args []driver.Value
+}
+
+func (f *fakeJobDB) Connect(context.Context) (driver.Conn, error) { return fakeJobConn{f}, nil }
+func (f *fakeJobDB) Driver() driver.Driver                        { return nil }
+
+type fakeJobConn struct{ db *fakeJobDB }
+
+func (c fakeJobConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepare is not supported")
+}
+func (c fakeJobConn) Close() error              { return nil }
+func (c fakeJobConn) Begin() (driver.Tx, error) { return c, nil }
+func (c fakeJobConn) Commit() error             { return nil }
+func (c fakeJobConn) Rollback() error           { return nil }
+
+func (c fakeJobConn) ExecContext(_ context.Context, query string, named []driver.NamedValue) (driver.Result, error) {
+	c.db.mu.Lock()
+	defer c.db.mu.Unlock()
+	c.db.updates = append(c.db.updates, fakeJobUpdate{name: strings.Fields(query)[2], args: values(named)})
+	return driver.RowsAffected(1), nil
+}
+
+// processFailingJob 失敗するハンドラーでジョブを処理し、ジョブの状態の更新を返す
+func processFailingJob(t *testing.T, job *domain.Job) fakeJobUpdate {
+	t.Helper()
+	fake := &fakeJobDB{}
+	db := sql.OpenDB(fake)
+	t.Cleanup(func() { _ = db.Close() })
+
+	registry := NewRegistry()
+	registry.RegisterFunc(job.JobType, func(context.Context, json.RawMessage) error {
+		return errors.New("webhook endpoint returned status 503")
+	})
+	w := NewWorker(infrastructure.NewTransactionManager(db), registry, DefaultConfig(), slog.New(slog.NewTextHandler(io.Discard, nil)))
+	w.processJob(context.Background(), job)
+
+	if len(fake.updates) != 1 {
+		t.Fatalf("expected one job update, got %+v", fake.updates)
+	}
+	return fake.updates[0]
+}
+
+func TestWorker_ProcessJobSchedulesRetryWithBackoff(t *testing.T) {
+	job := domain.NewJob("deliver_webhook", json.RawMessage(`{}`), 8)
+	job.Attempts = 3
+
+	before := time.Now()
+	update := processFailingJob(t, job)
+	after := time.Now()
+
+	if update.name != "MarkJobRetryable" {
+		t.Fatalf("expected the job to be retried, got %s", update.name)
+	}
+	// 3回目の失敗の後は 5秒 * 2^2 = 20秒後に再試行する
+	scheduledAt := update.args[2].(time.Time)
+	if scheduledAt.Before(before.Add(20*time.Second)) || scheduledAt.After(after.Add(20*time.Second)) {
+		t.Errorf("expected retry 20s after the failure, got %v (failed between %v and %v)", scheduledAt, before, after)
+	}
+}
+
+func TestWorker_ProcessJobMovesExhaustedJobToDeadLetter(t *testing.T) {
+	job := domain.NewJob("deliver_webhook", json.RawMessage(`{}`), 8)
+	job.Attempts = 8
+
+	if update := processFailingJob(t, job); update.name != "MarkJobDead" {
+		t.Errorf("expected the job to be moved to the dead letter, got %s", update.name)
+	}
+}
+
+func TestCalculateBackoff(t *testing.T) {
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{attempt: 1, want: 5 * time.Second},
+		{attempt: 2, want: 10 * time.Second},
+		{attempt: 6, want: 160 * time.Second},
+		{attempt: 7, want: 5 * time.Minute},
+		{attempt: 20, want: 5 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		if got := calculateBackoff(tt.attempt); got != tt.want {
+			t.Errorf("calculateBackoff(%d) = %v, want %v", tt.attempt, got, tt.want)
+		}
+	}
+}
@@ -4,6 +4,7 @@
 package openapi
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"time"
@@ -13,6 +14,193 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+const (
+	ApiKeyAuthScopes        = "ApiKeyAuth.Scopes"
+	BearerAuthScopes        = "BearerAuth.Scopes"
+	SessionCookieAuthScopes = "SessionCookieAuth.Scopes"
+)
+
+// Defines values for InboundEventStatus.
+const (
+	InboundEventStatusFailed    InboundEventStatus = "failed"
+	InboundEventStatusIgnored   InboundEventStatus = "ignored"
+	InboundEventStatusPending   InboundEventStatus = "pending"
+	InboundEventStatusProcessed InboundEventStatus = "processed"
+)
+
+// Defines values for Role.
+const (
+	Admin       Role = "admin"
+	Auditor     Role = "auditor"
+	UserManager Role = "user_manager"
+	Viewer      Role = "viewer"
+)
+
+// Defines values for UserImportFormat.
+const (
+	Csv    UserImportFormat = "csv"
+	Ndjson UserImportFormat = "ndjson"
+)
+
+// Defines values for UserImportRowStatus.
+const (
+	UserImportRowStatusCreated          UserImportRowStatus = "created"
+	UserImportRowStatusInvalid          UserImportRowStatus = "invalid"
+	UserImportRowStatusSkippedDuplicate UserImportRowStatus = "skipped_duplicate"
+)
+
+// Defines values for UserImportStatus.
+const (
+	UserImportStatusCompleted  UserImportStatus = "completed"
+	UserImportStatusFailed     UserImportStatus = "failed"
+	UserImportStatusPending    UserImportStatus = "pending"
+	UserImportStatusProcessing UserImportStatus = "processing"
+)
+
+// Defines values for UserLogAction.
+const (
+	UserLogActionCreated UserLogAction = "created"
+	UserLogActionDeleted UserLogAction = "deleted"
+	UserLogActionUpdated UserLogAction = "updated"
+)
+
+// Defines values for UserLogChainBreakReason.
+const (
+	HashMismatch          UserLogChainBreakReason = "hash_mismatch"
+	HeadMismatch          UserLogChainBreakReason = "head_mismatch"
+	HeadSignatureMismatch UserLogChainBreakReason = "head_signature_mismatch"
+	PrevHashMismatch      UserLogChainBreakReason = "prev_hash_mismatch"
+	SequenceGap           UserLogChainBreakReason = "sequence_gap"
+)
+
+// Defines values for WebhookEventType.
+const (
+	UserCreated          WebhookEventType = "user.created"
+	UserDeleted          WebhookEventType = "user.deleted"
+	UserEmailChanged     WebhookEventType = "user.email_changed"
+	UserEmailInvalidated WebhookEventType = "user.email_invalidated"
+	UserEmailVerified    WebhookEventType = "user.email_verified"
+	UserPasswordChanged  WebhookEventType = "user.password_changed"
+	UserRenamed          WebhookEventType = "user.renamed"
+)
+
+// ApiKey API key for service-to-service access (the secret is never returned after creation)
+type ApiKey struct {
+	// CreatedAt Creation timestamp
+	CreatedAt time.Time `json:"createdAt"`
+
+	// CreatedBy Principal that created the key (e.g. "user:01ARZ...")
+	CreatedBy string `json:"createdBy"`
+
+	// ExpiresAt Expiry time (absent when the key does not expire)
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Id API key ID (ULID format)
+	Id string `json:"id"`
+
+	// LastUsedAt Time the key was last used to authenticate (recorded at most once a minute)
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+
+	// Name API key name
+	Name string `json:"name"`
+
+	// Prefix Public part of the key, used to identify it (e.g. "cqk_1a2b3c4d5e6f7a8b")
+	Prefix string `json:"prefix"`
+
+	// RevokedAt Time the key was revoked
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+
+	// Scopes Scopes granted to the key (e.g. "users:read")
+	Scopes []string `json:"scopes"`
+}
+
+// ApiKeyList API key list response
+type ApiKeyList struct {
+	// ApiKeys List of API keys, newest first
+	ApiKeys []ApiKey `json:"apiKeys"`
+
+	// Total Total number of matching API keys
+	Total int32 `json:"total"`
+}
+
+// AuditEvent Audit event recorded for an action on an aggregate
+type AuditEvent struct {
+	// Action Action performed on the aggregate (e.g. "created")
+	Action string `json:"action"`
+
+	// Actor Principal that performed the action (e.g. "user:01ARZ...", "anonymous")
+	Actor string `json:"actor"`
+
+	// AggregateId ID of the aggregate
+	AggregateId string `json:"aggregateId"`
+
+	// AggregateType Type of the aggregate (e.g. "user", "user_import")
+	AggregateType string `json:"aggregateType"`
+
+	// CreatedAt Time the action was performed
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id Audit event ID (ULID format)
+	Id string `json:"id"`
+
+	// Metadata Metadata of the request that performed the action
+	Metadata AuditRequestMetadata `json:"metadata"`
+
+	// Payload Aggregate-specific details of the action
+	Payload map[string]interface{} `json:"payload"`
+
+	// RequestId ID of the request that performed the action
+	RequestId string `json:"requestId"`
+}
+
+// AuditEventList Audit event list response
+type AuditEventList struct {
+	// Events List of audit events, newest first
+	Events []AuditEvent `json:"events"`
+
+	// Total Total number of matching audit events
+	Total int32 `json:"total"`
+}
+
+// AuditRequestMetadata Metadata of the request that performed an audited action
+type AuditRequestMetadata struct {
+	// RemoteAddr Client IP address
+	RemoteAddr *string `json:"remoteAddr,omitempty"`
+
+	// UserAgent Client User-Agent
+	UserAgent *string `json:"userAgent,omitempty"`
+}
+
+// ChangePasswordRequest Change password request
+type ChangePasswordRequest struct {
+	// CurrentPassword Current password (required when users change their own password)
+	CurrentPassword *string `json:"currentPassword,omitempty"`
+
+	// NewPassword New password
+	NewPassword string `json:"newPassword"`
+}
+
+// CreateApiKeyRequest Create API key request
+type CreateApiKeyRequest struct {
+	// ExpiresAt Expiry time (the key does not expire when omitted)
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Name API key name
+	Name string `json:"name"`
+
+	// Scopes Scopes to grant to the key (e.g. "users:read")
+	Scopes *[]string `json:"scopes,omitempty"`
+}
+
+// CreateOrganizationRequest Create organization request
+type CreateOrganizationRequest struct {
+	// Name Organization name
+	Name string `json:"name"`
+
+	// Slug Unique name of the organization (lowercase letters, digits and hyphens)
+	Slug string `json:"slug"`
+}
+
 // CreateUserRequest Create user request
 type CreateUserRequest struct {
 	// Email User email address
@@ -20,6 +208,87 @@ type CreateUserRequest struct {
 
 	// Name User name
 	Name string `json:"name"`
+
+	// Password Initial password (the user cannot log in with a password when omitted)
+	Password *string `json:"password,omitempty"`
+}
+
+// CreateWebhookRequest Create webhook request
+type CreateWebhookRequest struct {
+	// EventTypes Kinds of events to deliver
+	EventTypes []WebhookEventType `json:"eventTypes"`
+
+	// Url http or https URL the events are posted to
+	Url string `json:"url"`
+}
+
+// CreatedApiKey Newly created API key, including its secret
+type CreatedApiKey struct {
+	// CreatedAt Creation timestamp
+	CreatedAt time.Time `json:"createdAt"`
+
+	// CreatedBy Principal that created the key (e.g. "user:01ARZ...")
+	CreatedBy string `json:"createdBy"`
+
+	// ExpiresAt Expiry time (absent when the key does not expire)
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Id API key ID (ULID format)
+	Id string `json:"id"`
+
+	// LastUsedAt Time the key was last used to authenticate (recorded at most once a minute)
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+
+	// Name API key name
+	Name string `json:"name"`
+
+	// Prefix Public part of the key, used to identify it (e.g. "cqk_1a2b3c4d5e6f7a8b")
+	Prefix string `json:"prefix"`
+
+	// RevokedAt Time the key was revoked
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+
+	// Scopes Scopes granted to the key (e.g. "users:read")
+	Scopes []string `json:"scopes"`
+
+	// Secret API key to send in the X-API-Key header. It is shown only once and cannot be retrieved later.
+	Secret string `json:"secret"`
+}
+
+// CreatedWebhook Newly created webhook, including its signing secret
+type CreatedWebhook struct {
+	// Active Whether events are delivered. Webhooks are disabled automatically after sustained delivery failures.
+	Active bool `json:"active"`
+
+	// ConsecutiveFailures Number of failed deliveries since the last successful one
+	ConsecutiveFailures int32 `json:"consecutiveFailures"`
+
+	// CreatedAt Creation timestamp
+	CreatedAt time.Time `json:"createdAt"`
+
+	// CreatedBy Principal that created the webhook (e.g. "user:01ARZ...")
+	CreatedBy string `json:"createdBy"`
+
+	// DisabledAt Time the webhook was disabled
+	DisabledAt *time.Time `json:"disabledAt,omitempty"`
+
+	// DisabledReason Error of the last delivery when the webhook was disabled automatically
+	DisabledReason *string `json:"disabledReason,omitempty"`
+
+	// EventTypes Kinds of events delivered to the URL
+	EventTypes []WebhookEventType `json:"eventTypes"`
+
+	// Id Webhook ID (ULID format)
+	Id string `json:"id"`
+
+	// Secret Secret used to sign the requests. It is shown only once and cannot be retrieved later.
+	Secret string `json:"secret"`
+
+	// UpdatedAt Last update timestamp
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// Url URL the events are posted to
+	Url string `json:"url"`
 }
 
 // Error Error response
@@ -31,6 +300,169 @@ type Error struct {
 	Message string `json:"message"`
 }
 
+// InboundEvent Event received from an external service through an inbound webhook (e.g. a bounce reported by the email provider).
+// Events are received at POST /inbound-webhooks/{provider}, authenticated by the provider's signature instead of credentials.
+type InboundEvent struct {
+	// EventType Normalized kind of the event (e.g. "email.hard_bounced")
+	EventType string `json:"eventType"`
+
+	// Id Inbound event ID (ULID format)
+	Id string `json:"id"`
+
+	// LastError Reason the last processing failed
+	LastError *string `json:"lastError,omitempty"`
+
+	// Payload Event as received from the provider
+	Payload map[string]interface{} `json:"payload"`
+
+	// ProcessedAt Time the event was processed
+	ProcessedAt *time.Time `json:"processedAt,omitempty"`
+
+	// Provider Provider that sent the event (e.g. "email")
+	Provider string `json:"provider"`
+
+	// ProviderEventId Event ID assigned by the provider. Events redelivered with the same ID are ignored.
+	ProviderEventId string `json:"providerEventId"`
+
+	// ReceivedAt Time the event was received
+	ReceivedAt time.Time `json:"receivedAt"`
+
+	// Status Processing status
+	Status InboundEventStatus `json:"status"`
+
+	// Subject Subject of the event such as the bounced email address
+	Subject *string `json:"subject,omitempty"`
+}
+
+// InboundEventList Inbound event list response
+type InboundEventList struct {
+	// Events List of inbound events, newest first
+	Events []InboundEvent `json:"events"`
+
+	// Total Total number of inbound events
+	Total int32 `json:"total"`
+}
+
+// InboundEventStatus Processing status of an event received through an inbound webhook
+type InboundEventStatus string
+
+// LoginRequest Login request
+type LoginRequest struct {
+	// Email User email address
+	Email openapi_types.Email `json:"email"`
+
+	// Password Password
+	Password string `json:"password"`
+}
+
+// Membership Member of an organization
+type Membership struct {
+	// CreatedAt Time the user joined the organization
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Roles Roles granted to the member within the organization
+	Roles []Role `json:"roles"`
+
+	// UpdatedAt Last update timestamp
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// UserId User ID (or the subject of an external identity)
+	UserId string `json:"userId"`
+}
+
+// MembershipList Member list response
+type MembershipList struct {
+	// Members Members of the organization, in the order they joined
+	Members []Membership `json:"members"`
+
+	// Total Total number of members
+	Total int32 `json:"total"`
+}
+
+// Organization Organization (tenant)
+type Organization struct {
+	// CreatedAt Creation timestamp
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id Organization ID (ULID format), sent in the X-Organization-ID header to act on the organization
+	Id string `json:"id"`
+
+	// Name Organization name
+	Name string `json:"name"`
+
+	// Slug Unique name of the organization (lowercase letters, digits and hyphens)
+	Slug string `json:"slug"`
+
+	// UpdatedAt Last update timestamp
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// OrganizationList Organization list response
+type OrganizationList struct {
+	// Organizations Organizations the user is a member of, sorted by name
+	Organizations []Organization `json:"organizations"`
+}
+
+// PasswordResetRequest Password reset request
+type PasswordResetRequest struct {
+	// Email Email address of the account
+	Email openapi_types.Email `json:"email"`
+}
+
+// ResetPasswordRequest Password reset confirmation
+type ResetPasswordRequest struct {
+	// NewPassword New password
+	NewPassword string `json:"newPassword"`
+
+	// Token Token from the password reset email
+	Token string `json:"token"`
+}
+
+// Role Role granted to a member of an organization
+type Role string
+
+// Session Login session (the token is only sent in the session cookie)
+type Session struct {
+	// CreatedAt Login timestamp
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Current Whether this is the session that made the request
+	Current bool `json:"current"`
+
+	// ExpiresAt Expiry time
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Id Session ID (ULID format)
+	Id string `json:"id"`
+
+	// LastSeenAt Time the session was last used (recorded at most once a minute)
+	LastSeenAt time.Time `json:"lastSeenAt"`
+
+	// OrganizationId ID of the organization the session is bound to
+	OrganizationId string `json:"organizationId"`
+
+	// RemoteAddr IP address of the client that logged in
+	RemoteAddr string `json:"remoteAddr"`
+
+	// UserAgent User-Agent of the client that logged in
+	UserAgent string `json:"userAgent"`
+
+	// UserId ID of the logged-in user
+	UserId string `json:"userId"`
+}
+
+// SessionList Session list response
+type SessionList struct {
+	// Sessions Active sessions of the logged-in user, newest first
+	Sessions []Session `json:"sessions"`
+}
+
+// SetMembershipRequest Add member or change member roles request
+type SetMembershipRequest struct {
+	// Roles Roles to grant to the member (replaces the current roles)
+	Roles []Role `json:"roles"`
+}
+
 // UpdateUserRequest Update user request
 type UpdateUserRequest struct {
 	// Email User email address
@@ -40,6 +472,18 @@ type UpdateUserRequest struct {
 	Name *string `json:"name,omitempty"`
 }
 
+// UpdateWebhookRequest Update webhook request
+type UpdateWebhookRequest struct {
+	// Active Enable or disable the webhook. Enabling it resets the failure count.
+	Active *bool `json:"active,omitempty"`
+
+	// EventTypes Kinds of events to deliver (replaces the current ones)
+	EventTypes *[]WebhookEventType `json:"eventTypes,omitempty"`
+
+	// Url http or https URL the events are posted to
+	Url *string `json:"url,omitempty"`
+}
+
 // User User model
 type User struct {
 	// CreatedAt Creation timestamp
@@ -48,104 +492,2046 @@ type User struct {
 	// Email User email address
 	Email openapi_types.Email `json:"email"`
 
+	// EmailInvalidatedAt Time the current email address was reported undeliverable, e.g. by a hard bounce (absent while deliverable)
+	EmailInvalidatedAt *time.Time `json:"emailInvalidatedAt,omitempty"`
+
+	// EmailVerifiedAt Time the current email address was verified (absent until the verification link is followed)
+	EmailVerifiedAt *time.Time `json:"emailVerifiedAt,omitempty"`
+
 	// Id User ID (ULID format)
 	Id string `json:"id"`
 
-	// Name User name
-	Name string `json:"name"`
+	// Name User name
+	Name string `json:"name"`
+
+	// OrganizationId ID of the organization the user belongs to
+	OrganizationId string `json:"organizationId"`
+
+	// UpdatedAt Last update timestamp
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// UserFieldChange Value of a user field before and after an update
+type UserFieldChange struct {
+	// After Value after the update
+	After string `json:"after"`
+
+	// Before Value before the update
+	Before string `json:"before"`
+}
+
+// UserImport User import model
+type UserImport struct {
+	// CompletedAt Completion timestamp
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+
+	// CreatedAt Creation timestamp
+	CreatedAt time.Time `json:"createdAt"`
+
+	// CreatedCount Number of rows that created a user
+	CreatedCount int32 `json:"createdCount"`
+
+	// Error Reason the import failed
+	Error *string `json:"error,omitempty"`
+
+	// Format Uploaded file format
+	Format UserImportFormat `json:"format"`
+
+	// Id Import ID (ULID format)
+	Id string `json:"id"`
+
+	// InvalidCount Number of rows rejected as invalid
+	InvalidCount int32 `json:"invalidCount"`
+
+	// SkippedDuplicateCount Number of rows skipped because the email already exists
+	SkippedDuplicateCount int32 `json:"skippedDuplicateCount"`
+
+	// Status Processing status
+	Status UserImportStatus `json:"status"`
+
+	// TotalRows Number of rows in the uploaded file
+	TotalRows int32 `json:"totalRows"`
+
+	// UpdatedAt Last update timestamp
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// UserImportFormat User import file format
+type UserImportFormat string
+
+// UserImportRow Per-row result of a user import
+type UserImportRow struct {
+	// Email Email given in the row
+	Email string `json:"email"`
+
+	// Line Line number in the uploaded file (1-based, including the CSV header)
+	Line int32 `json:"line"`
+
+	// Name Name given in the row
+	Name string `json:"name"`
+
+	// Reason Reason the row was skipped or rejected
+	Reason *string `json:"reason,omitempty"`
+
+	// Status Row result
+	Status UserImportRowStatus `json:"status"`
+
+	// UserId Created user ID, or the existing user ID for skipped duplicates
+	UserId *string `json:"userId,omitempty"`
+}
+
+// UserImportRowList User import row list response
+type UserImportRowList struct {
+	// Rows List of row results
+	Rows []UserImportRow `json:"rows"`
+
+	// Total Total number of matching rows
+	Total int32 `json:"total"`
+}
+
+// UserImportRowStatus Result of a single imported row
+type UserImportRowStatus string
+
+// UserImportStatus User import processing status
+type UserImportStatus string
+
+// UserList User list response
+type UserList struct {
+	// Total Total number of users
+	Total int32 `json:"total"`
+
+	// Users List of users
+	Users []UserSummary `json:"users"`
+}
+
+// UserLog User activity log entry
+type UserLog struct {
+	// Action Action performed on the user
+	Action UserLogAction `json:"action"`
+
+	// Actor Principal that performed the action (e.g. "user:01ARZ...", "anonymous")
+	Actor string `json:"actor"`
+
+	// Changes Changed fields keyed by field name (only for updated)
+	Changes map[string]UserFieldChange `json:"changes"`
+
+	// CreatedAt Time the action was performed
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id Log ID (ULID format)
+	Id string `json:"id"`
+
+	// RequestId ID of the request that performed the action
+	RequestId string `json:"requestId"`
+
+	// UserId User ID (ULID format)
+	UserId string `json:"userId"`
+}
+
+// UserLogAction User activity log action
+type UserLogAction string
+
+// UserLogChainBreak First broken link in the user log hash chain
+type UserLogChainBreak struct {
+	// LogId ID of the log entry found at the broken link (absent when trailing entries were deleted)
+	LogId *string `json:"logId,omitempty"`
+
+	// Reason Reason the link is broken
+	Reason UserLogChainBreakReason `json:"reason"`
+
+	// Seq Position in the chain where the link is broken
+	Seq int64 `json:"seq"`
+}
+
+// UserLogChainBreakReason Reason the user log hash chain is broken
+type UserLogChainBreakReason string
+
+// UserLogChainVerification Result of verifying the user log hash chain of an organization
+type UserLogChainVerification struct {
+	// Break First broken link (absent when the chain is intact)
+	Break *UserLogChainBreak `json:"break,omitempty"`
+
+	// CheckedCount Number of log entries checked
+	CheckedCount int32 `json:"checkedCount"`
+
+	// HeadSeq Position of the last entry in the chain at the time of verification
+	HeadSeq int64 `json:"headSeq"`
+
+	// Valid Whether the chain is intact
+	Valid bool `json:"valid"`
+}
+
+// UserLogList User activity log list response
+type UserLogList struct {
+	// Logs List of log entries, newest first
+	Logs []UserLog `json:"logs"`
+
+	// Total Total number of matching log entries
+	Total int32 `json:"total"`
+}
+
+// UserSummary User list item with activity counts
+type UserSummary struct {
+	// CreatedAt Creation timestamp
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Email User email address
+	Email openapi_types.Email `json:"email"`
+
+	// EmailInvalidatedAt Time the current email address was reported undeliverable, e.g. by a hard bounce (absent while deliverable)
+	EmailInvalidatedAt *time.Time `json:"emailInvalidatedAt,omitempty"`
+
+	// EmailVerifiedAt Time the current email address was verified (absent until the verification link is followed)
+	EmailVerifiedAt *time.Time `json:"emailVerifiedAt,omitempty"`
+
+	// Id User ID (ULID format)
+	Id string `json:"id"`
+
+	// LastActivityAt Time of the latest activity log entry (absent when the user has none)
+	LastActivityAt *time.Time `json:"lastActivityAt,omitempty"`
+
+	// LogCount Number of activity log entries of the user
+	LogCount int32 `json:"logCount"`
+
+	// Name User name
+	Name string `json:"name"`
+
+	// OrganizationId ID of the organization the user belongs to
+	OrganizationId string `json:"organizationId"`
+
+	// UpdatedAt Last update timestamp
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// VerifyEmailRequest Email verification confirmation
+type VerifyEmailRequest struct {
+	// Token Token from the verification email
+	Token string `json:"token"`
+}
+
+// Webhook Webhook that notifies a partner endpoint of changes to the users of the organization.
+// Requests are signed with HMAC-SHA256 (X-Webhook-Signature: v1=hex(HMAC(secret, "<X-Webhook-Timestamp>.<body>")))
+// and carry the event ID in X-Webhook-ID, which stays the same across retries.
+type Webhook struct {
+	// Active Whether events are delivered. Webhooks are disabled automatically after sustained delivery failures.
+	Active bool `json:"active"`
+
+	// ConsecutiveFailures Number of failed deliveries since the last successful one
+	ConsecutiveFailures int32 `json:"consecutiveFailures"`
+
+	// CreatedAt Creation timestamp
+	CreatedAt time.Time `json:"createdAt"`
+
+	// CreatedBy Principal that created the webhook (e.g. "user:01ARZ...")
+	CreatedBy string `json:"createdBy"`
+
+	// DisabledAt Time the webhook was disabled
+	DisabledAt *time.Time `json:"disabledAt,omitempty"`
+
+	// DisabledReason Error of the last delivery when the webhook was disabled automatically
+	DisabledReason *string `json:"disabledReason,omitempty"`
+
+	// EventTypes Kinds of events delivered to the URL
+	EventTypes []WebhookEventType `json:"eventTypes"`
+
+	// Id Webhook ID (ULID format)
+	Id string `json:"id"`
+
+	// UpdatedAt Last update timestamp
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// Url URL the events are posted to
+	Url string `json:"url"`
+}
+
+// WebhookDelivery Attempt to deliver an event to a webhook
+type WebhookDelivery struct {
+	// Attempt Attempt number for the event, starting at 1
+	Attempt int32 `json:"attempt"`
+
+	// DeliveredAt Time of the attempt
+	DeliveredAt time.Time `json:"deliveredAt"`
+
+	// DurationMs Time taken by the request in milliseconds
+	DurationMs int32 `json:"durationMs"`
+
+	// Error Reason of the failure
+	Error *string `json:"error,omitempty"`
+
+	// EventId ID of the delivered event (sent as X-Webhook-ID)
+	EventId string `json:"eventId"`
+
+	// EventType Kind of the delivered event
+	EventType string `json:"eventType"`
+
+	// Id Delivery ID (ULID format)
+	Id string `json:"id"`
+
+	// StatusCode HTTP status returned by the receiver (absent when no response was received)
+	StatusCode *int32 `json:"statusCode,omitempty"`
+
+	// Succeeded Whether the receiver accepted the event with a 2xx status
+	Succeeded bool `json:"succeeded"`
+}
+
+// WebhookDeliveryList Webhook delivery list response
+type WebhookDeliveryList struct {
+	// Deliveries Delivery attempts, newest first
+	Deliveries []WebhookDelivery `json:"deliveries"`
+
+	// Total Total number of delivery attempts
+	Total int32 `json:"total"`
+}
+
+// WebhookEventType Kind of domain event a webhook can subscribe to
+type WebhookEventType string
+
+// WebhookList Webhook list response
+type WebhookList struct {
+	// Total Total number of webhooks
+	Total int32 `json:"total"`
+
+	// Webhooks List of webhooks, newest first
+	Webhooks []Webhook `json:"webhooks"`
+}
+
+// ApiKeysListApiKeysParams defines parameters for ApiKeysListApiKeys.
+type ApiKeysListApiKeysParams struct {
+	// IncludeRevoked Also return revoked API keys
+	IncludeRevoked *bool `form:"includeRevoked,omitempty" json:"includeRevoked,omitempty"`
+
+	// Limit Maximum number of API keys to return
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of API keys to skip
+	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// AuditEventsListAuditEventsParams defines parameters for AuditEventsListAuditEvents.
+type AuditEventsListAuditEventsParams struct {
+	// AggregateType Only return events for this aggregate type
+	AggregateType *string `form:"aggregateType,omitempty" json:"aggregateType,omitempty"`
+
+	// AggregateId Only return events for this aggregate ID
+	AggregateId *string `form:"aggregateId,omitempty" json:"aggregateId,omitempty"`
+
+	// Action Only return events with this action
+	Action *string `form:"action,omitempty" json:"action,omitempty"`
+
+	// Actor Only return events performed by this principal
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// Since Only return events at or after this time
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Only return events before this time
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+
+	// Limit Maximum number of events to return
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of events to skip
+	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// EventsStreamEventsParams defines parameters for EventsStreamEvents.
+type EventsStreamEventsParams struct {
+	// LastEventID ID of the last event the client received, sent by EventSource when it reconnects
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
+// InboundEventsListInboundEventsParams defines parameters for InboundEventsListInboundEvents.
+type InboundEventsListInboundEventsParams struct {
+	// Provider Only return events from this provider
+	Provider *string `form:"provider,omitempty" json:"provider,omitempty"`
+
+	// Status Only return events with this processing status
+	Status *InboundEventStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Limit Maximum number of events to return
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of events to skip
+	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// OrganizationsListMembersParams defines parameters for OrganizationsListMembers.
+type OrganizationsListMembersParams struct {
+	// Limit Maximum number of members to return
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of members to skip
+	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// UsersListUsersParams defines parameters for UsersListUsers.
+type UsersListUsersParams struct {
+	// Limit Maximum number of users to return
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of users to skip
+	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// UserImportsListUserImportRowsParams defines parameters for UserImportsListUserImportRows.
+type UserImportsListUserImportRowsParams struct {
+	// Status Only return rows with this result
+	Status *UserImportRowStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Limit Maximum number of rows to return
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of rows to skip
+	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// UsersListUserLogsParams defines parameters for UsersListUserLogs.
+type UsersListUserLogsParams struct {
+	// Action Only return log entries with this action
+	Action *UserLogAction `form:"action,omitempty" json:"action,omitempty"`
+
+	// Limit Maximum number of log entries to return
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of log entries to skip
+	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// WebhooksListWebhooksParams defines parameters for WebhooksListWebhooks.
+type WebhooksListWebhooksParams struct {
+	// Limit Maximum number of webhooks to return
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of webhooks to skip
+	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// WebhooksListWebhookDeliveriesParams defines parameters for WebhooksListWebhookDeliveries.
+type WebhooksListWebhookDeliveriesParams struct {
+	// Limit Maximum number of deliveries to return
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of deliveries to skip
+	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ApiKeysCreateApiKeyJSONRequestBody defines body for ApiKeysCreateApiKey for application/json ContentType.
+type ApiKeysCreateApiKeyJSONRequestBody = CreateApiKeyRequest
+
+// AuthVerifyEmailJSONRequestBody defines body for AuthVerifyEmail for application/json ContentType.
+type AuthVerifyEmailJSONRequestBody = VerifyEmailRequest
+
+// AuthLoginJSONRequestBody defines body for AuthLogin for application/json ContentType.
+type AuthLoginJSONRequestBody = LoginRequest
+
+// AuthRequestPasswordResetJSONRequestBody defines body for AuthRequestPasswordReset for application/json ContentType.
+type AuthRequestPasswordResetJSONRequestBody = PasswordResetRequest
+
+// AuthResetPasswordJSONRequestBody defines body for AuthResetPassword for application/json ContentType.
+type AuthResetPasswordJSONRequestBody = ResetPasswordRequest
+
+// OrganizationsCreateOrganizationJSONRequestBody defines body for OrganizationsCreateOrganization for application/json ContentType.
+type OrganizationsCreateOrganizationJSONRequestBody = CreateOrganizationRequest
+
+// OrganizationsSetMemberJSONRequestBody defines body for OrganizationsSetMember for application/json ContentType.
+type OrganizationsSetMemberJSONRequestBody = SetMembershipRequest
+
+// UsersCreateUserJSONRequestBody defines body for UsersCreateUser for application/json ContentType.
+type UsersCreateUserJSONRequestBody = CreateUserRequest
+
+// UsersUpdateUserJSONRequestBody defines body for UsersUpdateUser for application/json ContentType.
+type UsersUpdateUserJSONRequestBody = UpdateUserRequest
+
+// UsersChangeUserPasswordJSONRequestBody defines body for UsersChangeUserPassword for application/json ContentType.
+type UsersChangeUserPasswordJSONRequestBody = ChangePasswordRequest
+
+// WebhooksCreateWebhookJSONRequestBody defines body for WebhooksCreateWebhook for application/json ContentType.
+type WebhooksCreateWebhookJSONRequestBody = CreateWebhookRequest
+
+// WebhooksUpdateWebhookJSONRequestBody defines body for WebhooksUpdateWebhook for application/json ContentType.
+type WebhooksUpdateWebhookJSONRequestBody = UpdateWebhookRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /api-keys)
+	ApiKeysListApiKeys(w http.ResponseWriter, r *http.Request, params ApiKeysListApiKeysParams)
+
+	// (POST /api-keys)
+	ApiKeysCreateApiKey(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /api-keys/{apiKeyId})
+	ApiKeysRevokeApiKey(w http.ResponseWriter, r *http.Request, apiKeyId string)
+
+	// (GET /api-keys/{apiKeyId})
+	ApiKeysGetApiKey(w http.ResponseWriter, r *http.Request, apiKeyId string)
+
+	// (GET /audit-events)
+	AuditEventsListAuditEvents(w http.ResponseWriter, r *http.Request, params AuditEventsListAuditEventsParams)
+
+	// (POST /auth/email-verification/confirm)
+	AuthVerifyEmail(w http.ResponseWriter, r *http.Request)
+
+	// (POST /auth/login)
+	AuthLogin(w http.ResponseWriter, r *http.Request)
+
+	// (POST /auth/logout)
+	AuthLogout(w http.ResponseWriter, r *http.Request)
+
+	// (POST /auth/password-reset)
+	AuthRequestPasswordReset(w http.ResponseWriter, r *http.Request)
+
+	// (POST /auth/password-reset/confirm)
+	AuthResetPassword(w http.ResponseWriter, r *http.Request)
+
+	// (GET /auth/sessions)
+	AuthListSessions(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /auth/sessions/{sessionId})
+	AuthRevokeSession(w http.ResponseWriter, r *http.Request, sessionId string)
+
+	// (GET /events/stream)
+	EventsStreamEvents(w http.ResponseWriter, r *http.Request, params EventsStreamEventsParams)
+
+	// (GET /inbound-events)
+	InboundEventsListInboundEvents(w http.ResponseWriter, r *http.Request, params InboundEventsListInboundEventsParams)
+
+	// (POST /inbound-events/{inboundEventId}/replay)
+	InboundEventsReplayInboundEvent(w http.ResponseWriter, r *http.Request, inboundEventId string)
+
+	// (GET /organizations)
+	OrganizationsListOrganizations(w http.ResponseWriter, r *http.Request)
+
+	// (POST /organizations)
+	OrganizationsCreateOrganization(w http.ResponseWriter, r *http.Request)
+
+	// (GET /organizations/current)
+	OrganizationsGetCurrentOrganization(w http.ResponseWriter, r *http.Request)
+
+	// (GET /organizations/current/members)
+	OrganizationsListMembers(w http.ResponseWriter, r *http.Request, params OrganizationsListMembersParams)
+
+	// (DELETE /organizations/current/members/{userId})
+	OrganizationsRemoveMember(w http.ResponseWriter, r *http.Request, userId string)
+
+	// (PUT /organizations/current/members/{userId})
+	OrganizationsSetMember(w http.ResponseWriter, r *http.Request, userId string)
+
+	// (GET /user-logs/verification)
+	UserLogsVerifyUserLogChain(w http.ResponseWriter, r *http.Request)
+
+	// (GET /users)
+	UsersListUsers(w http.ResponseWriter, r *http.Request, params UsersListUsersParams)
+
+	// (POST /users)
+	UsersCreateUser(w http.ResponseWriter, r *http.Request)
+
+	// (GET /users/export)
+	UsersExportUsers(w http.ResponseWriter, r *http.Request)
+
+	// (POST /users/imports)
+	UserImportsCreateUserImport(w http.ResponseWriter, r *http.Request)
+
+	// (GET /users/imports/{importId})
+	UserImportsGetUserImport(w http.ResponseWriter, r *http.Request, importId string)
+
+	// (GET /users/imports/{importId}/rows)
+	UserImportsListUserImportRows(w http.ResponseWriter, r *http.Request, importId string, params UserImportsListUserImportRowsParams)
+
+	// (DELETE /users/{userId})
+	UsersDeleteUser(w http.ResponseWriter, r *http.Request, userId string)
+
+	// (GET /users/{userId})
+	UsersGetUser(w http.ResponseWriter, r *http.Request, userId string)
+
+	// (PUT /users/{userId})
+	UsersUpdateUser(w http.ResponseWriter, r *http.Request, userId string)
+
+	// (POST /users/{userId}/email-verification)
+	UsersRequestEmailVerification(w http.ResponseWriter, r *http.Request, userId string)
+
+	// (GET /users/{userId}/logs)
+	UsersListUserLogs(w http.ResponseWriter, r *http.Request, userId string, params UsersListUserLogsParams)
+
+	// (PUT /users/{userId}/password)
+	UsersChangeUserPassword(w http.ResponseWriter, r *http.Request, userId string)
+
+	// (GET /webhooks)
+	WebhooksListWebhooks(w http.ResponseWriter, r *http.Request, params WebhooksListWebhooksParams)
+
+	// (POST /webhooks)
+	WebhooksCreateWebhook(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /webhooks/{webhookId})
+	WebhooksDeleteWebhook(w http.ResponseWriter, r *http.Request, webhookId string)
+
+	// (GET /webhooks/{webhookId})
+	WebhooksGetWebhook(w http.ResponseWriter, r *http.Request, webhookId string)
+
+	// (PUT /webhooks/{webhookId})
+	WebhooksUpdateWebhook(w http.ResponseWriter, r *http.Request, webhookId string)
+
+	// (GET /webhooks/{webhookId}/deliveries)
+	WebhooksListWebhookDeliveries(w http.ResponseWriter, r *http.Request, webhookId string, params WebhooksListWebhookDeliveriesParams)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// (GET /api-keys)
+func (_ Unimplemented) ApiKeysListApiKeys(w http.ResponseWriter, r *http.Request, params ApiKeysListApiKeysParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api-keys)
+func (_ Unimplemented) ApiKeysCreateApiKey(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /api-keys/{apiKeyId})
+func (_ Unimplemented) ApiKeysRevokeApiKey(w http.ResponseWriter, r *http.Request, apiKeyId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api-keys/{apiKeyId})
+func (_ Unimplemented) ApiKeysGetApiKey(w http.ResponseWriter, r *http.Request, apiKeyId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /audit-events)
+func (_ Unimplemented) AuditEventsListAuditEvents(w http.ResponseWriter, r *http.Request, params AuditEventsListAuditEventsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /auth/email-verification/confirm)
+func (_ Unimplemented) AuthVerifyEmail(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /auth/login)
+func (_ Unimplemented) AuthLogin(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /auth/logout)
+func (_ Unimplemented) AuthLogout(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /auth/password-reset)
+func (_ Unimplemented) AuthRequestPasswordReset(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /auth/password-reset/confirm)
+func (_ Unimplemented) AuthResetPassword(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /auth/sessions)
+func (_ Unimplemented) AuthListSessions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /auth/sessions/{sessionId})
+func (_ Unimplemented) AuthRevokeSession(w http.ResponseWriter, r *http.Request, sessionId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /events/stream)
+func (_ Unimplemented) EventsStreamEvents(w http.ResponseWriter, r *http.Request, params EventsStreamEventsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /inbound-events)
+func (_ Unimplemented) InboundEventsListInboundEvents(w http.ResponseWriter, r *http.Request, params InboundEventsListInboundEventsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /inbound-events/{inboundEventId}/replay)
+func (_ Unimplemented) InboundEventsReplayInboundEvent(w http.ResponseWriter, r *http.Request, inboundEventId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /organizations)
+func (_ Unimplemented) OrganizationsListOrganizations(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /organizations)
+func (_ Unimplemented) OrganizationsCreateOrganization(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /organizations/current)
+func (_ Unimplemented) OrganizationsGetCurrentOrganization(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /organizations/current/members)
+func (_ Unimplemented) OrganizationsListMembers(w http.ResponseWriter, r *http.Request, params OrganizationsListMembersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /organizations/current/members/{userId})
+func (_ Unimplemented) OrganizationsRemoveMember(w http.ResponseWriter, r *http.Request, userId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /organizations/current/members/{userId})
+func (_ Unimplemented) OrganizationsSetMember(w http.ResponseWriter, r *http.Request, userId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /user-logs/verification)
+func (_ Unimplemented) UserLogsVerifyUserLogChain(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /users)
+func (_ Unimplemented) UsersListUsers(w http.ResponseWriter, r *http.Request, params UsersListUsersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /users)
+func (_ Unimplemented) UsersCreateUser(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /users/export)
+func (_ Unimplemented) UsersExportUsers(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /users/imports)
+func (_ Unimplemented) UserImportsCreateUserImport(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /users/imports/{importId})
+func (_ Unimplemented) UserImportsGetUserImport(w http.ResponseWriter, r *http.Request, importId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /users/imports/{importId}/rows)
+func (_ Unimplemented) UserImportsListUserImportRows(w http.ResponseWriter, r *http.Request, importId string, params UserImportsListUserImportRowsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /users/{userId})
+func (_ Unimplemented) UsersDeleteUser(w http.ResponseWriter, r *http.Request, userId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /users/{userId})
+func (_ Unimplemented) UsersGetUser(w http.ResponseWriter, r *http.Request, userId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /users/{userId})
+func (_ Unimplemented) UsersUpdateUser(w http.ResponseWriter, r *http.Request, userId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /users/{userId}/email-verification)
+func (_ Unimplemented) UsersRequestEmailVerification(w http.ResponseWriter, r *http.Request, userId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /users/{userId}/logs)
+func (_ Unimplemented) UsersListUserLogs(w http.ResponseWriter, r *http.Request, userId string, params UsersListUserLogsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /users/{userId}/password)
+func (_ Unimplemented) UsersChangeUserPassword(w http.ResponseWriter, r *http.Request, userId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /webhooks)
+func (_ Unimplemented) WebhooksListWebhooks(w http.ResponseWriter, r *http.Request, params WebhooksListWebhooksParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /webhooks)
+func (_ Unimplemented) WebhooksCreateWebhook(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /webhooks/{webhookId})
+func (_ Unimplemented) WebhooksDeleteWebhook(w http.ResponseWriter, r *http.Request, webhookId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /webhooks/{webhookId})
+func (_ Unimplemented) WebhooksGetWebhook(w http.ResponseWriter, r *http.Request, webhookId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /webhooks/{webhookId})
+func (_ Unimplemented) WebhooksUpdateWebhook(w http.ResponseWriter, r *http.Request, webhookId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /webhooks/{webhookId}/deliveries)
+func (_ Unimplemented) WebhooksListWebhookDeliveries(w http.ResponseWriter, r *http.Request, webhookId string, params WebhooksListWebhookDeliveriesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// ApiKeysListApiKeys operation middleware
+func (siw *ServerInterfaceWrapper) ApiKeysListApiKeys(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ApiKeysListApiKeysParams
+
+	// ------------- Optional query parameter "includeRevoked" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "includeRevoked", r.URL.Query(), &params.IncludeRevoked)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "includeRevoked", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApiKeysListApiKeys(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ApiKeysCreateApiKey operation middleware
+func (siw *ServerInterfaceWrapper) ApiKeysCreateApiKey(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApiKeysCreateApiKey(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ApiKeysRevokeApiKey operation middleware
+func (siw *ServerInterfaceWrapper) ApiKeysRevokeApiKey(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "apiKeyId" -------------
+	var apiKeyId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "apiKeyId", chi.URLParam(r, "apiKeyId"), &apiKeyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "apiKeyId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApiKeysRevokeApiKey(w, r, apiKeyId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ApiKeysGetApiKey operation middleware
+func (siw *ServerInterfaceWrapper) ApiKeysGetApiKey(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "apiKeyId" -------------
+	var apiKeyId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "apiKeyId", chi.URLParam(r, "apiKeyId"), &apiKeyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "apiKeyId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApiKeysGetApiKey(w, r, apiKeyId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AuditEventsListAuditEvents operation middleware
+func (siw *ServerInterfaceWrapper) AuditEventsListAuditEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AuditEventsListAuditEventsParams
+
+	// ------------- Optional query parameter "aggregateType" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "aggregateType", r.URL.Query(), &params.AggregateType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "aggregateType", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "aggregateId" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "aggregateId", r.URL.Query(), &params.AggregateId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "aggregateId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "action", r.URL.Query(), &params.Action)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "action", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "actor", r.URL.Query(), &params.Actor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "until", r.URL.Query(), &params.Until)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "until", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AuditEventsListAuditEvents(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AuthVerifyEmail operation middleware
+func (siw *ServerInterfaceWrapper) AuthVerifyEmail(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AuthVerifyEmail(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AuthLogin operation middleware
+func (siw *ServerInterfaceWrapper) AuthLogin(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AuthLogin(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AuthLogout operation middleware
+func (siw *ServerInterfaceWrapper) AuthLogout(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AuthLogout(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AuthRequestPasswordReset operation middleware
+func (siw *ServerInterfaceWrapper) AuthRequestPasswordReset(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AuthRequestPasswordReset(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AuthResetPassword operation middleware
+func (siw *ServerInterfaceWrapper) AuthResetPassword(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AuthResetPassword(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AuthListSessions operation middleware
+func (siw *ServerInterfaceWrapper) AuthListSessions(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AuthListSessions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AuthRevokeSession operation middleware
+func (siw *ServerInterfaceWrapper) AuthRevokeSession(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", chi.URLParam(r, "sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AuthRevokeSession(w, r, sessionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// EventsStreamEvents operation middleware
+func (siw *ServerInterfaceWrapper) EventsStreamEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EventsStreamEventsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Last-Event-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Last-Event-ID", Err: err})
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EventsStreamEvents(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// InboundEventsListInboundEvents operation middleware
+func (siw *ServerInterfaceWrapper) InboundEventsListInboundEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params InboundEventsListInboundEventsParams
+
+	// ------------- Optional query parameter "provider" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "provider", r.URL.Query(), &params.Provider)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "provider", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.InboundEventsListInboundEvents(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// InboundEventsReplayInboundEvent operation middleware
+func (siw *ServerInterfaceWrapper) InboundEventsReplayInboundEvent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "inboundEventId" -------------
+	var inboundEventId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "inboundEventId", chi.URLParam(r, "inboundEventId"), &inboundEventId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "inboundEventId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.InboundEventsReplayInboundEvent(w, r, inboundEventId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// OrganizationsListOrganizations operation middleware
+func (siw *ServerInterfaceWrapper) OrganizationsListOrganizations(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.OrganizationsListOrganizations(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// OrganizationsCreateOrganization operation middleware
+func (siw *ServerInterfaceWrapper) OrganizationsCreateOrganization(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.OrganizationsCreateOrganization(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// OrganizationsGetCurrentOrganization operation middleware
+func (siw *ServerInterfaceWrapper) OrganizationsGetCurrentOrganization(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.OrganizationsGetCurrentOrganization(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// OrganizationsListMembers operation middleware
+func (siw *ServerInterfaceWrapper) OrganizationsListMembers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params OrganizationsListMembersParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.OrganizationsListMembers(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// OrganizationsRemoveMember operation middleware
+func (siw *ServerInterfaceWrapper) OrganizationsRemoveMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.OrganizationsRemoveMember(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// OrganizationsSetMember operation middleware
+func (siw *ServerInterfaceWrapper) OrganizationsSetMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.OrganizationsSetMember(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UserLogsVerifyUserLogChain operation middleware
+func (siw *ServerInterfaceWrapper) UserLogsVerifyUserLogChain(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UserLogsVerifyUserLogChain(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UsersListUsers operation middleware
+func (siw *ServerInterfaceWrapper) UsersListUsers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UsersListUsersParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UsersListUsers(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UsersCreateUser operation middleware
+func (siw *ServerInterfaceWrapper) UsersCreateUser(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UsersCreateUser(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UsersExportUsers operation middleware
+func (siw *ServerInterfaceWrapper) UsersExportUsers(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UsersExportUsers(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UserImportsCreateUserImport operation middleware
+func (siw *ServerInterfaceWrapper) UserImportsCreateUserImport(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UserImportsCreateUserImport(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UserImportsGetUserImport operation middleware
+func (siw *ServerInterfaceWrapper) UserImportsGetUserImport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "importId" -------------
+	var importId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "importId", chi.URLParam(r, "importId"), &importId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "importId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UserImportsGetUserImport(w, r, importId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UserImportsListUserImportRows operation middleware
+func (siw *ServerInterfaceWrapper) UserImportsListUserImportRows(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "importId" -------------
+	var importId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "importId", chi.URLParam(r, "importId"), &importId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "importId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UserImportsListUserImportRowsParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UserImportsListUserImportRows(w, r, importId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UsersDeleteUser operation middleware
+func (siw *ServerInterfaceWrapper) UsersDeleteUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UsersDeleteUser(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UsersGetUser operation middleware
+func (siw *ServerInterfaceWrapper) UsersGetUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UsersGetUser(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UsersUpdateUser operation middleware
+func (siw *ServerInterfaceWrapper) UsersUpdateUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UsersUpdateUser(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UsersRequestEmailVerification operation middleware
+func (siw *ServerInterfaceWrapper) UsersRequestEmailVerification(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UsersRequestEmailVerification(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UsersListUserLogs operation middleware
+func (siw *ServerInterfaceWrapper) UsersListUserLogs(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UsersListUserLogsParams
+
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "action", r.URL.Query(), &params.Action)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "action", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
 
-	// UpdatedAt Last update timestamp
-	UpdatedAt time.Time `json:"updatedAt"`
-}
+	err = runtime.BindQueryParameter("form", false, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-// UserList User list response
-type UserList struct {
-	// Total Total number of users
-	Total int32 `json:"total"`
+	// ------------- Optional query parameter "offset" -------------
 
-	// Users List of users
-	Users []User `json:"users"`
-}
+	err = runtime.BindQueryParameter("form", false, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
 
-// UsersListUsersParams defines parameters for UsersListUsers.
-type UsersListUsersParams struct {
-	// Limit Maximum number of users to return
-	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UsersListUserLogs(w, r, userId, params)
+	}))
 
-	// Offset Number of users to skip
-	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-// UsersCreateUserJSONRequestBody defines body for UsersCreateUser for application/json ContentType.
-type UsersCreateUserJSONRequestBody = CreateUserRequest
+// UsersChangeUserPassword operation middleware
+func (siw *ServerInterfaceWrapper) UsersChangeUserPassword(w http.ResponseWriter, r *http.Request) {
 
-// UsersUpdateUserJSONRequestBody defines body for UsersUpdateUser for application/json ContentType.
-type UsersUpdateUserJSONRequestBody = UpdateUserRequest
+	var err error
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
+	// ------------- Path parameter "userId" -------------
+	var userId string
 
-	// (GET /users)
-	UsersListUsers(w http.ResponseWriter, r *http.Request, params UsersListUsersParams)
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
 
-	// (POST /users)
-	UsersCreateUser(w http.ResponseWriter, r *http.Request)
+	ctx := r.Context()
 
-	// (DELETE /users/{userId})
-	UsersDeleteUser(w http.ResponseWriter, r *http.Request, userId string)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	// (GET /users/{userId})
-	UsersGetUser(w http.ResponseWriter, r *http.Request, userId string)
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-	// (PUT /users/{userId})
-	UsersUpdateUser(w http.ResponseWriter, r *http.Request, userId string)
-}
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
 
-// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+	r = r.WithContext(ctx)
 
-type Unimplemented struct{}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UsersChangeUserPassword(w, r, userId)
+	}))
 
-// (GET /users)
-func (_ Unimplemented) UsersListUsers(w http.ResponseWriter, r *http.Request, params UsersListUsersParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-// (POST /users)
-func (_ Unimplemented) UsersCreateUser(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+	handler.ServeHTTP(w, r)
 }
 
-// (DELETE /users/{userId})
-func (_ Unimplemented) UsersDeleteUser(w http.ResponseWriter, r *http.Request, userId string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// WebhooksListWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) WebhooksListWebhooks(w http.ResponseWriter, r *http.Request) {
 
-// (GET /users/{userId})
-func (_ Unimplemented) UsersGetUser(w http.ResponseWriter, r *http.Request, userId string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	var err error
 
-// (PUT /users/{userId})
-func (_ Unimplemented) UsersUpdateUser(w http.ResponseWriter, r *http.Request, userId string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	ctx := r.Context()
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type MiddlewareFunc func(http.Handler) http.Handler
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-// UsersListUsers operation middleware
-func (siw *ServerInterfaceWrapper) UsersListUsers(w http.ResponseWriter, r *http.Request) {
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
 
-	var err error
+	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params UsersListUsersParams
+	var params WebhooksListWebhooksParams
 
 	// ------------- Optional query parameter "limit" -------------
 
@@ -164,7 +2550,7 @@ func (siw *ServerInterfaceWrapper) UsersListUsers(w http.ResponseWriter, r *http
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UsersListUsers(w, r, params)
+		siw.Handler.WebhooksListWebhooks(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -174,11 +2560,21 @@ func (siw *ServerInterfaceWrapper) UsersListUsers(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// UsersCreateUser operation middleware
-func (siw *ServerInterfaceWrapper) UsersCreateUser(w http.ResponseWriter, r *http.Request) {
+// WebhooksCreateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) WebhooksCreateWebhook(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UsersCreateUser(w, r)
+		siw.Handler.WebhooksCreateWebhook(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -188,22 +2584,32 @@ func (siw *ServerInterfaceWrapper) UsersCreateUser(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// UsersDeleteUser operation middleware
-func (siw *ServerInterfaceWrapper) UsersDeleteUser(w http.ResponseWriter, r *http.Request) {
+// WebhooksDeleteWebhook operation middleware
+func (siw *ServerInterfaceWrapper) WebhooksDeleteWebhook(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "userId" -------------
-	var userId string
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", chi.URLParam(r, "webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookId", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UsersDeleteUser(w, r, userId)
+		siw.Handler.WebhooksDeleteWebhook(w, r, webhookId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -213,22 +2619,67 @@ func (siw *ServerInterfaceWrapper) UsersDeleteUser(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// UsersGetUser operation middleware
-func (siw *ServerInterfaceWrapper) UsersGetUser(w http.ResponseWriter, r *http.Request) {
+// WebhooksGetWebhook operation middleware
+func (siw *ServerInterfaceWrapper) WebhooksGetWebhook(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "userId" -------------
-	var userId string
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", chi.URLParam(r, "webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WebhooksGetWebhook(w, r, webhookId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WebhooksUpdateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) WebhooksUpdateWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", chi.URLParam(r, "webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookId", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UsersGetUser(w, r, userId)
+		siw.Handler.WebhooksUpdateWebhook(w, r, webhookId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -238,22 +2689,51 @@ func (siw *ServerInterfaceWrapper) UsersGetUser(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// UsersUpdateUser operation middleware
-func (siw *ServerInterfaceWrapper) UsersUpdateUser(w http.ResponseWriter, r *http.Request) {
+// WebhooksListWebhookDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) WebhooksListWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "userId" -------------
-	var userId string
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", chi.URLParam(r, "webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionCookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WebhooksListWebhookDeliveriesParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UsersUpdateUser(w, r, userId)
+		siw.Handler.WebhooksListWebhookDeliveries(w, r, webhookId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -376,12 +2856,90 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api-keys", wrapper.ApiKeysListApiKeys)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api-keys", wrapper.ApiKeysCreateApiKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api-keys/{apiKeyId}", wrapper.ApiKeysRevokeApiKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api-keys/{apiKeyId}", wrapper.ApiKeysGetApiKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/audit-events", wrapper.AuditEventsListAuditEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/email-verification/confirm", wrapper.AuthVerifyEmail)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/login", wrapper.AuthLogin)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/logout", wrapper.AuthLogout)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/password-reset", wrapper.AuthRequestPasswordReset)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/password-reset/confirm", wrapper.AuthResetPassword)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/auth/sessions", wrapper.AuthListSessions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/auth/sessions/{sessionId}", wrapper.AuthRevokeSession)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/events/stream", wrapper.EventsStreamEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/inbound-events", wrapper.InboundEventsListInboundEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/inbound-events/{inboundEventId}/replay", wrapper.InboundEventsReplayInboundEvent)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/organizations", wrapper.OrganizationsListOrganizations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/organizations", wrapper.OrganizationsCreateOrganization)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/organizations/current", wrapper.OrganizationsGetCurrentOrganization)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/organizations/current/members", wrapper.OrganizationsListMembers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/organizations/current/members/{userId}", wrapper.OrganizationsRemoveMember)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/organizations/current/members/{userId}", wrapper.OrganizationsSetMember)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/user-logs/verification", wrapper.UserLogsVerifyUserLogChain)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/users", wrapper.UsersListUsers)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/users", wrapper.UsersCreateUser)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/export", wrapper.UsersExportUsers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/imports", wrapper.UserImportsCreateUserImport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/imports/{importId}", wrapper.UserImportsGetUserImport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/imports/{importId}/rows", wrapper.UserImportsListUserImportRows)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/users/{userId}", wrapper.UsersDeleteUser)
 	})
@@ -391,6 +2949,33 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/users/{userId}", wrapper.UsersUpdateUser)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/{userId}/email-verification", wrapper.UsersRequestEmailVerification)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{userId}/logs", wrapper.UsersListUserLogs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/users/{userId}/password", wrapper.UsersChangeUserPassword)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhooks", wrapper.WebhooksListWebhooks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhooks", wrapper.WebhooksCreateWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/webhooks/{webhookId}", wrapper.WebhooksDeleteWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhooks/{webhookId}", wrapper.WebhooksGetWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/webhooks/{webhookId}", wrapper.WebhooksUpdateWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhooks/{webhookId}/deliveries", wrapper.WebhooksListWebhookDeliveries)
+	})
 
 	return r
 }
@@ -0,0 +1,336 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+import {
+  useMutation,
+  useQuery
+} from '@tanstack/react-query';
+import type {
+  DataTag,
+  DefinedInitialDataOptions,
+  DefinedUseQueryResult,
+  MutationFunction,
+  QueryClient,
+  QueryFunction,
+  QueryKey,
+  UndefinedInitialDataOptions,
+  UseMutationOptions,
+  UseMutationResult,
+  UseQueryOptions,
+  UseQueryResult
+} from '@tanstack/react-query';
+
+import type {
+  ApiKey,
+  ApiKeyList,
+  ApiKeysListApiKeysParams,
+  CreateApiKeyRequest,
+  CreatedApiKey,
+  Error
+} from '.././models';
+
+import { customInstance } from '../../axios-instance';
+
+
+
+
+/**
+ * Get API keys, newest first
+ */
+export const apiKeysListApiKeys = (
+    params?: ApiKeysListApiKeysParams,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<ApiKeyList>(
+      {url: `/api-keys`, method: 'GET',
+        params, signal
+    },
+      );
+    }
+  
+
+
+
+export const getApiKeysListApiKeysQueryKey = (params?: ApiKeysListApiKeysParams,) => {
+    return [
+    `/api-keys`, ...(params ? [params]: [])
+    ] as const;
+    }
+
+    
+export const getApiKeysListApiKeysQueryOptions = <TData = Awaited<ReturnType<typeof apiKeysListApiKeys>>, TError = Error>(params?: ApiKeysListApiKeysParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof apiKeysListApiKeys>>, TError, TData>>, }
+) => {
+
+const {query: queryOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getApiKeysListApiKeysQueryKey(params);
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof apiKeysListApiKeys>>> = ({ signal }) => apiKeysListApiKeys(params, signal);
+
+      
+
+      
+
+   return  { queryKey, queryFn, ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof apiKeysListApiKeys>>, TError, TData> & { queryKey: DataTag<QueryKey, TData> }
+}
+
+export type ApiKeysListApiKeysQueryResult = NonNullable<Awaited<ReturnType<typeof apiKeysListApiKeys>>>
+export type ApiKeysListApiKeysQueryError = Error
+
+
+export function useApiKeysListApiKeys<TData = Awaited<ReturnType<typeof apiKeysListApiKeys>>, TError = Error>(
+ params: undefined |  ApiKeysListApiKeysParams, options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof apiKeysListApiKeys>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof apiKeysListApiKeys>>,
+          TError,
+          Awaited<ReturnType<typeof apiKeysListApiKeys>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+export function useApiKeysListApiKeys<TData = Awaited<ReturnType<typeof apiKeysListApiKeys>>, TError = Error>(
+ params?: ApiKeysListApiKeysParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof apiKeysListApiKeys>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof apiKeysListApiKeys>>,
+          TError,
+          Awaited<ReturnType<typeof apiKeysListApiKeys>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+export function useApiKeysListApiKeys<TData = Awaited<ReturnType<typeof apiKeysListApiKeys>>, TError = Error>(
+ params?: ApiKeysListApiKeysParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof apiKeysListApiKeys>>, TError, TData>>, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+
+export function useApiKeysListApiKeys<TData = Awaited<ReturnType<typeof apiKeysListApiKeys>>, TError = Error>(
+ params?: ApiKeysListApiKeysParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof apiKeysListApiKeys>>, TError, TData>>, }
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> } {
+
+  const queryOptions = getApiKeysListApiKeysQueryOptions(params,options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+/**
+ * Create an API key. The secret is included in the response only this once.
+ */
+export const apiKeysCreateApiKey = (
+    createApiKeyRequest: CreateApiKeyRequest,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<CreatedApiKey>(
+      {url: `/api-keys`, method: 'POST',
+      headers: {'Content-Type': 'application/json', },
+      data: createApiKeyRequest, signal
+    },
+      );
+    }
+  
+
+
+export const getApiKeysCreateApiKeyMutationOptions = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof apiKeysCreateApiKey>>, TError,{data: CreateApiKeyRequest}, TContext>, }
+): UseMutationOptions<Awaited<ReturnType<typeof apiKeysCreateApiKey>>, TError,{data: CreateApiKeyRequest}, TContext> => {
+
+const mutationKey = ['apiKeysCreateApiKey'];
+const {mutation: mutationOptions} = options ?
+      options.mutation && 'mutationKey' in options.mutation && options.mutation.mutationKey ?
+      options
+      : {...options, mutation: {...options.mutation, mutationKey}}
+      : {mutation: { mutationKey, }};
+
+      
+
+
+      const mutationFn: MutationFunction<Awaited<ReturnType<typeof apiKeysCreateApiKey>>, {data: CreateApiKeyRequest}> = (props) => {
+          const {data} = props ?? {};
+
+          return  apiKeysCreateApiKey(data,)
+        }
+
+        
+
+
+  return  { mutationFn, ...mutationOptions }}
+
+    export type ApiKeysCreateApiKeyMutationResult = NonNullable<Awaited<ReturnType<typeof apiKeysCreateApiKey>>>
+    export type ApiKeysCreateApiKeyMutationBody = CreateApiKeyRequest
+    export type ApiKeysCreateApiKeyMutationError = Error
+
+    export const useApiKeysCreateApiKey = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof apiKeysCreateApiKey>>, TError,{data: CreateApiKeyRequest}, TContext>, }
+ , queryClient?: QueryClient): UseMutationResult<
+        Awaited<ReturnType<typeof apiKeysCreateApiKey>>,
+        TError,
+        {data: CreateApiKeyRequest},
+        TContext
+      > => {
+
+      const mutationOptions = getApiKeysCreateApiKeyMutationOptions(options);
+
+      return useMutation(mutationOptions, queryClient);
+    }
+    /**
+ * Get API key by ID
+ */
+export const apiKeysGetApiKey = (
+    apiKeyId: string,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<ApiKey>(
+      {url: `/api-keys/${apiKeyId}`, method: 'GET', signal
+    },
+      );
+    }
+  
+
+
+
+export const getApiKeysGetApiKeyQueryKey = (apiKeyId?: string,) => {
+    return [
+    `/api-keys/${apiKeyId}`
+    ] as const;
+    }
+
+    
+export const getApiKeysGetApiKeyQueryOptions = <TData = Awaited<ReturnType<typeof apiKeysGetApiKey>>, TError = Error>(apiKeyId: string, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof apiKeysGetApiKey>>, TError, TData>>, }
+) => {
+
+const {query: queryOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getApiKeysGetApiKeyQueryKey(apiKeyId);
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof apiKeysGetApiKey>>> = ({ signal }) => apiKeysGetApiKey(apiKeyId, signal);
+
+      
+
+      
+
+   return  { queryKey, queryFn, enabled: !!(apiKeyId), ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof apiKeysGetApiKey>>, TError, TData> & { queryKey: DataTag<QueryKey, TData> }
+}
+
+export type ApiKeysGetApiKeyQueryResult = NonNullable<Awaited<ReturnType<typeof apiKeysGetApiKey>>>
+export type ApiKeysGetApiKeyQueryError = Error
+
+
+export function useApiKeysGetApiKey<TData = Awaited<ReturnType<typeof apiKeysGetApiKey>>, TError = Error>(
+ apiKeyId: string, options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof apiKeysGetApiKey>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof apiKeysGetApiKey>>,
+          TError,
+          Awaited<ReturnType<typeof apiKeysGetApiKey>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+export function useApiKeysGetApiKey<TData = Awaited<ReturnType<typeof apiKeysGetApiKey>>, TError = Error>(
+ apiKeyId: string, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof apiKeysGetApiKey>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof apiKeysGetApiKey>>,
+          TError,
+          Awaited<ReturnType<typeof apiKeysGetApiKey>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+export function useApiKeysGetApiKey<TData = Awaited<ReturnType<typeof apiKeysGetApiKey>>, TError = Error>(
+ apiKeyId: string, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof apiKeysGetApiKey>>, TError, TData>>, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+
+export function useApiKeysGetApiKey<TData = Awaited<ReturnType<typeof apiKeysGetApiKey>>, TError = Error>(
+ apiKeyId: string, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof apiKeysGetApiKey>>, TError, TData>>, }
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> } {
+
+  const queryOptions = getApiKeysGetApiKeyQueryOptions(apiKeyId,options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+/**
+ * Revoke API key. Revoking an already revoked key has no effect.
+ */
+export const apiKeysRevokeApiKey = (
+    apiKeyId: string,
+ ) => {
+      
+      
+      return customInstance<void>(
+      {url: `/api-keys/${apiKeyId}`, method: 'DELETE'
+    },
+      );
+    }
+  
+
+
+export const getApiKeysRevokeApiKeyMutationOptions = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof apiKeysRevokeApiKey>>, TError,{apiKeyId: string}, TContext>, }
+): UseMutationOptions<Awaited<ReturnType<typeof apiKeysRevokeApiKey>>, TError,{apiKeyId: string}, TContext> => {
+
+const mutationKey = ['apiKeysRevokeApiKey'];
+const {mutation: mutationOptions} = options ?
+      options.mutation && 'mutationKey' in options.mutation && options.mutation.mutationKey ?
+      options
+      : {...options, mutation: {...options.mutation, mutationKey}}
+      : {mutation: { mutationKey, }};
+
+      
+
+
+      const mutationFn: MutationFunction<Awaited<ReturnType<typeof apiKeysRevokeApiKey>>, {apiKeyId: string}> = (props) => {
+          const {apiKeyId} = props ?? {};
+
+          return  apiKeysRevokeApiKey(apiKeyId,)
+        }
+
+        
+
+
+  return  { mutationFn, ...mutationOptions }}
+
+    export type ApiKeysRevokeApiKeyMutationResult = NonNullable<Awaited<ReturnType<typeof apiKeysRevokeApiKey>>>
+    
+    export type ApiKeysRevokeApiKeyMutationError = Error
+
+    export const useApiKeysRevokeApiKey = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof apiKeysRevokeApiKey>>, TError,{apiKeyId: string}, TContext>, }
+ , queryClient?: QueryClient): UseMutationResult<
+        Awaited<ReturnType<typeof apiKeysRevokeApiKey>>,
+        TError,
+        {apiKeyId: string},
+        TContext
+      > => {
+
+      const mutationOptions = getApiKeysRevokeApiKeyMutationOptions(options);
+
+      return useMutation(mutationOptions, queryClient);
+    }
+    
\ No newline at end of file
@@ -0,0 +1,211 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+import {
+  useQuery
+} from '@tanstack/react-query';
+import type {
+  DataTag,
+  DefinedInitialDataOptions,
+  DefinedUseQueryResult,
+  QueryClient,
+  QueryFunction,
+  QueryKey,
+  UndefinedInitialDataOptions,
+  UseQueryOptions,
+  UseQueryResult
+} from '@tanstack/react-query';
+
+import type {
+  AuditEventList,
+  AuditEventsListAuditEventsParams,
+  Error,
+  UserLogChainVerification
+} from '.././models';
+
+import { customInstance } from '../../axios-instance';
+
+
+
+
+/**
+ * Search audit events of all aggregates, newest first
+ */
+export const auditEventsListAuditEvents = (
+    params?: AuditEventsListAuditEventsParams,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<AuditEventList>(
+      {url: `/audit-events`, method: 'GET',
+        params, signal
+    },
+      );
+    }
+  
+
+
+
+export const getAuditEventsListAuditEventsQueryKey = (params?: AuditEventsListAuditEventsParams,) => {
+    return [
+    `/audit-events`, ...(params ? [params]: [])
+    ] as const;
+    }
+
+    
+export const getAuditEventsListAuditEventsQueryOptions = <TData = Awaited<ReturnType<typeof auditEventsListAuditEvents>>, TError = Error>(params?: AuditEventsListAuditEventsParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof auditEventsListAuditEvents>>, TError, TData>>, }
+) => {
+
+const {query: queryOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getAuditEventsListAuditEventsQueryKey(params);
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof auditEventsListAuditEvents>>> = ({ signal }) => auditEventsListAuditEvents(params, signal);
+
+      
+
+      
+
+   return  { queryKey, queryFn, ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof auditEventsListAuditEvents>>, TError, TData> & { queryKey: DataTag<QueryKey, TData> }
+}
+
+export type AuditEventsListAuditEventsQueryResult = NonNullable<Awaited<ReturnType<typeof auditEventsListAuditEvents>>>
+export type AuditEventsListAuditEventsQueryError = Error
+
+
+export function useAuditEventsListAuditEvents<TData = Awaited<ReturnType<typeof auditEventsListAuditEvents>>, TError = Error>(
+ params: undefined |  AuditEventsListAuditEventsParams, options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof auditEventsListAuditEvents>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof auditEventsListAuditEvents>>,
+          TError,
+          Awaited<ReturnType<typeof auditEventsListAuditEvents>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+export function useAuditEventsListAuditEvents<TData = Awaited<ReturnType<typeof auditEventsListAuditEvents>>, TError = Error>(
+ params?: AuditEventsListAuditEventsParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof auditEventsListAuditEvents>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof auditEventsListAuditEvents>>,
+          TError,
+          Awaited<ReturnType<typeof auditEventsListAuditEvents>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+export function useAuditEventsListAuditEvents<TData = Awaited<ReturnType<typeof auditEventsListAuditEvents>>, TError = Error>(
+ params?: AuditEventsListAuditEventsParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof auditEventsListAuditEvents>>, TError, TData>>, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+
+export function useAuditEventsListAuditEvents<TData = Awaited<ReturnType<typeof auditEventsListAuditEvents>>, TError = Error>(
+ params?: AuditEventsListAuditEventsParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof auditEventsListAuditEvents>>, TError, TData>>, }
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> } {
+
+  const queryOptions = getAuditEventsListAuditEventsQueryOptions(params,options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+/**
+ * Verify that no user log entry of the current organization has been edited or deleted by walking its hash chain.
+ * Reports the first broken link.
+ */
+export const userLogsVerifyUserLogChain = (
+    
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<UserLogChainVerification>(
+      {url: `/user-logs/verification`, method: 'GET', signal
+    },
+      );
+    }
+  
+
+
+
+export const getUserLogsVerifyUserLogChainQueryKey = () => {
+    return [
+    `/user-logs/verification`
+    ] as const;
+    }
+
+    
+export const getUserLogsVerifyUserLogChainQueryOptions = <TData = Awaited<ReturnType<typeof userLogsVerifyUserLogChain>>, TError = Error>(options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof userLogsVerifyUserLogChain>>, TError, TData>>, }
+) => {
+
+const {query: queryOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getUserLogsVerifyUserLogChainQueryKey();
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof userLogsVerifyUserLogChain>>> = ({ signal }) => userLogsVerifyUserLogChain(signal);
+
+      
+
+      
+
+   return  { queryKey, queryFn, ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof userLogsVerifyUserLogChain>>, TError, TData> & { queryKey: DataTag<QueryKey, TData> }
+}
+
+export type UserLogsVerifyUserLogChainQueryResult = NonNullable<Awaited<ReturnType<typeof userLogsVerifyUserLogChain>>>
+export type UserLogsVerifyUserLogChainQueryError = Error
+
+
+export function useUserLogsVerifyUserLogChain<TData = Awaited<ReturnType<typeof userLogsVerifyUserLogChain>>, TError = Error>(
+ options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof userLogsVerifyUserLogChain>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof userLogsVerifyUserLogChain>>,
+          TError,
+          Awaited<ReturnType<typeof userLogsVerifyUserLogChain>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+export function useUserLogsVerifyUserLogChain<TData = Awaited<ReturnType<typeof userLogsVerifyUserLogChain>>, TError = Error>(
+ options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof userLogsVerifyUserLogChain>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof userLogsVerifyUserLogChain>>,
+          TError,
+          Awaited<ReturnType<typeof userLogsVerifyUserLogChain>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+export function useUserLogsVerifyUserLogChain<TData = Awaited<ReturnType<typeof userLogsVerifyUserLogChain>>, TError = Error>(
+ options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof userLogsVerifyUserLogChain>>, TError, TData>>, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+
+export function useUserLogsVerifyUserLogChain<TData = Awaited<ReturnType<typeof userLogsVerifyUserLogChain>>, TError = Error>(
+ options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof userLogsVerifyUserLogChain>>, TError, TData>>, }
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> } {
+
+  const queryOptions = getUserLogsVerifyUserLogChainQueryOptions(options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
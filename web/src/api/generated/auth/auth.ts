@@ -0,0 +1,492 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+import {
+  useMutation,
+  useQuery
+} from '@tanstack/react-query';
+import type {
+  DataTag,
+  DefinedInitialDataOptions,
+  DefinedUseQueryResult,
+  MutationFunction,
+  QueryClient,
+  QueryFunction,
+  QueryKey,
+  UndefinedInitialDataOptions,
+  UseMutationOptions,
+  UseMutationResult,
+  UseQueryOptions,
+  UseQueryResult
+} from '@tanstack/react-query';
+
+import type {
+  Error,
+  LoginRequest,
+  PasswordResetRequest,
+  ResetPasswordRequest,
+  Session,
+  SessionList,
+  VerifyEmailRequest
+} from '.././models';
+
+import { customInstance } from '../../axios-instance';
+
+
+
+
+/**
+ * Log in with email and password. The session token is set in an HttpOnly cookie.
+ * Repeated failures for the same email lock it out for a while (429 with Retry-After).
+ */
+export const authLogin = (
+    loginRequest: LoginRequest,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<Session>(
+      {url: `/auth/login`, method: 'POST',
+      headers: {'Content-Type': 'application/json', },
+      data: loginRequest, signal
+    },
+      );
+    }
+  
+
+
+export const getAuthLoginMutationOptions = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof authLogin>>, TError,{data: LoginRequest}, TContext>, }
+): UseMutationOptions<Awaited<ReturnType<typeof authLogin>>, TError,{data: LoginRequest}, TContext> => {
+
+const mutationKey = ['authLogin'];
+const {mutation: mutationOptions} = options ?
+      options.mutation && 'mutationKey' in options.mutation && options.mutation.mutationKey ?
+      options
+      : {...options, mutation: {...options.mutation, mutationKey}}
+      : {mutation: { mutationKey, }};
+
+      
+
+
+      const mutationFn: MutationFunction<Awaited<ReturnType<typeof authLogin>>, {data: LoginRequest}> = (props) => {
+          const {data} = props ?? {};
+
+          return  authLogin(data,)
+        }
+
+        
+
+
+  return  { mutationFn, ...mutationOptions }}
+
+    export type AuthLoginMutationResult = NonNullable<Awaited<ReturnType<typeof authLogin>>>
+    export type AuthLoginMutationBody = LoginRequest
+    export type AuthLoginMutationError = Error
+
+    export const useAuthLogin = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof authLogin>>, TError,{data: LoginRequest}, TContext>, }
+ , queryClient?: QueryClient): UseMutationResult<
+        Awaited<ReturnType<typeof authLogin>>,
+        TError,
+        {data: LoginRequest},
+        TContext
+      > => {
+
+      const mutationOptions = getAuthLoginMutationOptions(options);
+
+      return useMutation(mutationOptions, queryClient);
+    }
+    /**
+ * Log out by revoking the current session and clearing the session cookie
+ */
+export const authLogout = (
+    
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<void>(
+      {url: `/auth/logout`, method: 'POST', signal
+    },
+      );
+    }
+  
+
+
+export const getAuthLogoutMutationOptions = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof authLogout>>, TError,void, TContext>, }
+): UseMutationOptions<Awaited<ReturnType<typeof authLogout>>, TError,void, TContext> => {
+
+const mutationKey = ['authLogout'];
+const {mutation: mutationOptions} = options ?
+      options.mutation && 'mutationKey' in options.mutation && options.mutation.mutationKey ?
+      options
+      : {...options, mutation: {...options.mutation, mutationKey}}
+      : {mutation: { mutationKey, }};
+
+      
+
+
+      const mutationFn: MutationFunction<Awaited<ReturnType<typeof authLogout>>, void> = () => {
+          
+
+          return  authLogout()
+        }
+
+        
+
+
+  return  { mutationFn, ...mutationOptions }}
+
+    export type AuthLogoutMutationResult = NonNullable<Awaited<ReturnType<typeof authLogout>>>
+    
+    export type AuthLogoutMutationError = Error
+
+    export const useAuthLogout = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof authLogout>>, TError,void, TContext>, }
+ , queryClient?: QueryClient): UseMutationResult<
+        Awaited<ReturnType<typeof authLogout>>,
+        TError,
+        void,
+        TContext
+      > => {
+
+      const mutationOptions = getAuthLogoutMutationOptions(options);
+
+      return useMutation(mutationOptions, queryClient);
+    }
+    /**
+ * Get active sessions of the logged-in user, newest first
+ */
+export const authListSessions = (
+    
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<SessionList>(
+      {url: `/auth/sessions`, method: 'GET', signal
+    },
+      );
+    }
+  
+
+
+
+export const getAuthListSessionsQueryKey = () => {
+    return [
+    `/auth/sessions`
+    ] as const;
+    }
+
+    
+export const getAuthListSessionsQueryOptions = <TData = Awaited<ReturnType<typeof authListSessions>>, TError = Error>(options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof authListSessions>>, TError, TData>>, }
+) => {
+
+const {query: queryOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getAuthListSessionsQueryKey();
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof authListSessions>>> = ({ signal }) => authListSessions(signal);
+
+      
+
+      
+
+   return  { queryKey, queryFn, ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof authListSessions>>, TError, TData> & { queryKey: DataTag<QueryKey, TData> }
+}
+
+export type AuthListSessionsQueryResult = NonNullable<Awaited<ReturnType<typeof authListSessions>>>
+export type AuthListSessionsQueryError = Error
+
+
+export function useAuthListSessions<TData = Awaited<ReturnType<typeof authListSessions>>, TError = Error>(
+ options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof authListSessions>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof authListSessions>>,
+          TError,
+          Awaited<ReturnType<typeof authListSessions>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+export function useAuthListSessions<TData = Awaited<ReturnType<typeof authListSessions>>, TError = Error>(
+ options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof authListSessions>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof authListSessions>>,
+          TError,
+          Awaited<ReturnType<typeof authListSessions>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+export function useAuthListSessions<TData = Awaited<ReturnType<typeof authListSessions>>, TError = Error>(
+ options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof authListSessions>>, TError, TData>>, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+
+export function useAuthListSessions<TData = Awaited<ReturnType<typeof authListSessions>>, TError = Error>(
+ options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof authListSessions>>, TError, TData>>, }
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> } {
+
+  const queryOptions = getAuthListSessionsQueryOptions(options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+/**
+ * Revoke a session of the logged-in user. Revoking an already revoked session has no effect.
+ */
+export const authRevokeSession = (
+    sessionId: string,
+ ) => {
+      
+      
+      return customInstance<void>(
+      {url: `/auth/sessions/${sessionId}`, method: 'DELETE'
+    },
+      );
+    }
+  
+
+
+export const getAuthRevokeSessionMutationOptions = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof authRevokeSession>>, TError,{sessionId: string}, TContext>, }
+): UseMutationOptions<Awaited<ReturnType<typeof authRevokeSession>>, TError,{sessionId: string}, TContext> => {
+
+const mutationKey = ['authRevokeSession'];
+const {mutation: mutationOptions} = options ?
+      options.mutation && 'mutationKey' in options.mutation && options.mutation.mutationKey ?
+      options
+      : {...options, mutation: {...options.mutation, mutationKey}}
+      : {mutation: { mutationKey, }};
+
+      
+
+
+      const mutationFn: MutationFunction<Awaited<ReturnType<typeof authRevokeSession>>, {sessionId: string}> = (props) => {
+          const {sessionId} = props ?? {};
+
+          return  authRevokeSession(sessionId,)
+        }
+
+        
+
+
+  return  { mutationFn, ...mutationOptions }}
+
+    export type AuthRevokeSessionMutationResult = NonNullable<Awaited<ReturnType<typeof authRevokeSession>>>
+    
+    export type AuthRevokeSessionMutationError = Error
+
+    export const useAuthRevokeSession = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof authRevokeSession>>, TError,{sessionId: string}, TContext>, }
+ , queryClient?: QueryClient): UseMutationResult<
+        Awaited<ReturnType<typeof authRevokeSession>>,
+        TError,
+        {sessionId: string},
+        TContext
+      > => {
+
+      const mutationOptions = getAuthRevokeSessionMutationOptions(options);
+
+      return useMutation(mutationOptions, queryClient);
+    }
+    /**
+ * Verify an email address with the token from the verification email
+ */
+export const authVerifyEmail = (
+    verifyEmailRequest: VerifyEmailRequest,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<void>(
+      {url: `/auth/email-verification/confirm`, method: 'POST',
+      headers: {'Content-Type': 'application/json', },
+      data: verifyEmailRequest, signal
+    },
+      );
+    }
+  
+
+
+export const getAuthVerifyEmailMutationOptions = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof authVerifyEmail>>, TError,{data: VerifyEmailRequest}, TContext>, }
+): UseMutationOptions<Awaited<ReturnType<typeof authVerifyEmail>>, TError,{data: VerifyEmailRequest}, TContext> => {
+
+const mutationKey = ['authVerifyEmail'];
+const {mutation: mutationOptions} = options ?
+      options.mutation && 'mutationKey' in options.mutation && options.mutation.mutationKey ?
+      options
+      : {...options, mutation: {...options.mutation, mutationKey}}
+      : {mutation: { mutationKey, }};
+
+      
+
+
+      const mutationFn: MutationFunction<Awaited<ReturnType<typeof authVerifyEmail>>, {data: VerifyEmailRequest}> = (props) => {
+          const {data} = props ?? {};
+
+          return  authVerifyEmail(data,)
+        }
+
+        
+
+
+  return  { mutationFn, ...mutationOptions }}
+
+    export type AuthVerifyEmailMutationResult = NonNullable<Awaited<ReturnType<typeof authVerifyEmail>>>
+    export type AuthVerifyEmailMutationBody = VerifyEmailRequest
+    export type AuthVerifyEmailMutationError = Error
+
+    export const useAuthVerifyEmail = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof authVerifyEmail>>, TError,{data: VerifyEmailRequest}, TContext>, }
+ , queryClient?: QueryClient): UseMutationResult<
+        Awaited<ReturnType<typeof authVerifyEmail>>,
+        TError,
+        {data: VerifyEmailRequest},
+        TContext
+      > => {
+
+      const mutationOptions = getAuthVerifyEmailMutationOptions(options);
+
+      return useMutation(mutationOptions, queryClient);
+    }
+    /**
+ * Send a password reset email. Always accepted, whether or not the email is registered.
+ */
+export const authRequestPasswordReset = (
+    passwordResetRequest: PasswordResetRequest,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<void>(
+      {url: `/auth/password-reset`, method: 'POST',
+      headers: {'Content-Type': 'application/json', },
+      data: passwordResetRequest, signal
+    },
+      );
+    }
+  
+
+
+export const getAuthRequestPasswordResetMutationOptions = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof authRequestPasswordReset>>, TError,{data: PasswordResetRequest}, TContext>, }
+): UseMutationOptions<Awaited<ReturnType<typeof authRequestPasswordReset>>, TError,{data: PasswordResetRequest}, TContext> => {
+
+const mutationKey = ['authRequestPasswordReset'];
+const {mutation: mutationOptions} = options ?
+      options.mutation && 'mutationKey' in options.mutation && options.mutation.mutationKey ?
+      options
+      : {...options, mutation: {...options.mutation, mutationKey}}
+      : {mutation: { mutationKey, }};
+
+      
+
+
+      const mutationFn: MutationFunction<Awaited<ReturnType<typeof authRequestPasswordReset>>, {data: PasswordResetRequest}> = (props) => {
+          const {data} = props ?? {};
+
+          return  authRequestPasswordReset(data,)
+        }
+
+        
+
+
+  return  { mutationFn, ...mutationOptions }}
+
+    export type AuthRequestPasswordResetMutationResult = NonNullable<Awaited<ReturnType<typeof authRequestPasswordReset>>>
+    export type AuthRequestPasswordResetMutationBody = PasswordResetRequest
+    export type AuthRequestPasswordResetMutationError = Error
+
+    export const useAuthRequestPasswordReset = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof authRequestPasswordReset>>, TError,{data: PasswordResetRequest}, TContext>, }
+ , queryClient?: QueryClient): UseMutationResult<
+        Awaited<ReturnType<typeof authRequestPasswordReset>>,
+        TError,
+        {data: PasswordResetRequest},
+        TContext
+      > => {
+
+      const mutationOptions = getAuthRequestPasswordResetMutationOptions(options);
+
+      return useMutation(mutationOptions, queryClient);
+    }
+    /**
+ * Set a new password with the token from the password reset email.
+ * All sessions of the user are revoked.
+ */
+export const authResetPassword = (
+    resetPasswordRequest: ResetPasswordRequest,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<void>(
+      {url: `/auth/password-reset/confirm`, method: 'POST',
+      headers: {'Content-Type': 'application/json', },
+      data: resetPasswordRequest, signal
+    },
+      );
+    }
+  
+
+
+export const getAuthResetPasswordMutationOptions = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof authResetPassword>>, TError,{data: ResetPasswordRequest}, TContext>, }
+): UseMutationOptions<Awaited<ReturnType<typeof authResetPassword>>, TError,{data: ResetPasswordRequest}, TContext> => {
+
+const mutationKey = ['authResetPassword'];
+const {mutation: mutationOptions} = options ?
+      options.mutation && 'mutationKey' in options.mutation && options.mutation.mutationKey ?
+      options
+      : {...options, mutation: {...options.mutation, mutationKey}}
+      : {mutation: { mutationKey, }};
+
+      
+
+
+      const mutationFn: MutationFunction<Awaited<ReturnType<typeof authResetPassword>>, {data: ResetPasswordRequest}> = (props) => {
+          const {data} = props ?? {};
+
+          return  authResetPassword(data,)
+        }
+
+        
+
+
+  return  { mutationFn, ...mutationOptions }}
+
+    export type AuthResetPasswordMutationResult = NonNullable<Awaited<ReturnType<typeof authResetPassword>>>
+    export type AuthResetPasswordMutationBody = ResetPasswordRequest
+    export type AuthResetPasswordMutationError = Error
+
+    export const useAuthResetPassword = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof authResetPassword>>, TError,{data: ResetPasswordRequest}, TContext>, }
+ , queryClient?: QueryClient): UseMutationResult<
+        Awaited<ReturnType<typeof authResetPassword>>,
+        TError,
+        {data: ResetPasswordRequest},
+        TContext
+      > => {
+
+      const mutationOptions = getAuthResetPasswordMutationOptions(options);
+
+      return useMutation(mutationOptions, queryClient);
+    }
+    
\ No newline at end of file
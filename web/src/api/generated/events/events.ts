@@ -0,0 +1,122 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+import {
+  useQuery
+} from '@tanstack/react-query';
+import type {
+  DataTag,
+  DefinedInitialDataOptions,
+  DefinedUseQueryResult,
+  QueryClient,
+  QueryFunction,
+  QueryKey,
+  UndefinedInitialDataOptions,
+  UseQueryOptions,
+  UseQueryResult
+} from '@tanstack/react-query';
+
+import type {
+  Error
+} from '.././models';
+
+import { customInstance } from '../../axios-instance';
+
+
+
+
+/**
+ * Stream changes to the users of the current organization as Server-Sent Events.
+ * Each event is named after the change (user.created, user.updated or user.deleted),
+ * carries a ChangeEvent as its data and a sequence number as its ID.
+ * A resync event tells the client that changes after Last-Event-ID are no longer retained
+ * and it should refetch everything it displays.
+ */
+export const eventsStreamEvents = (
+    
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<string>(
+      {url: `/events/stream`, method: 'GET', signal
+    },
+      );
+    }
+  
+
+
+
+export const getEventsStreamEventsQueryKey = () => {
+    return [
+    `/events/stream`
+    ] as const;
+    }
+
+    
+export const getEventsStreamEventsQueryOptions = <TData = Awaited<ReturnType<typeof eventsStreamEvents>>, TError = Error>(options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof eventsStreamEvents>>, TError, TData>>, }
+) => {
+
+const {query: queryOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getEventsStreamEventsQueryKey();
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof eventsStreamEvents>>> = ({ signal }) => eventsStreamEvents(signal);
+
+      
+
+      
+
+   return  { queryKey, queryFn, ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof eventsStreamEvents>>, TError, TData> & { queryKey: DataTag<QueryKey, TData> }
+}
+
+export type EventsStreamEventsQueryResult = NonNullable<Awaited<ReturnType<typeof eventsStreamEvents>>>
+export type EventsStreamEventsQueryError = Error
+
+
+export function useEventsStreamEvents<TData = Awaited<ReturnType<typeof eventsStreamEvents>>, TError = Error>(
+ options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof eventsStreamEvents>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof eventsStreamEvents>>,
+          TError,
+          Awaited<ReturnType<typeof eventsStreamEvents>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+export function useEventsStreamEvents<TData = Awaited<ReturnType<typeof eventsStreamEvents>>, TError = Error>(
+ options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof eventsStreamEvents>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof eventsStreamEvents>>,
+          TError,
+          Awaited<ReturnType<typeof eventsStreamEvents>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+export function useEventsStreamEvents<TData = Awaited<ReturnType<typeof eventsStreamEvents>>, TError = Error>(
+ options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof eventsStreamEvents>>, TError, TData>>, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+
+export function useEventsStreamEvents<TData = Awaited<ReturnType<typeof eventsStreamEvents>>, TError = Error>(
+ options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof eventsStreamEvents>>, TError, TData>>, }
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> } {
+
+  const queryOptions = getEventsStreamEventsQueryOptions(options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
@@ -0,0 +1,186 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+import {
+  useMutation,
+  useQuery
+} from '@tanstack/react-query';
+import type {
+  DataTag,
+  DefinedInitialDataOptions,
+  DefinedUseQueryResult,
+  MutationFunction,
+  QueryClient,
+  QueryFunction,
+  QueryKey,
+  UndefinedInitialDataOptions,
+  UseMutationOptions,
+  UseMutationResult,
+  UseQueryOptions,
+  UseQueryResult
+} from '@tanstack/react-query';
+
+import type {
+  Error,
+  InboundEvent,
+  InboundEventList,
+  InboundEventsListInboundEventsParams
+} from '.././models';
+
+import { customInstance } from '../../axios-instance';
+
+
+
+
+/**
+ * Get events received through inbound webhooks, newest first
+ */
+export const inboundEventsListInboundEvents = (
+    params?: InboundEventsListInboundEventsParams,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<InboundEventList>(
+      {url: `/inbound-events`, method: 'GET',
+        params, signal
+    },
+      );
+    }
+  
+
+
+
+export const getInboundEventsListInboundEventsQueryKey = (params?: InboundEventsListInboundEventsParams,) => {
+    return [
+    `/inbound-events`, ...(params ? [params]: [])
+    ] as const;
+    }
+
+    
+export const getInboundEventsListInboundEventsQueryOptions = <TData = Awaited<ReturnType<typeof inboundEventsListInboundEvents>>, TError = Error>(params?: InboundEventsListInboundEventsParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof inboundEventsListInboundEvents>>, TError, TData>>, }
+) => {
+
+const {query: queryOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getInboundEventsListInboundEventsQueryKey(params);
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof inboundEventsListInboundEvents>>> = ({ signal }) => inboundEventsListInboundEvents(params, signal);
+
+      
+
+      
+
+   return  { queryKey, queryFn, ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof inboundEventsListInboundEvents>>, TError, TData> & { queryKey: DataTag<QueryKey, TData> }
+}
+
+export type InboundEventsListInboundEventsQueryResult = NonNullable<Awaited<ReturnType<typeof inboundEventsListInboundEvents>>>
+export type InboundEventsListInboundEventsQueryError = Error
+
+
+export function useInboundEventsListInboundEvents<TData = Awaited<ReturnType<typeof inboundEventsListInboundEvents>>, TError = Error>(
+ params: undefined |  InboundEventsListInboundEventsParams, options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof inboundEventsListInboundEvents>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof inboundEventsListInboundEvents>>,
+          TError,
+          Awaited<ReturnType<typeof inboundEventsListInboundEvents>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+export function useInboundEventsListInboundEvents<TData = Awaited<ReturnType<typeof inboundEventsListInboundEvents>>, TError = Error>(
+ params?: InboundEventsListInboundEventsParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof inboundEventsListInboundEvents>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof inboundEventsListInboundEvents>>,
+          TError,
+          Awaited<ReturnType<typeof inboundEventsListInboundEvents>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+export function useInboundEventsListInboundEvents<TData = Awaited<ReturnType<typeof inboundEventsListInboundEvents>>, TError = Error>(
+ params?: InboundEventsListInboundEventsParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof inboundEventsListInboundEvents>>, TError, TData>>, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+
+export function useInboundEventsListInboundEvents<TData = Awaited<ReturnType<typeof inboundEventsListInboundEvents>>, TError = Error>(
+ params?: InboundEventsListInboundEventsParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof inboundEventsListInboundEvents>>, TError, TData>>, }
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> } {
+
+  const queryOptions = getInboundEventsListInboundEventsQueryOptions(params,options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+/**
+ * Process a stored event again, e.g. after fixing the cause of a failure
+ */
+export const inboundEventsReplayInboundEvent = (
+    inboundEventId: string,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<InboundEvent>(
+      {url: `/inbound-events/${inboundEventId}/replay`, method: 'POST', signal
+    },
+      );
+    }
+  
+
+
+export const getInboundEventsReplayInboundEventMutationOptions = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof inboundEventsReplayInboundEvent>>, TError,{inboundEventId: string}, TContext>, }
+): UseMutationOptions<Awaited<ReturnType<typeof inboundEventsReplayInboundEvent>>, TError,{inboundEventId: string}, TContext> => {
+
+const mutationKey = ['inboundEventsReplayInboundEvent'];
+const {mutation: mutationOptions} = options ?
+      options.mutation && 'mutationKey' in options.mutation && options.mutation.mutationKey ?
+      options
+      : {...options, mutation: {...options.mutation, mutationKey}}
+      : {mutation: { mutationKey, }};
+
+      
+
+
+      const mutationFn: MutationFunction<Awaited<ReturnType<typeof inboundEventsReplayInboundEvent>>, {inboundEventId: string}> = (props) => {
+          const {inboundEventId} = props ?? {};
+
+          return  inboundEventsReplayInboundEvent(inboundEventId,)
+        }
+
+        
+
+
+  return  { mutationFn, ...mutationOptions }}
+
+    export type InboundEventsReplayInboundEventMutationResult = NonNullable<Awaited<ReturnType<typeof inboundEventsReplayInboundEvent>>>
+    
+    export type InboundEventsReplayInboundEventMutationError = Error
+
+    export const useInboundEventsReplayInboundEvent = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof inboundEventsReplayInboundEvent>>, TError,{inboundEventId: string}, TContext>, }
+ , queryClient?: QueryClient): UseMutationResult<
+        Awaited<ReturnType<typeof inboundEventsReplayInboundEvent>>,
+        TError,
+        {inboundEventId: string},
+        TContext
+      > => {
+
+      const mutationOptions = getInboundEventsReplayInboundEventMutationOptions(options);
+
+      return useMutation(mutationOptions, queryClient);
+    }
+    
\ No newline at end of file
@@ -0,0 +1,33 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+
+/**
+ * API key for service-to-service access (the secret is never returned after creation)
+ */
+export interface ApiKey {
+  /**
+   * API key ID (ULID format)
+   * @pattern ^[0-9A-HJKMNP-TV-Z]{26}$
+   */
+  id: string;
+  /** API key name */
+  name: string;
+  /** Public part of the key, used to identify it (e.g. "cqk_1a2b3c4d5e6f7a8b") */
+  prefix: string;
+  /** Scopes granted to the key (e.g. "users:read") */
+  scopes: string[];
+  /** Expiry time (absent when the key does not expire) */
+  expiresAt?: string;
+  /** Time the key was last used to authenticate (recorded at most once a minute) */
+  lastUsedAt?: string;
+  /** Time the key was revoked */
+  revokedAt?: string;
+  /** Principal that created the key (e.g. "user:01ARZ...") */
+  createdBy: string;
+  /** Creation timestamp */
+  createdAt: string;
+}
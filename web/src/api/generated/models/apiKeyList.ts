@@ -0,0 +1,17 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+import type { ApiKey } from './apiKey';
+
+/**
+ * API key list response
+ */
+export interface ApiKeyList {
+  /** List of API keys, newest first */
+  apiKeys: ApiKey[];
+  /** Total number of matching API keys */
+  total: number;
+}
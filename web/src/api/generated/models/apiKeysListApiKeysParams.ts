@@ -0,0 +1,24 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+
+export type ApiKeysListApiKeysParams = {
+/**
+ * Also return revoked API keys
+ */
+includeRevoked?: boolean;
+/**
+ * Maximum number of API keys to return
+ * @minimum 1
+ * @maximum 100
+ */
+limit?: number;
+/**
+ * Number of API keys to skip
+ * @minimum 0
+ */
+offset?: number;
+};
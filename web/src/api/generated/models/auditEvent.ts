@@ -0,0 +1,35 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+import type { AuditEventPayload } from './auditEventPayload';
+import type { AuditRequestMetadata } from './auditRequestMetadata';
+
+/**
+ * Audit event recorded for an action on an aggregate
+ */
+export interface AuditEvent {
+  /**
+   * Audit event ID (ULID format)
+   * @pattern ^[0-9A-HJKMNP-TV-Z]{26}$
+   */
+  id: string;
+  /** Type of the aggregate (e.g. "user", "user_import") */
+  aggregateType: string;
+  /** ID of the aggregate */
+  aggregateId: string;
+  /** Action performed on the aggregate (e.g. "created") */
+  action: string;
+  /** Principal that performed the action (e.g. "user:01ARZ...", "anonymous") */
+  actor: string;
+  /** ID of the request that performed the action */
+  requestId: string;
+  /** Metadata of the request that performed the action */
+  metadata: AuditRequestMetadata;
+  /** Aggregate-specific details of the action */
+  payload: AuditEventPayload;
+  /** Time the action was performed */
+  createdAt: string;
+}
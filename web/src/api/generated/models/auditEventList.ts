@@ -0,0 +1,17 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+import type { AuditEvent } from './auditEvent';
+
+/**
+ * Audit event list response
+ */
+export interface AuditEventList {
+  /** List of audit events, newest first */
+  events: AuditEvent[];
+  /** Total number of matching audit events */
+  total: number;
+}
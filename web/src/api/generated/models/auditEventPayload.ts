@@ -0,0 +1,11 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+
+/**
+ * Aggregate-specific details of the action
+ */
+export type AuditEventPayload = {[key: string]: unknown};
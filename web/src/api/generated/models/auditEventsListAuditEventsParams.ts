@@ -0,0 +1,44 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+
+export type AuditEventsListAuditEventsParams = {
+/**
+ * Only return events for this aggregate type
+ */
+aggregateType?: string;
+/**
+ * Only return events for this aggregate ID
+ */
+aggregateId?: string;
+/**
+ * Only return events with this action
+ */
+action?: string;
+/**
+ * Only return events performed by this principal
+ */
+actor?: string;
+/**
+ * Only return events at or after this time
+ */
+since?: string;
+/**
+ * Only return events before this time
+ */
+until?: string;
+/**
+ * Maximum number of events to return
+ * @minimum 1
+ * @maximum 100
+ */
+limit?: number;
+/**
+ * Number of events to skip
+ * @minimum 0
+ */
+offset?: number;
+};
@@ -0,0 +1,16 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+
+/**
+ * Metadata of the request that performed an audited action
+ */
+export interface AuditRequestMetadata {
+  /** Client IP address */
+  remoteAddr?: string;
+  /** Client User-Agent */
+  userAgent?: string;
+}
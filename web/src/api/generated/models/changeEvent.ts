@@ -0,0 +1,23 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+import type { ChangeEventAggregateType } from './changeEventAggregateType';
+import type { ChangeType } from './changeType';
+
+/**
+ * Change notification sent as the data of an event stream event.
+ * It carries no state of the aggregate, so clients refetch the aggregate to see the change.
+ */
+export interface ChangeEvent {
+  /** Kind of the changed aggregate */
+  aggregateType: ChangeEventAggregateType;
+  /** ID of the changed aggregate */
+  aggregateId: string;
+  /** Kind of change */
+  changeType: ChangeType;
+  /** Time the change occurred */
+  occurredAt: string;
+}
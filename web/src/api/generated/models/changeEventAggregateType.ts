@@ -0,0 +1,17 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+
+/**
+ * Kind of the changed aggregate
+ */
+export type ChangeEventAggregateType = typeof ChangeEventAggregateType[keyof typeof ChangeEventAggregateType];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const ChangeEventAggregateType = {
+  user: 'user',
+} as const;
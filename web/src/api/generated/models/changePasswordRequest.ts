@@ -0,0 +1,23 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+
+/**
+ * Change password request
+ */
+export interface ChangePasswordRequest {
+  /**
+   * Current password (required when users change their own password)
+   * @maxLength 128
+   */
+  currentPassword?: string;
+  /**
+   * New password
+   * @minLength 8
+   * @maxLength 128
+   */
+  newPassword: string;
+}
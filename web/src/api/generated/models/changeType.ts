@@ -0,0 +1,19 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+
+/**
+ * Kind of change notified by the event stream
+ */
+export type ChangeType = typeof ChangeType[keyof typeof ChangeType];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const ChangeType = {
+  created: 'created',
+  updated: 'updated',
+  deleted: 'deleted',
+} as const;
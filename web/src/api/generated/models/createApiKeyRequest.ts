@@ -0,0 +1,22 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+
+/**
+ * Create API key request
+ */
+export interface CreateApiKeyRequest {
+  /**
+   * API key name
+   * @minLength 1
+   * @maxLength 100
+   */
+  name: string;
+  /** Scopes to grant to the key (e.g. "users:read") */
+  scopes?: string[];
+  /** Expiry time (the key does not expire when omitted) */
+  expiresAt?: string;
+}
@@ -0,0 +1,23 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+
+/**
+ * Create organization request
+ */
+export interface CreateOrganizationRequest {
+  /**
+   * Organization name
+   * @minLength 1
+   * @maxLength 100
+   */
+  name: string;
+  /**
+   * Unique name of the organization (lowercase letters, digits and hyphens)
+   * @pattern ^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$
+   */
+  slug: string;
+}
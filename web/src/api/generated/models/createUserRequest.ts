@@ -17,4 +17,10 @@ export interface CreateUserRequest {
   name: string;
   /** User email address */
   email: string;
+  /**
+   * Initial password (the user cannot log in with a password when omitted)
+   * @minLength 8
+   * @maxLength 128
+   */
+  password?: string;
 }
@@ -0,0 +1,24 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+import type { WebhookEventType } from './webhookEventType';
+
+/**
+ * Create webhook request
+ */
+export interface CreateWebhookRequest {
+  /**
+   * http or https URL the events are posted to
+   * @minLength 1
+   * @maxLength 2048
+   */
+  url: string;
+  /**
+   * Kinds of events to deliver
+   * @minItems 1
+   */
+  eventTypes: WebhookEventType[];
+}
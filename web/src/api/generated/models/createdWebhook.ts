@@ -0,0 +1,38 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+import type { WebhookEventType } from './webhookEventType';
+
+/**
+ * Newly created webhook, including its signing secret
+ */
+export interface CreatedWebhook {
+  /**
+   * Webhook ID (ULID format)
+   * @pattern ^[0-9A-HJKMNP-TV-Z]{26}$
+   */
+  id: string;
+  /** URL the events are posted to */
+  url: string;
+  /** Kinds of events delivered to the URL */
+  eventTypes: WebhookEventType[];
+  /** Whether events are delivered. Webhooks are disabled automatically after sustained delivery failures. */
+  active: boolean;
+  /** Number of failed deliveries since the last successful one */
+  consecutiveFailures: number;
+  /** Time the webhook was disabled */
+  disabledAt?: string;
+  /** Error of the last delivery when the webhook was disabled automatically */
+  disabledReason?: string;
+  /** Principal that created the webhook (e.g. "user:01ARZ...") */
+  createdBy: string;
+  /** Creation timestamp */
+  createdAt: string;
+  /** Last update timestamp */
+  updatedAt: string;
+  /** Secret used to sign the requests. It is shown only once and cannot be retrieved later. */
+  secret: string;
+}
@@ -0,0 +1,38 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+import type { InboundEventPayload } from './inboundEventPayload';
+import type { InboundEventStatus } from './inboundEventStatus';
+
+/**
+ * Event received from an external service through an inbound webhook (e.g. a bounce reported by the email provider).
+ * Events are received at POST /inbound-webhooks/{provider}, authenticated by the provider's signature instead of credentials.
+ */
+export interface InboundEvent {
+  /**
+   * Inbound event ID (ULID format)
+   * @pattern ^[0-9A-HJKMNP-TV-Z]{26}$
+   */
+  id: string;
+  /** Provider that sent the event (e.g. "email") */
+  provider: string;
+  /** Event ID assigned by the provider. Events redelivered with the same ID are ignored. */
+  providerEventId: string;
+  /** Normalized kind of the event (e.g. "email.hard_bounced") */
+  eventType: string;
+  /** Subject of the event such as the bounced email address */
+  subject?: string;
+  /** Event as received from the provider */
+  payload: InboundEventPayload;
+  /** Processing status */
+  status: InboundEventStatus;
+  /** Reason the last processing failed */
+  lastError?: string;
+  /** Time the event was received */
+  receivedAt: string;
+  /** Time the event was processed */
+  processedAt?: string;
+}
@@ -0,0 +1,17 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+import type { InboundEvent } from './inboundEvent';
+
+/**
+ * Inbound event list response
+ */
+export interface InboundEventList {
+  /** List of inbound events, newest first */
+  events: InboundEvent[];
+  /** Total number of inbound events */
+  total: number;
+}
@@ -0,0 +1,11 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+
+/**
+ * Event as received from the provider
+ */
+export type InboundEventPayload = {[key: string]: unknown};
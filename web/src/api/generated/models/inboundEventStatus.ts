@@ -0,0 +1,20 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+
+/**
+ * Processing status of an event received through an inbound webhook
+ */
+export type InboundEventStatus = typeof InboundEventStatus[keyof typeof InboundEventStatus];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const InboundEventStatus = {
+  pending: 'pending',
+  processed: 'processed',
+  ignored: 'ignored',
+  failed: 'failed',
+} as const;
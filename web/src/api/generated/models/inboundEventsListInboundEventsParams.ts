@@ -0,0 +1,29 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+import type { InboundEventStatus } from './inboundEventStatus';
+
+export type InboundEventsListInboundEventsParams = {
+/**
+ * Only return events from this provider
+ */
+provider?: string;
+/**
+ * Only return events with this processing status
+ */
+status?: InboundEventStatus;
+/**
+ * Maximum number of events to return
+ * @minimum 1
+ * @maximum 100
+ */
+limit?: number;
+/**
+ * Number of events to skip
+ * @minimum 0
+ */
+offset?: number;
+};
@@ -5,9 +5,69 @@
  * OpenAPI spec version: 0.0.0
  */
 
+export * from './apiKey';
+export * from './apiKeyList';
+export * from './apiKeysListApiKeysParams';
+export * from './auditEvent';
+export * from './auditEventList';
+export * from './auditEventPayload';
+export * from './auditEventsListAuditEventsParams';
+export * from './auditRequestMetadata';
+export * from './changeEvent';
+export * from './changeEventAggregateType';
+export * from './changePasswordRequest';
+export * from './changeType';
+export * from './createApiKeyRequest';
+export * from './createdApiKey';
+export * from './createdWebhook';
+export * from './createOrganizationRequest';
 export * from './createUserRequest';
+export * from './createWebhookRequest';
 export * from './error';
+export * from './inboundEvent';
+export * from './inboundEventList';
+export * from './inboundEventPayload';
+export * from './inboundEventsListInboundEventsParams';
+export * from './inboundEventStatus';
+export * from './loginRequest';
+export * from './membership';
+export * from './membershipList';
+export * from './organization';
+export * from './organizationList';
+export * from './organizationsListMembersParams';
+export * from './passwordResetRequest';
+export * from './resetPasswordRequest';
+export * from './role';
+export * from './session';
+export * from './sessionList';
+export * from './setMembershipRequest';
 export * from './updateUserRequest';
+export * from './updateWebhookRequest';
 export * from './user';
+export * from './userFieldChange';
+export * from './userImport';
+export * from './userImportFormat';
+export * from './userImportRow';
+export * from './userImportRowList';
+export * from './userImportRowStatus';
+export * from './userImportsListUserImportRowsParams';
+export * from './userImportStatus';
 export * from './userList';
-export * from './usersListUsersParams';
\ No newline at end of file
+export * from './userLog';
+export * from './userLogAction';
+export * from './userLogChainBreak';
+export * from './userLogChainBreakReason';
+export * from './userLogChainVerification';
+export * from './userLogChanges';
+export * from './userLogList';
+export * from './usersListUserLogsParams';
+export * from './usersListUsersParams';
+export * from './userSummary';
+export * from './verifyEmailRequest';
+export * from './webhook';
+export * from './webhookDelivery';
+export * from './webhookDeliveryList';
+export * from './webhookEventType';
+export * from './webhookList';
+export * from './webhooksListWebhookDeliveriesParams';
+export * from './webhooksListWebhooksParams';
\ No newline at end of file
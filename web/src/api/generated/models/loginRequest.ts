@@ -0,0 +1,20 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+
+/**
+ * Login request
+ */
+export interface LoginRequest {
+  /** User email address */
+  email: string;
+  /**
+   * Password
+   * @minLength 1
+   * @maxLength 128
+   */
+  password: string;
+}
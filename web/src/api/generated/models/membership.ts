@@ -0,0 +1,21 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+import type { Role } from './role';
+
+/**
+ * Member of an organization
+ */
+export interface Membership {
+  /** User ID (or the subject of an external identity) */
+  userId: string;
+  /** Roles granted to the member within the organization */
+  roles: Role[];
+  /** Time the user joined the organization */
+  createdAt: string;
+  /** Last update timestamp */
+  updatedAt: string;
+}
@@ -0,0 +1,17 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+import type { Membership } from './membership';
+
+/**
+ * Member list response
+ */
+export interface MembershipList {
+  /** Members of the organization, in the order they joined */
+  members: Membership[];
+  /** Total number of members */
+  total: number;
+}
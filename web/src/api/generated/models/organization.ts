@@ -0,0 +1,29 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+
+/**
+ * Organization (tenant)
+ */
+export interface Organization {
+  /**
+   * Organization ID (ULID format), sent in the X-Organization-ID header to act on the organization
+   * @pattern ^[0-9A-HJKMNP-TV-Z]{26}$
+   */
+  id: string;
+  /**
+   * Organization name
+   * @minLength 1
+   * @maxLength 100
+   */
+  name: string;
+  /** Unique name of the organization (lowercase letters, digits and hyphens) */
+  slug: string;
+  /** Creation timestamp */
+  createdAt: string;
+  /** Last update timestamp */
+  updatedAt: string;
+}
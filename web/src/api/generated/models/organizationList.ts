@@ -0,0 +1,15 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+import type { Organization } from './organization';
+
+/**
+ * Organization list response
+ */
+export interface OrganizationList {
+  /** Organizations the user is a member of, sorted by name */
+  organizations: Organization[];
+}
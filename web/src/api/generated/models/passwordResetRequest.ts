@@ -0,0 +1,14 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+
+/**
+ * Password reset request
+ */
+export interface PasswordResetRequest {
+  /** Email address of the account */
+  email: string;
+}
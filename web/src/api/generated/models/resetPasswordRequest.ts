@@ -0,0 +1,24 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+
+/**
+ * Password reset confirmation
+ */
+export interface ResetPasswordRequest {
+  /**
+   * Token from the password reset email
+   * @minLength 1
+   * @maxLength 128
+   */
+  token: string;
+  /**
+   * New password
+   * @minLength 8
+   * @maxLength 128
+   */
+  newPassword: string;
+}
@@ -0,0 +1,20 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+
+/**
+ * Role granted to a member of an organization
+ */
+export type Role = typeof Role[keyof typeof Role];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const Role = {
+  admin: 'admin',
+  user_manager: 'user_manager',
+  auditor: 'auditor',
+  viewer: 'viewer',
+} as const;
@@ -0,0 +1,39 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+
+/**
+ * Login session (the token is only sent in the session cookie)
+ */
+export interface Session {
+  /**
+   * Session ID (ULID format)
+   * @pattern ^[0-9A-HJKMNP-TV-Z]{26}$
+   */
+  id: string;
+  /**
+   * ID of the logged-in user
+   * @pattern ^[0-9A-HJKMNP-TV-Z]{26}$
+   */
+  userId: string;
+  /**
+   * ID of the organization the session is bound to
+   * @pattern ^[0-9A-HJKMNP-TV-Z]{26}$
+   */
+  organizationId: string;
+  /** IP address of the client that logged in */
+  remoteAddr: string;
+  /** User-Agent of the client that logged in */
+  userAgent: string;
+  /** Login timestamp */
+  createdAt: string;
+  /** Time the session was last used (recorded at most once a minute) */
+  lastSeenAt: string;
+  /** Expiry time */
+  expiresAt: string;
+  /** Whether this is the session that made the request */
+  current: boolean;
+}
@@ -0,0 +1,15 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+import type { Session } from './session';
+
+/**
+ * Session list response
+ */
+export interface SessionList {
+  /** Active sessions of the logged-in user, newest first */
+  sessions: Session[];
+}
@@ -0,0 +1,15 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+import type { Role } from './role';
+
+/**
+ * Add member or change member roles request
+ */
+export interface SetMembershipRequest {
+  /** Roles to grant to the member (replaces the current roles) */
+  roles: Role[];
+}
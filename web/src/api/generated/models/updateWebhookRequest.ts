@@ -0,0 +1,26 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+import type { WebhookEventType } from './webhookEventType';
+
+/**
+ * Update webhook request
+ */
+export interface UpdateWebhookRequest {
+  /**
+   * http or https URL the events are posted to
+   * @minLength 1
+   * @maxLength 2048
+   */
+  url?: string;
+  /**
+   * Kinds of events to deliver (replaces the current ones)
+   * @minItems 1
+   */
+  eventTypes?: WebhookEventType[];
+  /** Enable or disable the webhook. Enabling it resets the failure count. */
+  active?: boolean;
+}
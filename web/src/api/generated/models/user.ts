@@ -14,6 +14,11 @@ export interface User {
    * @pattern ^[0-9A-HJKMNP-TV-Z]{26}$
    */
   id: string;
+  /**
+   * ID of the organization the user belongs to
+   * @pattern ^[0-9A-HJKMNP-TV-Z]{26}$
+   */
+  organizationId: string;
   /**
    * User name
    * @minLength 1
@@ -22,6 +27,10 @@ export interface User {
   name: string;
   /** User email address */
   email: string;
+  /** Time the current email address was verified (absent until the verification link is followed) */
+  emailVerifiedAt?: string;
+  /** Time the current email address was reported undeliverable, e.g. by a hard bounce (absent while deliverable) */
+  emailInvalidatedAt?: string;
   /** Creation timestamp */
   createdAt: string;
   /** Last update timestamp */
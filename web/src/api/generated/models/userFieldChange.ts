@@ -0,0 +1,16 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+
+/**
+ * Value of a user field before and after an update
+ */
+export interface UserFieldChange {
+  /** Value before the update */
+  before: string;
+  /** Value after the update */
+  after: string;
+}
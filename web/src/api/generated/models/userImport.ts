@@ -0,0 +1,39 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+import type { UserImportFormat } from './userImportFormat';
+import type { UserImportStatus } from './userImportStatus';
+
+/**
+ * User import model
+ */
+export interface UserImport {
+  /**
+   * Import ID (ULID format)
+   * @pattern ^[0-9A-HJKMNP-TV-Z]{26}$
+   */
+  id: string;
+  /** Uploaded file format */
+  format: UserImportFormat;
+  /** Processing status */
+  status: UserImportStatus;
+  /** Number of rows in the uploaded file */
+  totalRows: number;
+  /** Number of rows that created a user */
+  createdCount: number;
+  /** Number of rows skipped because the email already exists */
+  skippedDuplicateCount: number;
+  /** Number of rows rejected as invalid */
+  invalidCount: number;
+  /** Reason the import failed */
+  error?: string;
+  /** Creation timestamp */
+  createdAt: string;
+  /** Last update timestamp */
+  updatedAt: string;
+  /** Completion timestamp */
+  completedAt?: string;
+}
@@ -0,0 +1,18 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+
+/**
+ * User import file format
+ */
+export type UserImportFormat = typeof UserImportFormat[keyof typeof UserImportFormat];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const UserImportFormat = {
+  csv: 'csv',
+  ndjson: 'ndjson',
+} as const;
@@ -0,0 +1,25 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+import type { UserImportRowStatus } from './userImportRowStatus';
+
+/**
+ * Per-row result of a user import
+ */
+export interface UserImportRow {
+  /** Line number in the uploaded file (1-based, including the CSV header) */
+  line: number;
+  /** Row result */
+  status: UserImportRowStatus;
+  /** Name given in the row */
+  name: string;
+  /** Email given in the row */
+  email: string;
+  /** Created user ID, or the existing user ID for skipped duplicates */
+  userId?: string;
+  /** Reason the row was skipped or rejected */
+  reason?: string;
+}
@@ -0,0 +1,17 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+import type { UserImportRow } from './userImportRow';
+
+/**
+ * User import row list response
+ */
+export interface UserImportRowList {
+  /** List of row results */
+  rows: UserImportRow[];
+  /** Total number of matching rows */
+  total: number;
+}
@@ -0,0 +1,19 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+
+/**
+ * Result of a single imported row
+ */
+export type UserImportRowStatus = typeof UserImportRowStatus[keyof typeof UserImportRowStatus];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const UserImportRowStatus = {
+  created: 'created',
+  skipped_duplicate: 'skipped_duplicate',
+  invalid: 'invalid',
+} as const;
@@ -0,0 +1,20 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+
+/**
+ * User import processing status
+ */
+export type UserImportStatus = typeof UserImportStatus[keyof typeof UserImportStatus];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const UserImportStatus = {
+  pending: 'pending',
+  processing: 'processing',
+  completed: 'completed',
+  failed: 'failed',
+} as const;
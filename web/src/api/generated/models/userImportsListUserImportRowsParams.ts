@@ -0,0 +1,25 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+import type { UserImportRowStatus } from './userImportRowStatus';
+
+export type UserImportsListUserImportRowsParams = {
+/**
+ * Only return rows with this result
+ */
+status?: UserImportRowStatus;
+/**
+ * Maximum number of rows to return
+ * @minimum 1
+ * @maximum 1000
+ */
+limit?: number;
+/**
+ * Number of rows to skip
+ * @minimum 0
+ */
+offset?: number;
+};
@@ -4,14 +4,14 @@
  * User Management API
  * OpenAPI spec version: 0.0.0
  */
-import type { User } from './user';
+import type { UserSummary } from './userSummary';
 
 /**
  * User list response
  */
 export interface UserList {
   /** List of users */
-  users: User[];
+  users: UserSummary[];
   /** Total number of users */
   total: number;
 }
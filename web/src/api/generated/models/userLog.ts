@@ -0,0 +1,34 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+import type { UserLogAction } from './userLogAction';
+import type { UserLogChanges } from './userLogChanges';
+
+/**
+ * User activity log entry
+ */
+export interface UserLog {
+  /**
+   * Log ID (ULID format)
+   * @pattern ^[0-9A-HJKMNP-TV-Z]{26}$
+   */
+  id: string;
+  /**
+   * User ID (ULID format)
+   * @pattern ^[0-9A-HJKMNP-TV-Z]{26}$
+   */
+  userId: string;
+  /** Action performed on the user */
+  action: UserLogAction;
+  /** Changed fields keyed by field name (only for updated) */
+  changes: UserLogChanges;
+  /** Principal that performed the action (e.g. "user:01ARZ...", "anonymous") */
+  actor: string;
+  /** ID of the request that performed the action */
+  requestId: string;
+  /** Time the action was performed */
+  createdAt: string;
+}
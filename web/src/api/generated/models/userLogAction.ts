@@ -0,0 +1,19 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+
+/**
+ * User activity log action
+ */
+export type UserLogAction = typeof UserLogAction[keyof typeof UserLogAction];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const UserLogAction = {
+  created: 'created',
+  updated: 'updated',
+  deleted: 'deleted',
+} as const;
@@ -0,0 +1,19 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+import type { UserLogChainBreakReason } from './userLogChainBreakReason';
+
+/**
+ * First broken link in the user log hash chain
+ */
+export interface UserLogChainBreak {
+  /** Position in the chain where the link is broken */
+  seq: number;
+  /** ID of the log entry found at the broken link (absent when trailing entries were deleted) */
+  logId?: string;
+  /** Reason the link is broken */
+  reason: UserLogChainBreakReason;
+}
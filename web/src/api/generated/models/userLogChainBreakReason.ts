@@ -0,0 +1,21 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+
+/**
+ * Reason the user log hash chain is broken
+ */
+export type UserLogChainBreakReason = typeof UserLogChainBreakReason[keyof typeof UserLogChainBreakReason];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const UserLogChainBreakReason = {
+  hash_mismatch: 'hash_mismatch',
+  prev_hash_mismatch: 'prev_hash_mismatch',
+  sequence_gap: 'sequence_gap',
+  head_mismatch: 'head_mismatch',
+  head_signature_mismatch: 'head_signature_mismatch',
+} as const;
@@ -0,0 +1,21 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+import type { UserLogChainBreak } from './userLogChainBreak';
+
+/**
+ * Result of verifying the user log hash chain of an organization
+ */
+export interface UserLogChainVerification {
+  /** Whether the chain is intact */
+  valid: boolean;
+  /** Number of log entries checked */
+  checkedCount: number;
+  /** Position of the last entry in the chain at the time of verification */
+  headSeq: number;
+  /** First broken link (absent when the chain is intact) */
+  break?: UserLogChainBreak;
+}
@@ -0,0 +1,12 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+import type { UserFieldChange } from './userFieldChange';
+
+/**
+ * Changed fields keyed by field name (only for updated)
+ */
+export type UserLogChanges = {[key: string]: UserFieldChange};
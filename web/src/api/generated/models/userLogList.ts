@@ -0,0 +1,17 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+import type { UserLog } from './userLog';
+
+/**
+ * User activity log list response
+ */
+export interface UserLogList {
+  /** List of log entries, newest first */
+  logs: UserLog[];
+  /** Total number of matching log entries */
+  total: number;
+}
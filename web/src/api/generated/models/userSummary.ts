@@ -0,0 +1,42 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+
+/**
+ * User list item with activity counts
+ */
+export interface UserSummary {
+  /**
+   * User ID (ULID format)
+   * @pattern ^[0-9A-HJKMNP-TV-Z]{26}$
+   */
+  id: string;
+  /**
+   * ID of the organization the user belongs to
+   * @pattern ^[0-9A-HJKMNP-TV-Z]{26}$
+   */
+  organizationId: string;
+  /**
+   * User name
+   * @minLength 1
+   * @maxLength 100
+   */
+  name: string;
+  /** User email address */
+  email: string;
+  /** Time the current email address was verified (absent until the verification link is followed) */
+  emailVerifiedAt?: string;
+  /** Time the current email address was reported undeliverable, e.g. by a hard bounce (absent while deliverable) */
+  emailInvalidatedAt?: string;
+  /** Creation timestamp */
+  createdAt: string;
+  /** Last update timestamp */
+  updatedAt: string;
+  /** Number of activity log entries of the user */
+  logCount: number;
+  /** Time of the latest activity log entry (absent when the user has none) */
+  lastActivityAt?: string;
+}
@@ -0,0 +1,25 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+import type { UserLogAction } from './userLogAction';
+
+export type UsersListUserLogsParams = {
+/**
+ * Only return log entries with this action
+ */
+action?: UserLogAction;
+/**
+ * Maximum number of log entries to return
+ * @minimum 1
+ * @maximum 100
+ */
+limit?: number;
+/**
+ * Number of log entries to skip
+ * @minimum 0
+ */
+offset?: number;
+};
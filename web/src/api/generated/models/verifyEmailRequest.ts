@@ -0,0 +1,18 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+
+/**
+ * Email verification confirmation
+ */
+export interface VerifyEmailRequest {
+  /**
+   * Token from the verification email
+   * @minLength 1
+   * @maxLength 128
+   */
+  token: string;
+}
@@ -0,0 +1,33 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+
+/**
+ * Attempt to deliver an event to a webhook
+ */
+export interface WebhookDelivery {
+  /**
+   * Delivery ID (ULID format)
+   * @pattern ^[0-9A-HJKMNP-TV-Z]{26}$
+   */
+  id: string;
+  /** ID of the delivered event (sent as X-Webhook-ID) */
+  eventId: string;
+  /** Kind of the delivered event */
+  eventType: string;
+  /** Attempt number for the event, starting at 1 */
+  attempt: number;
+  /** HTTP status returned by the receiver (absent when no response was received) */
+  statusCode?: number;
+  /** Whether the receiver accepted the event with a 2xx status */
+  succeeded: boolean;
+  /** Reason of the failure */
+  error?: string;
+  /** Time taken by the request in milliseconds */
+  durationMs: number;
+  /** Time of the attempt */
+  deliveredAt: string;
+}
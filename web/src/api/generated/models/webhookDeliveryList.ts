@@ -0,0 +1,17 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+import type { WebhookDelivery } from './webhookDelivery';
+
+/**
+ * Webhook delivery list response
+ */
+export interface WebhookDeliveryList {
+  /** Delivery attempts, newest first */
+  deliveries: WebhookDelivery[];
+  /** Total number of delivery attempts */
+  total: number;
+}
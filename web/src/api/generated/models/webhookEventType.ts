@@ -0,0 +1,23 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+
+/**
+ * Kind of domain event a webhook can subscribe to
+ */
+export type WebhookEventType = typeof WebhookEventType[keyof typeof WebhookEventType];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const WebhookEventType = {
+  user.created: 'user.created',
+  user.renamed: 'user.renamed',
+  user.email_changed: 'user.email_changed',
+  user.email_verified: 'user.email_verified',
+  user.email_invalidated: 'user.email_invalidated',
+  user.password_changed: 'user.password_changed',
+  user.deleted: 'user.deleted',
+} as const;
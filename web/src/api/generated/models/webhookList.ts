@@ -0,0 +1,17 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+import type { Webhook } from './webhook';
+
+/**
+ * Webhook list response
+ */
+export interface WebhookList {
+  /** List of webhooks, newest first */
+  webhooks: Webhook[];
+  /** Total number of webhooks */
+  total: number;
+}
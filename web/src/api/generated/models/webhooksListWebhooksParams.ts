@@ -0,0 +1,20 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+
+export type WebhooksListWebhooksParams = {
+/**
+ * Maximum number of webhooks to return
+ * @minimum 1
+ * @maximum 100
+ */
+limit?: number;
+/**
+ * Number of webhooks to skip
+ * @minimum 0
+ */
+offset?: number;
+};
@@ -0,0 +1,487 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+import {
+  useMutation,
+  useQuery
+} from '@tanstack/react-query';
+import type {
+  DataTag,
+  DefinedInitialDataOptions,
+  DefinedUseQueryResult,
+  MutationFunction,
+  QueryClient,
+  QueryFunction,
+  QueryKey,
+  UndefinedInitialDataOptions,
+  UseMutationOptions,
+  UseMutationResult,
+  UseQueryOptions,
+  UseQueryResult
+} from '@tanstack/react-query';
+
+import type {
+  CreateOrganizationRequest,
+  Error,
+  Membership,
+  MembershipList,
+  Organization,
+  OrganizationList,
+  OrganizationsListMembersParams,
+  SetMembershipRequest
+} from '.././models';
+
+import { customInstance } from '../../axios-instance';
+
+
+
+
+/**
+ * Get organizations the logged-in user is a member of
+ */
+export const organizationsListOrganizations = (
+    
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<OrganizationList>(
+      {url: `/organizations`, method: 'GET', signal
+    },
+      );
+    }
+  
+
+
+
+export const getOrganizationsListOrganizationsQueryKey = () => {
+    return [
+    `/organizations`
+    ] as const;
+    }
+
+    
+export const getOrganizationsListOrganizationsQueryOptions = <TData = Awaited<ReturnType<typeof organizationsListOrganizations>>, TError = Error>(options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof organizationsListOrganizations>>, TError, TData>>, }
+) => {
+
+const {query: queryOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getOrganizationsListOrganizationsQueryKey();
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof organizationsListOrganizations>>> = ({ signal }) => organizationsListOrganizations(signal);
+
+      
+
+      
+
+   return  { queryKey, queryFn, ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof organizationsListOrganizations>>, TError, TData> & { queryKey: DataTag<QueryKey, TData> }
+}
+
+export type OrganizationsListOrganizationsQueryResult = NonNullable<Awaited<ReturnType<typeof organizationsListOrganizations>>>
+export type OrganizationsListOrganizationsQueryError = Error
+
+
+export function useOrganizationsListOrganizations<TData = Awaited<ReturnType<typeof organizationsListOrganizations>>, TError = Error>(
+ options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof organizationsListOrganizations>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof organizationsListOrganizations>>,
+          TError,
+          Awaited<ReturnType<typeof organizationsListOrganizations>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+export function useOrganizationsListOrganizations<TData = Awaited<ReturnType<typeof organizationsListOrganizations>>, TError = Error>(
+ options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof organizationsListOrganizations>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof organizationsListOrganizations>>,
+          TError,
+          Awaited<ReturnType<typeof organizationsListOrganizations>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+export function useOrganizationsListOrganizations<TData = Awaited<ReturnType<typeof organizationsListOrganizations>>, TError = Error>(
+ options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof organizationsListOrganizations>>, TError, TData>>, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+
+export function useOrganizationsListOrganizations<TData = Awaited<ReturnType<typeof organizationsListOrganizations>>, TError = Error>(
+ options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof organizationsListOrganizations>>, TError, TData>>, }
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> } {
+
+  const queryOptions = getOrganizationsListOrganizationsQueryOptions(options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+/**
+ * Create an organization. The logged-in user becomes its first admin.
+ */
+export const organizationsCreateOrganization = (
+    createOrganizationRequest: CreateOrganizationRequest,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<Organization>(
+      {url: `/organizations`, method: 'POST',
+      headers: {'Content-Type': 'application/json', },
+      data: createOrganizationRequest, signal
+    },
+      );
+    }
+  
+
+
+export const getOrganizationsCreateOrganizationMutationOptions = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof organizationsCreateOrganization>>, TError,{data: CreateOrganizationRequest}, TContext>, }
+): UseMutationOptions<Awaited<ReturnType<typeof organizationsCreateOrganization>>, TError,{data: CreateOrganizationRequest}, TContext> => {
+
+const mutationKey = ['organizationsCreateOrganization'];
+const {mutation: mutationOptions} = options ?
+      options.mutation && 'mutationKey' in options.mutation && options.mutation.mutationKey ?
+      options
+      : {...options, mutation: {...options.mutation, mutationKey}}
+      : {mutation: { mutationKey, }};
+
+      
+
+
+      const mutationFn: MutationFunction<Awaited<ReturnType<typeof organizationsCreateOrganization>>, {data: CreateOrganizationRequest}> = (props) => {
+          const {data} = props ?? {};
+
+          return  organizationsCreateOrganization(data,)
+        }
+
+        
+
+
+  return  { mutationFn, ...mutationOptions }}
+
+    export type OrganizationsCreateOrganizationMutationResult = NonNullable<Awaited<ReturnType<typeof organizationsCreateOrganization>>>
+    export type OrganizationsCreateOrganizationMutationBody = CreateOrganizationRequest
+    export type OrganizationsCreateOrganizationMutationError = Error
+
+    export const useOrganizationsCreateOrganization = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof organizationsCreateOrganization>>, TError,{data: CreateOrganizationRequest}, TContext>, }
+ , queryClient?: QueryClient): UseMutationResult<
+        Awaited<ReturnType<typeof organizationsCreateOrganization>>,
+        TError,
+        {data: CreateOrganizationRequest},
+        TContext
+      > => {
+
+      const mutationOptions = getOrganizationsCreateOrganizationMutationOptions(options);
+
+      return useMutation(mutationOptions, queryClient);
+    }
+    /**
+ * Get the organization the request acts on (selected with the X-Organization-ID header)
+ */
+export const organizationsGetCurrentOrganization = (
+    
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<Organization>(
+      {url: `/organizations/current`, method: 'GET', signal
+    },
+      );
+    }
+  
+
+
+
+export const getOrganizationsGetCurrentOrganizationQueryKey = () => {
+    return [
+    `/organizations/current`
+    ] as const;
+    }
+
+    
+export const getOrganizationsGetCurrentOrganizationQueryOptions = <TData = Awaited<ReturnType<typeof organizationsGetCurrentOrganization>>, TError = Error>(options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof organizationsGetCurrentOrganization>>, TError, TData>>, }
+) => {
+
+const {query: queryOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getOrganizationsGetCurrentOrganizationQueryKey();
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof organizationsGetCurrentOrganization>>> = ({ signal }) => organizationsGetCurrentOrganization(signal);
+
+      
+
+      
+
+   return  { queryKey, queryFn, ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof organizationsGetCurrentOrganization>>, TError, TData> & { queryKey: DataTag<QueryKey, TData> }
+}
+
+export type OrganizationsGetCurrentOrganizationQueryResult = NonNullable<Awaited<ReturnType<typeof organizationsGetCurrentOrganization>>>
+export type OrganizationsGetCurrentOrganizationQueryError = Error
+
+
+export function useOrganizationsGetCurrentOrganization<TData = Awaited<ReturnType<typeof organizationsGetCurrentOrganization>>, TError = Error>(
+ options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof organizationsGetCurrentOrganization>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof organizationsGetCurrentOrganization>>,
+          TError,
+          Awaited<ReturnType<typeof organizationsGetCurrentOrganization>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+export function useOrganizationsGetCurrentOrganization<TData = Awaited<ReturnType<typeof organizationsGetCurrentOrganization>>, TError = Error>(
+ options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof organizationsGetCurrentOrganization>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof organizationsGetCurrentOrganization>>,
+          TError,
+          Awaited<ReturnType<typeof organizationsGetCurrentOrganization>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+export function useOrganizationsGetCurrentOrganization<TData = Awaited<ReturnType<typeof organizationsGetCurrentOrganization>>, TError = Error>(
+ options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof organizationsGetCurrentOrganization>>, TError, TData>>, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+
+export function useOrganizationsGetCurrentOrganization<TData = Awaited<ReturnType<typeof organizationsGetCurrentOrganization>>, TError = Error>(
+ options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof organizationsGetCurrentOrganization>>, TError, TData>>, }
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> } {
+
+  const queryOptions = getOrganizationsGetCurrentOrganizationQueryOptions(options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+/**
+ * Get members of the current organization, in the order they joined
+ */
+export const organizationsListMembers = (
+    params?: OrganizationsListMembersParams,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<MembershipList>(
+      {url: `/organizations/current/members`, method: 'GET',
+        params, signal
+    },
+      );
+    }
+  
+
+
+
+export const getOrganizationsListMembersQueryKey = (params?: OrganizationsListMembersParams,) => {
+    return [
+    `/organizations/current/members`, ...(params ? [params]: [])
+    ] as const;
+    }
+
+    
+export const getOrganizationsListMembersQueryOptions = <TData = Awaited<ReturnType<typeof organizationsListMembers>>, TError = Error>(params?: OrganizationsListMembersParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof organizationsListMembers>>, TError, TData>>, }
+) => {
+
+const {query: queryOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getOrganizationsListMembersQueryKey(params);
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof organizationsListMembers>>> = ({ signal }) => organizationsListMembers(params, signal);
+
+      
+
+      
+
+   return  { queryKey, queryFn, ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof organizationsListMembers>>, TError, TData> & { queryKey: DataTag<QueryKey, TData> }
+}
+
+export type OrganizationsListMembersQueryResult = NonNullable<Awaited<ReturnType<typeof organizationsListMembers>>>
+export type OrganizationsListMembersQueryError = Error
+
+
+export function useOrganizationsListMembers<TData = Awaited<ReturnType<typeof organizationsListMembers>>, TError = Error>(
+ params: undefined |  OrganizationsListMembersParams, options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof organizationsListMembers>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof organizationsListMembers>>,
+          TError,
+          Awaited<ReturnType<typeof organizationsListMembers>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+export function useOrganizationsListMembers<TData = Awaited<ReturnType<typeof organizationsListMembers>>, TError = Error>(
+ params?: OrganizationsListMembersParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof organizationsListMembers>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof organizationsListMembers>>,
+          TError,
+          Awaited<ReturnType<typeof organizationsListMembers>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+export function useOrganizationsListMembers<TData = Awaited<ReturnType<typeof organizationsListMembers>>, TError = Error>(
+ params?: OrganizationsListMembersParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof organizationsListMembers>>, TError, TData>>, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+
+export function useOrganizationsListMembers<TData = Awaited<ReturnType<typeof organizationsListMembers>>, TError = Error>(
+ params?: OrganizationsListMembersParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof organizationsListMembers>>, TError, TData>>, }
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> } {
+
+  const queryOptions = getOrganizationsListMembersQueryOptions(params,options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+/**
+ * Add a user to the current organization, or replace the roles of a member
+ */
+export const organizationsSetMember = (
+    userId: string,
+    setMembershipRequest: SetMembershipRequest,
+ ) => {
+      
+      
+      return customInstance<Membership>(
+      {url: `/organizations/current/members/${userId}`, method: 'PUT',
+      headers: {'Content-Type': 'application/json', },
+      data: setMembershipRequest
+    },
+      );
+    }
+  
+
+
+export const getOrganizationsSetMemberMutationOptions = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof organizationsSetMember>>, TError,{userId: string;data: SetMembershipRequest}, TContext>, }
+): UseMutationOptions<Awaited<ReturnType<typeof organizationsSetMember>>, TError,{userId: string;data: SetMembershipRequest}, TContext> => {
+
+const mutationKey = ['organizationsSetMember'];
+const {mutation: mutationOptions} = options ?
+      options.mutation && 'mutationKey' in options.mutation && options.mutation.mutationKey ?
+      options
+      : {...options, mutation: {...options.mutation, mutationKey}}
+      : {mutation: { mutationKey, }};
+
+      
+
+
+      const mutationFn: MutationFunction<Awaited<ReturnType<typeof organizationsSetMember>>, {userId: string;data: SetMembershipRequest}> = (props) => {
+          const {userId,data} = props ?? {};
+
+          return  organizationsSetMember(userId,data,)
+        }
+
+        
+
+
+  return  { mutationFn, ...mutationOptions }}
+
+    export type OrganizationsSetMemberMutationResult = NonNullable<Awaited<ReturnType<typeof organizationsSetMember>>>
+    export type OrganizationsSetMemberMutationBody = SetMembershipRequest
+    export type OrganizationsSetMemberMutationError = Error
+
+    export const useOrganizationsSetMember = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof organizationsSetMember>>, TError,{userId: string;data: SetMembershipRequest}, TContext>, }
+ , queryClient?: QueryClient): UseMutationResult<
+        Awaited<ReturnType<typeof organizationsSetMember>>,
+        TError,
+        {userId: string;data: SetMembershipRequest},
+        TContext
+      > => {
+
+      const mutationOptions = getOrganizationsSetMemberMutationOptions(options);
+
+      return useMutation(mutationOptions, queryClient);
+    }
+    /**
+ * Remove a member from the current organization. The user itself is not deleted.
+ */
+export const organizationsRemoveMember = (
+    userId: string,
+ ) => {
+      
+      
+      return customInstance<void>(
+      {url: `/organizations/current/members/${userId}`, method: 'DELETE'
+    },
+      );
+    }
+  
+
+
+export const getOrganizationsRemoveMemberMutationOptions = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof organizationsRemoveMember>>, TError,{userId: string}, TContext>, }
+): UseMutationOptions<Awaited<ReturnType<typeof organizationsRemoveMember>>, TError,{userId: string}, TContext> => {
+
+const mutationKey = ['organizationsRemoveMember'];
+const {mutation: mutationOptions} = options ?
+      options.mutation && 'mutationKey' in options.mutation && options.mutation.mutationKey ?
+      options
+      : {...options, mutation: {...options.mutation, mutationKey}}
+      : {mutation: { mutationKey, }};
+
+      
+
+
+      const mutationFn: MutationFunction<Awaited<ReturnType<typeof organizationsRemoveMember>>, {userId: string}> = (props) => {
+          const {userId} = props ?? {};
+
+          return  organizationsRemoveMember(userId,)
+        }
+
+        
+
+
+  return  { mutationFn, ...mutationOptions }}
+
+    export type OrganizationsRemoveMemberMutationResult = NonNullable<Awaited<ReturnType<typeof organizationsRemoveMember>>>
+    
+    export type OrganizationsRemoveMemberMutationError = Error
+
+    export const useOrganizationsRemoveMember = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof organizationsRemoveMember>>, TError,{userId: string}, TContext>, }
+ , queryClient?: QueryClient): UseMutationResult<
+        Awaited<ReturnType<typeof organizationsRemoveMember>>,
+        TError,
+        {userId: string},
+        TContext
+      > => {
+
+      const mutationOptions = getOrganizationsRemoveMemberMutationOptions(options);
+
+      return useMutation(mutationOptions, queryClient);
+    }
+    
\ No newline at end of file
@@ -24,11 +24,17 @@ import type {
 } from '@tanstack/react-query';
 
 import type {
+  ChangePasswordRequest,
   CreateUserRequest,
   Error,
   UpdateUserRequest,
   User,
+  UserImport,
+  UserImportRowList,
+  UserImportsListUserImportRowsParams,
   UserList,
+  UserLogList,
+  UsersListUserLogsParams,
   UsersListUsersParams
 } from '.././models';
 
@@ -135,7 +141,7 @@ export const usersCreateUser = (
 ) => {
       
       
-      return customInstance<void>(
+      return customInstance<User>(
       {url: `/users`, method: 'POST',
       headers: {'Content-Type': 'application/json', },
       data: createUserRequest, signal
@@ -188,6 +194,95 @@ const {mutation: mutationOptions} = options ?
       return useMutation(mutationOptions, queryClient);
     }
     /**
+ * Export all users as CSV or NDJSON, chosen by the Accept header (defaults to CSV).
+ * The response is streamed, so it is not paginated.
+ */
+export const usersExportUsers = (
+    
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<string>(
+      {url: `/users/export`, method: 'GET', signal
+    },
+      );
+    }
+  
+
+
+
+export const getUsersExportUsersQueryKey = () => {
+    return [
+    `/users/export`
+    ] as const;
+    }
+
+    
+export const getUsersExportUsersQueryOptions = <TData = Awaited<ReturnType<typeof usersExportUsers>>, TError = Error>(options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof usersExportUsers>>, TError, TData>>, }
+) => {
+
+const {query: queryOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getUsersExportUsersQueryKey();
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof usersExportUsers>>> = ({ signal }) => usersExportUsers(signal);
+
+      
+
+      
+
+   return  { queryKey, queryFn, ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof usersExportUsers>>, TError, TData> & { queryKey: DataTag<QueryKey, TData> }
+}
+
+export type UsersExportUsersQueryResult = NonNullable<Awaited<ReturnType<typeof usersExportUsers>>>
+export type UsersExportUsersQueryError = Error
+
+
+export function useUsersExportUsers<TData = Awaited<ReturnType<typeof usersExportUsers>>, TError = Error>(
+ options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof usersExportUsers>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof usersExportUsers>>,
+          TError,
+          Awaited<ReturnType<typeof usersExportUsers>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+export function useUsersExportUsers<TData = Awaited<ReturnType<typeof usersExportUsers>>, TError = Error>(
+ options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof usersExportUsers>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof usersExportUsers>>,
+          TError,
+          Awaited<ReturnType<typeof usersExportUsers>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+export function useUsersExportUsers<TData = Awaited<ReturnType<typeof usersExportUsers>>, TError = Error>(
+ options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof usersExportUsers>>, TError, TData>>, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+
+export function useUsersExportUsers<TData = Awaited<ReturnType<typeof usersExportUsers>>, TError = Error>(
+ options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof usersExportUsers>>, TError, TData>>, }
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> } {
+
+  const queryOptions = getUsersExportUsersQueryOptions(options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+/**
  * Get user by ID
  */
 export const usersGetUser = (
@@ -394,4 +489,468 @@ const {mutation: mutationOptions} = options ?
 
       return useMutation(mutationOptions, queryClient);
     }
-    
\ No newline at end of file
+    /**
+ * Change the password of a user. Users changing their own password must send the current one.
+ * All other sessions of the user are revoked.
+ */
+export const usersChangeUserPassword = (
+    userId: string,
+    changePasswordRequest: ChangePasswordRequest,
+ ) => {
+      
+      
+      return customInstance<void>(
+      {url: `/users/${userId}/password`, method: 'PUT',
+      headers: {'Content-Type': 'application/json', },
+      data: changePasswordRequest
+    },
+      );
+    }
+  
+
+
+export const getUsersChangeUserPasswordMutationOptions = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof usersChangeUserPassword>>, TError,{userId: string;data: ChangePasswordRequest}, TContext>, }
+): UseMutationOptions<Awaited<ReturnType<typeof usersChangeUserPassword>>, TError,{userId: string;data: ChangePasswordRequest}, TContext> => {
+
+const mutationKey = ['usersChangeUserPassword'];
+const {mutation: mutationOptions} = options ?
+      options.mutation && 'mutationKey' in options.mutation && options.mutation.mutationKey ?
+      options
+      : {...options, mutation: {...options.mutation, mutationKey}}
+      : {mutation: { mutationKey, }};
+
+      
+
+
+      const mutationFn: MutationFunction<Awaited<ReturnType<typeof usersChangeUserPassword>>, {userId: string;data: ChangePasswordRequest}> = (props) => {
+          const {userId,data} = props ?? {};
+
+          return  usersChangeUserPassword(userId,data,)
+        }
+
+        
+
+
+  return  { mutationFn, ...mutationOptions }}
+
+    export type UsersChangeUserPasswordMutationResult = NonNullable<Awaited<ReturnType<typeof usersChangeUserPassword>>>
+    export type UsersChangeUserPasswordMutationBody = ChangePasswordRequest
+    export type UsersChangeUserPasswordMutationError = Error
+
+    export const useUsersChangeUserPassword = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof usersChangeUserPassword>>, TError,{userId: string;data: ChangePasswordRequest}, TContext>, }
+ , queryClient?: QueryClient): UseMutationResult<
+        Awaited<ReturnType<typeof usersChangeUserPassword>>,
+        TError,
+        {userId: string;data: ChangePasswordRequest},
+        TContext
+      > => {
+
+      const mutationOptions = getUsersChangeUserPasswordMutationOptions(options);
+
+      return useMutation(mutationOptions, queryClient);
+    }
+    /**
+ * Send the verification email for the current email address of a user again.
+ * Does nothing if the address is already verified.
+ */
+export const usersRequestEmailVerification = (
+    userId: string,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<void>(
+      {url: `/users/${userId}/email-verification`, method: 'POST', signal
+    },
+      );
+    }
+  
+
+
+export const getUsersRequestEmailVerificationMutationOptions = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof usersRequestEmailVerification>>, TError,{userId: string}, TContext>, }
+): UseMutationOptions<Awaited<ReturnType<typeof usersRequestEmailVerification>>, TError,{userId: string}, TContext> => {
+
+const mutationKey = ['usersRequestEmailVerification'];
+const {mutation: mutationOptions} = options ?
+      options.mutation && 'mutationKey' in options.mutation && options.mutation.mutationKey ?
+      options
+      : {...options, mutation: {...options.mutation, mutationKey}}
+      : {mutation: { mutationKey, }};
+
+      
+
+
+      const mutationFn: MutationFunction<Awaited<ReturnType<typeof usersRequestEmailVerification>>, {userId: string}> = (props) => {
+          const {userId} = props ?? {};
+
+          return  usersRequestEmailVerification(userId,)
+        }
+
+        
+
+
+  return  { mutationFn, ...mutationOptions }}
+
+    export type UsersRequestEmailVerificationMutationResult = NonNullable<Awaited<ReturnType<typeof usersRequestEmailVerification>>>
+    
+    export type UsersRequestEmailVerificationMutationError = Error
+
+    export const useUsersRequestEmailVerification = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof usersRequestEmailVerification>>, TError,{userId: string}, TContext>, }
+ , queryClient?: QueryClient): UseMutationResult<
+        Awaited<ReturnType<typeof usersRequestEmailVerification>>,
+        TError,
+        {userId: string},
+        TContext
+      > => {
+
+      const mutationOptions = getUsersRequestEmailVerificationMutationOptions(options);
+
+      return useMutation(mutationOptions, queryClient);
+    }
+    /**
+ * Get activity history of a user, newest first.
+ * Also available for deleted users.
+ */
+export const usersListUserLogs = (
+    userId: string,
+    params?: UsersListUserLogsParams,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<UserLogList>(
+      {url: `/users/${userId}/logs`, method: 'GET',
+        params, signal
+    },
+      );
+    }
+  
+
+
+
+export const getUsersListUserLogsQueryKey = (userId?: string,
+    params?: UsersListUserLogsParams,) => {
+    return [
+    `/users/${userId}/logs`, ...(params ? [params]: [])
+    ] as const;
+    }
+
+    
+export const getUsersListUserLogsQueryOptions = <TData = Awaited<ReturnType<typeof usersListUserLogs>>, TError = Error>(userId: string,
+    params?: UsersListUserLogsParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof usersListUserLogs>>, TError, TData>>, }
+) => {
+
+const {query: queryOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getUsersListUserLogsQueryKey(userId,params);
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof usersListUserLogs>>> = ({ signal }) => usersListUserLogs(userId, params, signal);
+
+      
+
+      
+
+   return  { queryKey, queryFn, enabled: !!(userId), ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof usersListUserLogs>>, TError, TData> & { queryKey: DataTag<QueryKey, TData> }
+}
+
+export type UsersListUserLogsQueryResult = NonNullable<Awaited<ReturnType<typeof usersListUserLogs>>>
+export type UsersListUserLogsQueryError = Error
+
+
+export function useUsersListUserLogs<TData = Awaited<ReturnType<typeof usersListUserLogs>>, TError = Error>(
+ userId: string,
+    params: undefined |  UsersListUserLogsParams, options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof usersListUserLogs>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof usersListUserLogs>>,
+          TError,
+          Awaited<ReturnType<typeof usersListUserLogs>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+export function useUsersListUserLogs<TData = Awaited<ReturnType<typeof usersListUserLogs>>, TError = Error>(
+ userId: string,
+    params?: UsersListUserLogsParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof usersListUserLogs>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof usersListUserLogs>>,
+          TError,
+          Awaited<ReturnType<typeof usersListUserLogs>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+export function useUsersListUserLogs<TData = Awaited<ReturnType<typeof usersListUserLogs>>, TError = Error>(
+ userId: string,
+    params?: UsersListUserLogsParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof usersListUserLogs>>, TError, TData>>, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+
+export function useUsersListUserLogs<TData = Awaited<ReturnType<typeof usersListUserLogs>>, TError = Error>(
+ userId: string,
+    params?: UsersListUserLogsParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof usersListUserLogs>>, TError, TData>>, }
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> } {
+
+  const queryOptions = getUsersListUserLogsQueryOptions(userId,params,options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+/**
+ * Import users from a CSV (name, email columns with a header row) or NDJSON file.
+ * Small files are processed immediately; large files are processed in the background.
+ */
+export const userImportsCreateUserImport = (
+    userImportsCreateUserImportBody: string,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<UserImport>(
+      {url: `/users/imports`, method: 'POST',
+      headers: {'Content-Type': 'text/csv', },
+      data: userImportsCreateUserImportBody, signal
+    },
+      );
+    }
+  
+
+
+export const getUserImportsCreateUserImportMutationOptions = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof userImportsCreateUserImport>>, TError,{data: string}, TContext>, }
+): UseMutationOptions<Awaited<ReturnType<typeof userImportsCreateUserImport>>, TError,{data: string}, TContext> => {
+
+const mutationKey = ['userImportsCreateUserImport'];
+const {mutation: mutationOptions} = options ?
+      options.mutation && 'mutationKey' in options.mutation && options.mutation.mutationKey ?
+      options
+      : {...options, mutation: {...options.mutation, mutationKey}}
+      : {mutation: { mutationKey, }};
+
+      
+
+
+      const mutationFn: MutationFunction<Awaited<ReturnType<typeof userImportsCreateUserImport>>, {data: string}> = (props) => {
+          const {data} = props ?? {};
+
+          return  userImportsCreateUserImport(data,)
+        }
+
+        
+
+
+  return  { mutationFn, ...mutationOptions }}
+
+    export type UserImportsCreateUserImportMutationResult = NonNullable<Awaited<ReturnType<typeof userImportsCreateUserImport>>>
+    export type UserImportsCreateUserImportMutationBody = string
+    export type UserImportsCreateUserImportMutationError = Error
+
+    export const useUserImportsCreateUserImport = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof userImportsCreateUserImport>>, TError,{data: string}, TContext>, }
+ , queryClient?: QueryClient): UseMutationResult<
+        Awaited<ReturnType<typeof userImportsCreateUserImport>>,
+        TError,
+        {data: string},
+        TContext
+      > => {
+
+      const mutationOptions = getUserImportsCreateUserImportMutationOptions(options);
+
+      return useMutation(mutationOptions, queryClient);
+    }
+    /**
+ * Get user import status and result counts
+ */
+export const userImportsGetUserImport = (
+    importId: string,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<UserImport>(
+      {url: `/users/imports/${importId}`, method: 'GET', signal
+    },
+      );
+    }
+  
+
+
+
+export const getUserImportsGetUserImportQueryKey = (importId?: string,) => {
+    return [
+    `/users/imports/${importId}`
+    ] as const;
+    }
+
+    
+export const getUserImportsGetUserImportQueryOptions = <TData = Awaited<ReturnType<typeof userImportsGetUserImport>>, TError = Error>(importId: string, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof userImportsGetUserImport>>, TError, TData>>, }
+) => {
+
+const {query: queryOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getUserImportsGetUserImportQueryKey(importId);
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof userImportsGetUserImport>>> = ({ signal }) => userImportsGetUserImport(importId, signal);
+
+      
+
+      
+
+   return  { queryKey, queryFn, enabled: !!(importId), ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof userImportsGetUserImport>>, TError, TData> & { queryKey: DataTag<QueryKey, TData> }
+}
+
+export type UserImportsGetUserImportQueryResult = NonNullable<Awaited<ReturnType<typeof userImportsGetUserImport>>>
+export type UserImportsGetUserImportQueryError = Error
+
+
+export function useUserImportsGetUserImport<TData = Awaited<ReturnType<typeof userImportsGetUserImport>>, TError = Error>(
+ importId: string, options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof userImportsGetUserImport>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof userImportsGetUserImport>>,
+          TError,
+          Awaited<ReturnType<typeof userImportsGetUserImport>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+export function useUserImportsGetUserImport<TData = Awaited<ReturnType<typeof userImportsGetUserImport>>, TError = Error>(
+ importId: string, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof userImportsGetUserImport>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof userImportsGetUserImport>>,
+          TError,
+          Awaited<ReturnType<typeof userImportsGetUserImport>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+export function useUserImportsGetUserImport<TData = Awaited<ReturnType<typeof userImportsGetUserImport>>, TError = Error>(
+ importId: string, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof userImportsGetUserImport>>, TError, TData>>, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+
+export function useUserImportsGetUserImport<TData = Awaited<ReturnType<typeof userImportsGetUserImport>>, TError = Error>(
+ importId: string, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof userImportsGetUserImport>>, TError, TData>>, }
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> } {
+
+  const queryOptions = getUserImportsGetUserImportQueryOptions(importId,options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+/**
+ * Get per-row results of a user import
+ */
+export const userImportsListUserImportRows = (
+    importId: string,
+    params?: UserImportsListUserImportRowsParams,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<UserImportRowList>(
+      {url: `/users/imports/${importId}/rows`, method: 'GET',
+        params, signal
+    },
+      );
+    }
+  
+
+
+
+export const getUserImportsListUserImportRowsQueryKey = (importId?: string,
+    params?: UserImportsListUserImportRowsParams,) => {
+    return [
+    `/users/imports/${importId}/rows`, ...(params ? [params]: [])
+    ] as const;
+    }
+
+    
+export const getUserImportsListUserImportRowsQueryOptions = <TData = Awaited<ReturnType<typeof userImportsListUserImportRows>>, TError = Error>(importId: string,
+    params?: UserImportsListUserImportRowsParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof userImportsListUserImportRows>>, TError, TData>>, }
+) => {
+
+const {query: queryOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getUserImportsListUserImportRowsQueryKey(importId,params);
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof userImportsListUserImportRows>>> = ({ signal }) => userImportsListUserImportRows(importId, params, signal);
+
+      
+
+      
+
+   return  { queryKey, queryFn, enabled: !!(importId), ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof userImportsListUserImportRows>>, TError, TData> & { queryKey: DataTag<QueryKey, TData> }
+}
+
+export type UserImportsListUserImportRowsQueryResult = NonNullable<Awaited<ReturnType<typeof userImportsListUserImportRows>>>
+export type UserImportsListUserImportRowsQueryError = Error
+
+
+export function useUserImportsListUserImportRows<TData = Awaited<ReturnType<typeof userImportsListUserImportRows>>, TError = Error>(
+ importId: string,
+    params: undefined |  UserImportsListUserImportRowsParams, options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof userImportsListUserImportRows>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof userImportsListUserImportRows>>,
+          TError,
+          Awaited<ReturnType<typeof userImportsListUserImportRows>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+export function useUserImportsListUserImportRows<TData = Awaited<ReturnType<typeof userImportsListUserImportRows>>, TError = Error>(
+ importId: string,
+    params?: UserImportsListUserImportRowsParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof userImportsListUserImportRows>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof userImportsListUserImportRows>>,
+          TError,
+          Awaited<ReturnType<typeof userImportsListUserImportRows>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+export function useUserImportsListUserImportRows<TData = Awaited<ReturnType<typeof userImportsListUserImportRows>>, TError = Error>(
+ importId: string,
+    params?: UserImportsListUserImportRowsParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof userImportsListUserImportRows>>, TError, TData>>, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+
+export function useUserImportsListUserImportRows<TData = Awaited<ReturnType<typeof userImportsListUserImportRows>>, TError = Error>(
+ importId: string,
+    params?: UserImportsListUserImportRowsParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof userImportsListUserImportRows>>, TError, TData>>, }
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> } {
+
+  const queryOptions = getUserImportsListUserImportRowsQueryOptions(importId,params,options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
@@ -0,0 +1,495 @@
+/**
+ * Generated by orval v7.14.0 🍺
+ * Do not edit manually.
+ * User Management API
+ * OpenAPI spec version: 0.0.0
+ */
+import {
+  useMutation,
+  useQuery
+} from '@tanstack/react-query';
+import type {
+  DataTag,
+  DefinedInitialDataOptions,
+  DefinedUseQueryResult,
+  MutationFunction,
+  QueryClient,
+  QueryFunction,
+  QueryKey,
+  UndefinedInitialDataOptions,
+  UseMutationOptions,
+  UseMutationResult,
+  UseQueryOptions,
+  UseQueryResult
+} from '@tanstack/react-query';
+
+import type {
+  CreateWebhookRequest,
+  CreatedWebhook,
+  Error,
+  UpdateWebhookRequest,
+  Webhook,
+  WebhookDeliveryList,
+  WebhookList,
+  WebhooksListWebhookDeliveriesParams,
+  WebhooksListWebhooksParams
+} from '.././models';
+
+import { customInstance } from '../../axios-instance';
+
+
+
+
+/**
+ * Get webhooks, newest first
+ */
+export const webhooksListWebhooks = (
+    params?: WebhooksListWebhooksParams,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<WebhookList>(
+      {url: `/webhooks`, method: 'GET',
+        params, signal
+    },
+      );
+    }
+  
+
+
+
+export const getWebhooksListWebhooksQueryKey = (params?: WebhooksListWebhooksParams,) => {
+    return [
+    `/webhooks`, ...(params ? [params]: [])
+    ] as const;
+    }
+
+    
+export const getWebhooksListWebhooksQueryOptions = <TData = Awaited<ReturnType<typeof webhooksListWebhooks>>, TError = Error>(params?: WebhooksListWebhooksParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof webhooksListWebhooks>>, TError, TData>>, }
+) => {
+
+const {query: queryOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getWebhooksListWebhooksQueryKey(params);
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof webhooksListWebhooks>>> = ({ signal }) => webhooksListWebhooks(params, signal);
+
+      
+
+      
+
+   return  { queryKey, queryFn, ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof webhooksListWebhooks>>, TError, TData> & { queryKey: DataTag<QueryKey, TData> }
+}
+
+export type WebhooksListWebhooksQueryResult = NonNullable<Awaited<ReturnType<typeof webhooksListWebhooks>>>
+export type WebhooksListWebhooksQueryError = Error
+
+
+export function useWebhooksListWebhooks<TData = Awaited<ReturnType<typeof webhooksListWebhooks>>, TError = Error>(
+ params: undefined |  WebhooksListWebhooksParams, options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof webhooksListWebhooks>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof webhooksListWebhooks>>,
+          TError,
+          Awaited<ReturnType<typeof webhooksListWebhooks>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+export function useWebhooksListWebhooks<TData = Awaited<ReturnType<typeof webhooksListWebhooks>>, TError = Error>(
+ params?: WebhooksListWebhooksParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof webhooksListWebhooks>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof webhooksListWebhooks>>,
+          TError,
+          Awaited<ReturnType<typeof webhooksListWebhooks>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+export function useWebhooksListWebhooks<TData = Awaited<ReturnType<typeof webhooksListWebhooks>>, TError = Error>(
+ params?: WebhooksListWebhooksParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof webhooksListWebhooks>>, TError, TData>>, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+
+export function useWebhooksListWebhooks<TData = Awaited<ReturnType<typeof webhooksListWebhooks>>, TError = Error>(
+ params?: WebhooksListWebhooksParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof webhooksListWebhooks>>, TError, TData>>, }
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> } {
+
+  const queryOptions = getWebhooksListWebhooksQueryOptions(params,options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+/**
+ * Create a webhook. The signing secret is included in the response only this once.
+ */
+export const webhooksCreateWebhook = (
+    createWebhookRequest: CreateWebhookRequest,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<CreatedWebhook>(
+      {url: `/webhooks`, method: 'POST',
+      headers: {'Content-Type': 'application/json', },
+      data: createWebhookRequest, signal
+    },
+      );
+    }
+  
+
+
+export const getWebhooksCreateWebhookMutationOptions = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof webhooksCreateWebhook>>, TError,{data: CreateWebhookRequest}, TContext>, }
+): UseMutationOptions<Awaited<ReturnType<typeof webhooksCreateWebhook>>, TError,{data: CreateWebhookRequest}, TContext> => {
+
+const mutationKey = ['webhooksCreateWebhook'];
+const {mutation: mutationOptions} = options ?
+      options.mutation && 'mutationKey' in options.mutation && options.mutation.mutationKey ?
+      options
+      : {...options, mutation: {...options.mutation, mutationKey}}
+      : {mutation: { mutationKey, }};
+
+      
+
+
+      const mutationFn: MutationFunction<Awaited<ReturnType<typeof webhooksCreateWebhook>>, {data: CreateWebhookRequest}> = (props) => {
+          const {data} = props ?? {};
+
+          return  webhooksCreateWebhook(data,)
+        }
+
+        
+
+
+  return  { mutationFn, ...mutationOptions }}
+
+    export type WebhooksCreateWebhookMutationResult = NonNullable<Awaited<ReturnType<typeof webhooksCreateWebhook>>>
+    export type WebhooksCreateWebhookMutationBody = CreateWebhookRequest
+    export type WebhooksCreateWebhookMutationError = Error
+
+    export const useWebhooksCreateWebhook = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof webhooksCreateWebhook>>, TError,{data: CreateWebhookRequest}, TContext>, }
+ , queryClient?: QueryClient): UseMutationResult<
+        Awaited<ReturnType<typeof webhooksCreateWebhook>>,
+        TError,
+        {data: CreateWebhookRequest},
+        TContext
+      > => {
+
+      const mutationOptions = getWebhooksCreateWebhookMutationOptions(options);
+
+      return useMutation(mutationOptions, queryClient);
+    }
+    /**
+ * Get webhook by ID
+ */
+export const webhooksGetWebhook = (
+    webhookId: string,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<Webhook>(
+      {url: `/webhooks/${webhookId}`, method: 'GET', signal
+    },
+      );
+    }
+  
+
+
+
+export const getWebhooksGetWebhookQueryKey = (webhookId?: string,) => {
+    return [
+    `/webhooks/${webhookId}`
+    ] as const;
+    }
+
+    
+export const getWebhooksGetWebhookQueryOptions = <TData = Awaited<ReturnType<typeof webhooksGetWebhook>>, TError = Error>(webhookId: string, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof webhooksGetWebhook>>, TError, TData>>, }
+) => {
+
+const {query: queryOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getWebhooksGetWebhookQueryKey(webhookId);
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof webhooksGetWebhook>>> = ({ signal }) => webhooksGetWebhook(webhookId, signal);
+
+      
+
+      
+
+   return  { queryKey, queryFn, enabled: !!(webhookId), ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof webhooksGetWebhook>>, TError, TData> & { queryKey: DataTag<QueryKey, TData> }
+}
+
+export type WebhooksGetWebhookQueryResult = NonNullable<Awaited<ReturnType<typeof webhooksGetWebhook>>>
+export type WebhooksGetWebhookQueryError = Error
+
+
+export function useWebhooksGetWebhook<TData = Awaited<ReturnType<typeof webhooksGetWebhook>>, TError = Error>(
+ webhookId: string, options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof webhooksGetWebhook>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof webhooksGetWebhook>>,
+          TError,
+          Awaited<ReturnType<typeof webhooksGetWebhook>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+export function useWebhooksGetWebhook<TData = Awaited<ReturnType<typeof webhooksGetWebhook>>, TError = Error>(
+ webhookId: string, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof webhooksGetWebhook>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof webhooksGetWebhook>>,
+          TError,
+          Awaited<ReturnType<typeof webhooksGetWebhook>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+export function useWebhooksGetWebhook<TData = Awaited<ReturnType<typeof webhooksGetWebhook>>, TError = Error>(
+ webhookId: string, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof webhooksGetWebhook>>, TError, TData>>, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+
+export function useWebhooksGetWebhook<TData = Awaited<ReturnType<typeof webhooksGetWebhook>>, TError = Error>(
+ webhookId: string, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof webhooksGetWebhook>>, TError, TData>>, }
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> } {
+
+  const queryOptions = getWebhooksGetWebhookQueryOptions(webhookId,options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+/**
+ * Update the URL, event types or status of a webhook
+ */
+export const webhooksUpdateWebhook = (
+    webhookId: string,
+    updateWebhookRequest: UpdateWebhookRequest,
+ ) => {
+      
+      
+      return customInstance<Webhook>(
+      {url: `/webhooks/${webhookId}`, method: 'PUT',
+      headers: {'Content-Type': 'application/json', },
+      data: updateWebhookRequest
+    },
+      );
+    }
+  
+
+
+export const getWebhooksUpdateWebhookMutationOptions = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof webhooksUpdateWebhook>>, TError,{webhookId: string;data: UpdateWebhookRequest}, TContext>, }
+): UseMutationOptions<Awaited<ReturnType<typeof webhooksUpdateWebhook>>, TError,{webhookId: string;data: UpdateWebhookRequest}, TContext> => {
+
+const mutationKey = ['webhooksUpdateWebhook'];
+const {mutation: mutationOptions} = options ?
+      options.mutation && 'mutationKey' in options.mutation && options.mutation.mutationKey ?
+      options
+      : {...options, mutation: {...options.mutation, mutationKey}}
+      : {mutation: { mutationKey, }};
+
+      
+
+
+      const mutationFn: MutationFunction<Awaited<ReturnType<typeof webhooksUpdateWebhook>>, {webhookId: string;data: UpdateWebhookRequest}> = (props) => {
+          const {webhookId,data} = props ?? {};
+
+          return  webhooksUpdateWebhook(webhookId,data,)
+        }
+
+        
+
+
+  return  { mutationFn, ...mutationOptions }}
+
+    export type WebhooksUpdateWebhookMutationResult = NonNullable<Awaited<ReturnType<typeof webhooksUpdateWebhook>>>
+    export type WebhooksUpdateWebhookMutationBody = UpdateWebhookRequest
+    export type WebhooksUpdateWebhookMutationError = Error
+
+    export const useWebhooksUpdateWebhook = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof webhooksUpdateWebhook>>, TError,{webhookId: string;data: UpdateWebhookRequest}, TContext>, }
+ , queryClient?: QueryClient): UseMutationResult<
+        Awaited<ReturnType<typeof webhooksUpdateWebhook>>,
+        TError,
+        {webhookId: string;data: UpdateWebhookRequest},
+        TContext
+      > => {
+
+      const mutationOptions = getWebhooksUpdateWebhookMutationOptions(options);
+
+      return useMutation(mutationOptions, queryClient);
+    }
+    /**
+ * Delete a webhook and its delivery log
+ */
+export const webhooksDeleteWebhook = (
+    webhookId: string,
+ ) => {
+      
+      
+      return customInstance<void>(
+      {url: `/webhooks/${webhookId}`, method: 'DELETE'
+    },
+      );
+    }
+  
+
+
+export const getWebhooksDeleteWebhookMutationOptions = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof webhooksDeleteWebhook>>, TError,{webhookId: string}, TContext>, }
+): UseMutationOptions<Awaited<ReturnType<typeof webhooksDeleteWebhook>>, TError,{webhookId: string}, TContext> => {
+
+const mutationKey = ['webhooksDeleteWebhook'];
+const {mutation: mutationOptions} = options ?
+      options.mutation && 'mutationKey' in options.mutation && options.mutation.mutationKey ?
+      options
+      : {...options, mutation: {...options.mutation, mutationKey}}
+      : {mutation: { mutationKey, }};
+
+      
+
+
+      const mutationFn: MutationFunction<Awaited<ReturnType<typeof webhooksDeleteWebhook>>, {webhookId: string}> = (props) => {
+          const {webhookId} = props ?? {};
+
+          return  webhooksDeleteWebhook(webhookId,)
+        }
+
+        
+
+
+  return  { mutationFn, ...mutationOptions }}
+
+    export type WebhooksDeleteWebhookMutationResult = NonNullable<Awaited<ReturnType<typeof webhooksDeleteWebhook>>>
+    
+    export type WebhooksDeleteWebhookMutationError = Error
+
+    export const useWebhooksDeleteWebhook = <TError = Error,
+    TContext = unknown>(options?: { mutation?:UseMutationOptions<Awaited<ReturnType<typeof webhooksDeleteWebhook>>, TError,{webhookId: string}, TContext>, }
+ , queryClient?: QueryClient): UseMutationResult<
+        Awaited<ReturnType<typeof webhooksDeleteWebhook>>,
+        TError,
+        {webhookId: string},
+        TContext
+      > => {
+
+      const mutationOptions = getWebhooksDeleteWebhookMutationOptions(options);
+
+      return useMutation(mutationOptions, queryClient);
+    }
+    /**
+ * Get the delivery log of a webhook, newest first
+ */
+export const webhooksListWebhookDeliveries = (
+    webhookId: string,
+    params?: WebhooksListWebhookDeliveriesParams,
+ signal?: AbortSignal
+) => {
+      
+      
+      return customInstance<WebhookDeliveryList>(
+      {url: `/webhooks/${webhookId}/deliveries`, method: 'GET',
+        params, signal
+    },
+      );
+    }
+  
+
+
+
+export const getWebhooksListWebhookDeliveriesQueryKey = (webhookId?: string,
+    params?: WebhooksListWebhookDeliveriesParams,) => {
+    return [
+    `/webhooks/${webhookId}/deliveries`, ...(params ? [params]: [])
+    ] as const;
+    }
+
+    
+export const getWebhooksListWebhookDeliveriesQueryOptions = <TData = Awaited<ReturnType<typeof webhooksListWebhookDeliveries>>, TError = Error>(webhookId: string,
+    params?: WebhooksListWebhookDeliveriesParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof webhooksListWebhookDeliveries>>, TError, TData>>, }
+) => {
+
+const {query: queryOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getWebhooksListWebhookDeliveriesQueryKey(webhookId,params);
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof webhooksListWebhookDeliveries>>> = ({ signal }) => webhooksListWebhookDeliveries(webhookId, params, signal);
+
+      
+
+      
+
+   return  { queryKey, queryFn, enabled: !!(webhookId), ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof webhooksListWebhookDeliveries>>, TError, TData> & { queryKey: DataTag<QueryKey, TData> }
+}
+
+export type WebhooksListWebhookDeliveriesQueryResult = NonNullable<Awaited<ReturnType<typeof webhooksListWebhookDeliveries>>>
+export type WebhooksListWebhookDeliveriesQueryError = Error
+
+
+export function useWebhooksListWebhookDeliveries<TData = Awaited<ReturnType<typeof webhooksListWebhookDeliveries>>, TError = Error>(
+ webhookId: string,
+    params: undefined |  WebhooksListWebhookDeliveriesParams, options: { query:Partial<UseQueryOptions<Awaited<ReturnType<typeof webhooksListWebhookDeliveries>>, TError, TData>> & Pick<
+        DefinedInitialDataOptions<
+          Awaited<ReturnType<typeof webhooksListWebhookDeliveries>>,
+          TError,
+          Awaited<ReturnType<typeof webhooksListWebhookDeliveries>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  DefinedUseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+export function useWebhooksListWebhookDeliveries<TData = Awaited<ReturnType<typeof webhooksListWebhookDeliveries>>, TError = Error>(
+ webhookId: string,
+    params?: WebhooksListWebhookDeliveriesParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof webhooksListWebhookDeliveries>>, TError, TData>> & Pick<
+        UndefinedInitialDataOptions<
+          Awaited<ReturnType<typeof webhooksListWebhookDeliveries>>,
+          TError,
+          Awaited<ReturnType<typeof webhooksListWebhookDeliveries>>
+        > , 'initialData'
+      >, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+export function useWebhooksListWebhookDeliveries<TData = Awaited<ReturnType<typeof webhooksListWebhookDeliveries>>, TError = Error>(
+ webhookId: string,
+    params?: WebhooksListWebhookDeliveriesParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof webhooksListWebhookDeliveries>>, TError, TData>>, }
+ , queryClient?: QueryClient
+  ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> }
+
+export function useWebhooksListWebhookDeliveries<TData = Awaited<ReturnType<typeof webhooksListWebhookDeliveries>>, TError = Error>(
+ webhookId: string,
+    params?: WebhooksListWebhookDeliveriesParams, options?: { query?:Partial<UseQueryOptions<Awaited<ReturnType<typeof webhooksListWebhookDeliveries>>, TError, TData>>, }
+ , queryClient?: QueryClient 
+ ):  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> } {
+
+  const queryOptions = getWebhooksListWebhookDeliveriesQueryOptions(webhookId,params,options)
+
+  const query = useQuery(queryOptions, queryClient) as  UseQueryResult<TData, TError> & { queryKey: DataTag<QueryKey, TData> };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+